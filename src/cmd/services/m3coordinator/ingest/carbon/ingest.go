@@ -58,6 +58,7 @@ const (
 	maxResourcePoolNameSize = 1024
 	maxPooledTagsSize       = 16
 	defaultResourcePoolSize = 4096
+	maxPacketSize           = 65535
 )
 
 var (
@@ -98,18 +99,37 @@ func (o *Options) Validate() error {
 	return nil
 }
 
+// Ingester is a carbon ingester which handles connections using the carbon
+// plaintext protocol and can also ingest the carbon pickle protocol and
+// plaintext metrics sent over UDP, all sharing the same ingestion rules.
+type Ingester interface {
+	m3xserver.Handler
+
+	// PickleHandler returns a handler for connections using the carbon
+	// pickle protocol.
+	PickleHandler() m3xserver.Handler
+
+	// ServePacketConn ingests carbon plaintext metrics from the packets read
+	// from the connection until reading fails, e.g. when it is closed.
+	ServePacketConn(conn net.PacketConn) error
+}
+
 // NewIngester returns an ingester for carbon metrics.
 func NewIngester(
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
 	clusterNamespacesWatcher m3.ClusterNamespacesWatcher,
 	opts Options,
-) (m3xserver.Handler, error) {
+) (Ingester, error) {
 	err := opts.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	tagOpts := models.NewTagOptions().SetIDSchemeType(models.TypeGraphite)
+	// NB: tagged IDs keep the ID of a carbon series in the tagged syntax
+	// "a.b.c;k=v" equal to its name, as it was before the tags were parsed.
+	tagOpts := models.NewTagOptions().
+		SetIDSchemeType(models.TypeGraphite).
+		SetGraphiteTaggedIDs(true)
 	err = tagOpts.Validate()
 	if err != nil {
 		return nil, err
@@ -277,13 +297,37 @@ func (i *ingester) regenerateIngestionRulesWithLock(clusterNamespaces m3.Cluster
 }
 
 func (i *ingester) Handle(conn net.Conn) {
+	s := carbon.NewScanner(conn, i.opts.InstrumentOptions)
+	if err := i.handle(s, &s.MalformedCount); err != nil {
+		i.logger.Error("encountered error during carbon ingestion when scanning connection", zap.Error(err))
+	}
+
+	// Don't close the connection, that is the server's responsibility.
+}
+
+func (i *ingester) PickleHandler() m3xserver.Handler {
+	return &pickleHandler{ingester: i}
+}
+
+func (i *ingester) ServePacketConn(conn net.PacketConn) error {
+	s := newPacketScanner(conn)
+	return i.handle(s, &s.malformedCount)
+}
+
+// metricScanner is the scanner of the protocols the ingester supports.
+type metricScanner interface {
+	Scan() bool
+	Metric() ([]byte, time.Time, float64)
+	Err() error
+}
+
+func (i *ingester) handle(s metricScanner, malformedCount *int) error {
 	var (
 		// Interfaces require a context be passed, but M3DB client already has timeouts
 		// built in and allocating a new context each time is expensive so we just pass
 		// the same context always and rely on M3DB client timeouts.
 		ctx     = context.Background()
 		wg      = sync.WaitGroup{}
-		s       = carbon.NewScanner(conn, i.opts.InstrumentOptions)
 		logger  = i.opts.InstrumentOptions.Logger()
 		rewrite = &i.opts.IngesterConfig.Rewrite
 	)
@@ -328,19 +372,19 @@ func (i *ingester) Handle(conn net.Conn) {
 			i.opts.DynamicWorkerPool.GoAlways(work)
 		}
 
-		i.metrics.malformed.Inc(int64(s.MalformedCount))
-		s.MalformedCount = 0
+		i.metrics.malformed.Inc(int64(*malformedCount))
+		*malformedCount = 0
 	}
 
-	if err := s.Err(); err != nil {
-		logger.Error("encountered error during carbon ingestion when scanning connection", zap.Error(err))
-	}
+	// Count any malformed metrics found after the last valid one.
+	i.metrics.malformed.Inc(int64(*malformedCount))
+	*malformedCount = 0
 
 	logger.Debug("waiting for outstanding carbon ingestion writes to complete")
 	wg.Wait()
 	logger.Debug("all outstanding writes completed, shutting down carbon ingestion handler")
 
-	// Don't close the connection, that is the server's responsibility.
+	return s.Err()
 }
 
 func (i *ingester) write(
@@ -457,6 +501,67 @@ func (i *ingester) Close() {
 	// We don't maintain any state in-between connections so there is nothing to do here.
 }
 
+type pickleHandler struct {
+	ingester *ingester
+}
+
+func (h *pickleHandler) Handle(conn net.Conn) {
+	s := carbon.NewPickleScanner(conn, h.ingester.opts.InstrumentOptions)
+	if err := h.ingester.handle(s, &s.MalformedCount); err != nil {
+		h.ingester.logger.Error("encountered error during carbon pickle ingestion when scanning connection",
+			zap.Error(err))
+	}
+
+	// Don't close the connection, that is the server's responsibility.
+}
+
+func (h *pickleHandler) Close() {
+	// Pickle connections share the state of the ingester, nothing to do here.
+}
+
+// packetScanner scans carbon plaintext metrics from the packets read from a
+// packet connection, with each packet holding one or more lines.
+type packetScanner struct {
+	conn           net.PacketConn
+	buf            []byte
+	metrics        []carbon.Metric
+	idx            int
+	err            error
+	malformedCount int
+}
+
+func newPacketScanner(conn net.PacketConn) *packetScanner {
+	return &packetScanner{
+		conn: conn,
+		buf:  make([]byte, maxPacketSize),
+	}
+}
+
+func (s *packetScanner) Scan() bool {
+	for s.idx >= len(s.metrics) {
+		n, _, err := s.conn.ReadFrom(s.buf)
+		if err != nil {
+			s.err = err
+			return false
+		}
+
+		var malformed int
+		s.metrics, malformed = carbon.ParseAndAppendPacket(s.metrics[:0], s.buf[:n])
+		s.malformedCount += malformed
+		s.idx = 0
+	}
+
+	s.idx++
+	return true
+}
+
+func (s *packetScanner) Metric() ([]byte, time.Time, float64) {
+	m := s.metrics[s.idx-1]
+	return m.Name, m.Time, m.Val
+}
+
+func (s *packetScanner) Err() error { return s.err }
+
 type carbonIngesterMetrics struct {
 	success       tally.Counter
 	err           tally.Counter
@@ -486,6 +591,13 @@ func newCarbonIngesterMetrics(scope tally.Scope) (carbonIngesterMetrics, error)
 //      __g0__:foo
 //      __g1__:bar
 //      __g2__:baz
+// Names using the graphite tag syntax have their tags appended as real tags
+// rather than path components, such that an input like:
+//      foo.bar;dc=east
+// becomes
+//      __g0__:foo
+//      __g1__:bar
+//      dc:east
 func GenerateTagsFromName(
 	name []byte,
 	opts models.TagOptions,
@@ -512,7 +624,14 @@ func generateTagsFromName(
 		return models.EmptyTags(), errCannotGenerateTagsFromEmptyName
 	}
 
-	numTags := bytes.Count(name, carbonSeparatorBytes) + 1
+	fullName := name
+	name, taggedTags, err := carbon.ParseTaggedName(fullName, nil)
+	if err != nil {
+		return models.EmptyTags(),
+			fmt.Errorf("carbon metric: %s has invalid tags: %v", string(fullName), err)
+	}
+
+	numTags := bytes.Count(name, carbonSeparatorBytes) + 1 + len(taggedTags)
 
 	if cap(tags) >= numTags {
 		tags = tags[:0]
@@ -554,7 +673,24 @@ func generateTagsFromName(
 		})
 	}
 
-	return models.Tags{Opts: opts, Tags: tags}, nil
+	if len(taggedTags) == 0 {
+		return models.Tags{Opts: opts, Tags: tags}, nil
+	}
+
+	for _, tag := range taggedTags {
+		// Disallow tags that would collide with the path tags or the name
+		// tag that tagged series are queried by.
+		if _, isPathTag := graphite.TagIndex(tag.Name); isPathTag ||
+			string(tag.Name) == graphite.NameTag {
+			return models.EmptyTags(),
+				fmt.Errorf("carbon metric: %s has reserved tag: %s",
+					string(fullName), string(tag.Name))
+		}
+
+		tags = append(tags, models.Tag{Name: tag.Name, Value: tag.Value})
+	}
+
+	return models.Tags{Opts: opts, Tags: tags}.Normalize(), nil
 }
 
 // Compile all the carbon ingestion rules into matcher so that we can
@@ -23,6 +23,7 @@ package ingestcarbon
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -52,6 +53,7 @@ import (
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hydrogen18/stalecucumber"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,7 +75,8 @@ var (
 	}
 
 	testTagOpts = models.NewTagOptions().
-			SetIDSchemeType(models.TypeGraphite)
+			SetIDSchemeType(models.TypeGraphite).
+			SetGraphiteTaggedIDs(true)
 
 	testRulesMatchAll = CarbonIngesterRules{
 		Rules: []config.CarbonIngesterRuleConfiguration{
@@ -257,6 +260,122 @@ func TestIngesterHandleConn(t *testing.T) {
 	assertTestMetricsAreEqual(t, testMetrics, found)
 }
 
+func TestIngesterHandleTaggedConn(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter, found := newMockDownsamplerAndWriter(ctrl,
+		func(_ []downsample.AutoMappingRule) {})
+
+	session := client.NewMockSession(ctrl)
+	watcher := newTestWatcher(t, session, m3.AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("10s:48h"),
+		Resolution:  10 * time.Second,
+		Retention:   48 * time.Hour,
+		Session:     session,
+	})
+
+	packet := "foo.bar;env=prod;dc=east 1 1\nfoo.bar;dc 2 2\n"
+	byteConn := &byteConn{b: bytes.NewBufferString(packet)}
+	ingester, err := NewIngester(mockDownsamplerAndWriter, watcher, newTestOpts(testRulesMatchAll))
+	require.NoError(t, err)
+	ingester.Handle(byteConn)
+
+	// The metric with the malformed tag is never written.
+	require.Equal(t, 1, len(*found))
+	tags := (*found)[0].tags
+	assert.Equal(t, "foo.bar;dc=east;env=prod", string(tags.ID()))
+	value, ok := tags.Get([]byte("dc"))
+	require.True(t, ok)
+	assert.Equal(t, "east", string(value))
+	value, ok = tags.Get(graphite.TagName(1))
+	require.True(t, ok)
+	assert.Equal(t, "bar", string(value))
+}
+
+func TestIngesterPickleHandleConn(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter, found := newMockDownsamplerAndWriter(ctrl,
+		func(_ []downsample.AutoMappingRule) {})
+
+	session := client.NewMockSession(ctrl)
+	watcher := newTestWatcher(t, session, m3.AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("10s:48h"),
+		Resolution:  10 * time.Second,
+		Retention:   48 * time.Hour,
+		Session:     session,
+	})
+
+	var (
+		buf     bytes.Buffer
+		batch   []interface{}
+		flushFn = func() {
+			var payload bytes.Buffer
+			_, err := stalecucumber.NewPickler(&payload).Pickle(batch)
+			require.NoError(t, err)
+
+			var header [4]byte
+			binary.BigEndian.PutUint32(header[:], uint32(payload.Len()))
+			buf.Write(header[:])
+			buf.Write(payload.Bytes())
+			batch = batch[:0]
+		}
+	)
+	for _, metric := range testMetrics {
+		batch = append(batch, stalecucumber.NewTuple(string(metric.metric),
+			stalecucumber.NewTuple(int64(metric.timestamp), metric.value)))
+		if len(batch) == 500 {
+			flushFn()
+		}
+	}
+	flushFn()
+
+	ingester, err := NewIngester(mockDownsamplerAndWriter, watcher, newTestOpts(testRulesMatchAll))
+	require.NoError(t, err)
+	ingester.PickleHandler().Handle(&byteConn{b: &buf})
+
+	assertTestMetricsAreEqual(t, testMetrics, *found)
+}
+
+func TestIngesterServePacketConn(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter, found := newMockDownsamplerAndWriter(ctrl,
+		func(_ []downsample.AutoMappingRule) {})
+
+	session := client.NewMockSession(ctrl)
+	watcher := newTestWatcher(t, session, m3.AggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("10s:48h"),
+		Resolution:  10 * time.Second,
+		Retention:   48 * time.Hour,
+		Session:     session,
+	})
+
+	// Split the test packet into packets of at most 100 lines each.
+	var (
+		packets [][]byte
+		lines   = bytes.SplitAfter(testPacket, []byte("\n"))
+	)
+	for len(lines) > 0 {
+		n := 100
+		if n > len(lines) {
+			n = len(lines)
+		}
+		packets = append(packets, bytes.Join(lines[:n], nil))
+		lines = lines[n:]
+	}
+
+	ingester, err := NewIngester(mockDownsamplerAndWriter, watcher, newTestOpts(testRulesMatchAll))
+	require.NoError(t, err)
+	err = ingester.ServePacketConn(&bytePacketConn{packets: packets})
+	require.Equal(t, io.EOF, err)
+
+	assertTestMetricsAreEqual(t, testMetrics, *found)
+}
+
 func TestIngesterHonorsMatchers(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -604,9 +723,36 @@ func TestGenerateTagsFromName(t *testing.T) {
 			expectedErr:  fmt.Errorf("carbon metric: foo.bar.baz.. has duplicate separator"),
 			expectedTags: []models.Tag{},
 		},
+		{
+			name: "foo.bar;env=prod;dc=east",
+			id:   "foo.bar;dc=east;env=prod",
+			expectedTags: []models.Tag{
+				{Name: []byte("dc"), Value: []byte("east")},
+				{Name: []byte("env"), Value: []byte("prod")},
+				{Name: graphite.TagName(0), Value: []byte("foo")},
+				{Name: graphite.TagName(1), Value: []byte("bar")},
+			},
+		},
+		{
+			name:         "foo.bar;dc",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;dc has invalid tags: invalid tag, expected tag=value"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;name=baz",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;name=baz has reserved tag: name"),
+			expectedTags: []models.Tag{},
+		},
+		{
+			name:         "foo.bar;__g0__=baz",
+			expectedErr:  fmt.Errorf("carbon metric: foo.bar;__g0__=baz has reserved tag: __g0__"),
+			expectedTags: []models.Tag{},
+		},
 	}
 
-	opts := models.NewTagOptions().SetIDSchemeType(models.TypeGraphite)
+	opts := models.NewTagOptions().
+		SetIDSchemeType(models.TypeGraphite).
+		SetGraphiteTaggedIDs(true)
 	for _, tc := range testCases {
 		tags, err := GenerateTagsFromName([]byte(tc.name), opts)
 		if tc.expectedErr != nil {
@@ -698,6 +844,47 @@ func (b *byteConn) SetWriteDeadline(t time.Time) error {
 	panic("not_implemented")
 }
 
+// bytePacketConn implements the net.PacketConn interface so that we can test
+// reading packets without going over the network.
+type bytePacketConn struct {
+	packets [][]byte
+}
+
+func (b *bytePacketConn) ReadFrom(buf []byte) (int, net.Addr, error) {
+	if len(b.packets) == 0 {
+		return 0, nil, io.EOF
+	}
+
+	n := copy(buf, b.packets[0])
+	b.packets = b.packets[1:]
+	return n, nil, nil
+}
+
+func (b *bytePacketConn) WriteTo(buf []byte, addr net.Addr) (int, error) {
+	panic("not_implemented")
+}
+
+func (b *bytePacketConn) Close() error {
+	b.packets = nil
+	return nil
+}
+
+func (b *bytePacketConn) LocalAddr() net.Addr {
+	panic("not_implemented")
+}
+
+func (b *bytePacketConn) SetDeadline(t time.Time) error {
+	panic("not_implemented")
+}
+
+func (b *bytePacketConn) SetReadDeadline(t time.Time) error {
+	panic("not_implemented")
+}
+
+func (b *bytePacketConn) SetWriteDeadline(t time.Time) error {
+	panic("not_implemented")
+}
+
 type testMetric struct {
 	metric    []byte
 	tags      models.Tags
@@ -747,7 +934,9 @@ func init() {
 
 		metric = []byte(fmt.Sprintf("test.metric.%d", i))
 
-		opts := models.NewTagOptions().SetIDSchemeType(models.TypeGraphite)
+		opts := models.NewTagOptions().
+			SetIDSchemeType(models.TypeGraphite).
+			SetGraphiteTaggedIDs(true)
 		tags, err := GenerateTagsFromName(metric, opts)
 		if err != nil {
 			panic(err)
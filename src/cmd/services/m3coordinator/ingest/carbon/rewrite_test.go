@@ -113,6 +113,14 @@ func TestCopyAndRewrite(t *testing.T) {
 				Cleanup: true,
 			},
 		},
+		{
+			name:     "tagged name only rewrites path with rewrite cleanup",
+			input:    "foo$$..bar.;dc=us-east;env=prod",
+			expected: "foo_.bar;dc=us-east;env=prod",
+			cfg: &config.CarbonIngesterRewriteConfiguration{
+				Cleanup: true,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,290 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestopentsdb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+const (
+	putCommand = "put"
+
+	// secondsMask is set for timestamps that are too large to be in seconds,
+	// OpenTSDB treats such timestamps as milliseconds.
+	secondsMask = int64(-1) << 32
+)
+
+var (
+	errMissingMetric    = errors.New("missing metric")
+	errMissingTags      = errors.New("missing tags, at least one tag is required")
+	errInvalidTimestamp = errors.New("invalid timestamp, must be positive")
+	errInvalidValue     = errors.New("invalid value, must be finite")
+)
+
+// Datapoint is a single OpenTSDB datapoint as written by the put APIs.
+type Datapoint struct {
+	Metric    string            `json:"metric"`
+	Timestamp int64             `json:"timestamp"`
+	Value     float64           `json:"value"`
+	Tags      map[string]string `json:"tags"`
+}
+
+type jsonDatapoint struct {
+	Metric    string            `json:"metric"`
+	Timestamp json.Number       `json:"timestamp"`
+	Value     json.Number       `json:"value"`
+	Tags      map[string]string `json:"tags"`
+}
+
+// UnmarshalJSON unmarshals a datapoint, accepting the timestamp and value as
+// either JSON numbers or strings as OpenTSDB does.
+func (d *Datapoint) UnmarshalJSON(data []byte) error {
+	var dp jsonDatapoint
+	if err := json.Unmarshal(data, &dp); err != nil {
+		return err
+	}
+
+	timestamp, err := parseTimestamp(string(dp.Timestamp))
+	if err != nil {
+		return err
+	}
+	value, err := parseValue(string(dp.Value))
+	if err != nil {
+		return err
+	}
+
+	*d = Datapoint{
+		Metric:    dp.Metric,
+		Timestamp: timestamp,
+		Value:     value,
+		Tags:      dp.Tags,
+	}
+	return nil
+}
+
+// ParsePutLine parses a telnet style put command of the form:
+//
+//	put <metric> <timestamp> <value> <tagk1=tagv1[ tagk2=tagv2 ...]>
+func ParsePutLine(line string) (Datapoint, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 || fields[0] != putCommand {
+		return Datapoint{}, fmt.Errorf("not a put command: %q", line)
+	}
+	if len(fields) < 5 {
+		return Datapoint{}, fmt.Errorf(
+			"not enough arguments (need at least 4, got %d)", len(fields)-1)
+	}
+
+	timestamp, err := parseTimestamp(fields[2])
+	if err != nil {
+		return Datapoint{}, err
+	}
+	value, err := parseValue(fields[3])
+	if err != nil {
+		return Datapoint{}, err
+	}
+
+	tags := make(map[string]string, len(fields)-4)
+	for _, field := range fields[4:] {
+		idx := strings.IndexByte(field, '=')
+		if idx <= 0 || idx == len(field)-1 {
+			return Datapoint{}, fmt.Errorf("invalid tag: %s", field)
+		}
+		name, value := field[:idx], field[idx+1:]
+		if _, ok := tags[name]; ok {
+			return Datapoint{}, fmt.Errorf("duplicate tag: %s", name)
+		}
+		tags[name] = value
+	}
+
+	return Datapoint{
+		Metric:    fields[1],
+		Timestamp: timestamp,
+		Value:     value,
+		Tags:      tags,
+	}, nil
+}
+
+func parseTimestamp(s string) (int64, error) {
+	timestamp, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q: %v", s, err)
+	}
+	return timestamp, nil
+}
+
+func parseValue(s string) (float64, error) {
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q: %v", s, err)
+	}
+	return value, nil
+}
+
+// Validate validates the datapoint, reserved tag names are checked against
+// the given tag options.
+func (d Datapoint) Validate(tagOpts models.TagOptions) error {
+	if d.Metric == "" {
+		return errMissingMetric
+	}
+	if err := validateString("metric", d.Metric); err != nil {
+		return err
+	}
+	if d.Timestamp <= 0 {
+		return errInvalidTimestamp
+	}
+	if math.IsNaN(d.Value) || math.IsInf(d.Value, 0) {
+		return errInvalidValue
+	}
+	if len(d.Tags) == 0 {
+		return errMissingTags
+	}
+
+	metricName := string(tagOpts.MetricName())
+	for name, value := range d.Tags {
+		if name == metricName {
+			return fmt.Errorf("tag name %s is reserved", name)
+		}
+		if err := validateString("tag name", name); err != nil {
+			return err
+		}
+		if err := validateString("tag value", value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateString validates s only uses the characters OpenTSDB allows in
+// metric names and tags.
+func validateString(kind, s string) error {
+	if s == "" {
+		return fmt.Errorf("empty %s", kind)
+	}
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) ||
+			r == '-' || r == '_' || r == '.' || r == '/' {
+			continue
+		}
+		return fmt.Errorf("invalid %s %q: illegal character %q", kind, s, r)
+	}
+	return nil
+}
+
+// TimeAndUnit returns the time of the datapoint and the unit it was written
+// with, timestamps too large to be in seconds are in milliseconds.
+func (d Datapoint) TimeAndUnit() (xtime.UnixNano, xtime.Unit) {
+	if d.Timestamp&secondsMask != 0 {
+		return xtime.FromNormalizedTime(d.Timestamp, time.Millisecond), xtime.Millisecond
+	}
+	return xtime.FromSeconds(d.Timestamp), xtime.Second
+}
+
+// ModelTags returns the datapoint's metric and tags as model tags, with the
+// metric stored as the metric name tag.
+func (d Datapoint) ModelTags(tagOpts models.TagOptions) models.Tags {
+	tags := models.NewTags(len(d.Tags)+1, tagOpts)
+	tags = tags.AddTagWithoutNormalizing(models.Tag{
+		Name:  tagOpts.MetricName(),
+		Value: []byte(d.Metric),
+	})
+	for name, value := range d.Tags {
+		tags = tags.AddTagWithoutNormalizing(models.Tag{
+			Name:  []byte(name),
+			Value: []byte(value),
+		})
+	}
+	return tags.Normalize()
+}
+
+type datapointIter struct {
+	datapoints []Datapoint
+	tagOpts    models.TagOptions
+	idx        int
+	metadatas  []ts.Metadata
+}
+
+// NewDatapointIter returns an iterator to write the given validated
+// datapoints with a DownsamplerAndWriter.
+func NewDatapointIter(
+	datapoints []Datapoint,
+	tagOpts models.TagOptions,
+) ingest.DownsampleAndWriteIter {
+	return &datapointIter{
+		datapoints: datapoints,
+		tagOpts:    tagOpts,
+		idx:        -1,
+	}
+}
+
+func (i *datapointIter) Next() bool {
+	i.idx++
+	return i.idx < len(i.datapoints)
+}
+
+func (i *datapointIter) Current() ingest.IterValue {
+	if i.idx < 0 || i.idx >= len(i.datapoints) {
+		return ingest.IterValue{}
+	}
+
+	dp := i.datapoints[i.idx]
+	t, unit := dp.TimeAndUnit()
+	value := ingest.IterValue{
+		Tags:       dp.ModelTags(i.tagOpts),
+		Datapoints: ts.Datapoints{{Timestamp: t, Value: dp.Value}},
+		Attributes: ts.DefaultSeriesAttributes(),
+		Unit:       unit,
+	}
+	if i.idx < len(i.metadatas) {
+		value.Metadata = i.metadatas[i.idx]
+	}
+	return value
+}
+
+func (i *datapointIter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *datapointIter) Error() error {
+	return nil
+}
+
+func (i *datapointIter) SetCurrentMetadata(metadata ts.Metadata) {
+	if len(i.metadatas) == 0 {
+		i.metadatas = make([]ts.Metadata, len(i.datapoints))
+	}
+	if i.idx >= 0 && i.idx < len(i.datapoints) {
+		i.metadatas[i.idx] = metadata
+	}
+}
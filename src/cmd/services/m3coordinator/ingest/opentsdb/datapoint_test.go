@@ -0,0 +1,167 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestopentsdb
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/m3db/m3/src/query/models"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePutLine(t *testing.T) {
+	dp, err := ParsePutLine("put sys.cpu.user 1356998400 42.5 host=web01 cpu=0")
+	require.NoError(t, err)
+	assert.Equal(t, Datapoint{
+		Metric:    "sys.cpu.user",
+		Timestamp: 1356998400,
+		Value:     42.5,
+		Tags:      map[string]string{"host": "web01", "cpu": "0"},
+	}, dp)
+
+	for _, line := range []string{
+		"put sys.cpu.user 1356998400 42.5",
+		"put sys.cpu.user foo 42.5 host=web01",
+		"put sys.cpu.user 1356998400 foo host=web01",
+		"put sys.cpu.user 1356998400 42.5 host",
+		"put sys.cpu.user 1356998400 42.5 host=",
+		"put sys.cpu.user 1356998400 42.5 =web01",
+		"put sys.cpu.user 1356998400 42.5 host=web01 host=web02",
+		"get sys.cpu.user 1356998400 42.5 host=web01",
+	} {
+		_, err := ParsePutLine(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestDatapointUnmarshalJSON(t *testing.T) {
+	var dps []Datapoint
+	err := json.Unmarshal([]byte(`[
+		{"metric": "sys.cpu.nice", "timestamp": 1346846400, "value": 18, "tags": {"host": "web01"}},
+		{"metric": "sys.cpu.nice", "timestamp": "1346846400000", "value": "9.5", "tags": {"host": "web02"}}
+	]`), &dps)
+	require.NoError(t, err)
+	assert.Equal(t, []Datapoint{
+		{
+			Metric:    "sys.cpu.nice",
+			Timestamp: 1346846400,
+			Value:     18,
+			Tags:      map[string]string{"host": "web01"},
+		},
+		{
+			Metric:    "sys.cpu.nice",
+			Timestamp: 1346846400000,
+			Value:     9.5,
+			Tags:      map[string]string{"host": "web02"},
+		},
+	}, dps)
+
+	var dp Datapoint
+	assert.Error(t, json.Unmarshal([]byte(`{"metric": "foo", "timestamp": 1.5, "value": 1}`), &dp))
+	assert.Error(t, json.Unmarshal([]byte(`{"metric": "foo", "timestamp": 1, "value": "bar"}`), &dp))
+}
+
+func TestDatapointValidate(t *testing.T) {
+	var (
+		tagOpts = models.NewTagOptions()
+		valid   = Datapoint{
+			Metric:    "sys.cpu.user",
+			Timestamp: 1356998400,
+			Value:     1,
+			Tags:      map[string]string{"host": "web-01/a_b"},
+		}
+	)
+	require.NoError(t, valid.Validate(tagOpts))
+
+	tests := []struct {
+		name   string
+		modify func(dp *Datapoint)
+	}{
+		{"missing metric", func(dp *Datapoint) { dp.Metric = "" }},
+		{"invalid metric", func(dp *Datapoint) { dp.Metric = "sys cpu" }},
+		{"invalid timestamp", func(dp *Datapoint) { dp.Timestamp = 0 }},
+		{"nan value", func(dp *Datapoint) { dp.Value = math.NaN() }},
+		{"inf value", func(dp *Datapoint) { dp.Value = math.Inf(1) }},
+		{"missing tags", func(dp *Datapoint) { dp.Tags = nil }},
+		{"reserved tag", func(dp *Datapoint) { dp.Tags = map[string]string{"__name__": "foo"} }},
+		{"invalid tag value", func(dp *Datapoint) { dp.Tags = map[string]string{"host": "a,b"} }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dp := valid
+			test.modify(&dp)
+			assert.Error(t, dp.Validate(tagOpts))
+		})
+	}
+}
+
+func TestDatapointTimeAndUnit(t *testing.T) {
+	dp := Datapoint{Timestamp: 1356998400}
+	ts, unit := dp.TimeAndUnit()
+	assert.Equal(t, xtime.FromSeconds(1356998400), ts)
+	assert.Equal(t, xtime.Second, unit)
+
+	dp = Datapoint{Timestamp: 1356998400123}
+	ts, unit = dp.TimeAndUnit()
+	assert.Equal(t, xtime.FromSecondsAndNanos(1356998400, 123000000), ts)
+	assert.Equal(t, xtime.Millisecond, unit)
+}
+
+func TestDatapointIter(t *testing.T) {
+	iter := NewDatapointIter([]Datapoint{
+		{
+			Metric:    "sys.cpu.user",
+			Timestamp: 1356998400,
+			Value:     42,
+			Tags:      map[string]string{"host": "web01", "cpu": "0"},
+		},
+		{
+			Metric:    "sys.cpu.nice",
+			Timestamp: 1356998400500,
+			Value:     7,
+			Tags:      map[string]string{"host": "web02"},
+		},
+	}, models.NewTagOptions())
+
+	for i := 0; i < 2; i++ {
+		require.True(t, iter.Next())
+		value := iter.Current()
+		assert.Equal(t, "__name__: sys.cpu.user, cpu: 0, host: web01", value.Tags.String())
+		require.Equal(t, 1, len(value.Datapoints))
+		assert.Equal(t, xtime.FromSeconds(1356998400), value.Datapoints[0].Timestamp)
+		assert.Equal(t, 42.0, value.Datapoints[0].Value)
+		assert.Equal(t, xtime.Second, value.Unit)
+
+		require.True(t, iter.Next())
+		value = iter.Current()
+		assert.Equal(t, "__name__: sys.cpu.nice, host: web02", value.Tags.String())
+		assert.Equal(t, xtime.Millisecond, value.Unit)
+
+		require.False(t, iter.Next())
+		require.NoError(t, iter.Error())
+		require.NoError(t, iter.Reset())
+	}
+}
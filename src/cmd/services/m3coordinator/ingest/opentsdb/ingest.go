@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package ingestopentsdb implements an OpenTSDB ingester.
+package ingestopentsdb
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	m3xserver "github.com/m3db/m3/src/x/server"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxLineSize is the maximum size of a single telnet command.
+	maxLineSize = 1 << 16
+
+	versionCommand = "version"
+	exitCommand    = "exit"
+	versionReply   = "m3coordinator OpenTSDB telnet ingester\n"
+)
+
+var (
+	errIOptsMustBeSet   = errors.New("opentsdb ingester options: instrument options must be set")
+	errTagOptsMustBeSet = errors.New("opentsdb ingester options: tag options must be set")
+)
+
+// Options configures the ingester.
+type Options struct {
+	InstrumentOptions instrument.Options
+	TagOptions        models.TagOptions
+}
+
+// Validate validates the options struct.
+func (o *Options) Validate() error {
+	if o.InstrumentOptions == nil {
+		return errIOptsMustBeSet
+	}
+	if o.TagOptions == nil {
+		return errTagOptsMustBeSet
+	}
+	return nil
+}
+
+// NewIngester returns an ingester for connections using the OpenTSDB telnet
+// protocol.
+func NewIngester(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	opts Options,
+) (m3xserver.Handler, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	scope := opts.InstrumentOptions.MetricsScope()
+	metrics, err := newOpenTSDBIngesterMetrics(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingester{
+		downsamplerAndWriter: downsamplerAndWriter,
+		opts:                 opts,
+		logger:               opts.InstrumentOptions.Logger(),
+		metrics:              metrics,
+	}, nil
+}
+
+type ingester struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	opts                 Options
+	logger               *zap.Logger
+	metrics              openTSDBIngesterMetrics
+}
+
+func (i *ingester) Handle(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineSize)
+
+	i.logger.Debug("handling new opentsdb ingestion connection")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		command := line
+		if idx := strings.IndexByte(line, ' '); idx >= 0 {
+			command = line[:idx]
+		}
+
+		var reply string
+		switch command {
+		case putCommand:
+			if err := i.put(line); err != nil {
+				reply = fmt.Sprintf("put: %v\n", err)
+			}
+		case versionCommand:
+			reply = versionReply
+		case exitCommand:
+			return
+		default:
+			reply = fmt.Sprintf("unknown command: %s\n", command)
+		}
+
+		if reply == "" {
+			continue
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			i.logger.Debug("could not write opentsdb reply", zap.Error(err))
+			return
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		i.logger.Error("encountered error during opentsdb ingestion when scanning connection",
+			zap.Error(err))
+	}
+
+	i.logger.Debug("connection closed")
+}
+
+func (i *ingester) Close() {
+	// We don't maintain any state in-between connections so there is nothing to do here.
+}
+
+func (i *ingester) put(line string) error {
+	dp, err := ParsePutLine(line)
+	if err == nil {
+		err = dp.Validate(i.opts.TagOptions)
+	}
+	if err != nil {
+		i.metrics.malformed.Inc(1)
+		return fmt.Errorf("illegal argument: %v", err)
+	}
+
+	var (
+		start      = time.Now()
+		t, unit    = dp.TimeAndUnit()
+		tags       = dp.ModelTags(i.opts.TagOptions)
+		datapoints = ts.Datapoints{{Timestamp: t, Value: dp.Value}}
+	)
+	err = i.downsamplerAndWriter.Write(context.Background(), tags, datapoints,
+		unit, nil, ingest.WriteOptions{})
+	i.metrics.writeLatency.RecordDuration(time.Since(start))
+	if err != nil {
+		i.metrics.err.Inc(1)
+		i.logger.Error("err writing opentsdb metric",
+			zap.String("metric", dp.Metric), zap.Error(err))
+		return err
+	}
+
+	i.metrics.success.Inc(1)
+	i.metrics.ingestLatency.RecordDuration(time.Since(t.ToTime()))
+	return nil
+}
+
+type openTSDBIngesterMetrics struct {
+	success       tally.Counter
+	err           tally.Counter
+	malformed     tally.Counter
+	ingestLatency tally.Histogram
+	writeLatency  tally.Histogram
+}
+
+func newOpenTSDBIngesterMetrics(scope tally.Scope) (openTSDBIngesterMetrics, error) {
+	buckets, err := ingest.NewLatencyBuckets()
+	if err != nil {
+		return openTSDBIngesterMetrics{}, err
+	}
+	return openTSDBIngesterMetrics{
+		success:       scope.Counter("success"),
+		err:           scope.Counter("error"),
+		malformed:     scope.Counter("malformed"),
+		writeLatency:  scope.SubScope("write").Histogram("latency", buckets.WriteLatencyBuckets),
+		ingestLatency: scope.SubScope("ingest").Histogram("latency", buckets.IngestLatencyBuckets),
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestopentsdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIngesterHandleConn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var found []string
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.EXPECT().
+		Write(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			tags models.Tags,
+			dp ts.Datapoints,
+			unit xtime.Unit,
+			_ []byte,
+			_ ingest.WriteOptions,
+		) error {
+			require.Equal(t, 1, len(dp))
+			found = append(found, tags.String())
+			if dp[0].Value < 0 {
+				return errors.New("some_error")
+			}
+			return nil
+		}).Times(3)
+
+	ingester, err := NewIngester(mockDownsamplerAndWriter, Options{
+		InstrumentOptions: instrument.NewOptions(),
+		TagOptions:        models.NewTagOptions(),
+	})
+	require.NoError(t, err)
+
+	conn := &byteConn{r: strings.NewReader(strings.Join([]string{
+		"version",
+		"put sys.cpu.user 1356998400 42.5 host=web01 cpu=0",
+		"",
+		"put sys.cpu.user 1356998400 42.5",
+		"put sys.cpu.nice 1356998400500 -1 host=web01",
+		"foo bar",
+		"put sys.cpu.idle 1356998401 1 host=web02",
+		"exit",
+		"put sys.cpu.user 1356998402 1 host=web03",
+	}, "\n"))}
+	ingester.Handle(conn)
+
+	assert.Equal(t, []string{
+		"__name__: sys.cpu.user, cpu: 0, host: web01",
+		"__name__: sys.cpu.nice, host: web01",
+		"__name__: sys.cpu.idle, host: web02",
+	}, found)
+
+	replies := strings.Split(strings.TrimSpace(conn.w.String()), "\n")
+	require.Equal(t, 4, len(replies))
+	assert.Equal(t, strings.TrimSpace(versionReply), replies[0])
+	assert.True(t, strings.HasPrefix(replies[1], "put: illegal argument: "), replies[1])
+	assert.Equal(t, "put: some_error", replies[2])
+	assert.Equal(t, "unknown command: foo", replies[3])
+	assert.True(t, conn.closed)
+}
+
+type byteConn struct {
+	net.Conn
+
+	r      io.Reader
+	w      bytes.Buffer
+	closed bool
+}
+
+func (b *byteConn) Read(buf []byte) (int, error) {
+	if b.closed {
+		return 0, io.EOF
+	}
+	return b.r.Read(buf)
+}
+
+func (b *byteConn) Write(buf []byte) (int, error) {
+	return b.w.Write(buf)
+}
+
+func (b *byteConn) Close() error {
+	b.closed = true
+	return nil
+}
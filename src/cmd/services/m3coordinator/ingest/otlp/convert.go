@@ -0,0 +1,628 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package ingestotlp converts OpenTelemetry OTLP metrics into M3 series and
+// implements the OTLP metrics gRPC service.
+package ingestotlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const (
+	bucketSuffix = "_bucket"
+	sumSuffix    = "_sum"
+	countSuffix  = "_count"
+
+	bucketLabel   = "le"
+	quantileLabel = "quantile"
+
+	scopeNameLabel    = "otel_scope_name"
+	scopeVersionLabel = "otel_scope_version"
+	jobLabel          = "job"
+	instanceLabel     = "instance"
+
+	serviceNameAttr       = "service.name"
+	serviceNamespaceAttr  = "service.namespace"
+	serviceInstanceIDAttr = "service.instance.id"
+
+	// minNativeHistogramSchema and maxNativeHistogramSchema bound the
+	// exponential histogram scales that can be stored as native histograms,
+	// higher scales are reduced to the maximum.
+	minNativeHistogramSchema = -4
+	maxNativeHistogramSchema = 8
+
+	noRecordedValueFlag = uint32(metricspb.DataPointFlags_FLAG_NO_RECORDED_VALUE)
+)
+
+var (
+	errMissingMetricName = errors.New("metric has no name")
+	errUnsupportedScale  = errors.New("exponential histogram scale is too low")
+)
+
+// Options is the options for OTLP ingestion.
+type Options struct {
+	// InstrumentOptions are the instrument options of the gRPC server.
+	InstrumentOptions instrument.Options
+	// TagOptions are the tag options for the converted series.
+	TagOptions models.TagOptions
+	// StoreMetricsType stores the metric type of each series in its
+	// datapoint annotations.
+	StoreMetricsType bool
+}
+
+type series struct {
+	tags       models.Tags
+	datapoints ts.Datapoints
+	attributes ts.SeriesAttributes
+	annotation []byte
+}
+
+type converter struct {
+	opts   Options
+	series []series
+}
+
+// NewIter converts the OTLP metrics into an iterator of M3 series. Resource
+// and scope attributes are added as tags to every series of the resource and
+// scope, and datapoint attributes take precedence over both.
+//
+// Cumulative sums and histograms are written as Prometheus counters and
+// histograms. Delta sums and histograms are written as M3 counters so that
+// the aggregator sums the increments within each resolution, gauges and
+// non-monotonic cumulative sums are written as gauges. Exponential
+// histograms are written as native histograms.
+func NewIter(
+	metrics *metricspb.MetricsData,
+	opts Options,
+) (ingest.DownsampleAndWriteIter, error) {
+	iter, err := newIter(metrics, opts)
+	if err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
+
+func newIter(metrics *metricspb.MetricsData, opts Options) (*iter, error) {
+	c := &converter{opts: opts}
+	for _, rm := range metrics.GetResourceMetrics() {
+		resourceLabels := resourceLabels(rm.GetResource())
+		for _, sm := range rm.GetScopeMetrics() {
+			labels := scopeLabels(resourceLabels, sm.GetScope())
+			for _, m := range sm.GetMetrics() {
+				if err := c.addMetric(m, labels); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return &iter{idx: -1, series: c.series}, nil
+}
+
+func (c *converter) addMetric(m *metricspb.Metric, labels []models.Tag) error {
+	name := sanitizeMetricName(m.GetName())
+	if name == "" {
+		return errMissingMetricName
+	}
+
+	switch data := m.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		attrs := ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge}
+		for _, p := range data.Gauge.GetDataPoints() {
+			c.addNumberDataPoint(name, labels, attrs, p)
+		}
+
+	case *metricspb.Metric_Sum:
+		attrs := ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge}
+		switch {
+		case isDelta(data.Sum.GetAggregationTemporality()):
+			attrs = ts.SeriesAttributes{
+				M3Type:   ts.M3MetricTypeCounter,
+				PromType: ts.PromMetricTypeCounter,
+			}
+		case data.Sum.GetIsMonotonic():
+			attrs = ts.SeriesAttributes{
+				PromType:          ts.PromMetricTypeCounter,
+				HandleValueResets: true,
+			}
+		}
+		for _, p := range data.Sum.GetDataPoints() {
+			c.addNumberDataPoint(name, labels, attrs, p)
+		}
+
+	case *metricspb.Metric_Histogram:
+		attrs := ts.SeriesAttributes{
+			PromType:          ts.PromMetricTypeHistogram,
+			HandleValueResets: true,
+		}
+		if isDelta(data.Histogram.GetAggregationTemporality()) {
+			attrs = ts.SeriesAttributes{
+				M3Type:   ts.M3MetricTypeCounter,
+				PromType: ts.PromMetricTypeHistogram,
+			}
+		}
+		for _, p := range data.Histogram.GetDataPoints() {
+			c.addHistogramDataPoint(name, labels, attrs, p)
+		}
+
+	case *metricspb.Metric_ExponentialHistogram:
+		delta := isDelta(data.ExponentialHistogram.GetAggregationTemporality())
+		for _, p := range data.ExponentialHistogram.GetDataPoints() {
+			if err := c.addExponentialHistogramDataPoint(name, labels, delta, p); err != nil {
+				return fmt.Errorf("metric %s: %v", m.GetName(), err)
+			}
+		}
+
+	case *metricspb.Metric_Summary:
+		attrs := ts.SeriesAttributes{
+			PromType:          ts.PromMetricTypeSummary,
+			HandleValueResets: true,
+		}
+		for _, p := range data.Summary.GetDataPoints() {
+			c.addSummaryDataPoint(name, labels, attrs, p)
+		}
+	}
+
+	return nil
+}
+
+func (c *converter) addNumberDataPoint(
+	name string,
+	labels []models.Tag,
+	attrs ts.SeriesAttributes,
+	p *metricspb.NumberDataPoint,
+) {
+	if p.GetFlags()&noRecordedValueFlag != 0 {
+		return
+	}
+
+	value := p.GetAsDouble()
+	if v, ok := p.GetValue().(*metricspb.NumberDataPoint_AsInt); ok {
+		value = float64(v.AsInt)
+	}
+
+	labels = withAttributes(labels, p.GetAttributes())
+	c.add(name, labels, attrs, p.GetTimeUnixNano(), value)
+}
+
+func (c *converter) addHistogramDataPoint(
+	name string,
+	labels []models.Tag,
+	attrs ts.SeriesAttributes,
+	p *metricspb.HistogramDataPoint,
+) {
+	if p.GetFlags()&noRecordedValueFlag != 0 {
+		return
+	}
+
+	var (
+		timestamp = p.GetTimeUnixNano()
+		bounds    = p.GetExplicitBounds()
+		counts    = p.GetBucketCounts()
+		bucket    = name + bucketSuffix
+		cumulated uint64
+	)
+	labels = withAttributes(labels, p.GetAttributes())
+	for i, bound := range bounds {
+		// OTLP buckets hold the count of each bucket on its own where
+		// Prometheus buckets are cumulative.
+		if i < len(counts) {
+			cumulated += counts[i]
+		}
+		le := strconv.FormatFloat(bound, 'f', -1, 64)
+		c.add(bucket, withLabel(labels, bucketLabel, le), attrs, timestamp,
+			float64(cumulated))
+	}
+	c.add(bucket, withLabel(labels, bucketLabel, "+Inf"), attrs, timestamp,
+		float64(p.GetCount()))
+	if p.Sum != nil {
+		c.add(name+sumSuffix, labels, attrs, timestamp, p.GetSum())
+	}
+	c.add(name+countSuffix, labels, attrs, timestamp, float64(p.GetCount()))
+}
+
+func (c *converter) addSummaryDataPoint(
+	name string,
+	labels []models.Tag,
+	attrs ts.SeriesAttributes,
+	p *metricspb.SummaryDataPoint,
+) {
+	if p.GetFlags()&noRecordedValueFlag != 0 {
+		return
+	}
+
+	timestamp := p.GetTimeUnixNano()
+	labels = withAttributes(labels, p.GetAttributes())
+	for _, q := range p.GetQuantileValues() {
+		quantile := strconv.FormatFloat(q.GetQuantile(), 'f', -1, 64)
+		c.add(name, withLabel(labels, quantileLabel, quantile), attrs, timestamp,
+			q.GetValue())
+	}
+	c.add(name+sumSuffix, labels, attrs, timestamp, p.GetSum())
+	c.add(name+countSuffix, labels, attrs, timestamp, float64(p.GetCount()))
+}
+
+func (c *converter) addExponentialHistogramDataPoint(
+	name string,
+	labels []models.Tag,
+	delta bool,
+	p *metricspb.ExponentialHistogramDataPoint,
+) error {
+	if p.GetFlags()&noRecordedValueFlag != 0 {
+		return nil
+	}
+
+	h, err := exponentialToNativeHistogram(p, delta)
+	if err != nil {
+		return err
+	}
+
+	dp, annotation, err := storage.PromHistogramToM3Datapoint(h)
+	if err != nil {
+		return err
+	}
+
+	attrs := ts.SeriesAttributes{
+		PromType:          ts.PromMetricTypeHistogram,
+		HandleValueResets: true,
+	}
+	if delta {
+		attrs.PromType = ts.PromMetricTypeGaugeHistogram
+		attrs.HandleValueResets = false
+	}
+
+	c.series = append(c.series, series{
+		tags:       c.tags(name, withAttributes(labels, p.GetAttributes())),
+		datapoints: ts.Datapoints{dp},
+		attributes: attrs,
+		annotation: annotation,
+	})
+	return nil
+}
+
+func (c *converter) add(
+	name string,
+	labels []models.Tag,
+	attrs ts.SeriesAttributes,
+	timestamp uint64,
+	value float64,
+) {
+	s := series{
+		tags: c.tags(name, labels),
+		datapoints: ts.Datapoints{{
+			Timestamp: xtime.UnixNano(timestamp).Truncate(time.Millisecond),
+			Value:     value,
+		}},
+		attributes: attrs,
+	}
+	if c.opts.StoreMetricsType {
+		// Errors are not possible since the attributes are built from
+		// known Prometheus types.
+		payload, _ := storage.SeriesAttributesToAnnotationPayload(attrs)
+		s.annotation, _ = payload.Marshal()
+	}
+	c.series = append(c.series, s)
+}
+
+func (c *converter) tags(name string, labels []models.Tag) models.Tags {
+	return models.NewTags(len(labels)+1, c.opts.TagOptions).
+		AddTags(labels).
+		SetName([]byte(name))
+}
+
+// exponentialToNativeHistogram converts an OTLP exponential histogram into a
+// Prometheus native histogram, which share the same bucket boundaries with
+// the bucket indexes of native histograms being offset by one.
+func exponentialToNativeHistogram(
+	p *metricspb.ExponentialHistogramDataPoint,
+	delta bool,
+) (prompb.Histogram, error) {
+	scale := p.GetScale()
+	if scale < minNativeHistogramSchema {
+		return prompb.Histogram{}, errUnsupportedScale
+	}
+
+	var scaleDown int32
+	if scale > maxNativeHistogramSchema {
+		scaleDown = scale - maxNativeHistogramSchema
+		scale = maxNativeHistogramSchema
+	}
+
+	h := prompb.Histogram{
+		CountInt:     p.GetCount(),
+		Sum:          p.GetSum(),
+		Schema:       scale,
+		ZeroCountInt: p.GetZeroCount(),
+		Timestamp:    int64(p.GetTimeUnixNano()) / int64(time.Millisecond),
+	}
+	h.PositiveSpans, h.PositiveDeltas = exponentialBucketsToSpans(p.GetPositive(), scaleDown)
+	h.NegativeSpans, h.NegativeDeltas = exponentialBucketsToSpans(p.GetNegative(), scaleDown)
+	if delta {
+		// Each delta histogram holds the observations of a single interval
+		// so it may go down from one datapoint to the next.
+		h.ResetHint = prompb.Histogram_GAUGE
+	}
+	return h, nil
+}
+
+func exponentialBucketsToSpans(
+	buckets *metricspb.ExponentialHistogramDataPoint_Buckets,
+	scaleDown int32,
+) ([]prompb.BucketSpan, []int64) {
+	counts := buckets.GetBucketCounts()
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	// Merge adjacent buckets when reducing the scale, each bucket at the
+	// lower scale covers 2^scaleDown buckets of the original scale.
+	var (
+		offset = buckets.GetOffset() >> scaleDown
+		merged = make([]uint64, 0, len(counts))
+	)
+	for i, count := range counts {
+		idx := int((buckets.GetOffset()+int32(i))>>scaleDown - offset)
+		for len(merged) <= idx {
+			merged = append(merged, 0)
+		}
+		merged[idx] += count
+	}
+
+	var (
+		spans  = []prompb.BucketSpan{{Offset: offset + 1, Length: uint32(len(merged))}}
+		deltas = make([]int64, 0, len(merged))
+		prev   int64
+	)
+	for _, count := range merged {
+		deltas = append(deltas, int64(count)-prev)
+		prev = int64(count)
+	}
+	return spans, deltas
+}
+
+func isDelta(temporality metricspb.AggregationTemporality) bool {
+	return temporality == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+}
+
+// resourceLabels returns the labels for the resource attributes, along with
+// the job and instance labels derived from the service attributes the same
+// way as Prometheus does.
+func resourceLabels(resource *resourcepb.Resource) []models.Tag {
+	var (
+		attrs     = resource.GetAttributes()
+		labels    = withAttributes(nil, attrs)
+		service   string
+		namespace string
+	)
+	for _, attr := range attrs {
+		switch attr.GetKey() {
+		case serviceNameAttr:
+			service = anyValueString(attr.GetValue())
+		case serviceNamespaceAttr:
+			namespace = anyValueString(attr.GetValue())
+		case serviceInstanceIDAttr:
+			labels = withLabel(labels, instanceLabel, anyValueString(attr.GetValue()))
+		}
+	}
+	if service != "" {
+		if namespace != "" {
+			service = namespace + "/" + service
+		}
+		labels = withLabel(labels, jobLabel, service)
+	}
+	return labels
+}
+
+func scopeLabels(labels []models.Tag, scope *commonpb.InstrumentationScope) []models.Tag {
+	labels = withAttributes(labels, scope.GetAttributes())
+	if name := scope.GetName(); name != "" {
+		labels = withLabel(labels, scopeNameLabel, name)
+	}
+	if version := scope.GetVersion(); version != "" {
+		labels = withLabel(labels, scopeVersionLabel, version)
+	}
+	return labels
+}
+
+// withAttributes returns a copy of the labels with the attributes added,
+// replacing any existing labels of the same name.
+func withAttributes(labels []models.Tag, attrs []*commonpb.KeyValue) []models.Tag {
+	result := make([]models.Tag, len(labels), len(labels)+len(attrs))
+	copy(result, labels)
+	for _, attr := range attrs {
+		result = setLabel(result, sanitizeLabelName(attr.GetKey()),
+			anyValueString(attr.GetValue()))
+	}
+	return result
+}
+
+// withLabel returns a copy of the labels with the label added, replacing any
+// existing label of the same name.
+func withLabel(labels []models.Tag, name, value string) []models.Tag {
+	result := make([]models.Tag, len(labels), len(labels)+1)
+	copy(result, labels)
+	return setLabel(result, name, value)
+}
+
+func setLabel(labels []models.Tag, name, value string) []models.Tag {
+	if name == "" || value == "" {
+		return labels
+	}
+	for i, label := range labels {
+		if string(label.Name) == name {
+			labels[i].Value = []byte(value)
+			return labels
+		}
+	}
+	return append(labels, models.Tag{Name: []byte(name), Value: []byte(value)})
+}
+
+func anyValueString(v *commonpb.AnyValue) string {
+	switch value := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(value.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(value.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(value.DoubleValue, 'f', -1, 64)
+	case *commonpb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(value.BytesValue)
+	case *commonpb.AnyValue_ArrayValue, *commonpb.AnyValue_KvlistValue:
+		b, err := json.Marshal(anyValueJSON(v))
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+	return ""
+}
+
+func anyValueJSON(v *commonpb.AnyValue) interface{} {
+	switch value := v.GetValue().(type) {
+	case *commonpb.AnyValue_ArrayValue:
+		values := make([]interface{}, 0, len(value.ArrayValue.GetValues()))
+		for _, elem := range value.ArrayValue.GetValues() {
+			values = append(values, anyValueJSON(elem))
+		}
+		return values
+	case *commonpb.AnyValue_KvlistValue:
+		values := make(map[string]interface{}, len(value.KvlistValue.GetValues()))
+		for _, kv := range value.KvlistValue.GetValues() {
+			values[kv.GetKey()] = anyValueJSON(kv.GetValue())
+		}
+		return values
+	case *commonpb.AnyValue_BoolValue:
+		return value.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return value.IntValue
+	case *commonpb.AnyValue_DoubleValue:
+		if math.IsNaN(value.DoubleValue) || math.IsInf(value.DoubleValue, 0) {
+			return anyValueString(v)
+		}
+		return value.DoubleValue
+	}
+	return anyValueString(v)
+}
+
+// sanitizeMetricName replaces the characters that are not valid in a
+// Prometheus metric name with underscores.
+func sanitizeMetricName(name string) string {
+	return sanitize(name, true)
+}
+
+// sanitizeLabelName replaces the characters that are not valid in a
+// Prometheus label name with underscores.
+func sanitizeLabelName(name string) string {
+	return sanitize(name, false)
+}
+
+func sanitize(name string, allowColons bool) string {
+	if name == "" {
+		return ""
+	}
+
+	var b strings.Builder
+	b.Grow(len(name))
+	if name[0] >= '0' && name[0] <= '9' {
+		b.WriteByte('_')
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		case r == ':' && allowColons:
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+type iter struct {
+	idx       int
+	series    []series
+	metadatas []ts.Metadata
+}
+
+func (i *iter) Next() bool {
+	i.idx++
+	return i.idx < len(i.series)
+}
+
+func (i *iter) Current() ingest.IterValue {
+	if i.idx < 0 || i.idx >= len(i.series) {
+		return ingest.IterValue{}
+	}
+
+	s := i.series[i.idx]
+	value := ingest.IterValue{
+		Tags:       s.tags,
+		Datapoints: s.datapoints,
+		Attributes: s.attributes,
+		Unit:       xtime.Millisecond,
+		Annotation: s.annotation,
+	}
+	if i.idx < len(i.metadatas) {
+		value.Metadata = i.metadatas[i.idx]
+	}
+	return value
+}
+
+func (i *iter) Reset() error {
+	i.idx = -1
+	return nil
+}
+
+func (i *iter) Error() error {
+	return nil
+}
+
+func (i *iter) SetCurrentMetadata(metadata ts.Metadata) {
+	if len(i.metadatas) == 0 {
+		i.metadatas = make([]ts.Metadata, len(i.series))
+	}
+	if i.idx < 0 || i.idx >= len(i.metadatas) {
+		return
+	}
+	i.metadatas[i.idx] = metadata
+}
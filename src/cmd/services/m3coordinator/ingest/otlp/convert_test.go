@@ -0,0 +1,388 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestotlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+var testTime = time.Unix(1600000000, 123456789)
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+func testMetricsData(metrics ...*metricspb.Metric) *metricspb.MetricsData {
+	return &metricspb.MetricsData{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						stringAttr("service.name", "api"),
+						stringAttr("service.namespace", "shop"),
+						stringAttr("service.instance.id", "host-1:8080"),
+						stringAttr("host.name", "host-1"),
+					},
+				},
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope: &commonpb.InstrumentationScope{
+							Name:    "otelhttp",
+							Version: "1.0.0",
+						},
+						Metrics: metrics,
+					},
+				},
+			},
+		},
+	}
+}
+
+func numberDataPoint(value float64, attrs ...*commonpb.KeyValue) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		Attributes:   attrs,
+		TimeUnixNano: uint64(testTime.UnixNano()),
+		Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+type testSeries struct {
+	tags  map[string]string
+	value ingest.IterValue
+}
+
+func collectSeries(
+	t *testing.T,
+	metrics *metricspb.MetricsData,
+	opts Options,
+) map[string][]testSeries {
+	iter, err := NewIter(metrics, opts)
+	require.NoError(t, err)
+
+	result := make(map[string][]testSeries)
+	for iter.Next() {
+		value := iter.Current()
+		require.NoError(t, value.Tags.Validate())
+		tags := make(map[string]string, value.Tags.Len())
+		for _, tag := range value.Tags.Tags {
+			tags[string(tag.Name)] = string(tag.Value)
+		}
+		name := tags["__name__"]
+		result[name] = append(result[name], testSeries{tags: tags, value: value})
+	}
+	require.NoError(t, iter.Error())
+	return result
+}
+
+func TestNewIterGaugeAndSums(t *testing.T) {
+	metrics := testMetricsData(
+		&metricspb.Metric{
+			Name: "process.cpu.utilization",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{
+					numberDataPoint(0.5, stringAttr("cpu", "0"), stringAttr("host.name", "override")),
+				},
+			}},
+		},
+		&metricspb.Metric{
+			Name: "http.requests",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+				DataPoints: []*metricspb.NumberDataPoint{{
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 42},
+				}},
+			}},
+		},
+		&metricspb.Metric{
+			Name: "queue.size",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints:             []*metricspb.NumberDataPoint{numberDataPoint(3)},
+			}},
+		},
+		&metricspb.Metric{
+			Name: "bytes.sent",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+				DataPoints:             []*metricspb.NumberDataPoint{numberDataPoint(128)},
+			}},
+		},
+	)
+
+	series := collectSeries(t, metrics, Options{TagOptions: models.NewTagOptions()})
+	require.Equal(t, 4, len(series))
+
+	gauge := series["process_cpu_utilization"]
+	require.Equal(t, 1, len(gauge))
+	assert.Equal(t, map[string]string{
+		"__name__":            "process_cpu_utilization",
+		"cpu":                 "0",
+		"host_name":           "override",
+		"instance":            "host-1:8080",
+		"job":                 "shop/api",
+		"otel_scope_name":     "otelhttp",
+		"otel_scope_version":  "1.0.0",
+		"service_instance_id": "host-1:8080",
+		"service_name":        "api",
+		"service_namespace":   "shop",
+	}, gauge[0].tags)
+	assert.Equal(t, ts.SeriesAttributes{PromType: ts.PromMetricTypeGauge},
+		gauge[0].value.Attributes)
+	assert.Equal(t, xtime.Millisecond, gauge[0].value.Unit)
+	assert.Equal(t, ts.Datapoints{{
+		Timestamp: xtime.ToUnixNano(testTime.Truncate(time.Millisecond)),
+		Value:     0.5,
+	}}, gauge[0].value.Datapoints)
+	assert.Nil(t, gauge[0].value.Annotation)
+
+	counter := series["http_requests"]
+	require.Equal(t, 1, len(counter))
+	assert.Equal(t, 42.0, counter[0].value.Datapoints[0].Value)
+	assert.Equal(t, ts.SeriesAttributes{
+		PromType:          ts.PromMetricTypeCounter,
+		HandleValueResets: true,
+	}, counter[0].value.Attributes)
+
+	upDown := series["queue_size"]
+	require.Equal(t, 1, len(upDown))
+	assert.Equal(t, ts.PromMetricTypeGauge, upDown[0].value.Attributes.PromType)
+
+	delta := series["bytes_sent"]
+	require.Equal(t, 1, len(delta))
+	assert.Equal(t, ts.SeriesAttributes{
+		M3Type:   ts.M3MetricTypeCounter,
+		PromType: ts.PromMetricTypeCounter,
+	}, delta[0].value.Attributes)
+}
+
+func TestNewIterHistogram(t *testing.T) {
+	sum := 12.5
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "http.duration",
+		Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			DataPoints: []*metricspb.HistogramDataPoint{{
+				TimeUnixNano:   uint64(testTime.UnixNano()),
+				Count:          6,
+				Sum:            &sum,
+				BucketCounts:   []uint64{1, 2, 3},
+				ExplicitBounds: []float64{0.5, 1},
+			}},
+		}},
+	})
+
+	series := collectSeries(t, metrics, Options{TagOptions: models.NewTagOptions()})
+	require.Equal(t, 3, len(series))
+
+	buckets := make(map[string]float64)
+	for _, s := range series["http_duration_bucket"] {
+		assert.Equal(t, ts.PromMetricTypeHistogram, s.value.Attributes.PromType)
+		buckets[s.tags["le"]] = s.value.Datapoints[0].Value
+	}
+	assert.Equal(t, map[string]float64{"0.5": 1, "1": 3, "+Inf": 6}, buckets)
+
+	require.Equal(t, 1, len(series["http_duration_sum"]))
+	assert.Equal(t, 12.5, series["http_duration_sum"][0].value.Datapoints[0].Value)
+	require.Equal(t, 1, len(series["http_duration_count"]))
+	assert.Equal(t, 6.0, series["http_duration_count"][0].value.Datapoints[0].Value)
+}
+
+func TestNewIterSummary(t *testing.T) {
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "rpc.latency",
+		Data: &metricspb.Metric_Summary{Summary: &metricspb.Summary{
+			DataPoints: []*metricspb.SummaryDataPoint{{
+				TimeUnixNano: uint64(testTime.UnixNano()),
+				Count:        10,
+				Sum:          4,
+				QuantileValues: []*metricspb.SummaryDataPoint_ValueAtQuantile{
+					{Quantile: 0.5, Value: 0.3},
+					{Quantile: 0.99, Value: 0.9},
+				},
+			}},
+		}},
+	})
+
+	series := collectSeries(t, metrics, Options{TagOptions: models.NewTagOptions()})
+	require.Equal(t, 3, len(series))
+
+	quantiles := make(map[string]float64)
+	for _, s := range series["rpc_latency"] {
+		assert.Equal(t, ts.PromMetricTypeSummary, s.value.Attributes.PromType)
+		quantiles[s.tags["quantile"]] = s.value.Datapoints[0].Value
+	}
+	assert.Equal(t, map[string]float64{"0.5": 0.3, "0.99": 0.9}, quantiles)
+	assert.Equal(t, 4.0, series["rpc_latency_sum"][0].value.Datapoints[0].Value)
+	assert.Equal(t, 10.0, series["rpc_latency_count"][0].value.Datapoints[0].Value)
+}
+
+func TestNewIterExponentialHistogram(t *testing.T) {
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "payload.size",
+		Data: &metricspb.Metric_ExponentialHistogram{
+			ExponentialHistogram: &metricspb.ExponentialHistogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*metricspb.ExponentialHistogramDataPoint{{
+					TimeUnixNano: uint64(testTime.UnixNano()),
+					Count:        7,
+					Scale:        2,
+					ZeroCount:    1,
+					Positive: &metricspb.ExponentialHistogramDataPoint_Buckets{
+						Offset:       -1,
+						BucketCounts: []uint64{2, 4},
+					},
+				}},
+			},
+		},
+	})
+
+	series := collectSeries(t, metrics, Options{TagOptions: models.NewTagOptions()})
+	require.Equal(t, 1, len(series["payload_size"]))
+
+	value := series["payload_size"][0].value
+	assert.Equal(t, 7.0, value.Datapoints[0].Value)
+	assert.Equal(t, ts.PromMetricTypeHistogram, value.Attributes.PromType)
+
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(value.Annotation))
+	assert.Equal(t, annotation.MetricType_HISTOGRAM, payload.MetricType)
+	assert.NotEmpty(t, payload.NativeHistogram)
+}
+
+func TestExponentialBucketsToSpans(t *testing.T) {
+	buckets := &metricspb.ExponentialHistogramDataPoint_Buckets{
+		Offset:       -1,
+		BucketCounts: []uint64{1, 2, 3, 4},
+	}
+
+	spans, deltas := exponentialBucketsToSpans(buckets, 0)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 0, Length: 4}}, spans)
+	assert.Equal(t, []int64{1, 1, 1, 1}, deltas)
+
+	// Reducing the scale by two merges every four buckets.
+	spans, deltas = exponentialBucketsToSpans(buckets, 2)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 0, Length: 2}}, spans)
+	assert.Equal(t, []int64{1, 8}, deltas)
+
+	spans, deltas = exponentialBucketsToSpans(nil, 0)
+	assert.Nil(t, spans)
+	assert.Nil(t, deltas)
+}
+
+func TestNewIterStoreMetricsType(t *testing.T) {
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "http.requests",
+		Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+			DataPoints:             []*metricspb.NumberDataPoint{numberDataPoint(1)},
+		}},
+	})
+
+	series := collectSeries(t, metrics, Options{
+		TagOptions:       models.NewTagOptions(),
+		StoreMetricsType: true,
+	})
+	require.Equal(t, 1, len(series["http_requests"]))
+
+	var payload annotation.Payload
+	require.NoError(t, payload.Unmarshal(series["http_requests"][0].value.Annotation))
+	assert.Equal(t, annotation.MetricType_COUNTER, payload.MetricType)
+	assert.True(t, payload.HandleValueResets)
+}
+
+func TestNewIterSkipsNoRecordedValue(t *testing.T) {
+	dp := numberDataPoint(1)
+	dp.Flags = uint32(metricspb.DataPointFlags_FLAG_NO_RECORDED_VALUE)
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "queue.size",
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{dp},
+		}},
+	})
+
+	series := collectSeries(t, metrics, Options{TagOptions: models.NewTagOptions()})
+	assert.Equal(t, 0, len(series))
+}
+
+func TestNewIterMissingName(t *testing.T) {
+	metrics := testMetricsData(&metricspb.Metric{
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{numberDataPoint(1)},
+		}},
+	})
+
+	_, err := NewIter(metrics, Options{TagOptions: models.NewTagOptions()})
+	require.Error(t, err)
+}
+
+func TestSanitize(t *testing.T) {
+	assert.Equal(t, "http_server_duration", sanitizeMetricName("http.server.duration"))
+	assert.Equal(t, "job:rate5m", sanitizeMetricName("job:rate5m"))
+	assert.Equal(t, "_2xx_count", sanitizeMetricName("2xx.count"))
+	assert.Equal(t, "k8s_pod_name", sanitizeLabelName("k8s.pod.name"))
+	assert.Equal(t, "a_b", sanitizeLabelName("a:b"))
+}
+
+func TestAnyValueString(t *testing.T) {
+	tests := []struct {
+		value    *commonpb.AnyValue
+		expected string
+	}{
+		{&commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "foo"}}, "foo"},
+		{&commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: true}}, "true"},
+		{&commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: -3}}, "-3"},
+		{&commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 1.5}}, "1.5"},
+		{&commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{
+			ArrayValue: &commonpb.ArrayValue{Values: []*commonpb.AnyValue{
+				{Value: &commonpb.AnyValue_StringValue{StringValue: "a"}},
+				{Value: &commonpb.AnyValue_IntValue{IntValue: 1}},
+			}},
+		}}, `["a",1]`},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, anyValueString(tt.value))
+	}
+}
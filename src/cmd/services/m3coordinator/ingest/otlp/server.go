@@ -0,0 +1,217 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestotlp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/generated/proto/otlppb"
+	xerrors "github.com/m3db/m3/src/x/errors"
+
+	"github.com/uber-go/tally"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metricsServiceName = "opentelemetry.proto.collector.metrics.v1.MetricsService"
+	exportMethodName   = "Export"
+)
+
+// Write converts the OTLP metrics and writes them. If only some of the
+// writes were rejected as bad requests the response reports the partial
+// success, the error is an invalid params error if the metrics are malformed
+// or if every write was rejected as a bad request, any other error may be
+// retried.
+func Write(
+	ctx context.Context,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	metrics *metricspb.MetricsData,
+	opts Options,
+) (*otlppb.ExportMetricsServiceResponse, error) {
+	iter, err := newIter(metrics, opts)
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+
+	batchErr := downsamplerAndWriter.WriteBatch(ctx, iter, ingest.WriteOptions{})
+	if batchErr == nil {
+		return &otlppb.ExportMetricsServiceResponse{}, nil
+	}
+
+	var (
+		errs              = batchErr.Errors()
+		numBadRequest     int
+		lastBadRequestErr error
+		lastRegularErr    error
+	)
+	for _, err := range errs {
+		if client.IsBadRequestError(err) || xerrors.IsInvalidParams(err) {
+			numBadRequest++
+			lastBadRequestErr = err
+			continue
+		}
+		lastRegularErr = err
+	}
+
+	if numBadRequest < len(errs) {
+		return nil, fmt.Errorf("retryable_errors: count=%d, last=%v",
+			len(errs)-numBadRequest, lastRegularErr)
+	}
+
+	badRequestErr := fmt.Errorf("bad_request_errors: count=%d, last=%v",
+		numBadRequest, lastBadRequestErr)
+	if numBadRequest >= len(iter.series) {
+		return nil, xerrors.NewInvalidParamsError(badRequestErr)
+	}
+
+	// Exporters must not retry a partially successful export, so the
+	// rejected writes are reported in the response instead of an error.
+	return &otlppb.ExportMetricsServiceResponse{
+		PartialSuccess: &otlppb.ExportMetricsPartialSuccess{
+			RejectedDataPoints: int64(numBadRequest),
+			ErrorMessage:       badRequestErr.Error(),
+		},
+	}, nil
+}
+
+// metricsServiceServer is the OTLP metrics service, the export request is
+// decoded as MetricsData which shares its wire format.
+type metricsServiceServer interface {
+	Export(
+		ctx context.Context,
+		req *metricspb.MetricsData,
+	) (*otlppb.ExportMetricsServiceResponse, error)
+}
+
+var metricsServiceDesc = grpc.ServiceDesc{
+	ServiceName: metricsServiceName,
+	HandlerType: (*metricsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: exportMethodName,
+			Handler:    exportHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opentelemetry/proto/collector/metrics/v1/metrics_service.proto",
+}
+
+func exportHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	req := &metricspb.MetricsData{}
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(metricsServiceServer).Export(ctx, req)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + metricsServiceName + "/" + exportMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(metricsServiceServer).Export(ctx, req.(*metricspb.MetricsData))
+	}
+	return interceptor(ctx, req, info, handler)
+}
+
+type grpcServer struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	opts                 Options
+	logger               *zap.Logger
+	metrics              serverMetrics
+}
+
+// NewGRPCServer builds a gRPC server serving the OTLP metrics service which
+// must be started later.
+func NewGRPCServer(
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+	opts Options,
+) (*grpc.Server, error) {
+	metrics, err := newServerMetrics(opts.InstrumentOptions.MetricsScope())
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer()
+	server.RegisterService(&metricsServiceDesc, &grpcServer{
+		downsamplerAndWriter: downsamplerAndWriter,
+		opts:                 opts,
+		logger:               opts.InstrumentOptions.Logger(),
+		metrics:              metrics,
+	})
+	return server, nil
+}
+
+func (s *grpcServer) Export(
+	ctx context.Context,
+	req *metricspb.MetricsData,
+) (*otlppb.ExportMetricsServiceResponse, error) {
+	start := time.Now()
+	resp, err := Write(ctx, s.downsamplerAndWriter, req, s.opts)
+	s.metrics.writeLatency.RecordDuration(time.Since(start))
+	if err == nil {
+		s.metrics.success.Inc(1)
+		return resp, nil
+	}
+
+	s.logger.Error("otlp export error", zap.Error(err))
+	if xerrors.IsInvalidParams(err) {
+		s.metrics.malformed.Inc(1)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Unavailable signals the exporter to retry the export.
+	s.metrics.err.Inc(1)
+	return nil, status.Error(codes.Unavailable, err.Error())
+}
+
+type serverMetrics struct {
+	success      tally.Counter
+	err          tally.Counter
+	malformed    tally.Counter
+	writeLatency tally.Histogram
+}
+
+func newServerMetrics(scope tally.Scope) (serverMetrics, error) {
+	buckets, err := ingest.NewLatencyBuckets()
+	if err != nil {
+		return serverMetrics{}, err
+	}
+	return serverMetrics{
+		success:      scope.Counter("success"),
+		err:          scope.Counter("error"),
+		malformed:    scope.Counter("malformed"),
+		writeLatency: scope.SubScope("write").Histogram("latency", buckets.WriteLatencyBuckets),
+	}, nil
+}
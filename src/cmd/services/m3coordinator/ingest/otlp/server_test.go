@@ -0,0 +1,144 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package ingestotlp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestServer(t *testing.T, ds ingest.DownsamplerAndWriter) *grpcServer {
+	metrics, err := newServerMetrics(tally.NoopScope)
+	require.NoError(t, err)
+	return &grpcServer{
+		downsamplerAndWriter: ds,
+		opts: Options{
+			InstrumentOptions: instrument.NewOptions(),
+			TagOptions:        models.NewTagOptions(),
+		},
+		logger:  instrument.NewOptions().Logger(),
+		metrics: metrics,
+	}
+}
+
+func testGaugeMetrics() *metricspb.MetricsData {
+	return testMetricsData(&metricspb.Metric{
+		Name: "queue.size",
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{numberDataPoint(1)},
+		}},
+	})
+}
+
+func TestGRPCServerExport(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			require.True(t, iter.Next())
+			name, ok := iter.Current().Tags.Name()
+			require.True(t, ok)
+			assert.Equal(t, "queue_size", string(name))
+			assert.False(t, iter.Next())
+			return nil
+		})
+
+	resp, err := newTestServer(t, ds).Export(context.Background(), testGaugeMetrics())
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Nil(t, resp.PartialSuccess)
+}
+
+func TestGRPCServerExportPartialSuccess(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(
+			xerrors.NewInvalidParamsError(errors.New("bad"))))
+
+	metrics := testMetricsData(&metricspb.Metric{
+		Name: "queue.size",
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{
+				numberDataPoint(1, stringAttr("queue", "a")),
+				numberDataPoint(2, stringAttr("queue", "b")),
+			},
+		}},
+	})
+	resp, err := newTestServer(t, ds).Export(context.Background(), metrics)
+	require.NoError(t, err)
+	require.NotNil(t, resp.PartialSuccess)
+	assert.Equal(t, int64(1), resp.PartialSuccess.RejectedDataPoints)
+	assert.Contains(t, resp.PartialSuccess.ErrorMessage, "bad")
+}
+
+func TestGRPCServerExportErrors(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(errors.New("unavailable")))
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(
+			xerrors.NewInvalidParamsError(errors.New("bad"))))
+
+	server := newTestServer(t, ds)
+	_, err := server.Export(context.Background(), testGaugeMetrics())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = server.Export(context.Background(), testGaugeMetrics())
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Malformed metrics are rejected without writing.
+	malformed := testMetricsData(&metricspb.Metric{
+		Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{}},
+	})
+	_, err = server.Export(context.Background(), malformed)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
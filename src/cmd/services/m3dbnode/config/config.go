@@ -38,6 +38,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/discovery"
 	"github.com/m3db/m3/src/dbnode/environment"
+	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/x/config/hostid"
@@ -191,6 +192,10 @@ type DBConfiguration struct {
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
 
+	// NativeHistograms contains the configuration for encoding series with the
+	// native histogram encoding scheme.
+	NativeHistograms *NativeHistogramsConfiguration `yaml:"nativeHistograms"`
+
 	// Tracing configures opentracing. If not provided, tracing is disabled.
 	Tracing *opentracing.TracingConfiguration `yaml:"tracing"`
 
@@ -212,6 +217,9 @@ type DBConfiguration struct {
 	// ForceColdWritesEnabled will force enable cold writes for all namespaces
 	// if set.
 	ForceColdWritesEnabled *bool `yaml:"forceColdWritesEnabled"`
+
+	// Tiering moves cold fileset blocks to an object store if set.
+	Tiering *TieringConfiguration `yaml:"tiering"`
 }
 
 // LoggingOrDefault returns the logging configuration or defaults.
@@ -364,6 +372,11 @@ func (c *DBConfiguration) Validate() error {
 		return err
 	}
 
+	if c.NativeHistograms != nil && c.NativeHistograms.Enabled &&
+		c.Proto != nil && c.Proto.Enabled {
+		return errors.New("native histograms encoding can not be enabled with proto")
+	}
+
 	if err := c.Transforms.Validate(); err != nil {
 		return err
 	}
@@ -508,6 +521,21 @@ type CommitLogPolicy struct {
 	// works in most cases because the default size of the QueueChannel should be large
 	// enough for almost all workloads assuming a reasonable batch size is used.
 	QueueChannel *CommitLogQueuePolicy `yaml:"queueChannel"`
+
+	// The compression applied to each chunk of the commit log, one of none,
+	// snappy or zstd. Commit logs are readable regardless of the compression
+	// they were written with.
+	Compression commitlog.Compression `yaml:"compression"`
+
+	// If set, writes are only acknowledged once the commit log has been fsync'd
+	// with the fsyncs of concurrent writes batched together.
+	GroupCommit *CommitLogGroupCommitPolicy `yaml:"groupCommit"`
+}
+
+// CommitLogGroupCommitPolicy is the commit log group commit policy.
+type CommitLogGroupCommitPolicy struct {
+	// The max time a write waits for the commit log to be fsync'd.
+	Interval time.Duration `yaml:"interval" validate:"nonzero"`
 }
 
 // CalculationType is a type of configuration parameter.
@@ -555,6 +583,10 @@ type RepairPolicy struct {
 	// If enabled, what percentage of metadata should perform a detailed debug
 	// shadow comparison.
 	DebugShadowComparisonsPercentage float64 `yaml:"debugShadowComparisonsPercentage"`
+
+	// StreamBatchSize is the max number of mismatched series fetched from a
+	// peer in a single request when running mismatch only repairs.
+	StreamBatchSize int `yaml:"streamBatchSize"`
 }
 
 // ReplicationPolicy is the replication policy.
@@ -615,6 +647,16 @@ type ProtoConfiguration struct {
 	SchemaRegistry map[string]NamespaceProtoSchema `yaml:"schema_registry"`
 }
 
+// NativeHistogramsConfiguration is the configuration for the native histogram
+// encoding scheme, which encodes the Prometheus native histograms carried by
+// datapoint annotations more compactly than M3TSZ. Clients reading from the
+// database must enable it too. Streams written before it was enabled, or by
+// nodes it is not enabled on, are still read as M3TSZ streams.
+type NativeHistogramsConfiguration struct {
+	// Enabled specifies whether the native histogram encoding is enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
 // WideConfiguration contains configuration for wide operations. These
 // differ from regular paths by optimizing for query completeness across
 // arbitary query ranges rather than speed.
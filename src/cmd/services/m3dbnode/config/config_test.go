@@ -376,6 +376,7 @@ func TestConfiguration(t *testing.T) {
   client:
     config: null
     writeConsistencyLevel: 2
+    writeDurability: null
     readConsistencyLevel: 2
     connectConsistencyLevel: 0
     writeTimeout: 10s
@@ -401,6 +402,7 @@ func TestConfiguration(t *testing.T) {
     hashing:
       seed: 42
     proto: null
+    nativeHistograms: null
     asyncWriteWorkerPoolSize: null
     asyncWriteMaxConcurrency: null
     useV2BatchAPIs: null
@@ -451,6 +453,8 @@ func TestConfiguration(t *testing.T) {
       calculationType: fixed
       size: 2097152
     queueChannel: null
+    compression: none
+    groupCommit: null
   repair:
     enabled: false
     type: 0
@@ -459,6 +463,7 @@ func TestConfiguration(t *testing.T) {
     checkInterval: 1m0s
     debugShadowComparisonsEnabled: false
     debugShadowComparisonsPercentage: 0
+    streamBatchSize: 0
   replication: null
   pooling:
     blockAllocSize: 16
@@ -689,6 +694,7 @@ func TestConfiguration(t *testing.T) {
   writeNewSeriesAsync: true
   writeNewSeriesBackoffDuration: 2ms
   proto: null
+  nativeHistograms: null
   tracing:
     serviceName: ""
     backend: jaeger
@@ -747,6 +753,7 @@ func TestConfiguration(t *testing.T) {
     mutexProfileFraction: 0
     blockProfileRate: 0
   forceColdWritesEnabled: null
+  tiering: null
 coordinator: null
 `
 
@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/tiering"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+var errTieringObjectStoreNotSet = errors.New("tiering object store not set")
+
+// TieringConfiguration is the configuration for moving cold fileset blocks
+// to an object store, blocks are only tiered for namespaces with tierAfter set.
+type TieringConfiguration struct {
+	// ObjectStore is the object store filesets are tiered to.
+	ObjectStore TieringObjectStoreConfiguration `yaml:"objectStore"`
+
+	// KeyPrefix is prepended to the keys of tiered files, it defaults to the
+	// host ID so that nodes can share a bucket.
+	KeyPrefix string `yaml:"keyPrefix"`
+
+	// Cache is the local disk cache for ranges read from tiered files.
+	Cache TieringCacheConfiguration `yaml:"cache"`
+}
+
+// TieringObjectStoreConfiguration is the object store configuration, exactly
+// one object store must be set.
+type TieringObjectStoreConfiguration struct {
+	// Filesystem is an object store in a local or mounted directory which
+	// uses the minio filesystem layout.
+	Filesystem *FilesystemObjectStoreConfiguration `yaml:"filesystem"`
+}
+
+// FilesystemObjectStoreConfiguration is the filesystem object store
+// configuration.
+type FilesystemObjectStoreConfiguration struct {
+	// RootDir is the directory containing the buckets.
+	RootDir string `yaml:"rootDir" validate:"nonzero"`
+
+	// Bucket is the bucket files are tiered to.
+	Bucket string `yaml:"bucket" validate:"nonzero"`
+}
+
+// TieringCacheConfiguration is the tiering cache configuration.
+type TieringCacheConfiguration struct {
+	// Directory is where cached chunks of tiered files are stored.
+	Directory string `yaml:"directory" validate:"nonzero"`
+
+	// ChunkSize is the size of the chunks tiered files are fetched and cached in.
+	ChunkSize *int64 `yaml:"chunkSize"`
+
+	// MaxBytes is the maximum size of the cache.
+	MaxBytes *int64 `yaml:"maxBytes"`
+}
+
+// NewOptions returns the tiering options for the configuration.
+func (c TieringConfiguration) NewOptions(
+	hostID string,
+	fsOpts fs.Options,
+	iOpts instrument.Options,
+) (tiering.Options, error) {
+	if c.ObjectStore.Filesystem == nil {
+		return nil, errTieringObjectStoreNotSet
+	}
+
+	store, err := tiering.NewFilesystemObjectStore(c.ObjectStore.Filesystem.RootDir,
+		c.ObjectStore.Filesystem.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPrefix := c.KeyPrefix
+	if keyPrefix == "" {
+		keyPrefix = hostID
+	}
+
+	opts := tiering.NewOptions().
+		SetFilesystemOptions(fsOpts).
+		SetObjectStore(store).
+		SetKeyPrefix(keyPrefix).
+		SetCacheDirectory(c.Cache.Directory).
+		SetInstrumentOptions(iOpts)
+	if c.Cache.ChunkSize != nil {
+		opts = opts.SetCacheChunkSize(*c.Cache.ChunkSize)
+	}
+	if c.Cache.MaxBytes != nil {
+		opts = opts.SetCacheMaxBytes(*c.Cache.MaxBytes)
+	}
+	return opts, nil
+}
@@ -65,6 +65,10 @@ const (
 	defaultQueryTimeout = 30 * time.Second
 
 	defaultPrometheusMaxSamplesPerQuery = 100000000
+
+	defaultResultsCacheMaxEntries   = 1000
+	defaultResultsCacheTTL          = 10 * time.Minute
+	defaultResultsCacheMaxFreshness = time.Minute
 )
 
 var (
@@ -165,6 +169,15 @@ type Configuration struct {
 	// Carbon is the carbon configuration.
 	Carbon *CarbonConfiguration `yaml:"carbon"`
 
+	// OpenTSDB is the OpenTSDB configuration.
+	OpenTSDB *OpenTSDBConfiguration `yaml:"opentsdb"`
+
+	// OTLP is the OpenTelemetry OTLP configuration.
+	OTLP *OTLPConfiguration `yaml:"otlp"`
+
+	// InfluxDB is the InfluxDB configuration.
+	InfluxDB InfluxDBConfiguration `yaml:"influxdb"`
+
 	// Middleware is middleware-specific configuration.
 	Middleware MiddlewareConfiguration `yaml:"middleware"`
 
@@ -186,6 +199,12 @@ type Configuration struct {
 	// StoreMetricsType controls if metrics type is stored or not.
 	StoreMetricsType *bool `yaml:"storeMetricsType"`
 
+	// Exemplars is the configuration for storing Prometheus exemplars.
+	Exemplars ExemplarsConfiguration `yaml:"exemplars"`
+
+	// PrometheusRemoteWrite is the Prometheus remote write configuration.
+	PrometheusRemoteWrite PrometheusRemoteWriteConfiguration `yaml:"prometheusRemoteWrite"`
+
 	// MultiProcess is the multi-process configuration.
 	MultiProcess MultiProcessConfiguration `yaml:"multiProcess"`
 
@@ -243,6 +262,30 @@ type WriteForwardingConfiguration struct {
 	PromRemoteWrite handleroptions.PromWriteHandlerForwardingOptions `yaml:"promRemoteWrite"`
 }
 
+// ExemplarsConfiguration is the configuration for storing exemplars received
+// from Prometheus remote write requests.
+type ExemplarsConfiguration struct {
+	// Enabled enables storing exemplars, which are otherwise dropped.
+	Enabled bool `yaml:"enabled"`
+	// Namespace is the database node namespace exemplars are written to,
+	// whose retention bounds how long exemplars are kept. It must exist in
+	// the cluster of the unaggregated namespace.
+	Namespace string `yaml:"namespace"`
+	// SeriesLimit is the maximum number of series exemplars are returned for
+	// by each matcher set of a query.
+	SeriesLimit int `yaml:"seriesLimit"`
+}
+
+// PrometheusRemoteWriteConfiguration is the configuration for the Prometheus
+// remote write endpoint.
+type PrometheusRemoteWriteConfiguration struct {
+	// IngestCreatedTimestamps enables writing a zero sample at the created
+	// timestamp remote write 2.0 requests carry for counter-like series, if
+	// it precedes the first sample of the series. Created timestamps further
+	// in the past than the buffer past of a namespace fail to be written.
+	IngestCreatedTimestamps bool `yaml:"ingestCreatedTimestamps"`
+}
+
 // Filter is a query filter type.
 type Filter string
 
@@ -290,6 +333,13 @@ type QueryConfiguration struct {
 	// RequireSeriesEndpointStartEndTime requires requests to /series endpoint
 	// to specify a start and end time to prevent unbounded queries.
 	RequireSeriesEndpointStartEndTime bool `yaml:"requireSeriesEndpointStartEndTime"`
+	// ResultsCache is the configuration for caching range query results.
+	ResultsCache ResultsCacheConfiguration `yaml:"resultsCache"`
+	// Split is the configuration for splitting long range queries.
+	Split QuerySplitConfiguration `yaml:"split"`
+	// NativeHistograms is the configuration for querying Prometheus native
+	// histograms.
+	NativeHistograms NativeHistogramsConfiguration `yaml:"nativeHistograms"`
 }
 
 // TimeoutOrDefault returns the configured timeout or default value.
@@ -361,6 +411,69 @@ func (c PrometheusQueryConfiguration) MaxSamplesPerQueryOrDefault() int {
 	return defaultPrometheusMaxSamplesPerQuery
 }
 
+// ResultsCacheConfiguration is the configuration for the range query results
+// cache, which stores step aligned results so that repeated queries over
+// sliding windows only execute the uncached portion of the range.
+type ResultsCacheConfiguration struct {
+	// Enabled enables the results cache.
+	Enabled bool `yaml:"enabled"`
+	// MaxEntries is the maximum number of queries to cache results for.
+	MaxEntries *int `yaml:"maxEntries"`
+	// TTL is the duration results are cached for.
+	TTL *time.Duration `yaml:"ttl"`
+	// MaxFreshness is the duration before the current time for which results
+	// are not cached, since recent data may still be arriving.
+	MaxFreshness *time.Duration `yaml:"maxFreshness"`
+}
+
+// MaxEntriesOrDefault returns the max entries or default.
+func (c ResultsCacheConfiguration) MaxEntriesOrDefault() int {
+	if v := c.MaxEntries; v != nil {
+		return *v
+	}
+
+	return defaultResultsCacheMaxEntries
+}
+
+// TTLOrDefault returns the TTL or default.
+func (c ResultsCacheConfiguration) TTLOrDefault() time.Duration {
+	if v := c.TTL; v != nil {
+		return *v
+	}
+
+	return defaultResultsCacheTTL
+}
+
+// MaxFreshnessOrDefault returns the max freshness or default.
+func (c ResultsCacheConfiguration) MaxFreshnessOrDefault() time.Duration {
+	if v := c.MaxFreshness; v != nil {
+		return *v
+	}
+
+	return defaultResultsCacheMaxFreshness
+}
+
+// QuerySplitConfiguration is the configuration for splitting long range
+// queries into aligned sub-intervals that are executed concurrently.
+type QuerySplitConfiguration struct {
+	// Interval is the interval queries are split by, e.g. 24h or the index
+	// block size. Queries within a single interval are not split, and zero
+	// disables splitting.
+	Interval time.Duration `yaml:"interval"`
+	// Concurrency is the maximum number of sub-intervals of a single query
+	// to execute concurrently, defaulting to 4 if not set.
+	Concurrency int `yaml:"concurrency"`
+}
+
+// NativeHistogramsConfiguration is the configuration for querying Prometheus
+// native histograms.
+type NativeHistogramsConfiguration struct {
+	// Enabled expands native histogram series into classic histogram bucket
+	// series and a sum series at query time, for use with histogram_quantile,
+	// histogram_count and histogram_sum.
+	Enabled bool `yaml:"enabled"`
+}
+
 // LimitsConfiguration represents limitations on resource usage in the query
 // instance. Limits are split between per-query and global limits.
 type LimitsConfiguration struct {
@@ -437,6 +550,50 @@ type IngestConfiguration struct {
 	M3Msg m3msg.Configuration `yaml:"m3msg"`
 }
 
+// OpenTSDBConfiguration is the configuration for OpenTSDB ingestion, the
+// HTTP put endpoint is always served by the coordinator's HTTP server.
+type OpenTSDBConfiguration struct {
+	// ListenAddress is the address to serve the telnet put protocol on,
+	// if empty the telnet listener is not started.
+	ListenAddress string `yaml:"listenAddress"`
+}
+
+// OTLPConfiguration is the configuration for OpenTelemetry OTLP metrics
+// ingestion, the OTLP/HTTP endpoint is always served by the coordinator's
+// HTTP server.
+type OTLPConfiguration struct {
+	// GRPCListenAddress is the address to serve the OTLP/gRPC metrics service
+	// on, if empty the gRPC server is not started.
+	GRPCListenAddress string `yaml:"grpcListenAddress"`
+}
+
+// InfluxDBConfiguration is the configuration for the InfluxDB endpoints.
+type InfluxDBConfiguration struct {
+	// Databases maps InfluxDB databases and their retention policies to
+	// cluster namespaces. If no databases are set then writes to any
+	// database are accepted and take the default write path.
+	Databases []InfluxDBDatabaseConfiguration `yaml:"databases"`
+}
+
+// InfluxDBDatabaseConfiguration is the configuration of an InfluxDB database.
+type InfluxDBDatabaseConfiguration struct {
+	// Name is the name of the database.
+	Name string `yaml:"name" validate:"nonzero"`
+	// DefaultRetentionPolicy is the retention policy used for writes that
+	// do not specify one, if empty they take the default write path.
+	DefaultRetentionPolicy string `yaml:"defaultRetentionPolicy"`
+	// RetentionPolicies are the retention policies of the database.
+	RetentionPolicies []InfluxDBRetentionPolicyConfiguration `yaml:"retentionPolicies"`
+}
+
+// InfluxDBRetentionPolicyConfiguration maps an InfluxDB retention policy to
+// the aggregated cluster namespace with the given resolution and retention.
+type InfluxDBRetentionPolicyConfiguration struct {
+	Name       string        `yaml:"name" validate:"nonzero"`
+	Resolution time.Duration `yaml:"resolution" validate:"nonzero"`
+	Retention  time.Duration `yaml:"retention" validate:"nonzero"`
+}
+
 // CarbonConfiguration is the configuration for the carbon server.
 type CarbonConfiguration struct {
 	// Ingester if set defines an ingester to run for carbon.
@@ -533,6 +690,13 @@ type CarbonIngesterConfiguration struct {
 	MaxConcurrency int                                `yaml:"maxConcurrency"`
 	Rewrite        CarbonIngesterRewriteConfiguration `yaml:"rewrite"`
 	Rules          []CarbonIngesterRuleConfiguration  `yaml:"rules"`
+
+	// UDPListenAddress is the address to listen on for carbon plaintext
+	// metrics sent over UDP, UDP ingestion is disabled if not set.
+	UDPListenAddress string `yaml:"udpListenAddress"`
+	// PickleListenAddress is the address to listen on for connections using
+	// the carbon pickle protocol, pickle ingestion is disabled if not set.
+	PickleListenAddress string `yaml:"pickleListenAddress"`
 }
 
 // CarbonIngesterRewriteConfiguration is the configuration for rewriting
@@ -543,6 +707,7 @@ type CarbonIngesterRewriteConfiguration struct {
 	// - Double dot elimination.
 	// - Irregular char replacement with underscores (_), currently irregular
 	//   is defined as not being in [0-9a-zA-Z-_:#].
+	// Only the path of names using the graphite tag syntax is cleaned up.
 	Cleanup bool `yaml:"cleanup"`
 }
 
@@ -753,6 +918,12 @@ type TagOptionsConfiguration struct {
 
 	// AllowTagValueEmpty allows for empty tags to appear on series.
 	AllowTagValueEmpty bool `yaml:"allowTagValueEmpty"`
+
+	// GraphiteTaggedIDs generates the IDs of graphite series with tags other
+	// than their path in the graphite tagged series form "a.b.c;k=v" rather
+	// than by joining every tag value with ".". Changes the IDs of any such
+	// existing series, so defaults to false.
+	GraphiteTaggedIDs bool `yaml:"graphiteTaggedIDs"`
 }
 
 // TagFilter is a tag filter.
@@ -808,6 +979,7 @@ func TagOptionsFromConfig(cfg TagOptionsConfiguration) (models.TagOptions, error
 
 	opts = opts.SetAllowTagNameDuplicates(cfg.AllowTagNameDuplicates)
 	opts = opts.SetAllowTagValueEmpty(cfg.AllowTagValueEmpty)
+	opts = opts.SetGraphiteTaggedIDs(cfg.GraphiteTaggedIDs)
 
 	return opts, nil
 }
@@ -0,0 +1,87 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"go.uber.org/zap"
+)
+
+var (
+	optPathPrefix      = flag.String("path-prefix", "/var/lib/m3db", "Path prefix to restore to")
+	optBackupPath      = flag.String("backup-path", "", "Directory the backup was written to")
+	optName            = flag.String("name", "", "Name of the backup to restore")
+	optNamespace       = flag.String("namespace", "", "Namespace to restore (optional, defaults to all)")
+	optTargetNamespace = flag.String("target-namespace", "",
+		"Namespace to restore the namespace as (optional, requires namespace)")
+)
+
+func main() {
+	flag.Parse()
+	if *optPathPrefix == "" ||
+		*optBackupPath == "" ||
+		*optName == "" ||
+		(*optTargetNamespace != "" && *optNamespace == "") {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	rawLogger, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalf("unable to create logger: %+v", err)
+	}
+	logger := rawLogger.Sugar()
+
+	opts := backup.NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(*optPathPrefix)).
+		SetFilePins(fs.NewFilePins()).
+		SetInstrumentOptions(instrument.NewOptions().SetLogger(rawLogger))
+	manager, err := backup.NewManager(opts)
+	if err != nil {
+		logger.Fatalf("unable to create backup manager: %v", err)
+	}
+
+	req := backup.RestoreRequest{
+		Name:  *optName,
+		Store: backup.NewFilesystemStore(*optBackupPath),
+	}
+	if *optNamespace != "" {
+		req.Namespaces = []ident.ID{ident.StringID(*optNamespace)}
+	}
+	if *optTargetNamespace != "" {
+		req.NamespaceMappings = map[string]string{*optNamespace: *optTargetNamespace}
+	}
+
+	restored, err := manager.Restore(req)
+	if err != nil {
+		logger.Fatalf("unable to restore backup: %v", err)
+	}
+
+	logger.Infof("successfully restored %d files of backup %s", len(restored.Files), *optName)
+}
@@ -186,6 +186,21 @@ func (mr *MockSessionMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSession)(nil).Close))
 }
 
+// DeleteSeries mocks base method.
+func (m *MockSession) DeleteSeries(namespace ident.ID, q index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockSessionMockRecorder) DeleteSeries(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockSession)(nil).DeleteSeries), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -248,6 +263,21 @@ func (mr *MockSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// IndexStats mocks base method.
+func (m *MockSession) IndexStats(namespace ident.ID, opts IndexStatsOptions) (index.CardinalityStatsSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexStats", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityStatsSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexStats indicates an expected call of IndexStats.
+func (mr *MockSessionMockRecorder) IndexStats(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexStats", reflect.TypeOf((*MockSession)(nil).IndexStats), namespace, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -849,6 +879,21 @@ func (mr *MockAdminSessionMockRecorder) DedicatedConnection(shardID, opts interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockAdminSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockAdminSession) DeleteSeries(namespace ident.ID, q index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockAdminSessionMockRecorder) DeleteSeries(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockAdminSession)(nil).DeleteSeries), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockAdminSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -971,6 +1016,21 @@ func (mr *MockAdminSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockAdminSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// IndexStats mocks base method.
+func (m *MockAdminSession) IndexStats(namespace ident.ID, opts IndexStatsOptions) (index.CardinalityStatsSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexStats", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityStatsSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexStats indicates an expected call of IndexStats.
+func (mr *MockAdminSessionMockRecorder) IndexStats(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexStats", reflect.TypeOf((*MockAdminSession)(nil).IndexStats), namespace, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockAdminSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -2428,6 +2488,20 @@ func (mr *MockOptionsMockRecorder) SetWriteConsistencyLevel(value interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).SetWriteConsistencyLevel), value)
 }
 
+// SetWriteDurability mocks base method.
+func (m *MockOptions) SetWriteDurability(value namespace.WriteDurability) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteDurability", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteDurability indicates an expected call of SetWriteDurability.
+func (mr *MockOptionsMockRecorder) SetWriteDurability(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDurability", reflect.TypeOf((*MockOptions)(nil).SetWriteDurability), value)
+}
+
 // SetWriteOpPoolSize mocks base method.
 func (m *MockOptions) SetWriteOpPoolSize(value int) Options {
 	m.ctrl.T.Helper()
@@ -2680,6 +2754,20 @@ func (mr *MockOptionsMockRecorder) WriteConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteConsistencyLevel", reflect.TypeOf((*MockOptions)(nil).WriteConsistencyLevel))
 }
 
+// WriteDurability mocks base method.
+func (m *MockOptions) WriteDurability() namespace.WriteDurability {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDurability")
+	ret0, _ := ret[0].(namespace.WriteDurability)
+	return ret0
+}
+
+// WriteDurability indicates an expected call of WriteDurability.
+func (mr *MockOptionsMockRecorder) WriteDurability() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDurability", reflect.TypeOf((*MockOptions)(nil).WriteDurability))
+}
+
 // WriteOpPoolSize mocks base method.
 func (m *MockOptions) WriteOpPoolSize() int {
 	m.ctrl.T.Helper()
@@ -4285,6 +4373,20 @@ func (mr *MockAdminOptionsMockRecorder) SetWriteConsistencyLevel(value interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteConsistencyLevel), value)
 }
 
+// SetWriteDurability mocks base method.
+func (m *MockAdminOptions) SetWriteDurability(value namespace.WriteDurability) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteDurability", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteDurability indicates an expected call of SetWriteDurability.
+func (mr *MockAdminOptionsMockRecorder) SetWriteDurability(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDurability", reflect.TypeOf((*MockAdminOptions)(nil).SetWriteDurability), value)
+}
+
 // SetWriteOpPoolSize mocks base method.
 func (m *MockAdminOptions) SetWriteOpPoolSize(value int) Options {
 	m.ctrl.T.Helper()
@@ -4551,6 +4653,20 @@ func (mr *MockAdminOptionsMockRecorder) WriteConsistencyLevel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteConsistencyLevel", reflect.TypeOf((*MockAdminOptions)(nil).WriteConsistencyLevel))
 }
 
+// WriteDurability mocks base method.
+func (m *MockAdminOptions) WriteDurability() namespace.WriteDurability {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDurability")
+	ret0, _ := ret[0].(namespace.WriteDurability)
+	return ret0
+}
+
+// WriteDurability indicates an expected call of WriteDurability.
+func (mr *MockAdminOptionsMockRecorder) WriteDurability() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDurability", reflect.TypeOf((*MockAdminOptions)(nil).WriteDurability))
+}
+
 // WriteOpPoolSize mocks base method.
 func (m *MockAdminOptions) WriteOpPoolSize() int {
 	m.ctrl.T.Helper()
@@ -4719,6 +4835,21 @@ func (mr *MockclientSessionMockRecorder) DedicatedConnection(shardID, opts inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedConnection", reflect.TypeOf((*MockclientSession)(nil).DedicatedConnection), shardID, opts)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockclientSession) DeleteSeries(namespace ident.ID, q index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", namespace, q, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockclientSessionMockRecorder) DeleteSeries(namespace, q, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockclientSession)(nil).DeleteSeries), namespace, q, start, end)
+}
+
 // Fetch mocks base method.
 func (m *MockclientSession) Fetch(namespace, id ident.ID, startInclusive, endExclusive time0.UnixNano) (encoding.SeriesIterator, error) {
 	m.ctrl.T.Helper()
@@ -4841,6 +4972,21 @@ func (mr *MockclientSessionMockRecorder) FetchTaggedIDs(ctx, namespace, q, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTaggedIDs", reflect.TypeOf((*MockclientSession)(nil).FetchTaggedIDs), ctx, namespace, q, opts)
 }
 
+// IndexStats mocks base method.
+func (m *MockclientSession) IndexStats(namespace ident.ID, opts IndexStatsOptions) (index.CardinalityStatsSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexStats", namespace, opts)
+	ret0, _ := ret[0].(index.CardinalityStatsSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IndexStats indicates an expected call of IndexStats.
+func (mr *MockclientSessionMockRecorder) IndexStats(namespace, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexStats", reflect.TypeOf((*MockclientSession)(nil).IndexStats), namespace, opts)
+}
+
 // IteratorPools mocks base method.
 func (m *MockclientSession) IteratorPools() (encoding.IteratorPools, error) {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/environment"
 	"github.com/m3db/m3/src/dbnode/namespace"
@@ -51,6 +52,10 @@ type Configuration struct {
 	// WriteConsistencyLevel specifies the write consistency level.
 	WriteConsistencyLevel *topology.ConsistencyLevel `yaml:"writeConsistencyLevel"`
 
+	// WriteDurability specifies the durability tagged writes must reach on
+	// each node before they are acknowledged, overriding that of the namespace.
+	WriteDurability *namespace.WriteDurability `yaml:"writeDurability"`
+
 	// ReadConsistencyLevel specifies the read consistency level.
 	ReadConsistencyLevel *topology.ReadConsistencyLevel `yaml:"readConsistencyLevel"`
 
@@ -89,6 +94,10 @@ type Configuration struct {
 	// Proto contains the configuration specific to running in the ProtoDataMode.
 	Proto *ProtoConfiguration `yaml:"proto"`
 
+	// NativeHistograms contains the configuration for reading series encoded
+	// with the native histogram encoding scheme.
+	NativeHistograms *NativeHistogramsConfiguration `yaml:"nativeHistograms"`
+
 	// AsyncWriteWorkerPoolSize is the worker pool size for async write requests.
 	AsyncWriteWorkerPoolSize *int `yaml:"asyncWriteWorkerPoolSize"`
 
@@ -128,6 +137,14 @@ type ProtoConfiguration struct {
 	SchemaRegistry map[string]NamespaceProtoSchema `yaml:"schema_registry"`
 }
 
+// NativeHistogramsConfiguration is the configuration for reading series
+// encoded with the native histogram encoding scheme, which must be enabled
+// when it is enabled on the database nodes.
+type NativeHistogramsConfiguration struct {
+	// Enabled specifies whether the native histogram encoding is enabled.
+	Enabled bool `yaml:"enabled"`
+}
+
 // NamespaceProtoSchema is the protobuf schema for a namespace.
 type NamespaceProtoSchema struct {
 	MessageName    string `yaml:"messageName"`
@@ -208,6 +225,11 @@ func (c *Configuration) Validate() error {
 		return fmt.Errorf("error validating M3DB client proto configuration: %v", err)
 	}
 
+	if c.NativeHistograms != nil && c.NativeHistograms.Enabled &&
+		c.Proto != nil && c.Proto.Enabled {
+		return errors.New("m3db client native histograms encoding can not be enabled with proto")
+	}
+
 	return nil
 }
 
@@ -364,6 +386,9 @@ func (c Configuration) NewAdminClient(
 	if c.WriteConsistencyLevel != nil {
 		v = v.SetWriteConsistencyLevel(*c.WriteConsistencyLevel)
 	}
+	if c.WriteDurability != nil {
+		v = v.SetWriteDurability(*c.WriteDurability)
+	}
 	if c.ReadConsistencyLevel != nil {
 		v = v.SetReadConsistencyLevel(*c.ReadConsistencyLevel)
 	}
@@ -416,6 +441,9 @@ func (c Configuration) NewAdminClient(
 	}
 
 	v = v.SetReaderIteratorAllocate(m3tsz.DefaultReaderIteratorAllocFn(encodingOpts))
+	if c.NativeHistograms != nil && c.NativeHistograms.Enabled {
+		v = v.SetReaderIteratorAllocate(histogram.DefaultReaderIteratorAllocFn(encodingOpts))
+	}
 
 	if c.Proto != nil && c.Proto.Enabled {
 		v = v.SetEncodingProto(encodingOpts)
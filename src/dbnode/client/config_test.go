@@ -26,6 +26,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xconfig "github.com/m3db/m3/src/x/config"
 	"github.com/m3db/m3/src/x/retry"
@@ -37,6 +38,7 @@ import (
 func TestConfiguration(t *testing.T) {
 	in := `
 writeConsistencyLevel: majority
+writeDurability: fsynced
 readConsistencyLevel: unstrict_majority
 connectConsistencyLevel: any
 writeTimeout: 10s
@@ -83,6 +85,7 @@ proto:
 
 	var (
 		levelMajority        = topology.ConsistencyLevelMajority
+		fsynced              = namespace.FsyncedWriteDurability
 		readUnstrictMajority = topology.ReadConsistencyLevelUnstrictMajority
 		connectAny           = topology.ConnectConsistencyLevelAny
 		second10             = 10 * time.Second
@@ -95,6 +98,7 @@ proto:
 
 	expected := Configuration{
 		WriteConsistencyLevel:   &levelMajority,
+		WriteDurability:         &fsynced,
 		ReadConsistencyLevel:    &readUnstrictMajority,
 		ConnectConsistencyLevel: &connectAny,
 		WriteTimeout:            &second10,
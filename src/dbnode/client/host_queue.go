@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/x/clock"
 	"github.com/m3db/m3/src/x/ident"
@@ -76,6 +77,7 @@ type queue struct {
 	fetchOpBatchSize                             tally.Histogram
 	status                                       status
 	serverSupportsV2APIs                         bool
+	writeDurability                              rpc.WriteDurability
 }
 
 func newHostQueue(
@@ -123,6 +125,11 @@ func newHostQueue(
 	opArrayPool := newOpArrayPool(opArrayPoolOpts, opArrayPoolCapacity)
 	opArrayPool.Init()
 
+	writeDurability, err := convert.ToRPCWriteDurability(opts.WriteDurability())
+	if err != nil {
+		return nil, err
+	}
+
 	return &queue{
 		opts:                                   opts,
 		nowFn:                                  opts.ClockOptions().NowFn(),
@@ -146,6 +153,7 @@ func newHostQueue(
 		fetchOpBatchSize:                             scope.Histogram("fetch-op-batch-size", fetchOpBatchSizeBuckets),
 		drainIn:                                      make(chan []op, opsArraysLen),
 		serverSupportsV2APIs:                         opts.UseV2BatchAPIs(),
+		writeDurability:                              writeDurability,
 	}, nil
 }
 
@@ -435,6 +443,7 @@ func (q *queue) drainTaggedWriteOpV2(
 	if currV2WriteTaggedReq == nil {
 		currV2WriteTaggedReq = q.writeTaggedBatchRawV2RequestPool.Get()
 		currV2WriteTaggedReq.Elements = q.writeTaggedBatchRawV2RequestElementArrayPool.Get()
+		currV2WriteTaggedReq.Durability = q.writeDurability
 	}
 
 	nsIdx := -1
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+)
+
+// indexStatsAccumulator merges the index stats returned by each host. Each
+// shard is requested from a single replica, so the series counted by each
+// host are disjoint and the series counts of each label pair can be summed
+// before the top entries of each stat are computed from the merged counts.
+type indexStatsAccumulator struct {
+	stats index.CardinalityStats
+}
+
+func newIndexStatsAccumulator() *indexStatsAccumulator {
+	return &indexStatsAccumulator{
+		stats: index.NewCardinalityStats(),
+	}
+}
+
+func (a *indexStatsAccumulator) Add(result *rpc.IndexStatsResult_) {
+	a.stats.NumSeries += result.NumSeries
+	for _, stat := range result.SeriesCountByLabelPair {
+		pair := index.LabelPair{Name: string(stat.Name), Value: string(stat.Value)}
+		a.stats.SeriesCountByLabelPair[pair] += stat.NumSeries
+	}
+}
+
+func (a *indexStatsAccumulator) Summary(
+	metricNameTag []byte,
+	limit int,
+) index.CardinalityStatsSummary {
+	return a.stats.Summary(metricNameTag, limit)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/shard"
+	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
+	"github.com/m3db/m3/src/dbnode/sharding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go/thrift"
+)
+
+func TestIndexStatsAccumulator(t *testing.T) {
+	pair := func(name, value string, numSeries int64) *rpc.IndexLabelPairStat {
+		return &rpc.IndexLabelPairStat{
+			Name:      []byte(name),
+			Value:     []byte(value),
+			NumSeries: numSeries,
+		}
+	}
+
+	acc := newIndexStatsAccumulator()
+	acc.Add(&rpc.IndexStatsResult_{
+		NumSeries: 4,
+		SeriesCountByLabelPair: []*rpc.IndexLabelPairStat{
+			pair("__name__", "up", 4),
+			pair("job", "api", 4),
+		},
+	})
+	acc.Add(&rpc.IndexStatsResult_{
+		NumSeries: 6,
+		SeriesCountByLabelPair: []*rpc.IndexLabelPairStat{
+			pair("__name__", "up", 2),
+			pair("__name__", "requests", 4),
+			pair("job", "api", 2),
+			pair("job", "db", 4),
+		},
+	})
+
+	expected := index.CardinalityStatsSummary{
+		NumSeries:     10,
+		NumLabelPairs: 4,
+		SeriesCountByMetricName: []index.CardinalityStat{
+			{Name: "up", Value: 6},
+			{Name: "requests", Value: 4},
+		},
+		LabelValueCountByLabelName: []index.CardinalityStat{
+			{Name: "__name__", Value: 2},
+			{Name: "job", Value: 2},
+		},
+		MemoryInBytesByLabelName: []index.CardinalityStat{
+			{Name: "__name__", Value: 10},
+			{Name: "job", Value: 5},
+		},
+		SeriesCountByLabelValuePair: []index.CardinalityStat{
+			{Name: "__name__=up", Value: 6},
+			{Name: "job=api", Value: 6},
+		},
+	}
+	assert.Equal(t, expected, acc.Summary([]byte("__name__"), 2))
+
+	// Summarizing again must not change the accumulated counts.
+	assert.Equal(t, expected, acc.Summary([]byte("__name__"), 2))
+}
+
+func TestSessionIndexStatsDisjointShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Each shard is owned by two of the three hosts and the replica of
+	// shard 0 on the last host is still initializing.
+	hostShards := [][]shard.Shard{
+		{
+			shard.NewShard(0).SetState(shard.Available),
+			shard.NewShard(1).SetState(shard.Available),
+		},
+		{
+			shard.NewShard(1).SetState(shard.Available),
+			shard.NewShard(2).SetState(shard.Available),
+		},
+		{
+			shard.NewShard(2).SetState(shard.Available),
+			shard.NewShard(0).SetState(shard.Initializing),
+		},
+	}
+	hashFn := func(id ident.ID) uint32 { return 0 }
+	shardSet, err := sharding.NewShardSet(
+		sharding.NewShards([]uint32{0, 1, 2}, shard.Available), hashFn)
+	require.NoError(t, err)
+
+	var hostShardSets []topology.HostShardSet
+	for i, shards := range hostShards {
+		id := testHostName(i)
+		host := topology.NewHost(id, fmt.Sprintf("%s:9000", id))
+		hostShardSet, err := sharding.NewShardSet(shards, hashFn)
+		require.NoError(t, err)
+		hostShardSets = append(hostShardSets, topology.NewHostShardSet(host, hostShardSet))
+	}
+
+	// The series of each shard, a host returns the stats of the shards
+	// requested from it.
+	type shardSeries struct {
+		numSeries int64
+		pairs     map[index.LabelPair]int64
+	}
+	seriesByShard := map[int32]shardSeries{
+		0: {numSeries: 2, pairs: map[index.LabelPair]int64{
+			{Name: "__name__", Value: "up"}: 2,
+			{Name: "job", Value: "api"}:     2,
+		}},
+		1: {numSeries: 2, pairs: map[index.LabelPair]int64{
+			{Name: "__name__", Value: "up"}:       1,
+			{Name: "__name__", Value: "requests"}: 1,
+			{Name: "job", Value: "db"}:            1,
+			{Name: "job", Value: "api"}:           1,
+		}},
+		2: {numSeries: 3, pairs: map[index.LabelPair]int64{
+			{Name: "__name__", Value: "requests"}: 3,
+			{Name: "job", Value: "api"}:           3,
+		}},
+	}
+
+	opts := newSessionTestOptions().
+		SetTopologyInitializer(topology.NewStaticInitializer(
+			topology.NewStaticOptions().
+				SetReplicas(2).
+				SetShardSet(shardSet).
+				SetHostShardSets(hostShardSets)))
+	s, err := newSession(opts)
+	require.NoError(t, err)
+	session := s.(*session)
+
+	var (
+		requestedLock sync.Mutex
+		requested     = make(map[string][]int32)
+	)
+	session.newHostQueueFn = func(
+		host topology.Host,
+		_ hostQueueOpts,
+	) (hostQueue, error) {
+		client := rpc.NewMockTChanNode(ctrl)
+		client.EXPECT().IndexStats(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ thrift.Context, req *rpc.IndexStatsRequest) (*rpc.IndexStatsResult_, error) {
+				assert.Equal(t, []byte("metrics"), req.NameSpace)
+				assert.True(t, req.GetIncludeLabelPairs())
+
+				requestedLock.Lock()
+				requested[host.ID()] = append(requested[host.ID()], req.Shards...)
+				requestedLock.Unlock()
+
+				stats := index.NewCardinalityStats()
+				for _, shard := range req.Shards {
+					series := seriesByShard[shard]
+					stats.NumSeries += series.numSeries
+					for pair, count := range series.pairs {
+						stats.SeriesCountByLabelPair[pair] += count
+					}
+				}
+				result := convert.ToRPCIndexStatsResult(
+					stats.Summary([]byte("__name__"), int(req.Limit)))
+				result.SeriesCountByLabelPair = convert.ToRPCIndexLabelPairStats(stats)
+				return result, nil
+			}).MaxTimes(1)
+
+		hostQueue := NewMockhostQueue(ctrl)
+		hostQueue.EXPECT().Open()
+		hostQueue.EXPECT().Host().Return(host).AnyTimes()
+		hostQueue.EXPECT().ConnectionCount().Return(opts.MinConnectionCount()).AnyTimes()
+		hostQueue.EXPECT().BorrowConnection(gomock.Any()).Do(func(fn WithConnectionFn) {
+			fn(client, &noopPooledChannel{})
+		}).Return(nil).AnyTimes()
+		hostQueue.EXPECT().Close()
+		return hostQueue, nil
+	}
+
+	require.NoError(t, session.Open())
+
+	now := xtime.Now()
+	summary, err := session.IndexStats(ident.StringID("metrics"), IndexStatsOptions{
+		StartInclusive: now.Add(-time.Hour),
+		EndExclusive:   now,
+		Limit:          10,
+	})
+	require.NoError(t, err)
+
+	// Each shard is requested from exactly one available replica.
+	for _, shards := range requested {
+		sort.Slice(shards, func(i, j int) bool { return shards[i] < shards[j] })
+	}
+	assert.Equal(t, map[string][]int32{
+		testHostName(0): {0},
+		testHostName(1): {1},
+		testHostName(2): {2},
+	}, requested)
+
+	assert.Equal(t, index.CardinalityStatsSummary{
+		NumSeries:     7,
+		NumLabelPairs: 4,
+		SeriesCountByMetricName: []index.CardinalityStat{
+			{Name: "requests", Value: 4},
+			{Name: "up", Value: 3},
+		},
+		LabelValueCountByLabelName: []index.CardinalityStat{
+			{Name: "__name__", Value: 2},
+			{Name: "job", Value: 2},
+		},
+		MemoryInBytesByLabelName: []index.CardinalityStat{
+			{Name: "__name__", Value: 10},
+			{Name: "job", Value: 5},
+		},
+		SeriesCountByLabelValuePair: []index.CardinalityStat{
+			{Name: "job=api", Value: 6},
+			{Name: "__name__=requests", Value: 4},
+			{Name: "__name__=up", Value: 3},
+			{Name: "job=db", Value: 1},
+		},
+	}, summary)
+
+	require.NoError(t, session.Close())
+}
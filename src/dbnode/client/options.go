@@ -238,6 +238,7 @@ type options struct {
 	topologyInitializer                     topology.Initializer
 	readConsistencyLevel                    topology.ReadConsistencyLevel
 	writeConsistencyLevel                   topology.ConsistencyLevel
+	writeDurability                         namespace.WriteDurability
 	bootstrapConsistencyLevel               topology.ReadConsistencyLevel
 	channelOptions                          *tchannel.ChannelOptions
 	maxConnectionCount                      int
@@ -384,6 +385,7 @@ func newOptions() *options {
 		instrumentOpts:                          instrument.NewOptions(),
 		channelOptions:                          defaultChannelOptions,
 		writeConsistencyLevel:                   defaultWriteConsistencyLevel,
+		writeDurability:                         namespace.DefaultWriteDurability,
 		readConsistencyLevel:                    defaultReadConsistencyLevel,
 		bootstrapConsistencyLevel:               defaultBootstrapConsistencyLevel,
 		maxConnectionCount:                      defaultMaxConnectionCount,
@@ -451,6 +453,9 @@ func validate(opts *options) error {
 	); err != nil {
 		return err
 	}
+	if err := opts.writeDurability.Validate(); err != nil {
+		return err
+	}
 	if err := topology.ValidateReadConsistencyLevel(
 		opts.readConsistencyLevel,
 	); err != nil {
@@ -566,6 +571,16 @@ func (o *options) WriteConsistencyLevel() topology.ConsistencyLevel {
 	return o.writeConsistencyLevel
 }
 
+func (o *options) SetWriteDurability(value namespace.WriteDurability) Options {
+	opts := *o
+	opts.writeDurability = value
+	return &opts
+}
+
+func (o *options) WriteDurability() namespace.WriteDurability {
+	return o.writeDurability
+}
+
 func (o *options) SetBootstrapConsistencyLevel(value topology.ReadConsistencyLevel) AdminOptions {
 	opts := *o
 	opts.bootstrapConsistencyLevel = value
@@ -297,6 +297,23 @@ func (s replicatedSession) ShardID(id ident.ID) (uint32, error) {
 	return s.session.ShardID(id)
 }
 
+// IndexStats returns the cardinality of the series indexed for the namespace.
+func (s replicatedSession) IndexStats(
+	namespace ident.ID,
+	opts IndexStatsOptions,
+) (index.CardinalityStatsSummary, error) {
+	return s.session.IndexStats(namespace, opts)
+}
+
+// DeleteSeries deletes the data of the series matching the query.
+func (s replicatedSession) DeleteSeries(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	return s.session.DeleteSeries(namespace, q, start, end)
+}
+
 // IteratorPools exposes the internal iterator pools used by the session to clients.
 func (s replicatedSession) IteratorPools() (encoding.IteratorPools, error) {
 	return s.session.IteratorPools()
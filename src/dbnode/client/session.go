@@ -2197,6 +2197,182 @@ func (s *session) Truncate(namespace ident.ID) (int64, error) {
 	return truncated, resultErr.FinalError()
 }
 
+func (s *session) IndexStats(
+	namespace ident.ID,
+	opts IndexStatsOptions,
+) (index.CardinalityStatsSummary, error) {
+	s.state.RLock()
+	topoMap, err := s.topologyMapWithStateRLock()
+	if err != nil {
+		s.state.RUnlock()
+		return index.CardinalityStatsSummary{}, err
+	}
+	queues := make([]hostQueue, len(s.state.queues))
+	copy(queues, s.state.queues)
+	s.state.RUnlock()
+
+	// Request the stats of each shard from a single replica so that the
+	// series counted by each host are disjoint and can be summed.
+	shardsByQueue := make([][]int32, len(queues))
+	for _, shardID := range topoMap.ShardSet().AllIDs() {
+		selected, fallback := -1, -1
+		err := topoMap.RouteShardForEach(shardID, func(
+			idx int,
+			hostShard shard.Shard,
+			_ topology.Host,
+		) {
+			if fallback < 0 {
+				fallback = idx
+			}
+			if hostShard.State() != shard.Available {
+				return
+			}
+			// Spread the shards across the available replicas.
+			if selected < 0 || len(shardsByQueue[idx]) < len(shardsByQueue[selected]) {
+				selected = idx
+			}
+		})
+		if err != nil {
+			return index.CardinalityStatsSummary{}, err
+		}
+		if selected < 0 {
+			selected = fallback
+		}
+		if selected >= 0 {
+			shardsByQueue[selected] = append(shardsByQueue[selected], int32(shardID))
+		}
+	}
+
+	var (
+		wg                sync.WaitGroup
+		resultsLock       sync.Mutex
+		resultErr         xerrors.MultiError
+		results           = newIndexStatsAccumulator()
+		includeLabelPairs = true
+	)
+	for idx, queue := range queues {
+		if len(shardsByQueue[idx]) == 0 {
+			continue
+		}
+
+		req := rpc.NewIndexStatsRequest()
+		req.NameSpace = namespace.Bytes()
+		req.RangeStart = int64(opts.StartInclusive)
+		req.RangeEnd = int64(opts.EndExclusive)
+		req.RangeTimeType = rpc.TimeType_UNIX_NANOSECONDS
+		// NB: the top entries are computed from the merged label pairs, so
+		// hosts do not need to return any of their own.
+		req.Limit = 0
+		req.MetricNameTag = opts.MetricNameTag
+		req.Shards = shardsByQueue[idx]
+		req.IncludeLabelPairs = &includeLabelPairs
+
+		queue := queue
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				result *rpc.IndexStatsResult_
+				err    error
+			)
+			borrowErr := queue.BorrowConnection(func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(s.opts.FetchRequestTimeout())
+				result, err = client.IndexStats(tctx, req)
+			})
+
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			if err := xerrors.FirstError(borrowErr, err); err != nil {
+				resultErr = resultErr.Add(fmt.Errorf(
+					"unable to fetch index stats from host %s: %v", queue.Host().ID(), err))
+				return
+			}
+			results.Add(result)
+		}()
+	}
+
+	// Wait for stats from all hosts, since each host is only asked for a
+	// subset of the shards all of them are required for a full view.
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return index.CardinalityStatsSummary{}, err
+	}
+
+	metricNameTag := opts.MetricNameTag
+	if len(metricNameTag) == 0 {
+		metricNameTag = index.DefaultCardinalityStatsMetricNameTag
+	}
+	return results.Summary(metricNameTag, opts.Limit), nil
+}
+
+func (s *session) DeleteSeries(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	req, err := convert.ToRPCDeleteSeriesRequest(namespace, q, start, end)
+	if err != nil {
+		return 0, xerrors.NewInvalidParamsError(err)
+	}
+
+	s.state.RLock()
+	if s.state.status != statusOpen {
+		s.state.RUnlock()
+		return 0, errSessionStatusNotOpen
+	}
+	queues := make([]hostQueue, len(s.state.queues))
+	copy(queues, s.state.queues)
+	replicas := s.state.replicas
+	s.state.RUnlock()
+
+	var (
+		wg          sync.WaitGroup
+		resultsLock sync.Mutex
+		resultErr   xerrors.MultiError
+		deleted     int64
+	)
+	for _, queue := range queues {
+		queue := queue
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var (
+				result *rpc.DeleteSeriesResult_
+				err    error
+			)
+			borrowErr := queue.BorrowConnection(func(client rpc.TChanNode, _ Channel) {
+				tctx, _ := thrift.NewContext(s.opts.TruncateRequestTimeout())
+				result, err = client.DeleteSeries(tctx, &req)
+			})
+
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			if err := xerrors.FirstError(borrowErr, err); err != nil {
+				resultErr = resultErr.Add(fmt.Errorf(
+					"unable to delete series on host %s: %v", queue.Host().ID(), err))
+				return
+			}
+			deleted += result.NumSeries
+		}()
+	}
+
+	// Wait for the series to be deleted on all hosts, since each host only
+	// owns a subset of the shards all of them are required to succeed.
+	wg.Wait()
+
+	if err := resultErr.FinalError(); err != nil {
+		return 0, err
+	}
+	if replicas > 1 {
+		// Each series is deleted once on every replica that owns it.
+		deleted /= int64(replicas)
+	}
+	return deleted, nil
+}
+
 // NB(r): Excluding maligned struct check here as we can
 // live with a few extra bytes since this struct is only
 // ever passed by stack, its much more readable not optimized
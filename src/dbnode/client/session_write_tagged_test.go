@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/topology"
 	xmetrics "github.com/m3db/m3/src/dbnode/x/metrics"
 	"github.com/m3db/m3/src/x/checked"
@@ -45,6 +46,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go/thrift"
 )
 
 func TestSessionWriteTaggedNotOpenError(t *testing.T) {
@@ -430,6 +432,64 @@ func TestSessionWriteTaggedRetry(t *testing.T) {
 	assert.NoError(t, session.Close())
 }
 
+func TestSessionWriteTaggedDurability(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	opts := newSessionTestOptions().
+		SetUseV2BatchAPIs(true).
+		SetWriteConsistencyLevel(topology.ConsistencyLevelAll).
+		SetWriteDurability(namespace.FsyncedWriteDurability).
+		SetHostQueueOpsFlushSize(1)
+	session := newTestSession(t, opts).(*session)
+
+	var writesWg, closeWg sync.WaitGroup
+	mockClient := rpc.NewMockTChanNode(ctrl)
+	mockClient.EXPECT().WriteTaggedBatchRawV2(gomock.Any(), gomock.Any()).
+		Do(func(_ thrift.Context, req *rpc.WriteTaggedBatchRawV2Request) {
+			assert.Equal(t, rpc.WriteDurability_FSYNCED, req.Durability)
+			writesWg.Done()
+		}).
+		Return(nil).
+		Times(sessionTestReplicas)
+
+	// NB: use real host queues, so the durability is carried from the session
+	// options all the way to the request sent to each host.
+	session.newHostQueueFn = func(
+		host topology.Host,
+		hostQueueOpts hostQueueOpts,
+	) (hostQueue, error) {
+		hq, err := newHostQueue(host, hostQueueOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		mockConnPool := NewMockconnectionPool(ctrl)
+		mockConnPool.EXPECT().Open()
+		mockConnPool.EXPECT().ConnectionCount().
+			Return(opts.MinConnectionCount()).AnyTimes()
+		mockConnPool.EXPECT().NextClient().
+			Return(mockClient, &noopPooledChannel{}, nil).AnyTimes()
+		mockConnPool.EXPECT().Close().Do(func() {
+			closeWg.Done()
+		})
+		hq.(*queue).connPool = mockConnPool
+		return hq, nil
+	}
+
+	writesWg.Add(sessionTestReplicas)
+	closeWg.Add(sessionTestReplicas)
+	require.NoError(t, session.Open())
+
+	w := newWriteTaggedStub()
+	require.NoError(t, session.WriteTagged(w.ns, w.id,
+		ident.NewTagsIterator(w.tags), w.t, w.value, w.unit, w.annotation))
+	writesWg.Wait()
+
+	require.NoError(t, session.Close())
+	closeWg.Wait()
+}
+
 func TestSessionWriteTaggedConsistencyLevelAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
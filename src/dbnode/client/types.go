@@ -132,6 +132,22 @@ type Session interface {
 		opts index.AggregationOptions,
 	) (AggregatedTagsIterator, FetchResponseMetadata, error)
 
+	// IndexStats returns the cardinality of the series indexed for the
+	// namespace, aggregated across all hosts in the cluster.
+	IndexStats(
+		namespace ident.ID,
+		opts IndexStatsOptions,
+	) (index.CardinalityStatsSummary, error)
+
+	// DeleteSeries deletes the data of the series matching the query for
+	// the time range [start, end) from all hosts in the cluster, returning
+	// the number of series deleted.
+	DeleteSeries(
+		namespace ident.ID,
+		q index.Query,
+		start, end xtime.UnixNano,
+	) (int64, error)
+
 	// ShardID returns the given shard for an ID for callers
 	// to easily discern what shard is failing when operations
 	// for given IDs begin failing.
@@ -144,6 +160,19 @@ type Session interface {
 	Close() error
 }
 
+// IndexStatsOptions are options used when requesting index stats.
+type IndexStatsOptions struct {
+	// StartInclusive is the start of the time range to return stats for.
+	StartInclusive xtime.UnixNano
+	// EndExclusive is the end of the time range to return stats for.
+	EndExclusive xtime.UnixNano
+	// Limit is the number of entries to return for each stat.
+	Limit int
+	// MetricNameTag is the tag used to break down series counts by metric
+	// name, the database nodes use "__name__" if it is empty.
+	MetricNameTag []byte
+}
+
 // FetchResponseMetadata is metadata about a fetch response.
 type FetchResponseMetadata struct {
 	// Exhaustive indicates whether the underlying data set presents a full
@@ -399,6 +428,15 @@ type Options interface {
 	// WriteConsistencyLevel returns the write consistency level.
 	WriteConsistencyLevel() topology.ConsistencyLevel
 
+	// SetWriteDurability sets the durability tagged writes must reach on each
+	// node before they are acknowledged, overriding that of the namespace.
+	// Only applies to writes made with the V2 batch APIs.
+	SetWriteDurability(value namespace.WriteDurability) Options
+
+	// WriteDurability returns the durability tagged writes must reach on each
+	// node before they are acknowledged.
+	WriteDurability() namespace.WriteDurability
+
 	// SetChannelOptions sets the channelOptions.
 	SetChannelOptions(value *tchannel.ChannelOptions) Options
 
@@ -0,0 +1,381 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	encodingVersion byte = 1
+
+	// flagIntegerCounts is set when all counts of the histogram are integers,
+	// which allows encoding bucket counts as varint deltas instead of floats.
+	flagIntegerCounts byte = 1 << 0
+
+	// maxIntegerCount is the largest count that is encoded as an integer,
+	// beyond which float64 can no longer represent every integer exactly.
+	maxIntegerCount = 1 << 53
+
+	// maxEncodedBuckets bounds the number of buckets that are decoded, so
+	// that corrupt data cannot cause unbounded allocations.
+	maxEncodedBuckets = 1 << 16
+)
+
+var (
+	errTruncated       = errors.New("encoded histogram is truncated")
+	errTooManyBuckets  = errors.New("encoded histogram has too many buckets")
+	errTrailingEncoded = errors.New("encoded histogram has trailing bytes")
+	errInvalidDelta    = errors.New("encoded histogram delta has invalid counts")
+)
+
+// Encode encodes the histogram, returning an error if it is invalid.
+func Encode(h Histogram) ([]byte, error) {
+	if err := h.Validate(); err != nil {
+		return nil, err
+	}
+
+	integerCounts := hasIntegerCounts(h)
+
+	var flags byte
+	if integerCounts {
+		flags |= flagIntegerCounts
+	}
+
+	var (
+		size = 2 + binary.MaxVarintLen32 + 4*8 +
+			2*binary.MaxVarintLen64 +
+			(len(h.PositiveSpans)+len(h.NegativeSpans))*2*binary.MaxVarintLen32 +
+			(len(h.PositiveBuckets)+len(h.NegativeBuckets))*binary.MaxVarintLen64
+		w = writer{buf: make([]byte, 0, size)}
+	)
+	w.byte(encodingVersion)
+	w.byte(flags)
+	w.varint(int64(h.Schema))
+	w.float(h.ZeroThreshold)
+	w.float(h.Sum)
+	if integerCounts {
+		w.uvarint(uint64(h.Count))
+		w.uvarint(uint64(h.ZeroCount))
+	} else {
+		w.float(h.Count)
+		w.float(h.ZeroCount)
+	}
+
+	w.buckets(h.NegativeSpans, h.NegativeBuckets, integerCounts)
+	w.buckets(h.PositiveSpans, h.PositiveBuckets, integerCounts)
+	return w.buf, nil
+}
+
+// Decode decodes a histogram encoded with Encode.
+func Decode(b []byte) (Histogram, error) {
+	var (
+		h Histogram
+		r = reader{buf: b}
+	)
+	if version := r.byte(); r.err == nil && version != encodingVersion {
+		return Histogram{}, fmt.Errorf("unknown histogram encoding version: %d", version)
+	}
+
+	flags := r.byte()
+	integerCounts := flags&flagIntegerCounts != 0
+	h.Schema = int32(r.varint())
+	h.ZeroThreshold = r.float()
+	h.Sum = r.float()
+	if integerCounts {
+		h.Count = float64(r.uvarint())
+		h.ZeroCount = float64(r.uvarint())
+	} else {
+		h.Count = r.float()
+		h.ZeroCount = r.float()
+	}
+
+	h.NegativeSpans, h.NegativeBuckets = r.buckets(integerCounts)
+	h.PositiveSpans, h.PositiveBuckets = r.buckets(integerCounts)
+	if r.err != nil {
+		return Histogram{}, r.err
+	}
+
+	if len(r.buf) > 0 {
+		return Histogram{}, errTrailingEncoded
+	}
+
+	if err := h.Validate(); err != nil {
+		return Histogram{}, err
+	}
+
+	return h, nil
+}
+
+// encodeDelta encodes the histogram as the difference of its counts from the
+// previous histogram of a series, returning false if the histograms do not
+// share the same bucket layout or do not both have integer counts.
+func encodeDelta(prev, curr Histogram) ([]byte, bool) {
+	if !sameLayout(prev, curr) || !hasIntegerCounts(prev) || !hasIntegerCounts(curr) {
+		return nil, false
+	}
+
+	w := writer{buf: make([]byte, 0, 8+
+		(2+len(curr.NegativeBuckets)+len(curr.PositiveBuckets))*binary.MaxVarintLen64)}
+	w.float(curr.Sum)
+	w.varint(int64(curr.Count) - int64(prev.Count))
+	w.varint(int64(curr.ZeroCount) - int64(prev.ZeroCount))
+	for i, count := range curr.NegativeBuckets {
+		w.varint(int64(count) - int64(prev.NegativeBuckets[i]))
+	}
+	for i, count := range curr.PositiveBuckets {
+		w.varint(int64(count) - int64(prev.PositiveBuckets[i]))
+	}
+
+	return w.buf, true
+}
+
+// decodeDelta decodes a histogram encoded with encodeDelta relative to the
+// previous histogram of the series.
+func decodeDelta(prev Histogram, b []byte) (Histogram, error) {
+	var (
+		h = Histogram{
+			Schema:          prev.Schema,
+			ZeroThreshold:   prev.ZeroThreshold,
+			PositiveSpans:   prev.PositiveSpans,
+			PositiveBuckets: make([]float64, 0, len(prev.PositiveBuckets)),
+			NegativeSpans:   prev.NegativeSpans,
+			NegativeBuckets: make([]float64, 0, len(prev.NegativeBuckets)),
+		}
+		r = reader{buf: b}
+	)
+	h.Sum = r.float()
+	h.Count = prev.Count + float64(r.varint())
+	h.ZeroCount = prev.ZeroCount + float64(r.varint())
+	for _, count := range prev.NegativeBuckets {
+		h.NegativeBuckets = append(h.NegativeBuckets, count+float64(r.varint()))
+	}
+	for _, count := range prev.PositiveBuckets {
+		h.PositiveBuckets = append(h.PositiveBuckets, count+float64(r.varint()))
+	}
+
+	if r.err != nil {
+		return Histogram{}, r.err
+	}
+	if len(r.buf) > 0 {
+		return Histogram{}, errTrailingEncoded
+	}
+	if !hasIntegerCounts(h) {
+		return Histogram{}, errInvalidDelta
+	}
+
+	return h, nil
+}
+
+func sameLayout(a, b Histogram) bool {
+	return a.Schema == b.Schema &&
+		math.Float64bits(a.ZeroThreshold) == math.Float64bits(b.ZeroThreshold) &&
+		len(a.PositiveBuckets) == len(b.PositiveBuckets) &&
+		len(a.NegativeBuckets) == len(b.NegativeBuckets) &&
+		sameSpans(a.PositiveSpans, b.PositiveSpans) &&
+		sameSpans(a.NegativeSpans, b.NegativeSpans)
+}
+
+func sameSpans(a, b []Span) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func hasIntegerCounts(h Histogram) bool {
+	return isIntegerCount(h.Count) && isIntegerCount(h.ZeroCount) &&
+		areIntegerCounts(h.PositiveBuckets) && areIntegerCounts(h.NegativeBuckets)
+}
+
+func isIntegerCount(v float64) bool {
+	return v >= 0 && v < maxIntegerCount && v == math.Trunc(v)
+}
+
+func areIntegerCounts(values []float64) bool {
+	for _, v := range values {
+		if !isIntegerCount(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type writer struct {
+	buf     []byte
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (w *writer) byte(v byte) {
+	w.buf = append(w.buf, v)
+}
+
+func (w *writer) varint(v int64) {
+	n := binary.PutVarint(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:n]...)
+}
+
+func (w *writer) uvarint(v uint64) {
+	n := binary.PutUvarint(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:n]...)
+}
+
+func (w *writer) float(v float64) {
+	binary.BigEndian.PutUint64(w.scratch[:8], math.Float64bits(v))
+	w.buf = append(w.buf, w.scratch[:8]...)
+}
+
+func (w *writer) buckets(spans []Span, counts []float64, integerCounts bool) {
+	w.uvarint(uint64(len(spans)))
+	for _, span := range spans {
+		w.varint(int64(span.Offset))
+		w.uvarint(uint64(span.Length))
+	}
+
+	var prev int64
+	for _, count := range counts {
+		if !integerCounts {
+			w.float(count)
+			continue
+		}
+
+		curr := int64(count)
+		w.varint(curr - prev)
+		prev = curr
+	}
+}
+
+type reader struct {
+	buf []byte
+	err error
+}
+
+func (r *reader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 1 {
+		r.err = errTruncated
+		return 0
+	}
+
+	v := r.buf[0]
+	r.buf = r.buf[1:]
+	return v
+}
+
+func (r *reader) varint() int64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, n := binary.Varint(r.buf)
+	if n <= 0 {
+		r.err = errTruncated
+		return 0
+	}
+
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = errTruncated
+		return 0
+	}
+
+	r.buf = r.buf[n:]
+	return v
+}
+
+func (r *reader) float() float64 {
+	if r.err != nil {
+		return 0
+	}
+	if len(r.buf) < 8 {
+		r.err = errTruncated
+		return 0
+	}
+
+	v := math.Float64frombits(binary.BigEndian.Uint64(r.buf))
+	r.buf = r.buf[8:]
+	return v
+}
+
+func (r *reader) buckets(integerCounts bool) ([]Span, []float64) {
+	numSpans := r.uvarint()
+	if r.err == nil && numSpans > maxEncodedBuckets {
+		r.err = errTooManyBuckets
+	}
+	if r.err != nil || numSpans == 0 {
+		return nil, nil
+	}
+
+	var (
+		spans      = make([]Span, 0, numSpans)
+		numBuckets uint64
+	)
+	for i := uint64(0); i < numSpans && r.err == nil; i++ {
+		span := Span{
+			Offset: int32(r.varint()),
+			Length: uint32(r.uvarint()),
+		}
+		numBuckets += uint64(span.Length)
+		spans = append(spans, span)
+	}
+
+	if r.err == nil && numBuckets > maxEncodedBuckets {
+		r.err = errTooManyBuckets
+	}
+	if r.err != nil {
+		return nil, nil
+	}
+
+	var (
+		counts = make([]float64, 0, numBuckets)
+		prev   int64
+	)
+	for i := uint64(0); i < numBuckets && r.err == nil; i++ {
+		if !integerCounts {
+			counts = append(counts, r.float())
+			continue
+		}
+
+		prev += r.varint()
+		counts = append(counts, float64(prev))
+	}
+
+	return spans, counts
+}
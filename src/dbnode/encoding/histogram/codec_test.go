@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecodeIntegerCounts(t *testing.T) {
+	h := testHistogram()
+	encoded, err := Encode(h)
+	require.NoError(t, err)
+
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, h, decoded)
+}
+
+func TestEncodeDecodeFloatCounts(t *testing.T) {
+	h := testHistogram()
+	h.Count = 14.5
+	h.PositiveBuckets = []float64{1.25, 3, 4.75}
+	encoded, err := Encode(h)
+	require.NoError(t, err)
+
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, h, decoded)
+
+	// NB: float counts take eight bytes each, so are larger than integers.
+	integerEncoded, err := Encode(testHistogram())
+	require.NoError(t, err)
+	assert.True(t, len(integerEncoded) < len(encoded))
+}
+
+func TestEncodeDecodeNoBuckets(t *testing.T) {
+	h := Histogram{Schema: 3, Count: 5, ZeroCount: 5, Sum: 1}
+	encoded, err := Encode(h)
+	require.NoError(t, err)
+
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, h, decoded)
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	h := testHistogram()
+	h.PositiveBuckets = nil
+	_, err := Encode(h)
+	assert.Error(t, err)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	encoded, err := Encode(testHistogram())
+	require.NoError(t, err)
+
+	for i := 0; i < len(encoded); i++ {
+		_, err := Decode(encoded[:i])
+		assert.Error(t, err, "truncated at %d", i)
+	}
+
+	_, err = Decode(append(encoded, 0))
+	assert.Error(t, err)
+
+	badVersion := append([]byte{encodingVersion + 1}, encoded[1:]...)
+	_, err = Decode(badVersion)
+	assert.Error(t, err)
+}
+
+func TestEncodeDecodeDelta(t *testing.T) {
+	prev := testHistogram()
+	curr := testHistogram()
+	curr.Count, curr.Sum = 20, 50
+	curr.PositiveBuckets = []float64{2, 5, 7}
+
+	encoded, ok := encodeDelta(prev, curr)
+	require.True(t, ok)
+
+	full, err := Encode(curr)
+	require.NoError(t, err)
+	assert.True(t, len(encoded) < len(full))
+
+	decoded, err := decodeDelta(prev, encoded)
+	require.NoError(t, err)
+	assert.Equal(t, curr, decoded)
+
+	_, err = decodeDelta(prev, encoded[:len(encoded)-1])
+	assert.Error(t, err)
+}
+
+func TestEncodeDeltaRequiresSameLayout(t *testing.T) {
+	prev := testHistogram()
+
+	curr := testHistogram()
+	curr.Schema = 1
+	_, ok := encodeDelta(prev, curr)
+	assert.False(t, ok)
+
+	curr = testHistogram()
+	curr.PositiveSpans = []Span{{Offset: 0, Length: 3}}
+	_, ok = encodeDelta(prev, curr)
+	assert.False(t, ok)
+
+	curr = testHistogram()
+	curr.Count = 14.5
+	_, ok = encodeDelta(prev, curr)
+	assert.False(t, ok)
+}
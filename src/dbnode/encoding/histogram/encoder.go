@@ -0,0 +1,257 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/checked"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+// Annotations are written to the underlying M3TSZ stream prefixed with the
+// kind of their content. M3TSZ only writes an annotation when it differs from
+// the previous one, so annotations are always written while histograms are
+// being encoded, to tell apart datapoints that repeat the previous histogram
+// (or delta) from ones that carry no annotation.
+const (
+	// annotationRaw is followed by an annotation carrying no histogram.
+	annotationRaw byte = iota
+	// annotationHistogram is followed by the annotation payload without its
+	// histogram and the histogram encoded in full.
+	annotationHistogram
+	// annotationHistogramDelta is followed by the annotation payload without
+	// its histogram and the histogram encoded as a delta of the previous one.
+	annotationHistogramDelta
+)
+
+// streamFormatVersion is the version of the format of the streams written by
+// the native histogram encoder.
+const streamFormatVersion byte = 1
+
+// streamMagic starts the first annotation of every stream written by the
+// native histogram encoder, followed by the version of the stream format and
+// the annotation itself. Streams without it were written by the M3TSZ encoder,
+// i.e. before native histograms were enabled or by nodes that do not have them
+// enabled, and are read as they are.
+var streamMagic = []byte{0xff, 'N', 'H'}
+
+var (
+	errEncoderClosed       = errors.New("encoder is closed")
+	errNoEncodedDatapoints = errors.New("encoder has no encoded datapoints")
+
+	emptyAnnotationChecksum = xxhash.Sum64(nil)
+)
+
+// encoder encodes datapoints with M3TSZ, replacing the native histograms
+// carried by annotations with histograms delta encoded against the previous
+// histogram of the series.
+type encoder struct {
+	encoding.Encoder
+
+	opts encoding.Options
+
+	prev               Histogram
+	hasPrev            bool
+	annotationChecksum uint64
+	buf                []byte
+	closed             bool
+}
+
+// NewEncoder creates a new native histogram encoder.
+func NewEncoder(
+	start xtime.UnixNano,
+	bytes checked.Bytes,
+	opts encoding.Options,
+) encoding.Encoder {
+	if opts == nil {
+		opts = encoding.NewOptions()
+	}
+
+	// NB: the M3TSZ encoder must not return itself to the pool, which holds
+	// native histogram encoders.
+	return &encoder{
+		Encoder: m3tsz.NewEncoder(start, bytes,
+			m3tsz.DefaultIntOptimizationEnabled, opts.SetEncoderPool(nil)),
+		opts:               opts,
+		annotationChecksum: emptyAnnotationChecksum,
+	}
+}
+
+// Encode encodes the timestamp, the value and the annotation of a datapoint.
+func (enc *encoder) Encode(dp ts.Datapoint, tu xtime.Unit, ant ts.Annotation) error {
+	if enc.closed {
+		return errEncoderClosed
+	}
+
+	h, payload, isHistogram := annotationHistogramPayload(ant)
+
+	enc.buf = enc.buf[:0]
+	if enc.Encoder.NumEncoded() == 0 {
+		enc.buf = append(enc.buf, streamMagic...)
+		enc.buf = append(enc.buf, streamFormatVersion)
+	}
+
+	switch {
+	case isHistogram:
+		enc.appendHistogram(h, payload)
+	case len(ant) > 0 || enc.hasPrev || len(enc.buf) > 0:
+		enc.buf = append(append(enc.buf, annotationRaw), ant...)
+	}
+
+	var encoded ts.Annotation
+	if len(enc.buf) > 0 {
+		encoded = enc.buf
+	}
+
+	if err := enc.Encoder.Encode(dp, tu, encoded); err != nil {
+		return err
+	}
+
+	enc.prev, enc.hasPrev = h, isHistogram
+	if len(ant) > 0 {
+		enc.annotationChecksum = xxhash.Sum64(ant)
+	}
+
+	return nil
+}
+
+func (enc *encoder) appendHistogram(h Histogram, payload []byte) {
+	var (
+		kind    = annotationHistogram
+		encoded []byte
+	)
+	if enc.hasPrev {
+		var ok bool
+		if encoded, ok = encodeDelta(enc.prev, h); ok {
+			kind = annotationHistogramDelta
+		}
+	}
+	if kind == annotationHistogram {
+		// NB: the histogram was decoded successfully, so it encodes too.
+		encoded, _ = Encode(h)
+	}
+
+	var scratch [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(scratch[:], uint64(len(payload)))
+
+	enc.buf = append(enc.buf, kind)
+	enc.buf = append(enc.buf, scratch[:n]...)
+	enc.buf = append(enc.buf, payload...)
+	enc.buf = append(enc.buf, encoded...)
+}
+
+// annotationHistogramPayload returns the native histogram carried by the
+// annotation, along with the annotation payload without it.
+func annotationHistogramPayload(ant ts.Annotation) (Histogram, []byte, bool) {
+	if len(ant) == 0 {
+		return Histogram{}, nil, false
+	}
+
+	var payload annotation.Payload
+	if err := payload.Unmarshal(ant); err != nil || len(payload.NativeHistogram) == 0 {
+		return Histogram{}, nil, false
+	}
+
+	h, err := Decode(payload.NativeHistogram)
+	if err != nil {
+		// NB: histograms that cannot be decoded are kept as they are.
+		return Histogram{}, nil, false
+	}
+
+	payload.NativeHistogram = nil
+	rest, err := payload.Marshal()
+	if err != nil {
+		return Histogram{}, nil, false
+	}
+
+	return h, rest, true
+}
+
+// LastAnnotationChecksum returns the checksum of the last annotation as it
+// was given to the encoder.
+func (enc *encoder) LastAnnotationChecksum() (uint64, error) {
+	if enc.Encoder.NumEncoded() == 0 {
+		return 0, errNoEncodedDatapoints
+	}
+
+	return enc.annotationChecksum, nil
+}
+
+// Reset resets the encoder for reuse.
+func (enc *encoder) Reset(
+	start xtime.UnixNano,
+	capacity int,
+	schema namespace.SchemaDescr,
+) {
+	enc.Encoder.Reset(start, capacity, schema)
+	enc.reset()
+}
+
+func (enc *encoder) reset() {
+	enc.prev, enc.hasPrev = Histogram{}, false
+	enc.annotationChecksum = emptyAnnotationChecksum
+	enc.closed = false
+}
+
+// Close closes the encoder.
+func (enc *encoder) Close() {
+	if enc.closed {
+		return
+	}
+
+	enc.closed = true
+	enc.Encoder.Close()
+
+	if pool := enc.opts.EncoderPool(); pool != nil {
+		pool.Put(enc)
+	}
+}
+
+// Discard closes the encoder and transfers ownership of the data stream to
+// the caller.
+func (enc *encoder) Discard() ts.Segment {
+	// NB: discarding closes the M3TSZ encoder, which is reset along with this
+	// encoder before it is reused.
+	segment := enc.Encoder.Discard()
+	enc.Close()
+	return segment
+}
+
+// DiscardReset does the same thing as Discard except it does not close the
+// encoder but resets it for reuse.
+func (enc *encoder) DiscardReset(
+	start xtime.UnixNano,
+	capacity int,
+	schema namespace.SchemaDescr,
+) ts.Segment {
+	segment := enc.Encoder.DiscardReset(start, capacity, schema)
+	enc.reset()
+	return segment
+}
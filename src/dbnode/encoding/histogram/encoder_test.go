@@ -0,0 +1,288 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAnnotation(t *testing.T, h Histogram) ts.Annotation {
+	encoded, err := Encode(h)
+	require.NoError(t, err)
+
+	payload := annotation.Payload{
+		MetricType:        annotation.MetricType_HISTOGRAM,
+		HandleValueResets: true,
+		NativeHistogram:   encoded,
+	}
+	ant, err := payload.Marshal()
+	require.NoError(t, err)
+	return ant
+}
+
+func addObservations(h Histogram, n float64) Histogram {
+	next := h
+	next.Count += 3 * n
+	next.Sum += n
+	next.PositiveBuckets = []float64{
+		h.PositiveBuckets[0] + n, h.PositiveBuckets[1] + n, h.PositiveBuckets[2] + n,
+	}
+	return next
+}
+
+type testDatapoint struct {
+	value float64
+	ant   ts.Annotation
+}
+
+func encodeTestDatapoints(
+	t *testing.T,
+	enc encoding.Encoder,
+	start xtime.UnixNano,
+	dps []testDatapoint,
+) {
+	for i, dp := range dps {
+		require.NoError(t, enc.Encode(ts.Datapoint{
+			TimestampNanos: start.Add(time.Duration(i) * time.Second),
+			Value:          dp.value,
+		}, xtime.Second, dp.ant))
+	}
+}
+
+func TestEncoderRoundTrip(t *testing.T) {
+	var (
+		start  = xtime.Now().Truncate(time.Hour)
+		h0     = testHistogram()
+		h1     = addObservations(h0, 1)
+		h2     = h1
+		layout = testHistogram()
+		floats = testHistogram()
+	)
+	h2.Count, h2.PositiveBuckets = h1.Count+3, []float64{
+		h1.PositiveBuckets[0] + 1, h1.PositiveBuckets[1] + 1, h1.PositiveBuckets[2] + 1,
+	}
+	layout.PositiveSpans = []Span{{Offset: 0, Length: 3}}
+	floats.Count, floats.PositiveBuckets = 14.5, []float64{1.5, 3, 4}
+
+	dps := []testDatapoint{
+		{value: 1},
+		{value: 2, ant: ts.Annotation("foo")},
+		{value: h0.Count, ant: testAnnotation(t, h0)},
+		// Deltas from the previous histogram.
+		{value: h1.Count, ant: testAnnotation(t, h1)},
+		{value: h2.Count, ant: testAnnotation(t, h2)},
+		// An unchanged histogram, whose empty delta the stream only holds
+		// once.
+		{value: h2.Count, ant: testAnnotation(t, h2)},
+		{value: h2.Count, ant: testAnnotation(t, h2)},
+		{value: 3},
+		{value: 4},
+		{value: h0.Count, ant: testAnnotation(t, h0)},
+		{value: layout.Count, ant: testAnnotation(t, layout)},
+		{value: floats.Count, ant: testAnnotation(t, floats)},
+		{value: floats.Count, ant: testAnnotation(t, floats)},
+		{value: 5, ant: ts.Annotation("bar")},
+	}
+
+	enc := NewEncoder(start, nil, nil)
+	encodeTestDatapoints(t, enc, start, dps)
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	it := NewReaderIterator(stream, nil)
+	defer it.Close()
+
+	var i int
+	for it.Next() {
+		dp, unit, ant := it.Current()
+		require.True(t, i < len(dps))
+		assert.Equal(t, start.Add(time.Duration(i)*time.Second), dp.TimestampNanos)
+		assert.Equal(t, dps[i].value, dp.Value)
+		assert.Equal(t, xtime.Second, unit)
+		assert.Equal(t, dps[i].ant, ant, "datapoint %d", i)
+		i++
+	}
+
+	require.NoError(t, it.Err())
+	assert.Equal(t, len(dps), i)
+}
+
+func TestEncoderCompressesHistograms(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(time.Hour)
+		h     = testHistogram()
+		dps   []testDatapoint
+	)
+	for i := 0; i < 100; i++ {
+		h = addObservations(h, float64(i%4))
+		dps = append(dps, testDatapoint{value: h.Count, ant: testAnnotation(t, h)})
+	}
+
+	enc := NewEncoder(start, nil, nil)
+	encodeTestDatapoints(t, enc, start, dps)
+
+	m3tszEnc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, nil)
+	encodeTestDatapoints(t, m3tszEnc, start, dps)
+
+	assert.True(t, enc.Len() < m3tszEnc.Len(),
+		"expected %d to be less than %d", enc.Len(), m3tszEnc.Len())
+}
+
+func TestEncoderLastAnnotationChecksum(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(time.Hour)
+		ant   = testAnnotation(t, testHistogram())
+		enc   = NewEncoder(start, nil, nil)
+	)
+
+	_, err := enc.LastAnnotationChecksum()
+	require.Error(t, err)
+
+	encodeTestDatapoints(t, enc, start, []testDatapoint{{value: 14, ant: ant}})
+	checksum, err := enc.LastAnnotationChecksum()
+	require.NoError(t, err)
+	assert.Equal(t, xxhash.Sum64(ant), checksum)
+}
+
+func TestEncoderDiscardReset(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(time.Hour)
+		h     = testHistogram()
+		enc   = NewEncoder(start, nil, nil)
+	)
+	encodeTestDatapoints(t, enc, start, []testDatapoint{
+		{value: h.Count, ant: testAnnotation(t, h)},
+	})
+
+	segment := enc.DiscardReset(start, 0, nil)
+	assert.True(t, segment.Len() > 0)
+	assert.Equal(t, 0, enc.NumEncoded())
+
+	// NB: the first histogram after a reset is encoded in full.
+	next := addObservations(h, 1)
+	encodeTestDatapoints(t, enc, start, []testDatapoint{
+		{value: next.Count, ant: testAnnotation(t, next)},
+	})
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	it := NewReaderIterator(stream, nil)
+	defer it.Close()
+
+	require.True(t, it.Next())
+	_, _, ant := it.Current()
+	assert.Equal(t, testAnnotation(t, next), ant)
+	assert.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
+
+func TestReaderIteratorReadsM3TSZStreams(t *testing.T) {
+	// NB: annotations written without native histograms enabled, which start
+	// with the tag of their metric type.
+	counter, err := (&annotation.Payload{
+		MetricType: annotation.MetricType_COUNTER,
+	}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, byte(0x08), counter[0])
+
+	gauge, err := (&annotation.Payload{
+		MetricType: annotation.MetricType_GAUGE,
+	}).Marshal()
+	require.NoError(t, err)
+
+	for _, dps := range [][]testDatapoint{
+		{
+			{value: 1, ant: counter},
+			{value: 2},
+			{value: 3, ant: gauge},
+		},
+		{
+			{value: 1},
+			{value: 2, ant: counter},
+			{value: 3, ant: ts.Annotation{annotationHistogram}},
+		},
+	} {
+		start := xtime.Now().Truncate(time.Hour)
+		enc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, nil)
+		encodeTestDatapoints(t, enc, start, dps)
+
+		ctx := context.NewBackground()
+		stream, ok := enc.Stream(ctx)
+		require.True(t, ok)
+
+		it := NewReaderIterator(stream, nil)
+
+		var i int
+		for it.Next() {
+			dp, _, ant := it.Current()
+			require.True(t, i < len(dps))
+			assert.Equal(t, dps[i].value, dp.Value)
+			assert.Equal(t, dps[i].ant, ant, "datapoint %d", i)
+			i++
+		}
+
+		require.NoError(t, it.Err())
+		assert.Equal(t, len(dps), i)
+
+		it.Close()
+		ctx.Close()
+	}
+}
+
+func TestReaderIteratorUnsupportedStreamVersion(t *testing.T) {
+	start := xtime.Now().Truncate(time.Hour)
+	enc := m3tsz.NewEncoder(start, nil, m3tsz.DefaultIntOptimizationEnabled, nil)
+
+	ant := append(append([]byte(nil), streamMagic...), streamFormatVersion+1, annotationRaw)
+	encodeTestDatapoints(t, enc, start, []testDatapoint{{value: 1, ant: ant}})
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	stream, ok := enc.Stream(ctx)
+	require.True(t, ok)
+
+	it := NewReaderIterator(stream, nil)
+	defer it.Close()
+
+	assert.False(t, it.Next())
+	require.Error(t, it.Err())
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package histogram implements the encoding of Prometheus native histogram
+// samples. Each sample is stored as a datapoint holding the count of
+// observations, with the encoded histogram carried as its annotation.
+//
+// The package also implements the native histogram encoding scheme, an
+// alternative to M3TSZ which encodes each histogram of a series as the
+// difference from the previous one rather than in full.
+package histogram
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// MinSchema is the lowest supported histogram schema.
+	MinSchema = -4
+	// MaxSchema is the highest supported histogram schema.
+	MaxSchema = 8
+)
+
+// Span describes a number of consecutive buckets, starting at an offset from
+// the end of the previous span (or from bucket index zero for the first span).
+type Span struct {
+	Offset int32
+	Length uint32
+}
+
+// Histogram is a native histogram sample, with bucket counts stored as
+// absolute rather than delta values.
+type Histogram struct {
+	Schema          int32
+	ZeroThreshold   float64
+	ZeroCount       float64
+	Count           float64
+	Sum             float64
+	PositiveSpans   []Span
+	PositiveBuckets []float64
+	NegativeSpans   []Span
+	NegativeBuckets []float64
+}
+
+// Bucket is a single bucket of a histogram, covering observations in the
+// range (Lower, Upper].
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count float64
+}
+
+// Validate validates the histogram.
+func (h Histogram) Validate() error {
+	if h.Schema < MinSchema || h.Schema > MaxSchema {
+		return fmt.Errorf("histogram schema %d out of range [%d, %d]",
+			h.Schema, MinSchema, MaxSchema)
+	}
+
+	if h.ZeroThreshold < 0 || math.IsNaN(h.ZeroThreshold) {
+		return fmt.Errorf("invalid histogram zero threshold: %v", h.ZeroThreshold)
+	}
+
+	if err := validateSpans(h.PositiveSpans, len(h.PositiveBuckets)); err != nil {
+		return fmt.Errorf("invalid positive buckets: %v", err)
+	}
+
+	if err := validateSpans(h.NegativeSpans, len(h.NegativeBuckets)); err != nil {
+		return fmt.Errorf("invalid negative buckets: %v", err)
+	}
+
+	return nil
+}
+
+func validateSpans(spans []Span, numBuckets int) error {
+	var total int
+	for i, span := range spans {
+		if i > 0 && span.Offset < 0 {
+			return fmt.Errorf("span %d has negative offset %d", i, span.Offset)
+		}
+		total += int(span.Length)
+	}
+
+	if total != numBuckets {
+		return fmt.Errorf("spans cover %d buckets but %d bucket counts given",
+			total, numBuckets)
+	}
+
+	return nil
+}
+
+// Buckets returns the buckets of the histogram in ascending order of their
+// bounds, starting with the negative buckets, followed by the zero bucket and
+// then the positive buckets. Buckets that are not covered by any span are
+// omitted, as is the zero bucket if it is both empty and zero width.
+func (h Histogram) Buckets() []Bucket {
+	buckets := make([]Bucket, 0,
+		len(h.NegativeBuckets)+1+len(h.PositiveBuckets))
+
+	negative := spanBuckets(h.Schema, h.NegativeSpans, h.NegativeBuckets)
+	for i := len(negative) - 1; i >= 0; i-- {
+		b := negative[i]
+		buckets = append(buckets, Bucket{
+			Lower: -b.Upper,
+			Upper: -b.Lower,
+			Count: b.Count,
+		})
+	}
+
+	if h.ZeroCount != 0 || h.ZeroThreshold != 0 {
+		buckets = append(buckets, Bucket{
+			Lower: -h.ZeroThreshold,
+			Upper: h.ZeroThreshold,
+			Count: h.ZeroCount,
+		})
+	}
+
+	return append(buckets, spanBuckets(h.Schema, h.PositiveSpans, h.PositiveBuckets)...)
+}
+
+func spanBuckets(schema int32, spans []Span, counts []float64) []Bucket {
+	var (
+		buckets = make([]Bucket, 0, len(counts))
+		idx     int32
+		i       int
+	)
+	for s, span := range spans {
+		if s == 0 {
+			idx = span.Offset
+		} else {
+			idx += span.Offset
+		}
+
+		for j := uint32(0); j < span.Length && i < len(counts); j++ {
+			buckets = append(buckets, Bucket{
+				Lower: UpperBound(schema, idx-1),
+				Upper: UpperBound(schema, idx),
+				Count: counts[i],
+			})
+			idx++
+			i++
+		}
+	}
+
+	return buckets
+}
+
+// UpperBound returns the upper bound of the positive bucket with the given
+// index for a schema; buckets grow by a factor of 2^(2^-schema), with the
+// bucket at index zero having an upper bound of one.
+func UpperBound(schema int32, idx int32) float64 {
+	return math.Exp2(float64(idx) / math.Exp2(float64(schema)))
+}
+
+// UpperBounds returns the sorted upper bounds of the buckets of the
+// histogram, excluding the implicit +Inf bound.
+func (h Histogram) UpperBounds() []float64 {
+	buckets := h.Buckets()
+	bounds := make([]float64, 0, len(buckets))
+	for _, b := range buckets {
+		bounds = append(bounds, b.Upper)
+	}
+
+	return bounds
+}
+
+// CumulativeCount returns the count of observations in buckets with an upper
+// bound less than or equal to the given bound, matching the value of the
+// classic histogram bucket with that bound. A bound of +Inf returns the count
+// of all observations.
+func (h Histogram) CumulativeCount(upperBound float64) float64 {
+	if math.IsInf(upperBound, 1) {
+		return h.Count
+	}
+
+	var count float64
+	for _, b := range h.Buckets() {
+		if b.Upper > upperBound {
+			break
+		}
+		count += b.Count
+	}
+
+	return count
+}
+
+// MergeUpperBounds merges sorted sets of bucket upper bounds, returning the
+// sorted union of all of them.
+func MergeUpperBounds(bounds ...[]float64) []float64 {
+	var merged []float64
+	for _, b := range bounds {
+		merged = append(merged, b...)
+	}
+
+	sort.Float64s(merged)
+	deduped := merged[:0]
+	for _, b := range merged {
+		if len(deduped) > 0 && b == deduped[len(deduped)-1] {
+			continue
+		}
+		deduped = append(deduped, b)
+	}
+
+	return deduped
+}
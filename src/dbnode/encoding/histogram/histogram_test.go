@@ -0,0 +1,105 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHistogram() Histogram {
+	return Histogram{
+		Schema:        0,
+		ZeroThreshold: 0.001,
+		ZeroCount:     2,
+		Count:         14,
+		Sum:           42.5,
+		PositiveSpans: []Span{
+			{Offset: 0, Length: 2},
+			{Offset: 1, Length: 1},
+		},
+		PositiveBuckets: []float64{1, 3, 4},
+		NegativeSpans:   []Span{{Offset: 1, Length: 2}},
+		NegativeBuckets: []float64{3, 1},
+	}
+}
+
+func TestHistogramValidate(t *testing.T) {
+	require.NoError(t, testHistogram().Validate())
+
+	h := testHistogram()
+	h.Schema = MaxSchema + 1
+	assert.Error(t, h.Validate())
+
+	h = testHistogram()
+	h.ZeroThreshold = -1
+	assert.Error(t, h.Validate())
+
+	h = testHistogram()
+	h.PositiveBuckets = h.PositiveBuckets[:2]
+	assert.Error(t, h.Validate())
+
+	h = testHistogram()
+	h.NegativeSpans = append(h.NegativeSpans, Span{Offset: -1, Length: 0})
+	assert.Error(t, h.Validate())
+}
+
+func TestHistogramBuckets(t *testing.T) {
+	expected := []Bucket{
+		{Lower: -4, Upper: -2, Count: 1},
+		{Lower: -2, Upper: -1, Count: 3},
+		{Lower: -0.001, Upper: 0.001, Count: 2},
+		{Lower: 0.5, Upper: 1, Count: 1},
+		{Lower: 1, Upper: 2, Count: 3},
+		{Lower: 4, Upper: 8, Count: 4},
+	}
+
+	assert.Equal(t, expected, testHistogram().Buckets())
+	assert.Equal(t, []float64{-2, -1, 0.001, 1, 2, 8},
+		testHistogram().UpperBounds())
+}
+
+func TestHistogramUpperBound(t *testing.T) {
+	assert.Equal(t, 1.0, UpperBound(0, 0))
+	assert.Equal(t, 8.0, UpperBound(0, 3))
+	assert.Equal(t, 0.25, UpperBound(0, -2))
+	assert.Equal(t, 2.0, UpperBound(3, 8))
+	assert.Equal(t, 65536.0, UpperBound(-4, 1))
+}
+
+func TestHistogramCumulativeCount(t *testing.T) {
+	h := testHistogram()
+	assert.Equal(t, 0.0, h.CumulativeCount(-3))
+	assert.Equal(t, 1.0, h.CumulativeCount(-2))
+	assert.Equal(t, 6.0, h.CumulativeCount(0.001))
+	assert.Equal(t, 10.0, h.CumulativeCount(4))
+	assert.Equal(t, 14.0, h.CumulativeCount(8))
+	assert.Equal(t, 14.0, h.CumulativeCount(math.Inf(1)))
+}
+
+func TestMergeUpperBounds(t *testing.T) {
+	assert.Equal(t, []float64{0.5, 1, 2, 4},
+		MergeUpperBounds([]float64{1, 2}, []float64{0.5, 2, 4}, nil))
+	assert.Empty(t, MergeUpperBounds())
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package histogram
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var (
+	errIteratorClosed     = errors.New("iterator is closed")
+	errAnnotationTooShort = errors.New("encoded annotation is truncated")
+	errNoPrevHistogram    = errors.New("histogram delta without a previous histogram")
+)
+
+// DefaultReaderIteratorAllocFn returns a function for allocating new native
+// histogram reader iterators.
+func DefaultReaderIteratorAllocFn(
+	opts encoding.Options,
+) func(r xio.Reader64, _ namespace.SchemaDescr) encoding.ReaderIterator {
+	return func(r xio.Reader64, _ namespace.SchemaDescr) encoding.ReaderIterator {
+		return NewReaderIterator(r, opts)
+	}
+}
+
+// readerIterator reads datapoints encoded by the native histogram encoder,
+// restoring the native histograms carried by their annotations.
+type readerIterator struct {
+	encoding.ReaderIterator
+
+	opts encoding.Options
+
+	// started is set once the first datapoint is read, and passthrough if the
+	// stream was not written by the native histogram encoder.
+	started     bool
+	passthrough bool
+
+	// kind and encoded are the kind and content of the last annotation read
+	// from the stream, which is applied again when it is repeated.
+	kind    byte
+	encoded []byte
+	curr    Histogram
+	hasCurr bool
+	ant     ts.Annotation
+	err     error
+	closed  bool
+}
+
+// NewReaderIterator returns a new iterator for a given reader.
+func NewReaderIterator(
+	reader xio.Reader64,
+	opts encoding.Options,
+) encoding.ReaderIterator {
+	if opts == nil {
+		opts = encoding.NewOptions()
+	}
+
+	// NB: the M3TSZ iterator must not return itself to the pool, which holds
+	// native histogram iterators.
+	return &readerIterator{
+		ReaderIterator: m3tsz.NewReaderIterator(reader,
+			m3tsz.DefaultIntOptimizationEnabled, opts.SetReaderIteratorPool(nil)),
+		opts: opts,
+		kind: annotationRaw,
+	}
+}
+
+// Next moves to the next item.
+func (it *readerIterator) Next() bool {
+	if it.err != nil || it.closed {
+		return false
+	}
+	if !it.ReaderIterator.Next() {
+		return false
+	}
+
+	_, _, ant := it.ReaderIterator.Current()
+	if !it.started {
+		it.started = true
+		if ant, it.err = it.readStreamHeader(ant); it.err != nil {
+			return false
+		}
+	}
+	if it.passthrough {
+		it.ant = ant
+		return true
+	}
+
+	if len(ant) == 0 {
+		if it.kind == annotationRaw {
+			it.ant = nil
+			return true
+		}
+
+		// NB: the stream repeats the previous annotation, which for a delta
+		// adds the same counts again.
+		if it.kind == annotationHistogramDelta {
+			it.err = it.readHistogram()
+		}
+		return it.err == nil
+	}
+
+	it.kind = ant[0]
+	it.encoded = append(it.encoded[:0], ant[1:]...)
+	switch it.kind {
+	case annotationRaw:
+		it.ant, it.hasCurr = nil, false
+		if len(it.encoded) > 0 {
+			it.ant = it.encoded
+		}
+	case annotationHistogram, annotationHistogramDelta:
+		it.err = it.readHistogram()
+	default:
+		it.err = fmt.Errorf("unknown native histogram annotation kind: %d", it.kind)
+	}
+
+	return it.err == nil
+}
+
+// readStreamHeader reads the header the native histogram encoder starts the
+// first annotation of a stream with, returning the annotation that follows it.
+// Streams without it are read as M3TSZ streams.
+func (it *readerIterator) readStreamHeader(ant ts.Annotation) (ts.Annotation, error) {
+	if !bytes.HasPrefix(ant, streamMagic) {
+		it.passthrough = true
+		return ant, nil
+	}
+
+	ant = ant[len(streamMagic):]
+	if len(ant) < 2 {
+		return nil, errAnnotationTooShort
+	}
+	if version := ant[0]; version != streamFormatVersion {
+		return nil, fmt.Errorf("unsupported native histogram stream version: %d", version)
+	}
+
+	return ant[1:], nil
+}
+
+func (it *readerIterator) readHistogram() error {
+	size, n := binary.Uvarint(it.encoded)
+	if n <= 0 || uint64(len(it.encoded)-n) < size {
+		return errAnnotationTooShort
+	}
+
+	var (
+		payload = it.encoded[n : n+int(size)]
+		encoded = it.encoded[n+int(size):]
+		h       Histogram
+		err     error
+	)
+	if it.kind == annotationHistogram {
+		h, err = Decode(encoded)
+	} else if it.hasCurr {
+		h, err = decodeDelta(it.curr, encoded)
+	} else {
+		err = errNoPrevHistogram
+	}
+	if err != nil {
+		return err
+	}
+
+	var p annotation.Payload
+	if err := p.Unmarshal(payload); err != nil {
+		return err
+	}
+
+	if p.NativeHistogram, err = Encode(h); err != nil {
+		return err
+	}
+
+	it.ant, err = p.Marshal()
+	if err != nil {
+		return err
+	}
+
+	it.curr, it.hasCurr = h, true
+	return nil
+}
+
+// Current returns the value as well as the annotation associated with the
+// current datapoint, carrying the native histogram of the datapoint if any.
+func (it *readerIterator) Current() (ts.Datapoint, xtime.Unit, ts.Annotation) {
+	dp, unit, _ := it.ReaderIterator.Current()
+	return dp, unit, it.ant
+}
+
+// Err returns the error encountered.
+func (it *readerIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.ReaderIterator.Err()
+}
+
+// Reset resets the iterator to read from a new reader.
+func (it *readerIterator) Reset(reader xio.Reader64, schema namespace.SchemaDescr) {
+	it.ReaderIterator.Reset(reader, schema)
+	it.started, it.passthrough = false, false
+	it.kind = annotationRaw
+	it.encoded = it.encoded[:0]
+	it.curr, it.hasCurr = Histogram{}, false
+	it.ant = nil
+	it.err = nil
+	it.closed = false
+}
+
+// Close closes the iterator.
+func (it *readerIterator) Close() {
+	if it.closed {
+		return
+	}
+
+	it.closed = true
+	it.err = errIteratorClosed
+	it.ReaderIterator.Close()
+
+	if pool := it.opts.ReaderIteratorPool(); pool != nil {
+		pool.Put(it)
+	}
+}
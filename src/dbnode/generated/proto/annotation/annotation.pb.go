@@ -22,13 +22,15 @@
 // THE SOFTWARE.
 
 /*
-	Package annotation is a generated protocol buffer package.
+Package annotation is a generated protocol buffer package.
 
-	It is generated from these files:
-		github.com/m3db/m3/src/dbnode/generated/proto/annotation/annotation.proto
+It is generated from these files:
 
-	It has these top-level messages:
-		Payload
+	github.com/m3db/m3/src/dbnode/generated/proto/annotation/annotation.proto
+
+It has these top-level messages:
+
+	Payload
 */
 package annotation
 
@@ -91,6 +93,9 @@ func (MetricType) EnumDescriptor() ([]byte, []int) { return fileDescriptorAnnota
 type Payload struct {
 	MetricType        MetricType `protobuf:"varint,1,opt,name=metric_type,json=metricType,proto3,enum=annotation.MetricType" json:"metric_type,omitempty"`
 	HandleValueResets bool       `protobuf:"varint,2,opt,name=handle_value_resets,json=handleValueResets,proto3" json:"handle_value_resets,omitempty"`
+	// native_histogram is the encoded native histogram sample of which the
+	// annotated datapoint holds the count.
+	NativeHistogram []byte `protobuf:"bytes,3,opt,name=native_histogram,json=nativeHistogram,proto3" json:"native_histogram,omitempty"`
 }
 
 func (m *Payload) Reset()                    { *m = Payload{} }
@@ -112,6 +117,13 @@ func (m *Payload) GetHandleValueResets() bool {
 	return false
 }
 
+func (m *Payload) GetNativeHistogram() []byte {
+	if m != nil {
+		return m.NativeHistogram
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Payload)(nil), "annotation.Payload")
 	proto.RegisterEnum("annotation.MetricType", MetricType_name, MetricType_value)
@@ -146,6 +158,12 @@ func (m *Payload) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.NativeHistogram) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintAnnotation(dAtA, i, uint64(len(m.NativeHistogram)))
+		i += copy(dAtA[i:], m.NativeHistogram)
+	}
 	return i, nil
 }
 
@@ -167,6 +185,10 @@ func (m *Payload) Size() (n int) {
 	if m.HandleValueResets {
 		n += 2
 	}
+	l = len(m.NativeHistogram)
+	if l > 0 {
+		n += 1 + l + sovAnnotation(uint64(l))
+	}
 	return n
 }
 
@@ -251,6 +273,37 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.HandleValueResets = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeHistogram", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAnnotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAnnotation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeHistogram = append(m.NativeHistogram[:0], dAtA[iNdEx:postIndex]...)
+			if m.NativeHistogram == nil {
+				m.NativeHistogram = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAnnotation(dAtA[iNdEx:])
@@ -382,24 +435,25 @@ func init() {
 }
 
 var fileDescriptorAnnotation = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd0, 0x4d, 0x4b, 0xc3, 0x30,
-	0x1c, 0x06, 0xf0, 0x65, 0xee, 0xf5, 0x3f, 0x5f, 0x62, 0x06, 0xb2, 0x53, 0x19, 0x9e, 0x86, 0x87,
-	0x06, 0xdc, 0xc1, 0x73, 0x95, 0x3a, 0x87, 0xb4, 0x95, 0x36, 0x55, 0x3c, 0x95, 0x74, 0x09, 0xdb,
-	0x60, 0x4d, 0x46, 0x96, 0x09, 0xf3, 0x53, 0xf8, 0xb1, 0x3c, 0xfa, 0x11, 0x64, 0x7e, 0x11, 0x59,
-	0x05, 0xb7, 0xdb, 0x93, 0xe7, 0xc7, 0x93, 0xc3, 0x1f, 0xc6, 0xd3, 0xb9, 0x9d, 0xad, 0x73, 0x77,
-	0xa2, 0x0b, 0x5a, 0x0c, 0x45, 0x4e, 0x8b, 0x21, 0x5d, 0x99, 0x09, 0x15, 0xb9, 0xd2, 0x42, 0xd2,
-	0xa9, 0x54, 0xd2, 0x70, 0x2b, 0x05, 0x5d, 0x1a, 0x6d, 0x35, 0xe5, 0x4a, 0x69, 0xcb, 0xed, 0x5c,
-	0xab, 0x83, 0xe8, 0x96, 0x46, 0x60, 0xdf, 0x5c, 0x1a, 0x68, 0x3e, 0xf1, 0xcd, 0x42, 0x73, 0x41,
-	0x6e, 0xa0, 0x53, 0x48, 0x6b, 0xe6, 0x93, 0xcc, 0x6e, 0x96, 0xb2, 0x87, 0xfa, 0x68, 0x70, 0x7a,
-	0x7d, 0xe1, 0x1e, 0xcc, 0x83, 0x92, 0xd9, 0x66, 0x29, 0x63, 0x28, 0xfe, 0x33, 0x71, 0xa1, 0x3b,
-	0xe3, 0x4a, 0x2c, 0x64, 0xf6, 0xc6, 0x17, 0x6b, 0x99, 0x19, 0xb9, 0x92, 0x76, 0xd5, 0xab, 0xf6,
-	0xd1, 0xa0, 0x15, 0x9f, 0xff, 0xd1, 0xf3, 0x4e, 0xe2, 0x12, 0xae, 0xde, 0x01, 0xf6, 0x3f, 0x91,
-	0x0e, 0x34, 0xd3, 0xf0, 0x31, 0x8c, 0x5e, 0x42, 0x5c, 0xd9, 0x3d, 0xee, 0xa2, 0x34, 0x64, 0x7e,
-	0x8c, 0x11, 0x69, 0x43, 0x7d, 0xe4, 0xa5, 0x23, 0x1f, 0x57, 0xc9, 0x09, 0xb4, 0x1f, 0xc6, 0x09,
-	0x8b, 0x46, 0xb1, 0x17, 0xe0, 0x23, 0xd2, 0x85, 0xb3, 0x52, 0xb2, 0x7d, 0x59, 0xdb, 0x6d, 0x93,
-	0x34, 0x08, 0xbc, 0xf8, 0x15, 0xd7, 0x49, 0x0b, 0x6a, 0xe3, 0xf0, 0x3e, 0xc2, 0x0d, 0x72, 0x0c,
-	0xad, 0x84, 0x79, 0xcc, 0x4f, 0x7c, 0x86, 0x9b, 0xb7, 0xf8, 0x73, 0xeb, 0xa0, 0xaf, 0xad, 0x83,
-	0xbe, 0xb7, 0x0e, 0xfa, 0xf8, 0x71, 0x2a, 0x79, 0xa3, 0x3c, 0xca, 0xf0, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x25, 0xe6, 0x7a, 0x90, 0x61, 0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4d, 0x90, 0xcf, 0x4e, 0xc2, 0x40,
+	0x10, 0xc6, 0x29, 0xff, 0x19, 0x50, 0xd6, 0x25, 0x31, 0x9c, 0x88, 0xf1, 0xa4, 0x1e, 0xda, 0x44,
+	0x0e, 0x9e, 0xab, 0xa9, 0x40, 0x4c, 0x5b, 0xb3, 0x6d, 0x35, 0x9e, 0x9a, 0x2d, 0xdd, 0x40, 0x13,
+	0xba, 0x4b, 0xda, 0x85, 0x04, 0x9f, 0xc2, 0x17, 0xf0, 0x7d, 0x3c, 0xfa, 0x08, 0x46, 0x5f, 0xc4,
+	0xa5, 0x44, 0xe1, 0x30, 0x93, 0x99, 0xef, 0x37, 0xdf, 0x4c, 0x32, 0x30, 0x99, 0x25, 0x72, 0xbe,
+	0x8a, 0xf4, 0xa9, 0x48, 0x8d, 0x74, 0x18, 0x47, 0x2a, 0x19, 0x79, 0x36, 0x35, 0xe2, 0x88, 0x8b,
+	0x98, 0x19, 0x33, 0xc6, 0x59, 0x46, 0x25, 0x8b, 0x8d, 0x65, 0x26, 0xa4, 0x30, 0x28, 0xe7, 0x42,
+	0x52, 0x99, 0x08, 0x7e, 0x50, 0xea, 0x05, 0xc3, 0xb0, 0x57, 0xce, 0xdf, 0x35, 0x68, 0x3c, 0xd2,
+	0xcd, 0x42, 0xd0, 0x18, 0xdf, 0x40, 0x3b, 0x65, 0x32, 0x4b, 0xa6, 0xa1, 0xdc, 0x2c, 0x59, 0x5f,
+	0x3b, 0xd3, 0x2e, 0x8e, 0xaf, 0x4f, 0xf5, 0x03, 0xbf, 0x5d, 0x60, 0x5f, 0x51, 0x02, 0xe9, 0x7f,
+	0x8d, 0x75, 0xe8, 0xcd, 0x29, 0x8f, 0x17, 0x2c, 0x5c, 0xd3, 0xc5, 0x8a, 0x85, 0x19, 0xcb, 0x99,
+	0xcc, 0xfb, 0x65, 0xb5, 0xa0, 0x49, 0x4e, 0x76, 0xe8, 0x69, 0x4b, 0x48, 0x01, 0xf0, 0x25, 0x20,
+	0xae, 0x16, 0xae, 0x59, 0x38, 0x4f, 0x72, 0x29, 0x66, 0x19, 0x4d, 0xfb, 0x15, 0x35, 0xdc, 0x21,
+	0xdd, 0x9d, 0x3e, 0xfe, 0x93, 0xaf, 0x5e, 0x01, 0xf6, 0x47, 0x71, 0x1b, 0x1a, 0x81, 0xf3, 0xe0,
+	0xb8, 0xcf, 0x0e, 0x2a, 0x6d, 0x9b, 0x3b, 0x37, 0x70, 0x7c, 0x8b, 0x20, 0x0d, 0xb7, 0xa0, 0x36,
+	0x32, 0x83, 0x91, 0x85, 0xca, 0xf8, 0x08, 0x5a, 0xe3, 0x89, 0xe7, 0xbb, 0x23, 0x62, 0xda, 0xa8,
+	0x82, 0x7b, 0xd0, 0x2d, 0x48, 0xb8, 0x17, 0xab, 0x5b, 0xaf, 0x17, 0xd8, 0xb6, 0x49, 0x5e, 0x50,
+	0x0d, 0x37, 0xa1, 0x3a, 0x71, 0xee, 0x5d, 0x54, 0xc7, 0x1d, 0x68, 0x7a, 0xbe, 0xe9, 0x5b, 0x9e,
+	0xe5, 0xa3, 0xc6, 0x2d, 0xfa, 0xf8, 0x1e, 0x68, 0x9f, 0x2a, 0xbe, 0x54, 0xbc, 0xfd, 0x0c, 0x4a,
+	0x51, 0xbd, 0x78, 0xe0, 0xf0, 0x17, 0x2d, 0x03, 0xc7, 0x6a, 0x8d, 0x01, 0x00, 0x00,
 }
@@ -93,6 +93,38 @@ func (x StagingStatus) String() string {
 }
 func (StagingStatus) EnumDescriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{0} }
 
+// WriteDurability is the durability a write must reach before it is acknowledged.
+type WriteDurability int32
+
+const (
+	// Writes are acknowledged according to the commit log strategy of the node.
+	WriteDurability_WRITE_DURABILITY_DEFAULT WriteDurability = 0
+	// Writes are acknowledged once in memory and are not written to the commit log.
+	WriteDurability_WRITE_DURABILITY_NONE WriteDurability = 1
+	// Writes are acknowledged once enqueued to the commit log.
+	WriteDurability_WRITE_DURABILITY_COMMIT_LOG_ENQUEUED WriteDurability = 2
+	// Writes are acknowledged once the commit log has been fsync'd.
+	WriteDurability_WRITE_DURABILITY_FSYNCED WriteDurability = 3
+)
+
+var WriteDurability_name = map[int32]string{
+	0: "WRITE_DURABILITY_DEFAULT",
+	1: "WRITE_DURABILITY_NONE",
+	2: "WRITE_DURABILITY_COMMIT_LOG_ENQUEUED",
+	3: "WRITE_DURABILITY_FSYNCED",
+}
+var WriteDurability_value = map[string]int32{
+	"WRITE_DURABILITY_DEFAULT":             0,
+	"WRITE_DURABILITY_NONE":                1,
+	"WRITE_DURABILITY_COMMIT_LOG_ENQUEUED": 2,
+	"WRITE_DURABILITY_FSYNCED":             3,
+}
+
+func (x WriteDurability) String() string {
+	return proto.EnumName(WriteDurability_name, int32(x))
+}
+func (WriteDurability) EnumDescriptor() ([]byte, []int) { return fileDescriptorNamespace, []int{1} }
+
 type RetentionOptions struct {
 	RetentionPeriodNanos                     int64 `protobuf:"varint,1,opt,name=retentionPeriodNanos,proto3" json:"retentionPeriodNanos,omitempty"`
 	BlockSizeNanos                           int64 `protobuf:"varint,2,opt,name=blockSizeNanos,proto3" json:"blockSizeNanos,omitempty"`
@@ -196,6 +228,8 @@ type NamespaceOptions struct {
 	CacheBlocksOnRetrieve *google_protobuf1.BoolValue `protobuf:"bytes,12,opt,name=cacheBlocksOnRetrieve" json:"cacheBlocksOnRetrieve,omitempty"`
 	AggregationOptions    *AggregationOptions         `protobuf:"bytes,13,opt,name=aggregationOptions" json:"aggregationOptions,omitempty"`
 	StagingState          *StagingState               `protobuf:"bytes,14,opt,name=stagingState" json:"stagingState,omitempty"`
+	TierAfterNanos        int64                       `protobuf:"varint,15,opt,name=tierAfterNanos,proto3" json:"tierAfterNanos,omitempty"`
+	WriteDurability       WriteDurability             `protobuf:"varint,16,opt,name=writeDurability,proto3,enum=namespace.WriteDurability" json:"writeDurability,omitempty"`
 	// Use larger field ID to ensure new fields are always added before extended options.
 	ExtendedOptions *ExtendedOptions `protobuf:"bytes,1000,opt,name=extendedOptions" json:"extendedOptions,omitempty"`
 }
@@ -303,6 +337,20 @@ func (m *NamespaceOptions) GetStagingState() *StagingState {
 	return nil
 }
 
+func (m *NamespaceOptions) GetTierAfterNanos() int64 {
+	if m != nil {
+		return m.TierAfterNanos
+	}
+	return 0
+}
+
+func (m *NamespaceOptions) GetWriteDurability() WriteDurability {
+	if m != nil {
+		return m.WriteDurability
+	}
+	return WriteDurability_WRITE_DURABILITY_DEFAULT
+}
+
 func (m *NamespaceOptions) GetExtendedOptions() *ExtendedOptions {
 	if m != nil {
 		return m.ExtendedOptions
@@ -500,6 +548,7 @@ func init() {
 	proto.RegisterType((*NamespaceRuntimeOptions)(nil), "namespace.NamespaceRuntimeOptions")
 	proto.RegisterType((*ExtendedOptions)(nil), "namespace.ExtendedOptions")
 	proto.RegisterEnum("namespace.StagingStatus", StagingStatus_name, StagingStatus_value)
+	proto.RegisterEnum("namespace.WriteDurability", WriteDurability_name, WriteDurability_value)
 }
 func (m *RetentionOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -747,6 +796,18 @@ func (m *NamespaceOptions) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.TierAfterNanos != 0 {
+		dAtA[i] = 0x78
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.TierAfterNanos))
+	}
+	if m.WriteDurability != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintNamespace(dAtA, i, uint64(m.WriteDurability))
+	}
 	if m.ExtendedOptions != nil {
 		dAtA[i] = 0xc2
 		i++
@@ -1132,6 +1193,12 @@ func (m *NamespaceOptions) Size() (n int) {
 		l = m.StagingState.Size()
 		n += 1 + l + sovNamespace(uint64(l))
 	}
+	if m.TierAfterNanos != 0 {
+		n += 1 + sovNamespace(uint64(m.TierAfterNanos))
+	}
+	if m.WriteDurability != 0 {
+		n += 2 + sovNamespace(uint64(m.WriteDurability))
+	}
 	if m.ExtendedOptions != nil {
 		l = m.ExtendedOptions.Size()
 		n += 2 + l + sovNamespace(uint64(l))
@@ -1928,6 +1995,44 @@ func (m *NamespaceOptions) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierAfterNanos", wireType)
+			}
+			m.TierAfterNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TierAfterNanos |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteDurability", wireType)
+			}
+			m.WriteDurability = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteDurability |= (WriteDurability(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 1000:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedOptions", wireType)
@@ -2918,68 +3023,75 @@ func init() {
 }
 
 var fileDescriptorNamespace = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0x80, 0xbb, 0xf9, 0x73, 0x72, 0xec, 0x24, 0xce, 0xa8, 0x10, 0x2b, 0x2d, 0xa6, 0x5a, 0x7e,
-	0x14, 0x55, 0xc8, 0xa6, 0xc9, 0x0d, 0x14, 0xa9, 0xe0, 0x24, 0x26, 0x72, 0x29, 0x8e, 0x35, 0x69,
-	0x29, 0xe4, 0x6e, 0x76, 0xf7, 0x78, 0xb3, 0xea, 0x7a, 0x66, 0x35, 0x33, 0xdb, 0x24, 0x3c, 0x43,
-	0x2f, 0x78, 0x0f, 0x5e, 0x84, 0x4b, 0x1e, 0x01, 0x05, 0x21, 0x71, 0xc3, 0x3b, 0xa0, 0x9d, 0xf5,
-	0x3a, 0xfb, 0xe3, 0x96, 0x88, 0x9b, 0x68, 0x72, 0xce, 0x77, 0x7e, 0xe6, 0xfc, 0xcc, 0x1a, 0x8e,
-	0xfd, 0x40, 0x9f, 0xc7, 0x4e, 0xc7, 0x15, 0x93, 0xee, 0x64, 0xdf, 0x73, 0xba, 0x93, 0xfd, 0xae,
-	0x92, 0x6e, 0xd7, 0x73, 0xb8, 0xf0, 0xb0, 0xeb, 0x23, 0x47, 0xc9, 0x34, 0x7a, 0xdd, 0x48, 0x0a,
-	0x2d, 0xba, 0x9c, 0x4d, 0x50, 0x45, 0xcc, 0xc5, 0x9b, 0x53, 0xc7, 0x68, 0xc8, 0xda, 0x4c, 0xb0,
-	0x73, 0xdf, 0x17, 0xc2, 0x0f, 0x31, 0x35, 0x71, 0xe2, 0x71, 0x57, 0x69, 0x19, 0xbb, 0x3a, 0x05,
-	0x77, 0xda, 0x65, 0xed, 0x85, 0x64, 0x51, 0x84, 0x52, 0x4d, 0xf5, 0x47, 0xff, 0x37, 0x23, 0xe5,
-	0x9e, 0xe3, 0x84, 0xa5, 0x5e, 0xec, 0x37, 0x8b, 0xd0, 0xa4, 0xa8, 0x91, 0xeb, 0x40, 0xf0, 0x93,
-	0x28, 0xf9, 0xab, 0xc8, 0x1e, 0xdc, 0x95, 0x99, 0x6c, 0x84, 0x32, 0x10, 0xde, 0x90, 0x71, 0xa1,
-	0x5a, 0xd6, 0x03, 0x6b, 0x77, 0x91, 0xce, 0xd5, 0x91, 0x4f, 0x61, 0xc3, 0x09, 0x85, 0xfb, 0xea,
-	0x34, 0xf8, 0x19, 0x53, 0x7a, 0xc1, 0xd0, 0x25, 0x29, 0xf9, 0x0c, 0xb6, 0x9c, 0x78, 0x3c, 0x46,
-	0xf9, 0x6d, 0xac, 0x63, 0x39, 0x45, 0x17, 0x0d, 0x5a, 0x55, 0x90, 0x5d, 0xd8, 0x4c, 0x85, 0x23,
-	0xa6, 0x74, 0xca, 0x2e, 0x19, 0xb6, 0x2c, 0x36, 0x64, 0x12, 0xe9, 0x88, 0x69, 0xd6, 0xbf, 0x8c,
-	0x02, 0x79, 0xd5, 0x5a, 0x7e, 0x60, 0xed, 0xae, 0xd2, 0xb2, 0x98, 0x9c, 0xc1, 0x6e, 0x49, 0xd4,
-	0x1b, 0x6b, 0x94, 0x43, 0xa1, 0x7b, 0xae, 0x8b, 0x4a, 0xe5, 0x6f, 0xbc, 0x62, 0x82, 0xdd, 0x9a,
-	0x27, 0x4f, 0x60, 0x67, 0x6c, 0xd2, 0xa7, 0xf3, 0xea, 0x57, 0x33, 0xde, 0xde, 0x41, 0xd8, 0x23,
-	0x68, 0x0c, 0xb8, 0x87, 0x97, 0x59, 0x27, 0x5a, 0x50, 0x43, 0xce, 0x9c, 0x10, 0x3d, 0x53, 0xfc,
-	0x55, 0x9a, 0xfd, 0x7b, 0xdb, 0x7a, 0xdb, 0xff, 0xac, 0x40, 0x73, 0x98, 0xf5, 0x3e, 0x73, 0xfb,
-	0x10, 0x9a, 0x8e, 0x10, 0x5a, 0x69, 0xc9, 0xa2, 0x7e, 0xc1, 0x7f, 0x45, 0x4e, 0x6c, 0x68, 0x8c,
-	0xc3, 0x58, 0x9d, 0x67, 0xdc, 0x82, 0xe1, 0x0a, 0xb2, 0xa4, 0xa9, 0x17, 0x32, 0xd0, 0xa8, 0x9e,
-	0x8b, 0x43, 0x31, 0x99, 0x04, 0xfa, 0x99, 0xf0, 0x4d, 0x53, 0x57, 0x69, 0x55, 0x91, 0xa4, 0xee,
-	0x86, 0xc8, 0x78, 0x3c, 0x8b, 0xbd, 0x64, 0xd0, 0x92, 0x94, 0x7c, 0x0c, 0xeb, 0x12, 0x23, 0x16,
-	0xc8, 0x0c, 0x4b, 0x1b, 0x5a, 0x14, 0x92, 0x63, 0x68, 0xca, 0xd2, 0x00, 0x9b, 0xb6, 0xd5, 0xf7,
-	0xee, 0x75, 0x6e, 0x96, 0xaf, 0x3c, 0xe3, 0xb4, 0x62, 0x94, 0x4c, 0x90, 0xe2, 0x2c, 0x52, 0xe7,
-	0x42, 0x67, 0x01, 0x6b, 0xe9, 0x04, 0x95, 0xc4, 0xe4, 0x2b, 0x68, 0x04, 0xb9, 0x2e, 0xb5, 0x56,
-	0x4d, 0xb8, 0xed, 0x5c, 0xb8, 0x7c, 0x13, 0x69, 0x01, 0x26, 0x4f, 0x60, 0x3d, 0xdd, 0xc0, 0xcc,
-	0x7a, 0xcd, 0x58, 0xb7, 0x72, 0xd6, 0xa7, 0x79, 0x3d, 0x2d, 0xe2, 0x49, 0xad, 0x5d, 0x11, 0x7a,
-	0x2f, 0x4d, 0x59, 0xb3, 0x44, 0x21, 0xad, 0x75, 0x45, 0x41, 0x9e, 0xc2, 0x86, 0x8c, 0xb9, 0x0e,
-	0x26, 0x59, 0xef, 0x5b, 0x75, 0x13, 0xce, 0xce, 0x85, 0x9b, 0x8d, 0x07, 0x2d, 0x90, 0xb4, 0x64,
-	0x49, 0x46, 0xf0, 0x9e, 0xcb, 0xdc, 0x73, 0x3c, 0x48, 0x26, 0x4c, 0x9d, 0x70, 0x8a, 0x5a, 0x06,
-	0xf8, 0x1a, 0x5b, 0x0d, 0xe3, 0x72, 0xa7, 0x93, 0xbe, 0x58, 0x9d, 0xec, 0xc5, 0xea, 0x1c, 0x08,
-	0x11, 0xfe, 0xc0, 0xc2, 0x18, 0xe9, 0x7c, 0x43, 0xf2, 0x3d, 0x10, 0xe6, 0xfb, 0x12, 0x7d, 0x96,
-	0xef, 0xde, 0xba, 0x71, 0xf7, 0x41, 0x2e, 0xc3, 0x5e, 0x05, 0xa2, 0x73, 0x0c, 0x93, 0xbe, 0x28,
-	0xcd, 0xfc, 0x80, 0xfb, 0xa7, 0x9a, 0x69, 0x6c, 0x6d, 0x54, 0xfa, 0x72, 0x9a, 0x53, 0xd3, 0x02,
-	0x4c, 0xfa, 0xb0, 0x89, 0x97, 0x1a, 0xb9, 0x87, 0x5e, 0x96, 0xc8, 0xdf, 0xb5, 0xe9, 0xc5, 0x6e,
-	0x1c, 0xf4, 0x8b, 0x08, 0x2d, 0xdb, 0xd8, 0x23, 0x20, 0xd5, 0x6c, 0xc9, 0x63, 0x68, 0xe4, 0xf2,
-	0x4d, 0x5e, 0xd2, 0xc5, 0xdd, 0xfa, 0xde, 0xfb, 0xf3, 0xaf, 0x48, 0x0b, 0xac, 0xcd, 0xa1, 0x9e,
-	0x53, 0x92, 0x36, 0x40, 0xa6, 0x9e, 0x6d, 0x6d, 0x4e, 0x42, 0xbe, 0x06, 0x60, 0x5a, 0xcb, 0xc0,
-	0x89, 0x35, 0xa6, 0x8f, 0x42, 0x7d, 0xef, 0xc3, 0x39, 0x81, 0xd0, 0xeb, 0xcd, 0x30, 0x9a, 0x33,
-	0xb1, 0xdf, 0x58, 0x70, 0x77, 0x1e, 0x94, 0x2c, 0x88, 0x44, 0x25, 0xc2, 0x38, 0xc9, 0x23, 0xff,
-	0x45, 0x28, 0x8b, 0xc9, 0x53, 0xd8, 0xf2, 0xc4, 0x05, 0x57, 0x6c, 0x12, 0x85, 0xb3, 0xc1, 0x4b,
-	0x53, 0xb9, 0x9f, 0x4b, 0xe5, 0xa8, 0xcc, 0xd0, 0xaa, 0x99, 0xfd, 0x09, 0x6c, 0x55, 0x38, 0xd2,
-	0x84, 0x45, 0x16, 0x86, 0xd3, 0xdb, 0x27, 0x47, 0xfb, 0x1b, 0x68, 0xe4, 0x9b, 0x4b, 0x3e, 0x87,
-	0x15, 0xa5, 0x99, 0x8e, 0xd3, 0x1c, 0x37, 0x8a, 0xfb, 0x75, 0x03, 0xc6, 0x8a, 0x4e, 0x39, 0xfb,
-	0x57, 0x0b, 0x56, 0x29, 0xfa, 0x81, 0xd2, 0xf2, 0x8a, 0x1c, 0x02, 0xcc, 0xf8, 0xac, 0x5d, 0x1f,
-	0x15, 0xde, 0x93, 0x14, 0xbc, 0x59, 0x1e, 0xd5, 0xe7, 0x5a, 0x5e, 0xd1, 0x9c, 0xd9, 0xce, 0x19,
-	0x6c, 0x96, 0xd4, 0x49, 0xe2, 0xaf, 0xf0, 0xca, 0xe4, 0xb4, 0x46, 0x93, 0x23, 0x79, 0x04, 0xcb,
-	0xaf, 0x93, 0x1d, 0x99, 0xd6, 0xe7, 0xde, 0xbc, 0xc5, 0xcc, 0xca, 0x93, 0x92, 0x8f, 0x17, 0xbe,
-	0xb0, 0xec, 0xbf, 0x2c, 0xd8, 0x7e, 0xcb, 0xe2, 0x12, 0x0f, 0xda, 0xe6, 0xd5, 0x35, 0xaf, 0x50,
-	0xc0, 0xfd, 0x11, 0xca, 0xc3, 0xd1, 0x8b, 0x43, 0xc1, 0xdd, 0x58, 0x4a, 0xe4, 0x6e, 0x1a, 0x3f,
-	0xe9, 0x45, 0x79, 0x63, 0x8f, 0x44, 0xec, 0x84, 0x98, 0xee, 0xec, 0x7f, 0xf8, 0x48, 0xa2, 0x98,
-	0x8f, 0xc0, 0xdb, 0xa3, 0x2c, 0xdc, 0x26, 0xca, 0xbb, 0x7d, 0xd8, 0x3f, 0xc2, 0x66, 0x69, 0xe7,
-	0x08, 0x81, 0x25, 0x7d, 0x15, 0xe1, 0xb4, 0x88, 0xe6, 0x4c, 0x1e, 0x41, 0x4d, 0x14, 0xe6, 0x6c,
-	0xbb, 0x12, 0xf5, 0xd4, 0xfc, 0xba, 0xa2, 0x19, 0xf7, 0xf0, 0x4b, 0x58, 0x2f, 0x0c, 0x02, 0xa9,
-	0x43, 0xed, 0xc5, 0xf0, 0xbb, 0xe1, 0xc9, 0xcb, 0x61, 0xf3, 0x0e, 0x69, 0x42, 0x63, 0x30, 0x1c,
-	0x3c, 0x1f, 0xf4, 0x9e, 0x0d, 0xce, 0x06, 0xc3, 0xe3, 0xa6, 0x45, 0xd6, 0x60, 0x99, 0xf6, 0x7b,
-	0x47, 0x3f, 0x35, 0x17, 0x0e, 0x9a, 0xbf, 0x5d, 0xb7, 0xad, 0xdf, 0xaf, 0xdb, 0xd6, 0x1f, 0xd7,
-	0x6d, 0xeb, 0x97, 0x3f, 0xdb, 0x77, 0x9c, 0x15, 0x13, 0x66, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x48, 0x8b, 0xb8, 0x2d, 0x28, 0x0a, 0x00, 0x00,
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xde, 0x24, 0x6d, 0xd3, 0x9e, 0xa6, 0x8d, 0x3b, 0xda, 0xa5, 0xa1, 0x5b, 0xca, 0xca, 0xec,
+	0xa2, 0xaa, 0x42, 0x09, 0x74, 0x6f, 0x60, 0x91, 0x80, 0x34, 0x49, 0xab, 0x40, 0xeb, 0x94, 0x49,
+	0xb2, 0xa5, 0xbd, 0xa9, 0x1c, 0x7b, 0xea, 0x5a, 0xeb, 0x78, 0xac, 0xf1, 0x78, 0xdb, 0xf2, 0x0c,
+	0x7b, 0x81, 0x78, 0x0d, 0x5e, 0x84, 0x4b, 0xee, 0xb9, 0x41, 0x20, 0x24, 0x1e, 0x83, 0xf1, 0x38,
+	0x4e, 0xfc, 0x93, 0x5d, 0x2a, 0x2e, 0x62, 0x8d, 0xcf, 0xf9, 0xce, 0xcf, 0x9c, 0xf3, 0x9d, 0xe3,
+	0xc0, 0x91, 0x65, 0xf3, 0xeb, 0x60, 0x54, 0x37, 0xe8, 0xb8, 0x31, 0x7e, 0x6e, 0x8e, 0xc4, 0xa3,
+	0xe1, 0x33, 0xa3, 0x61, 0x8e, 0x5c, 0x6a, 0x92, 0x86, 0x45, 0x5c, 0xc2, 0x74, 0x4e, 0xcc, 0x86,
+	0xc7, 0x28, 0xa7, 0x0d, 0x57, 0x1f, 0x13, 0xdf, 0xd3, 0x0d, 0x32, 0x3b, 0xd5, 0xa5, 0x06, 0xad,
+	0x4c, 0x05, 0x5b, 0xdb, 0x16, 0xa5, 0x96, 0x43, 0x22, 0x93, 0x51, 0x70, 0xd5, 0xf0, 0x39, 0x0b,
+	0x0c, 0x1e, 0x01, 0xb7, 0x76, 0xb2, 0xda, 0x1b, 0xa6, 0x7b, 0x1e, 0x61, 0xfe, 0x44, 0xdf, 0xfe,
+	0xbf, 0x19, 0xf9, 0xc6, 0x35, 0x19, 0xeb, 0x91, 0x17, 0xf5, 0x4d, 0x09, 0x14, 0x4c, 0x38, 0x71,
+	0xb9, 0x4d, 0xdd, 0x9e, 0x17, 0x3e, 0x7d, 0xb4, 0x0f, 0x0f, 0x59, 0x2c, 0x3b, 0x25, 0xcc, 0xa6,
+	0xa6, 0xa6, 0xbb, 0xd4, 0xaf, 0x15, 0x9e, 0x14, 0x76, 0x4b, 0x78, 0xae, 0x0e, 0x7d, 0x0c, 0xeb,
+	0x23, 0x87, 0x1a, 0xaf, 0xfa, 0xf6, 0x8f, 0x24, 0x42, 0x17, 0x25, 0x3a, 0x23, 0x45, 0x9f, 0xc0,
+	0x86, 0xb8, 0xcc, 0x15, 0x61, 0x87, 0x01, 0x0f, 0xd8, 0x04, 0x5a, 0x92, 0xd0, 0xbc, 0x02, 0xed,
+	0x42, 0x35, 0x12, 0x9e, 0xea, 0x3e, 0x8f, 0xb0, 0x0b, 0x12, 0x9b, 0x15, 0x4b, 0x64, 0x18, 0xa9,
+	0xad, 0x73, 0xbd, 0x73, 0xeb, 0xd9, 0xec, 0xae, 0xb6, 0x28, 0x90, 0xcb, 0x38, 0x2b, 0x46, 0x17,
+	0xb0, 0x9b, 0x11, 0x35, 0xaf, 0x38, 0x61, 0x1a, 0xe5, 0x4d, 0xc3, 0x20, 0xbe, 0x9f, 0xbc, 0xf1,
+	0x92, 0x0c, 0x76, 0x6f, 0x3c, 0xfa, 0x0a, 0xb6, 0xae, 0x64, 0xfa, 0x78, 0x5e, 0xfd, 0xca, 0xd2,
+	0xdb, 0x3b, 0x10, 0xea, 0x29, 0x54, 0xba, 0xae, 0x49, 0x6e, 0xe3, 0x4e, 0xd4, 0xa0, 0x4c, 0x5c,
+	0x7d, 0xe4, 0x10, 0x53, 0x16, 0x7f, 0x19, 0xc7, 0xaf, 0xf7, 0xad, 0xb7, 0xfa, 0x7b, 0x19, 0x14,
+	0x2d, 0xee, 0x7d, 0xec, 0x76, 0x0f, 0x94, 0x11, 0xa5, 0x5c, 0xf0, 0x4d, 0xf7, 0x3a, 0x29, 0xff,
+	0x39, 0x39, 0x52, 0xa1, 0x72, 0xe5, 0x04, 0xfe, 0x75, 0x8c, 0x2b, 0x4a, 0x5c, 0x4a, 0x16, 0x36,
+	0xf5, 0x86, 0xd9, 0x9c, 0xf8, 0x03, 0xda, 0xa2, 0xe3, 0xb1, 0xcd, 0x8f, 0xa9, 0x25, 0x9b, 0xba,
+	0x8c, 0xf3, 0x8a, 0x30, 0x75, 0xc3, 0x21, 0xba, 0x1b, 0x4c, 0x63, 0x2f, 0x48, 0x68, 0x46, 0x8a,
+	0x9e, 0xc2, 0x1a, 0x23, 0x9e, 0x6e, 0xb3, 0x18, 0x16, 0x35, 0x34, 0x2d, 0x44, 0x47, 0xa0, 0xb0,
+	0x0c, 0x81, 0x65, 0xdb, 0x56, 0xf7, 0x1f, 0xd7, 0x67, 0xc3, 0x97, 0xe5, 0x38, 0xce, 0x19, 0x85,
+	0x0c, 0xf2, 0x5d, 0xdd, 0xf3, 0xaf, 0x29, 0x8f, 0x03, 0x96, 0x23, 0x06, 0x65, 0xc4, 0xe8, 0x4b,
+	0xa8, 0xd8, 0x89, 0x2e, 0xd5, 0x96, 0x65, 0xb8, 0xcd, 0x44, 0xb8, 0x64, 0x13, 0x71, 0x0a, 0x2c,
+	0x28, 0xb2, 0x16, 0x4d, 0x60, 0x6c, 0xbd, 0x22, 0xad, 0x6b, 0x09, 0xeb, 0x7e, 0x52, 0x8f, 0xd3,
+	0xf0, 0xb0, 0xd6, 0x06, 0x75, 0xcc, 0x33, 0x59, 0xd6, 0x38, 0x51, 0x88, 0x6a, 0x9d, 0x53, 0xa0,
+	0x6f, 0x61, 0x9d, 0x05, 0xe2, 0x9a, 0xe3, 0xb8, 0xf7, 0xb5, 0x55, 0x19, 0x4e, 0x4d, 0x84, 0x9b,
+	0xd2, 0x03, 0xa7, 0x90, 0x38, 0x63, 0x89, 0x4e, 0xe1, 0x91, 0xa1, 0x8b, 0x5c, 0x0e, 0x42, 0x86,
+	0xf9, 0x3d, 0x57, 0xd4, 0x94, 0xd9, 0xe4, 0x35, 0xa9, 0x55, 0xa4, 0xcb, 0xad, 0x7a, 0xb4, 0xb1,
+	0xea, 0xf1, 0xc6, 0xaa, 0x1f, 0x50, 0xea, 0xbc, 0xd4, 0x9d, 0x80, 0xe0, 0xf9, 0x86, 0xe8, 0x04,
+	0x90, 0x6e, 0x59, 0x8c, 0x58, 0x7a, 0xb2, 0x7b, 0x6b, 0xd2, 0xdd, 0x07, 0x89, 0x0c, 0x9b, 0x39,
+	0x10, 0x9e, 0x63, 0x18, 0xf6, 0xc5, 0xe7, 0xba, 0x65, 0xbb, 0x56, 0x9f, 0x8b, 0xd5, 0x57, 0x5b,
+	0xcf, 0xf5, 0xa5, 0x9f, 0x50, 0xe3, 0x14, 0x38, 0x64, 0x25, 0xb7, 0x09, 0x8b, 0x66, 0x5b, 0x0e,
+	0x54, 0x35, 0x1a, 0xa8, 0xb4, 0x14, 0xb5, 0xa1, 0x2a, 0x29, 0xdd, 0x0e, 0x98, 0x3e, 0xb2, 0x1d,
+	0x9b, 0xdf, 0xd5, 0x14, 0x01, 0x5c, 0x17, 0xf7, 0x9f, 0xc5, 0x39, 0x4b, 0x23, 0x70, 0xd6, 0x04,
+	0x75, 0xa0, 0x4a, 0x6e, 0x05, 0x01, 0x4d, 0x62, 0xc6, 0xd7, 0xfe, 0xa7, 0x3c, 0x29, 0xe3, 0xcc,
+	0x4d, 0x27, 0x0d, 0xc1, 0x59, 0x1b, 0xb1, 0x2f, 0x50, 0xbe, 0x36, 0xe8, 0x05, 0x54, 0x12, 0xd5,
+	0x09, 0xf7, 0x76, 0x49, 0x38, 0x7e, 0x6f, 0x7e, 0x41, 0x71, 0x0a, 0xab, 0xba, 0xb0, 0x9a, 0x50,
+	0xa2, 0x1d, 0x80, 0x58, 0x3d, 0xdd, 0x11, 0x09, 0x09, 0xfa, 0x5a, 0xe8, 0xb9, 0xe8, 0xe6, 0x28,
+	0x10, 0xa4, 0x93, 0xbb, 0x61, 0x75, 0xff, 0xc3, 0x39, 0x81, 0x88, 0xd9, 0x9c, 0xc2, 0x70, 0xc2,
+	0x44, 0x7d, 0x53, 0x80, 0x87, 0xf3, 0x40, 0xe1, 0x38, 0x32, 0xe2, 0x53, 0x27, 0x08, 0xf3, 0x48,
+	0x7e, 0x7f, 0xb2, 0x62, 0xc1, 0xf1, 0x0d, 0x93, 0xde, 0xb8, 0xbe, 0x3e, 0xf6, 0x9c, 0x29, 0xcd,
+	0xa3, 0x54, 0xb6, 0x13, 0xa9, 0xb4, 0xb3, 0x18, 0x9c, 0x37, 0x53, 0x9f, 0xc1, 0x46, 0x0e, 0x87,
+	0x14, 0x28, 0xe9, 0x8e, 0x33, 0xb9, 0x7d, 0x78, 0x54, 0xbf, 0x81, 0x4a, 0x92, 0x4a, 0xe8, 0x53,
+	0x58, 0x12, 0x64, 0xe2, 0x41, 0x94, 0xe3, 0x7a, 0x7a, 0x9a, 0x67, 0xc0, 0xc0, 0xc7, 0x13, 0x9c,
+	0xfa, 0x4b, 0x01, 0x96, 0x31, 0xb1, 0x6c, 0xb1, 0x6b, 0xef, 0x50, 0x0b, 0x60, 0x8a, 0x8f, 0xdb,
+	0xf5, 0x51, 0x6a, 0x7b, 0x45, 0xc0, 0xd9, 0xa8, 0x8a, 0x01, 0x17, 0xef, 0x38, 0x61, 0xb6, 0x75,
+	0x01, 0xd5, 0x8c, 0x3a, 0x4c, 0xfc, 0x15, 0xb9, 0x93, 0x39, 0xad, 0xe0, 0xf0, 0x88, 0x3e, 0x83,
+	0xc5, 0xd7, 0xe1, 0x44, 0x4e, 0xea, 0xf3, 0x78, 0xde, 0x1a, 0x88, 0xcb, 0x13, 0x21, 0x5f, 0x14,
+	0x3f, 0x2f, 0xa8, 0x7f, 0x17, 0x60, 0xf3, 0x2d, 0x6b, 0x02, 0x99, 0xb0, 0x23, 0xd9, 0x2d, 0x77,
+	0x9e, 0xb8, 0xa8, 0xf8, 0x9e, 0xb5, 0x4e, 0x87, 0x2d, 0xea, 0x1a, 0x01, 0x63, 0xc4, 0x35, 0xa2,
+	0xf8, 0x61, 0x2f, 0xb2, 0xfb, 0xa1, 0x4d, 0x03, 0xb1, 0xa4, 0xa2, 0x0d, 0xf1, 0x1f, 0x3e, 0xc2,
+	0x28, 0xf2, 0x93, 0xf3, 0xf6, 0x28, 0xc5, 0xfb, 0x44, 0x79, 0xb7, 0x0f, 0xf5, 0x07, 0xa8, 0x66,
+	0x66, 0x0e, 0x21, 0x58, 0xe0, 0x77, 0x1e, 0x99, 0x14, 0x51, 0x9e, 0x45, 0x15, 0xcb, 0x34, 0xc5,
+	0xb3, 0xcd, 0x5c, 0xd4, 0xbe, 0xfc, 0x2f, 0x87, 0x63, 0xdc, 0xde, 0x17, 0xb0, 0x96, 0x22, 0x02,
+	0x5a, 0x85, 0xf2, 0x50, 0xfb, 0x4e, 0xeb, 0x9d, 0x69, 0xca, 0x03, 0xd1, 0xa8, 0x4a, 0x57, 0xeb,
+	0x0e, 0xba, 0xcd, 0xe3, 0xee, 0x45, 0x57, 0x3b, 0x52, 0x0a, 0x68, 0x05, 0x16, 0x71, 0xa7, 0xd9,
+	0x3e, 0x57, 0x8a, 0x7b, 0x3f, 0x17, 0xa0, 0x9a, 0x59, 0x28, 0x68, 0x1b, 0x6a, 0x67, 0xb8, 0x3b,
+	0xe8, 0x5c, 0xb6, 0x87, 0xb8, 0x79, 0xd0, 0x3d, 0xee, 0x0e, 0xce, 0x2f, 0xdb, 0x9d, 0xc3, 0xe6,
+	0xf0, 0x78, 0x20, 0xdc, 0xbd, 0x0f, 0x8f, 0x72, 0x5a, 0xad, 0xa7, 0x75, 0x84, 0xdf, 0x5d, 0x78,
+	0x9a, 0x53, 0xb5, 0x7a, 0x27, 0x27, 0xdd, 0xc1, 0xe5, 0x71, 0xef, 0xe8, 0xb2, 0xa3, 0x7d, 0x3f,
+	0xec, 0x0c, 0x3b, 0x6d, 0xa5, 0x38, 0x37, 0xc4, 0x61, 0xff, 0x5c, 0x6b, 0x09, 0x6d, 0xe9, 0x40,
+	0xf9, 0xf5, 0xcf, 0x9d, 0xc2, 0x6f, 0xe2, 0xf7, 0x87, 0xf8, 0xfd, 0xf4, 0xd7, 0xce, 0x83, 0xd1,
+	0x92, 0xbc, 0xfb, 0xf3, 0x7f, 0x01, 0x01, 0x45, 0xae, 0xff, 0x2b, 0x0b, 0x00, 0x00,
 }
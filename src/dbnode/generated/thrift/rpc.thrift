@@ -38,6 +38,13 @@ enum ErrorFlags {
     SERVER_TIMEOUT     = 0x02
 }
 
+enum WriteDurability {
+	DEFAULT,
+	NONE,
+	COMMIT_LOG_ENQUEUED,
+	FSYNCED
+}
+
 exception Error {
 	1: required ErrorType type = ErrorType.INTERNAL_ERROR
 	2: required string message
@@ -91,6 +98,12 @@ service Node {
 	DebugProfileStartResult        debugProfileStart(1: DebugProfileStartRequest req) throws (1: Error err)
 	DebugProfileStopResult         debugProfileStop(1: DebugProfileStopRequest req) throws (1: Error err)
 	DebugIndexMemorySegmentsResult debugIndexMemorySegments(1: DebugIndexMemorySegmentsRequest req) throws (1: Error err)
+
+	// Index statistics endpoints
+	IndexStatsResult indexStats(1: IndexStatsRequest req) throws (1: Error err)
+
+	// Series deletion endpoints
+	DeleteSeriesResult deleteSeries(1: DeleteSeriesRequest req) throws (1: Error err)
 }
 
 struct FetchRequest {
@@ -294,6 +307,7 @@ struct WriteTaggedBatchRawRequest {
 struct WriteTaggedBatchRawV2Request {
 	1: required list<binary> nameSpaces
 	2: required list<WriteTaggedBatchRawV2RequestElement> elements
+	3: optional WriteDurability durability = WriteDurability.DEFAULT
 }
 
 struct WriteTaggedBatchRawRequestElement {
@@ -559,3 +573,47 @@ struct DebugIndexMemorySegmentsRequest {
 
 struct DebugIndexMemorySegmentsResult {
 }
+
+struct IndexStatsRequest {
+	1: required binary nameSpace
+	2: required i64 rangeStart
+	3: required i64 rangeEnd
+	4: optional i64 limit = 10
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+	6: optional binary metricNameTag
+	7: optional list<i32> shards
+	8: optional bool includeLabelPairs
+}
+
+struct IndexStatsResult {
+	1: required i64 numSeries
+	2: required i64 numLabelPairs
+	3: required list<IndexStat> seriesCountByMetricName
+	4: required list<IndexStat> labelValueCountByLabelName
+	5: required list<IndexStat> memoryInBytesByLabelName
+	6: required list<IndexStat> seriesCountByLabelValuePair
+	7: optional list<IndexLabelPairStat> seriesCountByLabelPair
+}
+
+struct IndexStat {
+	1: required binary name
+	2: required i64 value
+}
+
+struct IndexLabelPairStat {
+	1: required binary name
+	2: required binary value
+	3: required i64 numSeries
+}
+
+struct DeleteSeriesRequest {
+	1: required binary nameSpace
+	2: required binary query
+	3: required i64 rangeStart
+	4: required i64 rangeEnd
+	5: optional TimeType rangeTimeType = TimeType.UNIX_SECONDS
+}
+
+struct DeleteSeriesResult {
+	1: required i64 numSeries
+}
@@ -52,10 +52,12 @@ type MetadataConfiguration struct {
 	BootstrapEnabled      *bool                   `yaml:"bootstrapEnabled"`
 	FlushEnabled          *bool                   `yaml:"flushEnabled"`
 	WritesToCommitLog     *bool                   `yaml:"writesToCommitLog"`
+	WriteDurability       WriteDurability         `yaml:"writeDurability"`
 	CleanupEnabled        *bool                   `yaml:"cleanupEnabled"`
 	RepairEnabled         *bool                   `yaml:"repairEnabled"`
 	ColdWritesEnabled     *bool                   `yaml:"coldWritesEnabled"`
 	CacheBlocksOnRetrieve *bool                   `yaml:"cacheBlocksOnRetrieve"`
+	TierAfter             time.Duration           `yaml:"tierAfter"`
 	Retention             retention.Configuration `yaml:"retention" validate:"nonzero"`
 	Index                 IndexConfiguration      `yaml:"index"`
 }
@@ -76,6 +78,9 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.WritesToCommitLog; v != nil {
 		opts = opts.SetWritesToCommitLog(*v)
 	}
+	if v := mc.WriteDurability; v != DefaultWriteDurability {
+		opts = opts.SetWriteDurability(v)
+	}
 	if v := mc.CleanupEnabled; v != nil {
 		opts = opts.SetCleanupEnabled(*v)
 	}
@@ -88,6 +93,9 @@ func (mc *MetadataConfiguration) Metadata() (Metadata, error) {
 	if v := mc.CacheBlocksOnRetrieve; v != nil {
 		opts = opts.SetCacheBlocksOnRetrieve(*v)
 	}
+	if v := mc.TierAfter; v > 0 {
+		opts = opts.SetTierAfter(v)
+	}
 	return NewMetadata(ident.StringID(mc.ID), opts)
 }
 
@@ -145,6 +145,7 @@ metadatas:
     bootstrapEnabled: true
     flushEnabled: true
     writesToCommitLog: true
+    writeDurability: fsynced
     cleanupEnabled: true
     repairEnabled: true
     retention:
@@ -184,6 +185,7 @@ metadatas:
 	require.Equal(t, false, opts.BootstrapEnabled())
 	require.Equal(t, false, opts.FlushEnabled())
 	require.Equal(t, false, opts.WritesToCommitLog())
+	require.Equal(t, DefaultWriteDurability, opts.WriteDurability())
 	require.Equal(t, false, opts.CleanupEnabled())
 	require.Equal(t, false, opts.RepairEnabled())
 	require.Equal(t, false, opts.IndexOptions().Enabled())
@@ -202,6 +204,7 @@ metadatas:
 	require.Equal(t, true, opts.BootstrapEnabled())
 	require.Equal(t, true, opts.FlushEnabled())
 	require.Equal(t, true, opts.WritesToCommitLog())
+	require.Equal(t, FsyncedWriteDurability, opts.WriteDurability())
 	require.Equal(t, true, opts.CleanupEnabled())
 	require.Equal(t, true, opts.RepairEnabled())
 	require.Equal(t, false, opts.IndexOptions().Enabled())
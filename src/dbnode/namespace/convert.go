@@ -196,12 +196,18 @@ func ToMetadata(
 		return nil, err
 	}
 
+	writeDurability, err := NewWriteDurability(opts.WriteDurability)
+	if err != nil {
+		return nil, err
+	}
+
 	mOpts := NewOptions().
 		SetBootstrapEnabled(opts.BootstrapEnabled).
 		SetFlushEnabled(opts.FlushEnabled).
 		SetCleanupEnabled(opts.CleanupEnabled).
 		SetRepairEnabled(opts.RepairEnabled).
 		SetWritesToCommitLog(opts.WritesToCommitLog).
+		SetWriteDurability(writeDurability).
 		SetSnapshotEnabled(opts.SnapshotEnabled).
 		SetSchemaHistory(sr).
 		SetRetentionOptions(rOpts).
@@ -210,7 +216,8 @@ func ToMetadata(
 		SetRuntimeOptions(runtimeOpts).
 		SetExtendedOptions(extendedOpts).
 		SetAggregationOptions(aggOpts).
-		SetStagingState(stagingState)
+		SetStagingState(stagingState).
+		SetTierAfter(time.Duration(opts.TierAfterNanos))
 
 	if opts.CacheBlocksOnRetrieve != nil {
 		mOpts = mOpts.SetCacheBlocksOnRetrieve(opts.CacheBlocksOnRetrieve.Value)
@@ -313,6 +320,11 @@ func OptionsToProto(opts Options) (*nsproto.NamespaceOptions, error) {
 		return nil, err
 	}
 
+	writeDurability, err := toProtoWriteDurability(opts.WriteDurability())
+	if err != nil {
+		return nil, err
+	}
+
 	nsOpts := &nsproto.NamespaceOptions{
 		BootstrapEnabled:  opts.BootstrapEnabled(),
 		FlushEnabled:      opts.FlushEnabled(),
@@ -340,6 +352,8 @@ func OptionsToProto(opts Options) (*nsproto.NamespaceOptions, error) {
 		ExtendedOptions:       extendedOpts,
 		AggregationOptions:    toProtoAggregationOptions(opts.AggregationOptions()),
 		StagingState:          stagingState,
+		TierAfterNanos:        opts.TierAfter().Nanoseconds(),
+		WriteDurability:       writeDurability,
 	}
 
 	return nsOpts, nil
@@ -76,6 +76,8 @@ var (
 			SchemaOptions:         testSchemaOptions,
 			ExtendedOptions:       validExtendedOpts,
 			StagingState:          &nsproto.StagingState{Status: nsproto.StagingStatus_INITIALIZING},
+			TierAfterNanos:        toNanos(600), // 10h
+			WriteDurability:       nsproto.WriteDurability_WRITE_DURABILITY_FSYNCED,
 		},
 		{
 			BootstrapEnabled:  true,
@@ -400,6 +402,10 @@ func assertEqualMetadata(t *testing.T, name string, expected nsproto.NamespaceOp
 	require.Equal(t, expected.CleanupEnabled, opts.CleanupEnabled())
 	require.Equal(t, expected.RepairEnabled, opts.RepairEnabled())
 	require.Equal(t, expectedCacheBlocksOnRetrieve, opts.CacheBlocksOnRetrieve())
+	require.Equal(t, expected.TierAfterNanos, opts.TierAfter().Nanoseconds())
+	expectedWriteDurability, err := namespace.NewWriteDurability(expected.WriteDurability)
+	require.NoError(t, err)
+	require.Equal(t, expectedWriteDurability, opts.WriteDurability())
 	expectedSchemaReg, err := namespace.LoadSchemaHistory(expected.SchemaOptions)
 	require.NoError(t, err)
 	require.NotNil(t, expectedSchemaReg)
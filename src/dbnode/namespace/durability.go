@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package namespace
+
+import (
+	"fmt"
+
+	nsproto "github.com/m3db/m3/src/dbnode/generated/proto/namespace"
+)
+
+// NewWriteDurability creates a new WriteDurability from its proto representation.
+func NewWriteDurability(durability nsproto.WriteDurability) (WriteDurability, error) {
+	switch durability {
+	case nsproto.WriteDurability_WRITE_DURABILITY_DEFAULT:
+		return DefaultWriteDurability, nil
+	case nsproto.WriteDurability_WRITE_DURABILITY_NONE:
+		return NoneWriteDurability, nil
+	case nsproto.WriteDurability_WRITE_DURABILITY_COMMIT_LOG_ENQUEUED:
+		return CommitLogEnqueuedWriteDurability, nil
+	case nsproto.WriteDurability_WRITE_DURABILITY_FSYNCED:
+		return FsyncedWriteDurability, nil
+	}
+	return DefaultWriteDurability, fmt.Errorf("invalid write durability: %v", durability)
+}
+
+// ParseWriteDurability parses a WriteDurability from a string.
+func ParseWriteDurability(str string) (WriteDurability, error) {
+	for _, valid := range validWriteDurabilities {
+		if str == valid.String() {
+			return valid, nil
+		}
+	}
+	return DefaultWriteDurability, fmt.Errorf("invalid write durability '%s' valid types are: %v",
+		str, validWriteDurabilities)
+}
+
+// Validate validates the WriteDurability.
+func (d WriteDurability) Validate() error {
+	for _, valid := range validWriteDurabilities {
+		if valid == d {
+			return nil
+		}
+	}
+	return fmt.Errorf("write durability %d is invalid", uint8(d))
+}
+
+// UnmarshalYAML unmarshals a WriteDurability into a valid type from string.
+func (d *WriteDurability) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		*d = DefaultWriteDurability
+		return nil
+	}
+	r, err := ParseWriteDurability(str)
+	if err != nil {
+		return err
+	}
+	*d = r
+	return nil
+}
+
+func (d WriteDurability) String() string {
+	switch d {
+	case DefaultWriteDurability:
+		return "default"
+	case NoneWriteDurability:
+		return "none"
+	case CommitLogEnqueuedWriteDurability:
+		return "commit_log_enqueued"
+	case FsyncedWriteDurability:
+		return "fsynced"
+	default:
+		return "unknown"
+	}
+}
+
+func toProtoWriteDurability(durability WriteDurability) (nsproto.WriteDurability, error) {
+	switch durability {
+	case DefaultWriteDurability:
+		return nsproto.WriteDurability_WRITE_DURABILITY_DEFAULT, nil
+	case NoneWriteDurability:
+		return nsproto.WriteDurability_WRITE_DURABILITY_NONE, nil
+	case CommitLogEnqueuedWriteDurability:
+		return nsproto.WriteDurability_WRITE_DURABILITY_COMMIT_LOG_ENQUEUED, nil
+	case FsyncedWriteDurability:
+		return nsproto.WriteDurability_WRITE_DURABILITY_FSYNCED, nil
+	}
+	return nsproto.WriteDurability_WRITE_DURABILITY_DEFAULT,
+		fmt.Errorf("invalid write durability: %v", durability)
+}
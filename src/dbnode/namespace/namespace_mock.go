@@ -439,6 +439,34 @@ func (mr *MockOptionsMockRecorder) SetStagingState(value interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStagingState", reflect.TypeOf((*MockOptions)(nil).SetStagingState), value)
 }
 
+// SetTierAfter mocks base method.
+func (m *MockOptions) SetTierAfter(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTierAfter", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTierAfter indicates an expected call of SetTierAfter.
+func (mr *MockOptionsMockRecorder) SetTierAfter(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTierAfter", reflect.TypeOf((*MockOptions)(nil).SetTierAfter), value)
+}
+
+// SetWriteDurability mocks base method.
+func (m *MockOptions) SetWriteDurability(value WriteDurability) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWriteDurability", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetWriteDurability indicates an expected call of SetWriteDurability.
+func (mr *MockOptionsMockRecorder) SetWriteDurability(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDurability", reflect.TypeOf((*MockOptions)(nil).SetWriteDurability), value)
+}
+
 // SetWritesToCommitLog mocks base method.
 func (m *MockOptions) SetWritesToCommitLog(value bool) Options {
 	m.ctrl.T.Helper()
@@ -481,6 +509,20 @@ func (mr *MockOptionsMockRecorder) StagingState() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StagingState", reflect.TypeOf((*MockOptions)(nil).StagingState))
 }
 
+// TierAfter mocks base method.
+func (m *MockOptions) TierAfter() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TierAfter")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// TierAfter indicates an expected call of TierAfter.
+func (mr *MockOptionsMockRecorder) TierAfter() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TierAfter", reflect.TypeOf((*MockOptions)(nil).TierAfter))
+}
+
 // Validate mocks base method.
 func (m *MockOptions) Validate() error {
 	m.ctrl.T.Helper()
@@ -495,6 +537,20 @@ func (mr *MockOptionsMockRecorder) Validate() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockOptions)(nil).Validate))
 }
 
+// WriteDurability mocks base method.
+func (m *MockOptions) WriteDurability() WriteDurability {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteDurability")
+	ret0, _ := ret[0].(WriteDurability)
+	return ret0
+}
+
+// WriteDurability indicates an expected call of WriteDurability.
+func (mr *MockOptionsMockRecorder) WriteDurability() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteDurability", reflect.TypeOf((*MockOptions)(nil).WriteDurability))
+}
+
 // WritesToCommitLog mocks base method.
 func (m *MockOptions) WritesToCommitLog() bool {
 	m.ctrl.T.Helper()
@@ -22,6 +22,7 @@ package namespace
 
 import (
 	"errors"
+	"time"
 
 	"github.com/m3db/m3/src/dbnode/retention"
 )
@@ -39,6 +40,9 @@ const (
 	// Namespace writes go to commit logs by default.
 	defaultWritesToCommitLog = true
 
+	// Namespace writes are acknowledged according to the commit log strategy by default.
+	defaultWriteDurability = DefaultWriteDurability
+
 	// Namespace requires fileset/snapshot cleanup by default.
 	defaultCleanupEnabled = true
 
@@ -51,6 +55,9 @@ const (
 	// Namespace does not cache retrieved blocks by default since this is only
 	// useful specifically for usage patterns tending towards heavy historical reads.
 	defaultCacheBlocksOnRetrieve = false
+
+	// Namespace keeps all of its filesets on local disk by default.
+	defaultTierAfter = time.Duration(0)
 )
 
 var (
@@ -59,6 +66,8 @@ var (
 	errIndexBlockSizeMustBeAMultipleOfDataBlockSize = errors.New("index block size must be a multiple of data block size")
 	errNamespaceRuntimeOptionsNotSet                = errors.New("namespace runtime options is not set")
 	errAggregationOptionsNotSet                     = errors.New("aggregation options is not set")
+	errTierAfterNegative                            = errors.New("tier after must not be negative")
+	errTierAfterTooLarge                            = errors.New("tier after needs to be < namespace retention period")
 )
 
 type options struct {
@@ -66,10 +75,12 @@ type options struct {
 	flushEnabled          bool
 	snapshotEnabled       bool
 	writesToCommitLog     bool
+	writeDurability       WriteDurability
 	cleanupEnabled        bool
 	repairEnabled         bool
 	coldWritesEnabled     bool
 	cacheBlocksOnRetrieve bool
+	tierAfter             time.Duration
 	retentionOpts         retention.Options
 	indexOpts             IndexOptions
 	schemaHis             SchemaHistory
@@ -91,10 +102,12 @@ func NewOptions() Options {
 		flushEnabled:          defaultFlushEnabled,
 		snapshotEnabled:       defaultSnapshotEnabled,
 		writesToCommitLog:     defaultWritesToCommitLog,
+		writeDurability:       defaultWriteDurability,
 		cleanupEnabled:        defaultCleanupEnabled,
 		repairEnabled:         defaultRepairEnabled,
 		coldWritesEnabled:     defaultColdWritesEnabled,
 		cacheBlocksOnRetrieve: defaultCacheBlocksOnRetrieve,
+		tierAfter:             defaultTierAfter,
 		retentionOpts:         retention.NewOptions(),
 		indexOpts:             NewIndexOptions(),
 		schemaHis:             NewSchemaHistory(),
@@ -118,6 +131,17 @@ func (o *options) Validate() error {
 		return err
 	}
 
+	if err := o.writeDurability.Validate(); err != nil {
+		return err
+	}
+
+	if o.tierAfter < 0 {
+		return errTierAfterNegative
+	}
+	if o.tierAfter > 0 && o.tierAfter >= o.retentionOpts.RetentionPeriod() {
+		return errTierAfterTooLarge
+	}
+
 	if !o.indexOpts.Enabled() {
 		return nil
 	}
@@ -150,11 +174,13 @@ func (o *options) Equal(value Options) bool {
 	return o.bootstrapEnabled == value.BootstrapEnabled() &&
 		o.flushEnabled == value.FlushEnabled() &&
 		o.writesToCommitLog == value.WritesToCommitLog() &&
+		o.writeDurability == value.WriteDurability() &&
 		o.snapshotEnabled == value.SnapshotEnabled() &&
 		o.cleanupEnabled == value.CleanupEnabled() &&
 		o.repairEnabled == value.RepairEnabled() &&
 		o.coldWritesEnabled == value.ColdWritesEnabled() &&
 		o.cacheBlocksOnRetrieve == value.CacheBlocksOnRetrieve() &&
+		o.tierAfter == value.TierAfter() &&
 		o.retentionOpts.Equal(value.RetentionOptions()) &&
 		o.indexOpts.Equal(value.IndexOptions()) &&
 		o.schemaHis.Equal(value.SchemaHistory()) &&
@@ -203,6 +229,16 @@ func (o *options) WritesToCommitLog() bool {
 	return o.writesToCommitLog
 }
 
+func (o *options) SetWriteDurability(value WriteDurability) Options {
+	opts := *o
+	opts.writeDurability = value
+	return &opts
+}
+
+func (o *options) WriteDurability() WriteDurability {
+	return o.writeDurability
+}
+
 func (o *options) SetCleanupEnabled(value bool) Options {
 	opts := *o
 	opts.cleanupEnabled = value
@@ -243,6 +279,16 @@ func (o *options) CacheBlocksOnRetrieve() bool {
 	return o.cacheBlocksOnRetrieve
 }
 
+func (o *options) SetTierAfter(value time.Duration) Options {
+	opts := *o
+	opts.tierAfter = value
+	return &opts
+}
+
+func (o *options) TierAfter() time.Duration {
+	return o.tierAfter
+}
+
 func (o *options) SetRetentionOptions(value retention.Options) Options {
 	opts := *o
 	opts.retentionOpts = value
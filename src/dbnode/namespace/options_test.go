@@ -217,3 +217,37 @@ func TestOptionsValidateStagingStatus(t *testing.T) {
 	o1 = o1.SetStagingState(StagingState{status: StagingStatus(12)})
 	require.Error(t, o1.Validate())
 }
+
+func TestOptionsValidateTierAfter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rOpts := retention.NewMockOptions(ctrl)
+	iOpts := NewMockIndexOptions(ctrl)
+	o1 := NewOptions().
+		SetRetentionOptions(rOpts).
+		SetIndexOptions(iOpts)
+
+	iOpts.EXPECT().Enabled().Return(false).AnyTimes()
+
+	rOpts.EXPECT().Validate().Return(nil).AnyTimes()
+	rOpts.EXPECT().RetentionPeriod().Return(48 * time.Hour).AnyTimes()
+	require.NoError(t, o1.Validate())
+
+	require.NoError(t, o1.SetTierAfter(24*time.Hour).Validate())
+	require.Equal(t, errTierAfterNegative, o1.SetTierAfter(-time.Hour).Validate())
+	require.Equal(t, errTierAfterTooLarge, o1.SetTierAfter(48*time.Hour).Validate())
+}
+
+func TestOptionsValidateWriteDurability(t *testing.T) {
+	o1 := NewOptions()
+	require.Equal(t, DefaultWriteDurability, o1.WriteDurability())
+	require.NoError(t, o1.Validate())
+
+	o2 := o1.SetWriteDurability(FsyncedWriteDurability)
+	require.Equal(t, FsyncedWriteDurability, o2.WriteDurability())
+	require.NoError(t, o2.Validate())
+	require.False(t, o1.Equal(o2))
+
+	require.Error(t, o1.SetWriteDurability(WriteDurability(12)).Validate())
+}
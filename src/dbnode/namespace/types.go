@@ -64,6 +64,14 @@ type Options interface {
 	// WritesToCommitLog returns whether writes for series in this namespace need to go to commit log.
 	WritesToCommitLog() bool
 
+	// SetWriteDurability sets the durability writes to this namespace must reach
+	// before they are acknowledged.
+	SetWriteDurability(value WriteDurability) Options
+
+	// WriteDurability returns the durability writes to this namespace must reach
+	// before they are acknowledged.
+	WriteDurability() WriteDurability
+
 	// SetCleanupEnabled sets whether this namespace requires cleaning up fileset/snapshot files.
 	SetCleanupEnabled(value bool) Options
 
@@ -90,6 +98,14 @@ type Options interface {
 	// CacheBlocksOnRetrieve returns whether to cache blocks from this namespace when retrieved.
 	CacheBlocksOnRetrieve() bool
 
+	// SetTierAfter sets how long after a block ends its fileset is moved to the
+	// object store, zero disables tiering for this namespace.
+	SetTierAfter(value time.Duration) Options
+
+	// TierAfter returns how long after a block ends its fileset is moved to the
+	// object store, zero disables tiering for this namespace.
+	TierAfter() time.Duration
+
 	// SetRetentionOptions sets the retention options for this namespace.
 	SetRetentionOptions(value retention.Options) Options
 
@@ -389,3 +405,28 @@ var validStagingStatuses = []StagingStatus{
 	InitializingStagingStatus,
 	ReadyStagingStatus,
 }
+
+// WriteDurability is the durability a write must reach before it is acknowledged.
+type WriteDurability uint8
+
+const (
+	// DefaultWriteDurability acknowledges writes according to the commit log
+	// strategy of the node.
+	DefaultWriteDurability WriteDurability = iota
+	// NoneWriteDurability acknowledges writes once they are accepted in memory,
+	// the writes are not written to the commit log.
+	NoneWriteDurability
+	// CommitLogEnqueuedWriteDurability acknowledges writes once they are
+	// enqueued to the commit log.
+	CommitLogEnqueuedWriteDurability
+	// FsyncedWriteDurability acknowledges writes once the commit log they
+	// were written to has been fsync'd.
+	FsyncedWriteDurability
+)
+
+var validWriteDurabilities = []WriteDurability{
+	DefaultWriteDurability,
+	NoneWriteDurability,
+	CommitLogEnqueuedWriteDurability,
+	FsyncedWriteDurability,
+}
@@ -21,12 +21,15 @@
 package convert
 
 import (
+	"bytes"
 	stdctx "context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/limits"
@@ -42,9 +45,13 @@ import (
 )
 
 var (
-	errUnknownTimeType  = errors.New("unknown time type")
-	errUnknownUnit      = errors.New("unknown unit")
-	errNilTaggedRequest = errors.New("nil write tagged request")
+	errUnknownTimeType   = errors.New("unknown time type")
+	errUnknownUnit       = errors.New("unknown unit")
+	errUnknownDurability = errors.New("unknown write durability")
+	errNilTaggedRequest  = errors.New("nil write tagged request")
+
+	errNilDeleteSeriesRequest   = errors.New("nil delete series request")
+	errDeleteSeriesInvalidRange = errors.New("delete series range start must be before range end")
 
 	timeZero time.Time
 )
@@ -115,6 +122,36 @@ func ToTimeType(unit xtime.Unit) (rpc.TimeType, error) {
 	return 0, errUnknownUnit
 }
 
+// ToWriteDurability converts an rpc write durability to a write durability.
+func ToWriteDurability(durability rpc.WriteDurability) (namespace.WriteDurability, error) {
+	switch durability {
+	case rpc.WriteDurability_DEFAULT:
+		return namespace.DefaultWriteDurability, nil
+	case rpc.WriteDurability_NONE:
+		return namespace.NoneWriteDurability, nil
+	case rpc.WriteDurability_COMMIT_LOG_ENQUEUED:
+		return namespace.CommitLogEnqueuedWriteDurability, nil
+	case rpc.WriteDurability_FSYNCED:
+		return namespace.FsyncedWriteDurability, nil
+	}
+	return 0, errUnknownDurability
+}
+
+// ToRPCWriteDurability converts a write durability to an rpc write durability.
+func ToRPCWriteDurability(durability namespace.WriteDurability) (rpc.WriteDurability, error) {
+	switch durability {
+	case namespace.DefaultWriteDurability:
+		return rpc.WriteDurability_DEFAULT, nil
+	case namespace.NoneWriteDurability:
+		return rpc.WriteDurability_NONE, nil
+	case namespace.CommitLogEnqueuedWriteDurability:
+		return rpc.WriteDurability_COMMIT_LOG_ENQUEUED, nil
+	case namespace.FsyncedWriteDurability:
+		return rpc.WriteDurability_FSYNCED, nil
+	}
+	return 0, errUnknownDurability
+}
+
 // ToSegmentsResult is the result of a convert to segments call,
 // if the segments were merged then checksum is ptr to the checksum
 // otherwise it is nil.
@@ -709,3 +746,135 @@ func parseQuery(query *rpc.Query) (*querypb.Query, error) {
 	}
 	return result, nil
 }
+
+// ToRPCDeleteSeriesRequest converts the Go `client/` types into rpc request
+// type for DeleteSeriesRequest.
+func ToRPCDeleteSeriesRequest(
+	ns ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (rpc.DeleteSeriesRequest, error) {
+	rangeStart, tsErr := ToValue(start, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteSeriesRequest{}, tsErr
+	}
+
+	rangeEnd, tsErr := ToValue(end, fetchTaggedTimeType)
+	if tsErr != nil {
+		return rpc.DeleteSeriesRequest{}, tsErr
+	}
+
+	query, queryErr := idx.Marshal(q.Query)
+	if queryErr != nil {
+		return rpc.DeleteSeriesRequest{}, queryErr
+	}
+
+	return rpc.DeleteSeriesRequest{
+		NameSpace:     ns.Bytes(),
+		Query:         query,
+		RangeStart:    rangeStart,
+		RangeEnd:      rangeEnd,
+		RangeTimeType: fetchTaggedTimeType,
+	}, nil
+}
+
+// FromRPCDeleteSeriesRequest converts the rpc request type for
+// DeleteSeriesRequest into corresponding Go types.
+func FromRPCDeleteSeriesRequest(
+	req *rpc.DeleteSeriesRequest, pools FetchTaggedConversionPools,
+) (ident.ID, index.Query, xtime.UnixNano, xtime.UnixNano, error) {
+	if req == nil {
+		return nil, index.Query{}, 0, 0, errNilDeleteSeriesRequest
+	}
+
+	start, rangeStartErr := ToTime(req.RangeStart, req.RangeTimeType)
+	end, rangeEndErr := ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeStartErr != nil || rangeEndErr != nil {
+		return nil, index.Query{}, 0, 0, xerrors.FirstError(rangeStartErr, rangeEndErr)
+	}
+	if !start.Before(end) {
+		return nil, index.Query{}, 0, 0, errDeleteSeriesInvalidRange
+	}
+
+	q, err := idx.Unmarshal(req.Query)
+	if err != nil {
+		return nil, index.Query{}, 0, 0, err
+	}
+
+	var ns ident.ID
+	if pools != nil {
+		nsBytes := pools.CheckedBytesWrapper().Get(req.NameSpace)
+		ns = pools.ID().BinaryID(nsBytes)
+	} else {
+		ns = ident.StringID(string(req.NameSpace))
+	}
+	return ns, index.Query{Query: q}, start, end, nil
+}
+
+// ToRPCIndexStatsResult converts a cardinality stats summary to an index
+// stats result.
+func ToRPCIndexStatsResult(summary index.CardinalityStatsSummary) *rpc.IndexStatsResult_ {
+	return &rpc.IndexStatsResult_{
+		NumSeries:                   summary.NumSeries,
+		NumLabelPairs:               summary.NumLabelPairs,
+		SeriesCountByMetricName:     toRPCIndexStats(summary.SeriesCountByMetricName),
+		LabelValueCountByLabelName:  toRPCIndexStats(summary.LabelValueCountByLabelName),
+		MemoryInBytesByLabelName:    toRPCIndexStats(summary.MemoryInBytesByLabelName),
+		SeriesCountByLabelValuePair: toRPCIndexStats(summary.SeriesCountByLabelValuePair),
+	}
+}
+
+func toRPCIndexStats(stats []index.CardinalityStat) []*rpc.IndexStat {
+	result := make([]*rpc.IndexStat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, &rpc.IndexStat{
+			Name:  []byte(stat.Name),
+			Value: stat.Value,
+		})
+	}
+	return result
+}
+
+// ToRPCIndexLabelPairStats converts the series counts by label pair of
+// cardinality stats to index label pair stats ordered by label pair.
+func ToRPCIndexLabelPairStats(stats index.CardinalityStats) []*rpc.IndexLabelPairStat {
+	result := make([]*rpc.IndexLabelPairStat, 0, len(stats.SeriesCountByLabelPair))
+	for pair, count := range stats.SeriesCountByLabelPair {
+		result = append(result, &rpc.IndexLabelPairStat{
+			Name:      []byte(pair.Name),
+			Value:     []byte(pair.Value),
+			NumSeries: count,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if c := bytes.Compare(result[i].Name, result[j].Name); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(result[i].Value, result[j].Value) < 0
+	})
+	return result
+}
+
+// FromRPCIndexStatsResult converts an index stats result to a cardinality
+// stats summary.
+func FromRPCIndexStatsResult(result *rpc.IndexStatsResult_) index.CardinalityStatsSummary {
+	return index.CardinalityStatsSummary{
+		NumSeries:                   result.NumSeries,
+		NumLabelPairs:               result.NumLabelPairs,
+		SeriesCountByMetricName:     fromRPCIndexStats(result.SeriesCountByMetricName),
+		LabelValueCountByLabelName:  fromRPCIndexStats(result.LabelValueCountByLabelName),
+		MemoryInBytesByLabelName:    fromRPCIndexStats(result.MemoryInBytesByLabelName),
+		SeriesCountByLabelValuePair: fromRPCIndexStats(result.SeriesCountByLabelValuePair),
+	}
+}
+
+func fromRPCIndexStats(stats []*rpc.IndexStat) []index.CardinalityStat {
+	result := make([]index.CardinalityStat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, index.CardinalityStat{
+			Name:  string(stat.Name),
+			Value: stat.Value,
+		})
+	}
+	return result
+}
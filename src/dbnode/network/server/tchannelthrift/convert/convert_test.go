@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/m3db/m3/src/dbnode/generated/thrift/rpc"
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/convert"
 	tterrors "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/errors"
 	"github.com/m3db/m3/src/dbnode/storage/index"
@@ -298,6 +299,31 @@ func TestToRPCError(t *testing.T) {
 	)
 }
 
+func TestWriteDurabilityRoundTrip(t *testing.T) {
+	for _, durability := range []rpc.WriteDurability{
+		rpc.WriteDurability_DEFAULT,
+		rpc.WriteDurability_NONE,
+		rpc.WriteDurability_COMMIT_LOG_ENQUEUED,
+		rpc.WriteDurability_FSYNCED,
+	} {
+		converted, err := convert.ToWriteDurability(durability)
+		require.NoError(t, err)
+
+		rpcDurability, err := convert.ToRPCWriteDurability(converted)
+		require.NoError(t, err)
+		require.Equal(t, durability, rpcDurability)
+	}
+
+	converted, err := convert.ToWriteDurability(rpc.WriteDurability_FSYNCED)
+	require.NoError(t, err)
+	require.Equal(t, namespace.FsyncedWriteDurability, converted)
+
+	_, err = convert.ToWriteDurability(rpc.WriteDurability(42))
+	require.Error(t, err)
+	_, err = convert.ToRPCWriteDurability(namespace.WriteDurability(42))
+	require.Error(t, err)
+}
+
 type testPools struct {
 	id      ident.Pool
 	wrapper xpool.CheckedBytesWrapperPool
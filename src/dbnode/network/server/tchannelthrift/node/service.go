@@ -114,6 +114,7 @@ type serviceMetrics struct {
 	fetchBlocksMetadata     instrument.MethodMetrics
 	repair                  instrument.MethodMetrics
 	truncate                instrument.MethodMetrics
+	deleteSeries            instrument.MethodMetrics
 	fetchBatchRawRPCS       tally.Counter
 	fetchBatchRaw           instrument.BatchMethodMetrics
 	writeBatchRawRPCs       tally.Counter
@@ -138,6 +139,7 @@ func newServiceMetrics(scope tally.Scope, opts instrument.TimerOptions) serviceM
 		fetchBlocksMetadata:     instrument.NewMethodMetrics(scope, "fetchBlocksMetadata", opts),
 		repair:                  instrument.NewMethodMetrics(scope, "repair", opts),
 		truncate:                instrument.NewMethodMetrics(scope, "truncate", opts),
+		deleteSeries:            instrument.NewMethodMetrics(scope, "deleteSeries", opts),
 		fetchBatchRawRPCS:       scope.Counter("fetchBatchRaw-rpcs"),
 		fetchBatchRaw:           instrument.NewBatchMethodMetrics(scope, "fetchBatchRaw", opts),
 		writeBatchRawRPCs:       scope.Counter("writeBatchRaw-rpcs"),
@@ -2068,6 +2070,11 @@ func (s *service) WriteTaggedBatchRawV2(tctx thrift.Context, req *rpc.WriteTagge
 		}
 	}
 
+	durability, err := convert.ToWriteDurability(req.Durability)
+	if err != nil {
+		return tterrors.NewBadRequestError(err)
+	}
+
 	// Sort the elements so that they're sorted by namespace so we can reuse the same batch writer.
 	sort.Slice(req.Elements, func(i, j int) bool {
 		return req.Elements[i].NameSpace < req.Elements[j].NameSpace
@@ -2111,6 +2118,9 @@ func (s *service) WriteTaggedBatchRawV2(tctx thrift.Context, req *rpc.WriteTagge
 			// function and let the database take care of returning them to the pool.
 			batchWriter.SetFinalizeEncodedTagsFn(finalizeEncodedTagsFn)
 			batchWriter.SetFinalizeAnnotationFn(finalizeAnnotationFn)
+			// The batch is only acknowledged once it reaches the requested
+			// durability, or that of the namespace if none was requested.
+			batchWriter.SetDurability(durability)
 		}
 
 		unit, unitErr := convert.ToUnit(elem.Datapoint.TimestampTimeType)
@@ -2207,6 +2217,38 @@ func (s *service) Truncate(tctx thrift.Context, req *rpc.TruncateRequest) (r *rp
 	return res, nil
 }
 
+func (s *service) DeleteSeries(
+	tctx thrift.Context,
+	req *rpc.DeleteSeriesRequest,
+) (*rpc.DeleteSeriesResult_, error) {
+	db, err := s.startRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+
+	callStart := s.nowFn()
+	ctx := tchannelthrift.Context(tctx)
+
+	ns, query, start, end, err := convert.FromRPCDeleteSeriesRequest(req, s.pools)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	deleted, err := db.DeleteSeries(ctx, ns, query, start, end)
+	if err != nil {
+		s.metrics.deleteSeries.ReportError(s.nowFn().Sub(callStart))
+		return nil, convert.ToRPCError(err)
+	}
+
+	res := rpc.NewDeleteSeriesResult_()
+	res.NumSeries = deleted
+
+	s.metrics.deleteSeries.ReportSuccess(s.nowFn().Sub(callStart))
+
+	return res, nil
+}
+
 func (s *service) GetPersistRateLimit(
 	ctx thrift.Context,
 ) (*rpc.NodePersistRateLimitResult_, error) {
@@ -2503,6 +2545,59 @@ func (s *service) DebugIndexMemorySegments(
 	return &rpc.DebugIndexMemorySegmentsResult_{}, nil
 }
 
+func (s *service) IndexStats(
+	tctx thrift.Context,
+	req *rpc.IndexStatsRequest,
+) (
+	*rpc.IndexStatsResult_,
+	error,
+) {
+	db, err := s.startReadRPCWithDB()
+	if err != nil {
+		return nil, err
+	}
+	defer s.readRPCCompleted(tctx)
+
+	start, rangeStartErr := convert.ToTime(req.RangeStart, req.RangeTimeType)
+	end, rangeEndErr := convert.ToTime(req.RangeEnd, req.RangeTimeType)
+	if rangeStartErr != nil || rangeEndErr != nil {
+		return nil, tterrors.NewBadRequestError(xerrors.FirstError(rangeStartErr, rangeEndErr))
+	}
+
+	ctx := tchannelthrift.Context(tctx)
+	ns, ok := db.Namespace(s.newID(ctx, req.NameSpace))
+	if !ok {
+		return nil, tterrors.NewBadRequestError(
+			fmt.Errorf("unable to find specified namespace: %s", req.NameSpace))
+	}
+
+	idx, err := ns.Index()
+	if err != nil {
+		return nil, tterrors.NewBadRequestError(err)
+	}
+
+	var shards []uint32
+	for _, shard := range req.Shards {
+		shards = append(shards, uint32(shard))
+	}
+
+	stats, err := idx.CardinalityStats(start, end, shards)
+	if err != nil {
+		return nil, convert.ToRPCError(err)
+	}
+
+	metricNameTag := req.MetricNameTag
+	if len(metricNameTag) == 0 {
+		metricNameTag = index.DefaultCardinalityStatsMetricNameTag
+	}
+	summary := stats.Summary(metricNameTag, int(req.Limit))
+	result := convert.ToRPCIndexStatsResult(summary)
+	if req.GetIncludeLabelPairs() {
+		result.SeriesCountByLabelPair = convert.ToRPCIndexLabelPairStats(stats)
+	}
+	return result, nil
+}
+
 func (s *service) SetDatabase(db storage.Database) error {
 	s.state.Lock()
 	defer s.state.Unlock()
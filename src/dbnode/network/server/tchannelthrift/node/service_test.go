@@ -3148,6 +3148,128 @@ func TestServiceTruncate(t *testing.T) {
 	assert.Equal(t, truncated, r.NumSeries)
 }
 
+func TestServiceDeleteSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false).Times(2)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID  = "metrics"
+		start = xtime.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		end   = start.Add(2 * time.Hour)
+	)
+
+	req, err := idx.NewRegexpQuery([]byte("foo"), []byte("b.*"))
+	require.NoError(t, err)
+	qry := index.Query{Query: req}
+
+	mockDB.EXPECT().DeleteSeries(
+		gomock.Any(),
+		ident.NewIDMatcher(nsID),
+		index.NewQueryMatcher(qry),
+		start,
+		end,
+	).Return(int64(2), nil)
+
+	data, err := idx.Marshal(req)
+	require.NoError(t, err)
+	r, err := service.DeleteSeries(tctx, &rpc.DeleteSeriesRequest{
+		NameSpace:     []byte(nsID),
+		Query:         data,
+		RangeStart:    start.Seconds(),
+		RangeEnd:      end.Seconds(),
+		RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), r.NumSeries)
+
+	// Empty ranges are rejected.
+	_, err = service.DeleteSeries(tctx, &rpc.DeleteSeriesRequest{
+		NameSpace:     []byte(nsID),
+		Query:         data,
+		RangeStart:    end.Seconds(),
+		RangeEnd:      start.Seconds(),
+		RangeTimeType: rpc.TimeType_UNIX_SECONDS,
+	})
+	rpcErr, ok := err.(*rpc.Error)
+	require.True(t, ok)
+	require.True(t, tterrors.IsBadRequestError(rpcErr))
+}
+
+func TestServiceIndexStats(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := storage.NewMockDatabase(ctrl)
+	mockDB.EXPECT().Options().Return(testStorageOpts).AnyTimes()
+	mockDB.EXPECT().IsOverloaded().Return(false)
+
+	service := NewService(mockDB, testTChannelThriftOptions).(*service)
+
+	tctx, _ := tchannelthrift.NewContext(time.Minute)
+	ctx := tchannelthrift.Context(tctx)
+	defer ctx.Close()
+
+	var (
+		nsID  = "metrics"
+		start = xtime.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		end   = start.Add(2 * time.Hour)
+		stats = index.NewCardinalityStats()
+	)
+	stats.NumSeries = 3
+	stats.SeriesCountByLabelPair[index.LabelPair{Name: "__name__", Value: "up"}] = 2
+	stats.SeriesCountByLabelPair[index.LabelPair{Name: "__name__", Value: "requests"}] = 1
+	stats.SeriesCountByLabelPair[index.LabelPair{Name: "job", Value: "api"}] = 3
+
+	mockIdx := storage.NewMockNamespaceIndex(ctrl)
+	mockIdx.EXPECT().CardinalityStats(start, end, []uint32{1, 3}).Return(stats, nil)
+	mockNs := storage.NewMockNamespace(ctrl)
+	mockNs.EXPECT().Index().Return(mockIdx, nil)
+	mockDB.EXPECT().Namespace(ident.NewIDMatcher(nsID)).Return(mockNs, true)
+
+	req := rpc.NewIndexStatsRequest()
+	req.NameSpace = []byte(nsID)
+	req.RangeStart = start.Seconds()
+	req.RangeEnd = end.Seconds()
+	req.Limit = 1
+	req.Shards = []int32{1, 3}
+	includeLabelPairs := true
+	req.IncludeLabelPairs = &includeLabelPairs
+
+	r, err := service.IndexStats(tctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, &rpc.IndexStatsResult_{
+		NumSeries:     3,
+		NumLabelPairs: 3,
+		SeriesCountByMetricName: []*rpc.IndexStat{
+			{Name: []byte("up"), Value: 2},
+		},
+		LabelValueCountByLabelName: []*rpc.IndexStat{
+			{Name: []byte("__name__"), Value: 2},
+		},
+		MemoryInBytesByLabelName: []*rpc.IndexStat{
+			{Name: []byte("__name__"), Value: 10},
+		},
+		SeriesCountByLabelValuePair: []*rpc.IndexStat{
+			{Name: []byte("job=api"), Value: 3},
+		},
+		SeriesCountByLabelPair: []*rpc.IndexLabelPairStat{
+			{Name: []byte("__name__"), Value: []byte("requests"), NumSeries: 1},
+			{Name: []byte("__name__"), Value: []byte("up"), NumSeries: 2},
+			{Name: []byte("job"), Value: []byte("api"), NumSeries: 3},
+		},
+	}, r)
+}
+
 func TestServiceSetPersistRateLimit(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+// BackupURL is the URL of the backup handler.
+const BackupURL = "/backup"
+
+var errBackupPathRequired = errors.New("backup path is required")
+
+// BackupHTTPRequest is the body of a request to the backup handler.
+type BackupHTTPRequest struct {
+	// Name is the name of the backup, defaulting to the current time.
+	Name string `json:"name"`
+	// Namespaces are the namespaces to backup, defaulting to all.
+	Namespaces []string `json:"namespaces"`
+	// Path is the local directory the backup is written to.
+	Path string `json:"path"`
+}
+
+// BackupHTTPResponse is the body of a response from the backup handler.
+type BackupHTTPResponse struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	Files     int       `json:"files"`
+	Bytes     int64     `json:"bytes"`
+}
+
+type handler struct {
+	manager Manager
+	opts    Options
+	logger  *zap.Logger
+}
+
+// NewHandler returns a handler which takes a backup to a local directory.
+func NewHandler(manager Manager, opts Options) http.Handler {
+	return &handler{
+		manager: manager,
+		opts:    opts,
+		logger:  opts.InstrumentOptions().Logger(),
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		xhttp.WriteError(w, xhttp.NewError(
+			fmt.Errorf("unsupported method: %s", r.Method), http.StatusMethodNotAllowed))
+		return
+	}
+
+	var req BackupHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+	if req.Path == "" {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(errBackupPathRequired))
+		return
+	}
+	if req.Name == "" {
+		req.Name = h.opts.ClockOptions().NowFn()().UTC().Format("20060102T150405Z")
+	}
+
+	namespaces := make([]ident.ID, 0, len(req.Namespaces))
+	for _, namespace := range req.Namespaces {
+		namespaces = append(namespaces, ident.StringID(namespace))
+	}
+
+	manifest, err := h.manager.Backup(BackupRequest{
+		Name:       req.Name,
+		Namespaces: namespaces,
+		Store:      NewFilesystemStore(req.Path),
+	})
+	if err != nil {
+		h.logger.Error("backup failed", zap.String("name", req.Name), zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	resp := BackupHTTPResponse{
+		Name:      manifest.Name,
+		CreatedAt: manifest.CreatedAt,
+		Files:     len(manifest.Files),
+	}
+	for _, file := range manifest.Files {
+		resp.Bytes += file.Size
+	}
+	xhttp.WriteJSONResponse(w, resp, h.logger)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerBackup(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	body := `{"name":"` + testName + `","path":"` + test.backupDir + `"}`
+	req := httptest.NewRequest(http.MethodPost, BackupURL, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+
+	handler := NewHandler(test.manager, newTestOptions(test.filePathPrefix, test.pins))
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp BackupHTTPResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	require.Equal(t, testName, resp.Name)
+	require.Equal(t, 16, resp.Files)
+	require.True(t, resp.Bytes > 0)
+
+	manifest, err := readManifest(test.store(), testName)
+	require.NoError(t, err)
+	require.Len(t, manifest.Files, resp.Files)
+}
+
+func TestHandlerBadRequests(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	handler := NewHandler(test.manager, newTestOptions(test.filePathPrefix, test.pins))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, BackupURL, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, BackupURL,
+		strings.NewReader(`{"name":"foo"}`)))
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
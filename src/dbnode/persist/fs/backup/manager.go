@@ -0,0 +1,602 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/ident"
+
+	"go.uber.org/zap"
+)
+
+const (
+	manifestKey = "manifest.json"
+	filesKey    = "files"
+
+	checkpointFileNameSuffix = "-checkpoint.db"
+
+	// maxPinAttempts is the number of times files are listed and pinned before
+	// giving up when cleanup keeps removing them before they are pinned.
+	maxPinAttempts = 3
+)
+
+var (
+	errBackupNameRequired = errors.New("backup name is required")
+	errStoreRequired      = errors.New("backup store is required")
+	errFilesRemoved       = errors.New("filesets removed before they were pinned")
+)
+
+type manager struct {
+	opts   Options
+	logger *zap.Logger
+}
+
+// NewManager returns a new backup manager.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &manager{
+		opts:   opts,
+		logger: opts.InstrumentOptions().Logger(),
+	}, nil
+}
+
+// backupFileSet is a group of files which are backed up together, with the
+// checksums recorded for them by their digest or checkpoint files.
+type backupFileSet struct {
+	fileType    FileType
+	namespace   string
+	shard       uint32
+	blockStart  int64
+	volumeIndex int
+	filePaths   []string
+	digestsFn   func() (map[string]uint32, error)
+}
+
+func (m *manager) Backup(req BackupRequest) (Manifest, error) {
+	if req.Name == "" {
+		return Manifest{}, errBackupNameRequired
+	}
+	if req.Store == nil {
+		return Manifest{}, errStoreRequired
+	}
+
+	fileSets, release, err := m.listAndPin(req.Namespaces)
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer release()
+
+	manifest := Manifest{
+		Name:      req.Name,
+		CreatedAt: m.opts.ClockOptions().NowFn()(),
+	}
+	for _, fileSet := range fileSets {
+		files, err := m.backupFileSet(req, fileSet)
+		if err != nil {
+			return Manifest{}, err
+		}
+		manifest.Files = append(manifest.Files, files...)
+	}
+
+	// Write the manifest last so that only complete backups have one.
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err := req.Store.Put(path.Join(req.Name, manifestKey), bytes.NewReader(data)); err != nil {
+		return Manifest{}, err
+	}
+
+	m.logger.Info("backup complete",
+		zap.String("name", req.Name),
+		zap.Int("files", len(manifest.Files)))
+	return manifest, nil
+}
+
+// listAndPin lists the filesets to backup and pins them, listing them again
+// if cleanup removed any of them before they were pinned.
+func (m *manager) listAndPin(namespaces []ident.ID) ([]backupFileSet, func(), error) {
+	for attempt := 0; attempt < maxPinAttempts; attempt++ {
+		fileSets, err := m.list(namespaces)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var filePaths []string
+		for _, fileSet := range fileSets {
+			filePaths = append(filePaths, fileSet.filePaths...)
+		}
+		release := m.opts.FilePins().Pin(filePaths)
+
+		removed, err := anyFileRemoved(filePaths)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		if !removed {
+			return fileSets, release, nil
+		}
+
+		release()
+		m.logger.Info("filesets removed before they were pinned, listing again",
+			zap.Int("attempt", attempt+1))
+	}
+	return nil, nil, errFilesRemoved
+}
+
+func anyFileRemoved(filePaths []string) (bool, error) {
+	for _, filePath := range filePaths {
+		exists, err := fs.PathExists(filePath)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *manager) list(namespaces []ident.ID) ([]backupFileSet, error) {
+	fsOpts := m.opts.FilesystemOptions()
+	filePathPrefix := fsOpts.FilePathPrefix()
+	if len(namespaces) == 0 {
+		var err error
+		namespaces, err = namespacesOnDisk(filePathPrefix)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		result      []backupFileSet
+		anySnapshot bool
+	)
+	for _, namespace := range namespaces {
+		dataShards, err := shardsOnDisk(fs.NamespaceDataDirPath(filePathPrefix, namespace))
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range dataShards {
+			filesets, err := fs.DataFiles(filePathPrefix, namespace, shard)
+			if err != nil {
+				return nil, err
+			}
+			result = appendLatestVolumes(result, DataFileType, filesets)
+		}
+
+		snapshotShards, err := shardsOnDisk(fs.NamespaceSnapshotsDirPath(filePathPrefix, namespace))
+		if err != nil {
+			return nil, err
+		}
+		for _, shard := range snapshotShards {
+			filesets, err := fs.SnapshotFiles(filePathPrefix, namespace, shard)
+			if err != nil {
+				return nil, err
+			}
+			n := len(result)
+			result = appendLatestVolumes(result, SnapshotFileType, filesets)
+			anySnapshot = anySnapshot || len(result) > n
+		}
+
+		// All complete index volumes are backed up since every volume of a
+		// block is loaded when bootstrapping.
+		filesets, err := fs.IndexFiles(filePathPrefix, namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileset := range filesets {
+			if !fileset.HasCompleteCheckpointFile() {
+				continue
+			}
+			result = append(result, newBackupFileSet(IndexFileType, fileset))
+		}
+	}
+
+	if !anySnapshot {
+		return result, nil
+	}
+
+	// Snapshots are only read back alongside the latest snapshot metadata.
+	metadatas, _, err := fs.SortedSnapshotMetadataFiles(fsOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(metadatas) > 0 {
+		metadata := metadatas[len(metadatas)-1]
+		result = append(result, backupFileSet{
+			fileType:    SnapshotMetadataFileType,
+			volumeIndex: int(metadata.ID.Index),
+			filePaths:   metadata.AbsoluteFilePaths(),
+			digestsFn: func() (map[string]uint32, error) {
+				return fs.SnapshotMetadataDigests(metadata)
+			},
+		})
+	}
+	return result, nil
+}
+
+// appendLatestVolumes appends the latest complete volume of each block, which
+// supersedes any earlier volumes of the block.
+func appendLatestVolumes(
+	result []backupFileSet,
+	fileType FileType,
+	filesets fs.FileSetFilesSlice,
+) []backupFileSet {
+	seen := make(map[int64]struct{}, len(filesets))
+	for _, fileset := range filesets {
+		blockStart := int64(fileset.ID.BlockStart)
+		if _, ok := seen[blockStart]; ok {
+			continue
+		}
+		seen[blockStart] = struct{}{}
+
+		latest, ok := filesets.LatestVolumeForBlock(fileset.ID.BlockStart)
+		if !ok {
+			continue
+		}
+		result = append(result, newBackupFileSet(fileType, latest))
+	}
+	return result
+}
+
+func newBackupFileSet(fileType FileType, fileset fs.FileSetFile) backupFileSet {
+	contentType := persist.FileSetDataContentType
+	if fileType == IndexFileType {
+		contentType = persist.FileSetIndexContentType
+	}
+	return backupFileSet{
+		fileType:    fileType,
+		namespace:   fileset.ID.Namespace.String(),
+		shard:       fileset.ID.Shard,
+		blockStart:  int64(fileset.ID.BlockStart),
+		volumeIndex: fileset.ID.VolumeIndex,
+		filePaths:   fileset.AbsoluteFilePaths,
+		digestsFn: func() (map[string]uint32, error) {
+			return fs.FileSetDigests(fileset, contentType)
+		},
+	}
+}
+
+func namespacesOnDisk(filePathPrefix string) ([]ident.ID, error) {
+	names, err := subDirectoryNames(fs.DataDirPath(filePathPrefix))
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]ident.ID, 0, len(names))
+	for _, name := range names {
+		namespaces = append(namespaces, ident.StringID(name))
+	}
+	return namespaces, nil
+}
+
+func shardsOnDisk(namespaceDirPath string) ([]uint32, error) {
+	names, err := subDirectoryNames(namespaceDirPath)
+	if err != nil {
+		return nil, err
+	}
+	shards := make([]uint32, 0, len(names))
+	for _, name := range names {
+		shard, err := strconv.ParseUint(name, 10, 32)
+		if err != nil {
+			// Not a shard directory.
+			continue
+		}
+		shards = append(shards, uint32(shard))
+	}
+	return shards, nil
+}
+
+func subDirectoryNames(dirPath string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dirPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *manager) backupFileSet(req BackupRequest, fileSet backupFileSet) ([]ManifestFile, error) {
+	digests, err := fileSet.digestsFn()
+	if err != nil {
+		return nil, err
+	}
+
+	filePaths := fileSet.filePaths
+	if m.opts.FilesystemOptions().TieredFiles() != nil {
+		// Index and data files evicted to the object store are no longer on
+		// disk, back them up from their tiered copies instead.
+		filePaths = withEvictedFilePaths(filePaths, digests)
+	}
+
+	filePathPrefix := m.opts.FilesystemOptions().FilePathPrefix()
+	files := make([]ManifestFile, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		relPath, err := filepath.Rel(filePathPrefix, filePath)
+		if err != nil {
+			return nil, err
+		}
+
+		file := ManifestFile{
+			Path:        filepath.ToSlash(relPath),
+			Type:        fileSet.fileType,
+			Namespace:   fileSet.namespace,
+			Shard:       fileSet.shard,
+			BlockStart:  fileSet.blockStart,
+			VolumeIndex: fileSet.volumeIndex,
+		}
+		file.Size, file.Checksum, err = m.backupFile(req, filePath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if expected, ok := digests[filePath]; ok && expected != file.Checksum {
+			return nil, fmt.Errorf("file checksum does not match digest: path=%s, expected=%d, actual=%d",
+				filePath, expected, file.Checksum)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func withEvictedFilePaths(filePaths []string, digests map[string]uint32) []string {
+	local := make(map[string]struct{}, len(filePaths))
+	for _, filePath := range filePaths {
+		local[filePath] = struct{}{}
+	}
+
+	var evicted []string
+	for filePath := range digests {
+		if _, ok := local[filePath]; !ok {
+			evicted = append(evicted, filePath)
+		}
+	}
+	if len(evicted) == 0 {
+		return filePaths
+	}
+
+	sort.Strings(evicted)
+	result := make([]string, 0, len(filePaths)+len(evicted))
+	result = append(result, filePaths...)
+	return append(result, evicted...)
+}
+
+func (m *manager) backupFile(req BackupRequest, filePath, relPath string) (int64, uint32, error) {
+	file, err := fs.OpenReadAtFile(filePath, m.opts.FilesystemOptions().TieredFiles())
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	reader := fs.NewChecksumReader(io.NewSectionReader(file, 0, file.Size()))
+	if err := req.Store.Put(path.Join(req.Name, filesKey, relPath), reader); err != nil {
+		return 0, 0, err
+	}
+	return reader.Size(), reader.Checksum(), nil
+}
+
+func (m *manager) Restore(req RestoreRequest) (Manifest, error) {
+	if req.Name == "" {
+		return Manifest{}, errBackupNameRequired
+	}
+	if req.Store == nil {
+		return Manifest{}, errStoreRequired
+	}
+
+	manifest, err := readManifest(req.Store, req.Name)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	var include map[string]struct{}
+	if len(req.Namespaces) > 0 {
+		include = make(map[string]struct{}, len(req.Namespaces))
+		for _, namespace := range req.Namespaces {
+			include[namespace.String()] = struct{}{}
+		}
+	}
+
+	var (
+		restored    = Manifest{Name: manifest.Name, CreatedAt: manifest.CreatedAt}
+		anySnapshot bool
+	)
+	for _, file := range manifest.Files {
+		if file.Type == SnapshotMetadataFileType {
+			continue
+		}
+		if _, ok := include[file.Namespace]; include != nil && !ok {
+			continue
+		}
+		if _, ok := req.NamespaceMappings[file.Namespace]; ok && file.Type == SnapshotFileType {
+			continue
+		}
+		anySnapshot = anySnapshot || file.Type == SnapshotFileType
+		restored.Files = append(restored.Files, file)
+	}
+	if anySnapshot {
+		for _, file := range manifest.Files {
+			if file.Type == SnapshotMetadataFileType {
+				restored.Files = append(restored.Files, file)
+			}
+		}
+	}
+
+	// Write checkpoint files last so that no fileset is complete until all of
+	// its files have been written.
+	sort.SliceStable(restored.Files, func(i, j int) bool {
+		return !isCheckpointFile(restored.Files[i].Path) && isCheckpointFile(restored.Files[j].Path)
+	})
+
+	filePathPrefix := m.opts.FilesystemOptions().FilePathPrefix()
+	for i, file := range restored.Files {
+		targetPath, err := restorePath(filePathPrefix, file, req.NamespaceMappings)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if err := m.restoreFile(req, file, targetPath); err != nil {
+			return Manifest{}, err
+		}
+
+		relPath, err := filepath.Rel(filePathPrefix, targetPath)
+		if err != nil {
+			return Manifest{}, err
+		}
+		restored.Files[i].Path = filepath.ToSlash(relPath)
+		if target, ok := req.NamespaceMappings[file.Namespace]; ok {
+			restored.Files[i].Namespace = target
+		}
+	}
+
+	m.logger.Info("restore complete",
+		zap.String("name", req.Name),
+		zap.Int("files", len(restored.Files)))
+	return restored, nil
+}
+
+func readManifest(store Store, name string) (Manifest, error) {
+	r, err := store.Get(path.Join(name, manifestKey))
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer r.Close()
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return Manifest{}, fmt.Errorf("could not decode backup manifest: %v", err)
+	}
+	return manifest, nil
+}
+
+func isCheckpointFile(filePath string) bool {
+	return strings.HasSuffix(filePath, checkpointFileNameSuffix)
+}
+
+// restorePath returns the path a file of a backup is restored to, within the
+// directory of its namespace after mapping.
+func restorePath(
+	filePathPrefix string,
+	file ManifestFile,
+	mappings map[string]string,
+) (string, error) {
+	fileName := path.Base(file.Path)
+	if fileName == "." || fileName == "/" || fileName == ".." {
+		return "", fmt.Errorf("invalid backup file path: %s", file.Path)
+	}
+
+	namespace := file.Namespace
+	if target, ok := mappings[namespace]; ok {
+		namespace = target
+	}
+	if file.Type != SnapshotMetadataFileType && !validNamespaceDirName(namespace) {
+		return "", fmt.Errorf("invalid namespace for backup file %s: %q", file.Path, namespace)
+	}
+	nsID := ident.StringID(namespace)
+
+	switch file.Type {
+	case DataFileType:
+		return filepath.Join(fs.ShardDataDirPath(filePathPrefix, nsID, file.Shard), fileName), nil
+	case SnapshotFileType:
+		return filepath.Join(fs.ShardSnapshotsDirPath(filePathPrefix, nsID, file.Shard), fileName), nil
+	case IndexFileType:
+		return filepath.Join(fs.NamespaceIndexDataDirPath(filePathPrefix, nsID), fileName), nil
+	case SnapshotMetadataFileType:
+		return filepath.Join(fs.SnapshotDirPath(filePathPrefix), fileName), nil
+	default:
+		return "", fmt.Errorf("unknown backup file type: %s", file.Type)
+	}
+}
+
+func validNamespaceDirName(namespace string) bool {
+	return namespace != "" && namespace != "." && namespace != ".." &&
+		!strings.ContainsAny(namespace, `/\`)
+}
+
+func (m *manager) restoreFile(req RestoreRequest, file ManifestFile, targetPath string) error {
+	exists, err := fs.PathExists(targetPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("restore target file already exists: %s", targetPath)
+	}
+
+	fsOpts := m.opts.FilesystemOptions()
+	dir := filepath.Dir(targetPath)
+	if err := os.MkdirAll(dir, fsOpts.NewDirectoryMode()); err != nil {
+		return err
+	}
+
+	r, err := req.Store.Get(path.Join(req.Name, filesKey, file.Path))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	// Verify the checksum before the file is moved into place.
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(targetPath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	reader := fs.NewChecksumReader(r)
+	if _, err := io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if reader.Size() != file.Size || reader.Checksum() != file.Checksum {
+		return fmt.Errorf("restored file does not match manifest: path=%s, "+
+			"expectedSize=%d, actualSize=%d, expectedChecksum=%d, actualChecksum=%d",
+			file.Path, file.Size, reader.Size(), file.Checksum, reader.Checksum())
+	}
+	if err := os.Chmod(tmp.Name(), fsOpts.NewFileMode()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), targetPath)
+}
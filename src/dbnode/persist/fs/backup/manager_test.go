@@ -0,0 +1,393 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/fstest"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockSize = 2 * time.Hour
+	testName      = "test-backup"
+)
+
+var (
+	testNamespace  = ident.StringID("metrics")
+	testBlockStart = xtime.Now().Truncate(testBlockSize)
+)
+
+type testSetup struct {
+	dir            string
+	filePathPrefix string
+	backupDir      string
+	pins           fs.FilePins
+	manager        Manager
+}
+
+func newTestSetup(t *testing.T) testSetup {
+	dir, err := ioutil.TempDir("", "backup")
+	require.NoError(t, err)
+
+	filePathPrefix := filepath.Join(dir, "node")
+	pins := fs.NewFilePins()
+	manager, err := NewManager(newTestOptions(filePathPrefix, pins))
+	require.NoError(t, err)
+
+	return testSetup{
+		dir:            dir,
+		filePathPrefix: filePathPrefix,
+		backupDir:      filepath.Join(dir, "backups"),
+		pins:           pins,
+		manager:        manager,
+	}
+}
+
+func (s testSetup) cleanup() {
+	os.RemoveAll(s.dir)
+}
+
+func (s testSetup) store() Store {
+	return NewFilesystemStore(s.backupDir)
+}
+
+func newTestOptions(filePathPrefix string, pins fs.FilePins) Options {
+	return NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(filePathPrefix)).
+		SetFilePins(pins)
+}
+
+func writeTestFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	shard uint32,
+	volume int,
+	fileSetType persist.FileSetType,
+) {
+	opts := fs.DataWriterOpenOptions{
+		FileSetType: fileSetType,
+		BlockSize:   testBlockSize,
+		Identifier: fs.FileSetFileIdentifier{
+			Namespace:   testNamespace,
+			Shard:       shard,
+			BlockStart:  testBlockStart,
+			VolumeIndex: volume,
+		},
+	}
+	if fileSetType == persist.FileSetSnapshotType {
+		opts.Snapshot = fs.DataWriterSnapshotOptions{
+			SnapshotTime: testBlockStart,
+			SnapshotID:   uuid.NewRandom(),
+		}
+	}
+	fstest.WriteFileSet(t, filePathPrefix, opts)
+}
+
+func writeTestSnapshotMetadata(t *testing.T, filePathPrefix string) {
+	w := fs.NewSnapshotMetadataWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, w.Write(fs.SnapshotMetadataWriteArgs{
+		ID: fs.SnapshotMetadataIdentifier{
+			Index: 0,
+			UUID:  uuid.NewRandom(),
+		},
+		CommitlogIdentifier: persist.CommitLogFile{
+			FilePath: fs.CommitLogFilePath(filePathPrefix, 0),
+			Index:    0,
+		},
+	}))
+}
+
+func writeTestNode(t *testing.T, filePathPrefix string) {
+	// Volume 0 is superseded by volume 1 and should not be backed up.
+	writeTestFileSet(t, filePathPrefix, 1, 0, persist.FileSetFlushType)
+	writeTestFileSet(t, filePathPrefix, 1, 1, persist.FileSetFlushType)
+	writeTestFileSet(t, filePathPrefix, 2, 0, persist.FileSetSnapshotType)
+	writeTestSnapshotMetadata(t, filePathPrefix)
+}
+
+func filesByType(manifest Manifest) map[FileType][]ManifestFile {
+	result := make(map[FileType][]ManifestFile)
+	for _, file := range manifest.Files {
+		result[file.Type] = append(result[file.Type], file)
+	}
+	return result
+}
+
+func requireCompleteFileSets(t *testing.T, filePathPrefix string, namespace ident.ID) {
+	dataFiles, err := fs.DataFiles(filePathPrefix, namespace, 1)
+	require.NoError(t, err)
+	latest, ok := dataFiles.LatestVolumeForBlock(testBlockStart)
+	require.True(t, ok)
+	require.Equal(t, 1, latest.ID.VolumeIndex)
+
+	_, err = fs.FileSetDigests(latest, persist.FileSetDataContentType)
+	require.NoError(t, err)
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	manifest, err := test.manager.Backup(BackupRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, testName, manifest.Name)
+
+	byType := filesByType(manifest)
+	require.Len(t, byType[DataFileType], 7)
+	require.Len(t, byType[SnapshotFileType], 7)
+	require.Len(t, byType[SnapshotMetadataFileType], 2)
+	for _, file := range byType[DataFileType] {
+		require.Equal(t, testNamespace.String(), file.Namespace)
+		require.Equal(t, uint32(1), file.Shard)
+		require.Equal(t, 1, file.VolumeIndex)
+		require.Equal(t, int64(testBlockStart), file.BlockStart)
+	}
+
+	// Nothing remains pinned once the backup completes.
+	var filePaths []string
+	for _, file := range manifest.Files {
+		filePaths = append(filePaths, filepath.Join(test.filePathPrefix, file.Path))
+	}
+	require.Equal(t, filePaths, test.pins.Unpinned(filePaths))
+
+	restorePrefix := filepath.Join(test.dir, "restored")
+	restoreManager, err := NewManager(newTestOptions(restorePrefix, fs.NewFilePins()))
+	require.NoError(t, err)
+
+	restored, err := restoreManager.Restore(RestoreRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.NoError(t, err)
+	require.Len(t, restored.Files, len(manifest.Files))
+
+	requireCompleteFileSets(t, restorePrefix, testNamespace)
+
+	snapshotFiles, err := fs.SnapshotFiles(restorePrefix, testNamespace, 2)
+	require.NoError(t, err)
+	_, ok := snapshotFiles.LatestVolumeForBlock(testBlockStart)
+	require.True(t, ok)
+
+	metadatas, _, err := fs.SortedSnapshotMetadataFiles(
+		fs.NewOptions().SetFilePathPrefix(restorePrefix))
+	require.NoError(t, err)
+	require.Len(t, metadatas, 1)
+}
+
+func TestRestoreIntoNewNamespace(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	_, err := test.manager.Backup(BackupRequest{
+		Name:       testName,
+		Namespaces: []ident.ID{testNamespace},
+		Store:      test.store(),
+	})
+	require.NoError(t, err)
+
+	// Restore into the same node as a new namespace.
+	target := ident.StringID("restored")
+	restored, err := test.manager.Restore(RestoreRequest{
+		Name:              testName,
+		Namespaces:        []ident.ID{testNamespace},
+		NamespaceMappings: map[string]string{testNamespace.String(): target.String()},
+		Store:             test.store(),
+	})
+	require.NoError(t, err)
+
+	byType := filesByType(restored)
+	require.Len(t, byType[DataFileType], 7)
+	require.Empty(t, byType[SnapshotFileType])
+	require.Empty(t, byType[SnapshotMetadataFileType])
+	for _, file := range restored.Files {
+		require.Equal(t, target.String(), file.Namespace)
+		require.True(t, strings.HasPrefix(file.Path, "data/restored/1/"), file.Path)
+	}
+
+	requireCompleteFileSets(t, test.filePathPrefix, target)
+}
+
+func TestRestoreDoesNotOverwriteFiles(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	_, err := test.manager.Backup(BackupRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.NoError(t, err)
+
+	_, err = test.manager.Restore(RestoreRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "already exists")
+}
+
+func TestRestoreRejectsCorruptFile(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	manifest, err := test.manager.Backup(BackupRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.NoError(t, err)
+
+	var corrupted ManifestFile
+	for _, file := range manifest.Files {
+		if file.Type == DataFileType && strings.HasSuffix(file.Path, "-data.db") {
+			corrupted = file
+		}
+	}
+	require.NotEmpty(t, corrupted.Path)
+
+	storedPath := filepath.Join(test.backupDir, testName, filesKey, filepath.FromSlash(corrupted.Path))
+	data, err := ioutil.ReadFile(storedPath)
+	require.NoError(t, err)
+	data[0]++
+	require.NoError(t, ioutil.WriteFile(storedPath, data, 0666))
+
+	restorePrefix := filepath.Join(test.dir, "restored")
+	restoreManager, err := NewManager(newTestOptions(restorePrefix, fs.NewFilePins()))
+	require.NoError(t, err)
+
+	_, err = restoreManager.Restore(RestoreRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.Error(t, err)
+
+	// The corrupt file and its checkpoint file were not written.
+	exists, err := fs.PathExists(filepath.Join(restorePrefix, filepath.FromSlash(corrupted.Path)))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	dataFiles, err := fs.DataFiles(restorePrefix, testNamespace, 1)
+	require.NoError(t, err)
+	_, ok := dataFiles.LatestVolumeForBlock(testBlockStart)
+	require.False(t, ok)
+}
+
+func TestBackupDetectsCorruptFileSet(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	dataFiles, err := fs.DataFiles(test.filePathPrefix, testNamespace, 1)
+	require.NoError(t, err)
+	latest, ok := dataFiles.LatestVolumeForBlock(testBlockStart)
+	require.True(t, ok)
+
+	for _, filePath := range latest.AbsoluteFilePaths {
+		if !strings.HasSuffix(filePath, "-data.db") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filePath)
+		require.NoError(t, err)
+		data[0]++
+		require.NoError(t, ioutil.WriteFile(filePath, data, 0666))
+	}
+
+	_, err = test.manager.Backup(BackupRequest{
+		Name:  testName,
+		Store: test.store(),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not match digest")
+
+	// The backup has no manifest since it did not complete.
+	_, err = test.store().Get(testName + "/" + manifestKey)
+	require.True(t, os.IsNotExist(err))
+}
+
+type pinCheckingStore struct {
+	Store
+
+	t              *testing.T
+	filePathPrefix string
+	pins           fs.FilePins
+}
+
+func (s *pinCheckingStore) Put(key string, r io.Reader) error {
+	prefix := testName + "/" + filesKey + "/"
+	if strings.HasPrefix(key, prefix) {
+		filePath := filepath.Join(s.filePathPrefix, filepath.FromSlash(strings.TrimPrefix(key, prefix)))
+		require.Empty(s.t, s.pins.Unpinned([]string{filePath}), filePath)
+	}
+	return s.Store.Put(key, r)
+}
+
+func TestBackupPinsFilesWhileCopying(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	writeTestNode(t, test.filePathPrefix)
+
+	_, err := test.manager.Backup(BackupRequest{
+		Name: testName,
+		Store: &pinCheckingStore{
+			Store:          test.store(),
+			t:              t,
+			filePathPrefix: test.filePathPrefix,
+			pins:           test.pins,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestBackupRequiresNameAndStore(t *testing.T) {
+	test := newTestSetup(t)
+	defer test.cleanup()
+
+	_, err := test.manager.Backup(BackupRequest{Store: test.store()})
+	require.Equal(t, errBackupNameRequired, err)
+
+	_, err = test.manager.Backup(BackupRequest{Name: testName})
+	require.Equal(t, errStoreRequired, err)
+}
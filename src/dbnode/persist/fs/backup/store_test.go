@@ -0,0 +1,65 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backup
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystemStorePutGet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup-store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewFilesystemStore(dir)
+	require.NoError(t, store.Put("a/b/c.db", bytes.NewReader([]byte("foo"))))
+	require.NoError(t, store.Put("a/b/c.db", bytes.NewReader([]byte("bar"))))
+
+	r, err := store.Get("a/b/c.db")
+	require.NoError(t, err)
+	defer r.Close()
+
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), data)
+
+	// No temporary files are left behind.
+	entries, err := ioutil.ReadDir(dir + "/a/b")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	_, err = store.Get("a/b/missing.db")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFilesystemStoreInvalidKeys(t *testing.T) {
+	store := NewFilesystemStore("/tmp/backups")
+	for _, key := range []string{"", "/", "/a", "a/", "../a", "a/../../b", "a//b", "./a"} {
+		require.Error(t, store.Put(key, bytes.NewReader(nil)), key)
+		_, err := store.Get(key)
+		require.Error(t, err, key)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package backup takes consistent online backups of the filesets of a node
+// and restores them to re-seed a node or a new namespace.
+package backup
+
+import (
+	"io"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/clock"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// Store is the destination of backups, such as a local directory or an
+// object store bucket. Keys are slash separated paths.
+type Store interface {
+	// Put stores the contents of the reader under the key, replacing any
+	// contents already stored under it.
+	Put(key string, r io.Reader) error
+
+	// Get returns the contents stored under the key.
+	Get(key string) (io.ReadCloser, error)
+}
+
+// FileType is the type of a file in a backup.
+type FileType string
+
+const (
+	// DataFileType is a file of a flushed data fileset.
+	DataFileType FileType = "data"
+	// IndexFileType is a file of a flushed index fileset.
+	IndexFileType FileType = "index"
+	// SnapshotFileType is a file of a data snapshot fileset.
+	SnapshotFileType FileType = "snapshot"
+	// SnapshotMetadataFileType is a snapshot metadata or its checkpoint file.
+	SnapshotMetadataFileType FileType = "snapshotMetadata"
+)
+
+// Manifest describes the files of a backup.
+type Manifest struct {
+	Name      string         `json:"name"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Files     []ManifestFile `json:"files"`
+}
+
+// ManifestFile describes a single file of a backup.
+type ManifestFile struct {
+	// Path is the slash separated path of the file relative to the file
+	// path prefix of the node.
+	Path        string   `json:"path"`
+	Type        FileType `json:"type"`
+	Namespace   string   `json:"namespace,omitempty"`
+	Shard       uint32   `json:"shard"`
+	BlockStart  int64    `json:"blockStart"`
+	VolumeIndex int      `json:"volumeIndex"`
+	Size        int64    `json:"size"`
+	// Checksum is the adler32 checksum of the file, the same checksum which
+	// is recorded for fileset files by their digest and checkpoint files.
+	Checksum uint32 `json:"checksum"`
+}
+
+// BackupRequest is a request to take a backup.
+type BackupRequest struct {
+	// Name is the name of the backup, under which its files are stored.
+	Name string
+	// Namespaces are the namespaces to backup, all namespaces with files on
+	// disk are backed up if none are specified.
+	Namespaces []ident.ID
+	// Store is the store the backup is written to.
+	Store Store
+}
+
+// RestoreRequest is a request to restore a backup.
+type RestoreRequest struct {
+	// Name is the name of the backup to restore.
+	Name string
+	// Namespaces are the namespaces to restore, all namespaces of the backup
+	// are restored if none are specified.
+	Namespaces []ident.ID
+	// NamespaceMappings maps namespaces of the backup to the namespaces they
+	// are restored as. Snapshots are not restored for mapped namespaces.
+	NamespaceMappings map[string]string
+	// Store is the store the backup is read from.
+	Store Store
+}
+
+// Manager takes backups of the filesets of a node and restores them.
+type Manager interface {
+	// Backup pins the most recent complete flushed and snapshotted filesets so
+	// that they are not removed by cleanup while they are copied to the store,
+	// verifying their checksums against their digest files, and writes the
+	// manifest of the backup once all files are copied.
+	Backup(req BackupRequest) (Manifest, error)
+
+	// Restore copies the files of a backup into the file path prefix,
+	// verifying their checksums against the manifest. Files which already
+	// exist are not overwritten, and checkpoint files are written last so
+	// that incomplete filesets are never read.
+	Restore(req RestoreRequest) (Manifest, error)
+}
+
+// Options represents the options for backups.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetFilesystemOptions sets the filesystem options.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options.
+	FilesystemOptions() fs.Options
+
+	// SetFilePins sets the file pins shared with cleanup.
+	SetFilePins(value fs.FilePins) Options
+
+	// FilePins returns the file pins shared with cleanup.
+	FilePins() fs.FilePins
+
+	// SetClockOptions sets the clock options.
+	SetClockOptions(value clock.Options) Options
+
+	// ClockOptions returns the clock options.
+	ClockOptions() clock.Options
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"hash"
+	"hash/adler32"
+	"io"
+)
+
+// ChecksumReader computes the checksum and size of what is read through it,
+// using the same adler32 checksum as digest.Checksum.
+type ChecksumReader struct {
+	reader io.Reader
+	digest hash.Hash32
+	size   int64
+}
+
+// NewChecksumReader returns a new checksum reader reading from the reader.
+func NewChecksumReader(r io.Reader) *ChecksumReader {
+	return &ChecksumReader{reader: r, digest: adler32.New()}
+}
+
+// Read reads from the underlying reader, adding what is read to the checksum.
+func (r *ChecksumReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.digest.Write(p[:n])
+	r.size += int64(n)
+	return n, err
+}
+
+// Checksum returns the checksum of what has been read so far.
+func (r *ChecksumReader) Checksum() uint32 {
+	return r.digest.Sum32()
+}
+
+// Size returns the number of bytes read so far.
+func (r *ChecksumReader) Size() int64 {
+	return r.size
+}
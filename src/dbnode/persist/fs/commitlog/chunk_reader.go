@@ -42,6 +42,7 @@ type chunkReader struct {
 	buffer             *bufio.Reader
 	chunkData          []byte
 	chunkDataRemaining int
+	decompressBuff     []byte
 	charBuff           []byte
 }
 
@@ -65,7 +66,9 @@ func (r *chunkReader) readHeader() error {
 		return err
 	}
 
-	size := endianness.Uint32(header[sizeStart:sizeEnd])
+	sizeAndCompression := endianness.Uint32(header[sizeStart:sizeEnd])
+	size := sizeAndCompression & chunkHeaderSizeMask
+	compression := Compression(sizeAndCompression >> chunkHeaderSizeCompressionShift)
 	checksumSize := digest.
 		Buffer(header[checksumSizeStart:checksumSizeEnd]).
 		ReadDigest()
@@ -88,6 +91,10 @@ func (r *chunkReader) readHeader() error {
 	if chunkDataSize > cap(r.chunkData) {
 		// Increase chunkData capacity so that it can fit the new chunkData.
 		chunkDataCap := cap(r.chunkData)
+		if chunkDataCap == 0 {
+			// The buffer may be an empty decompressed chunk which was swapped in.
+			chunkDataCap = chunkDataSize
+		}
 		for chunkDataCap < chunkDataSize {
 			chunkDataCap *= 2
 		}
@@ -111,8 +118,18 @@ func (r *chunkReader) readHeader() error {
 		return errCommitLogReaderChunkSizeChecksumMismatch
 	}
 
+	if compression != CompressionNone {
+		// Swap the buffers so that both are reused for subsequent chunks.
+		compressed := r.chunkData
+		r.chunkData, err = decompress(compression, r.decompressBuff, compressed)
+		if err != nil {
+			return err
+		}
+		r.decompressBuff = compressed
+	}
+
 	// Set remaining data to be consumed
-	r.chunkDataRemaining = int(size)
+	r.chunkDataRemaining = len(r.chunkData)
 
 	return nil
 }
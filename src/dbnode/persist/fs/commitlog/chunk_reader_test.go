@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkReaderReadsCompressedAndUncompressedChunks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commitlog-chunks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "chunks")
+	fd, err := os.Create(filePath)
+	require.NoError(t, err)
+
+	var (
+		flushErrs []error
+		writer    = newChunkWriter(func(err error) {
+			flushErrs = append(flushErrs, err)
+		}, testOpts).(*fsChunkWriter)
+		chunks = [][]byte{
+			bytes.Repeat([]byte("uncompressed"), 100),
+			bytes.Repeat([]byte("snappy"), 1000),
+			randomByteSlice(100),
+			bytes.Repeat([]byte("zstd"), 1000),
+			{},
+			bytes.Repeat([]byte("uncompressed again"), 10),
+		}
+		compressions = []Compression{
+			CompressionNone,
+			CompressionSnappy,
+			CompressionSnappy,
+			CompressionZstd,
+			CompressionZstd,
+			CompressionNone,
+		}
+		expected []byte
+	)
+	writer.reset(fd)
+	for i, chunk := range chunks {
+		writer.compression = compressions[i]
+		n, err := writer.Write(chunk)
+		require.NoError(t, err)
+		require.Equal(t, len(chunk), n)
+		expected = append(expected, chunk...)
+	}
+	require.NoError(t, writer.close())
+	require.Equal(t, make([]error, len(chunks)), flushErrs)
+
+	fd, err = os.Open(filePath)
+	require.NoError(t, err)
+	defer fd.Close()
+
+	// Use a small buffer so chunks are larger than the reader buffer.
+	reader := newChunkReader(16)
+	reader.reset(fd)
+	actual, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	_, err = reader.Read(make([]byte, 1))
+	require.Equal(t, io.EOF, err)
+}
+
+func TestChunkWriterUnknownCompression(t *testing.T) {
+	dir, err := ioutil.TempDir("", "commitlog-chunks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	filePath := filepath.Join(dir, "chunks")
+	fd, err := os.Create(filePath)
+	require.NoError(t, err)
+
+	writer := newChunkWriter(func(error) {}, testOpts).(*fsChunkWriter)
+	writer.reset(fd)
+	writer.compression = CompressionZstd + 1
+	_, err = writer.Write([]byte("foo"))
+	require.Error(t, err)
+	require.NoError(t, writer.close())
+}
@@ -27,6 +27,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -192,6 +193,7 @@ const (
 	flushEventType
 	activeLogsEventType
 	rotateLogsEventType
+	groupCommitEventType
 )
 
 type callbackFn func(callbackResult)
@@ -243,6 +245,9 @@ type commitLogWrite struct {
 	eventType  eventType
 	write      writeOrWriteBatch
 	callbackFn callbackFn
+	// fsync is set for writes that are acknowledged once the commit log has
+	// been fsync'd regardless of the commit log strategy.
+	fsync bool
 }
 
 // NewCommitLog creates a new commit log
@@ -287,7 +292,7 @@ func NewCommitLog(opts Options) (CommitLog, error) {
 	commitLog.writerState.secondary.commitlog = commitLog
 
 	switch opts.Strategy() {
-	case StrategyWriteWait:
+	case StrategyWriteWait, StrategyWriteGroupCommit:
 		commitLog.writeFn = commitLog.writeWait
 	default:
 		commitLog.writeFn = commitLog.writeBehind
@@ -325,6 +330,11 @@ func (l *commitLog) Open() error {
 		go l.flushEvery(flushInterval)
 	}
 
+	if l.opts.Strategy() == StrategyWriteGroupCommit {
+		// Fsync the commit log at the group commit interval to acknowledge writes
+		go l.groupCommitEvery(l.opts.GroupCommitInterval())
+	}
+
 	return nil
 }
 
@@ -446,6 +456,23 @@ func (l *commitLog) flushEvery(interval time.Duration) {
 	}
 }
 
+func (l *commitLog) groupCommitEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Request a group commit
+		l.closedState.RLock()
+		if l.closedState.closed {
+			l.closedState.RUnlock()
+			return
+		}
+
+		l.writes <- commitLogWrite{eventType: groupCommitEventType}
+		l.closedState.RUnlock()
+	}
+}
+
 func (l *commitLog) write() {
 	// We use these to make the batch and non-batched write paths the same
 	// by turning non-batched writes into a batch of size one while avoiding
@@ -459,6 +486,15 @@ func (l *commitLog) write() {
 			continue
 		}
 
+		if write.eventType == groupCommitEventType {
+			// Flush and fsync all pending writes with a single fsync, the
+			// writes are acknowledged once the fsync completes.
+			if len(l.writerState.primary.pendingFlushFns) > 0 {
+				l.writerState.primary.writer.Flush(true)
+			}
+			continue
+		}
+
 		if write.eventType == activeLogsEventType {
 			write.callbackFn(callbackResult{
 				eventType: write.eventType,
@@ -471,7 +507,7 @@ func (l *commitLog) write() {
 		}
 
 		// For writes requiring acks add to pending acks
-		if write.eventType == writeEventType && write.callbackFn != nil {
+		if write.eventType == writeEventType && write.callbackFn != nil && !write.fsync {
 			l.writerState.primary.pendingFlushFns = append(
 				l.writerState.primary.pendingFlushFns, write.callbackFn)
 		}
@@ -547,6 +583,19 @@ func (l *commitLog) write() {
 
 		atomic.AddInt64(&l.numWritesInQueue, int64(-numDequeued))
 		l.metrics.success.Inc(numWritesSuccess)
+
+		if write.fsync {
+			// Flush and fsync the commit log before acknowledging the write,
+			// which also acknowledges any pending writes flushed with it.
+			err := l.writerState.primary.writer.Flush(true)
+			if err != nil {
+				l.handleWriteErr(err)
+			}
+			write.callbackFn(callbackResult{
+				eventType: flushEventType,
+				err:       err,
+			})
+		}
 	}
 
 	// Ensure that there is no active background goroutine in the middle of reseting
@@ -730,18 +779,61 @@ func (l *commitLog) Write(
 	})
 }
 
+func (l *commitLog) WriteWithDurability(
+	ctx context.Context,
+	series ts.Series,
+	datapoint ts.Datapoint,
+	unit xtime.Unit,
+	annotation ts.Annotation,
+	durability namespace.WriteDurability,
+) error {
+	return l.writeWithDurability(ctx, writeOrWriteBatch{
+		write: writes.Write{
+			Series:     series,
+			Datapoint:  datapoint,
+			Unit:       unit,
+			Annotation: annotation,
+		},
+	}, durability)
+}
+
 func (l *commitLog) WriteBatch(
 	ctx context.Context,
 	writes writes.WriteBatch,
 ) error {
-	return l.writeFn(ctx, writeOrWriteBatch{
+	return l.writeWithDurability(ctx, writeOrWriteBatch{
 		writeBatch: writes,
-	})
+	}, writes.Durability())
+}
+
+func (l *commitLog) writeWithDurability(
+	ctx context.Context,
+	write writeOrWriteBatch,
+	durability namespace.WriteDurability,
+) error {
+	switch durability {
+	case namespace.CommitLogEnqueuedWriteDurability:
+		return l.writeBehind(ctx, write)
+	case namespace.FsyncedWriteDurability:
+		// The write wait and group commit strategies only acknowledge writes
+		// once they have been fsync'd, write behind needs an explicit fsync.
+		return l.enqueueAndWait(ctx, write, l.opts.Strategy() == StrategyWriteBehind)
+	default:
+		return l.writeFn(ctx, write)
+	}
 }
 
 func (l *commitLog) writeWait(
 	ctx context.Context,
 	write writeOrWriteBatch,
+) error {
+	return l.enqueueAndWait(ctx, write, false)
+}
+
+func (l *commitLog) enqueueAndWait(
+	ctx context.Context,
+	write writeOrWriteBatch,
+	fsync bool,
 ) error {
 	l.closedState.RLock()
 	if l.closedState.closed {
@@ -764,6 +856,7 @@ func (l *commitLog) writeWait(
 	writeToEnqueue := commitLogWrite{
 		write:      write,
 		callbackFn: completion,
+		fsync:      fsync,
 	}
 
 	numToEnqueue := int64(1)
@@ -789,10 +882,7 @@ func (l *commitLog) writeWait(
 	}
 
 	// Otherwise submit the write.
-	l.writes <- commitLogWrite{
-		write:      write,
-		callbackFn: completion,
-	}
+	l.writes <- writeToEnqueue
 
 	l.closedState.RUnlock()
 
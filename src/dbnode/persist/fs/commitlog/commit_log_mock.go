@@ -28,6 +28,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -165,6 +166,20 @@ func (mr *MockCommitLogMockRecorder) WriteBatch(ctx, writes interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteBatch", reflect.TypeOf((*MockCommitLog)(nil).WriteBatch), ctx, writes)
 }
 
+// WriteWithDurability mocks base method.
+func (m *MockCommitLog) WriteWithDurability(ctx context.Context, series ts.Series, datapoint ts.Datapoint, unit time0.Unit, annotation ts.Annotation, durability namespace.WriteDurability) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteWithDurability", ctx, series, datapoint, unit, annotation, durability)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteWithDurability indicates an expected call of WriteWithDurability.
+func (mr *MockCommitLogMockRecorder) WriteWithDurability(ctx, series, datapoint, unit, annotation, durability interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteWithDurability", reflect.TypeOf((*MockCommitLog)(nil).WriteWithDurability), ctx, series, datapoint, unit, annotation, durability)
+}
+
 // MockIterator is a mock of Iterator interface.
 type MockIterator struct {
 	ctrl     *gomock.Controller
@@ -335,6 +350,20 @@ func (mr *MockOptionsMockRecorder) ClockOptions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOptions", reflect.TypeOf((*MockOptions)(nil).ClockOptions))
 }
 
+// Compression mocks base method.
+func (m *MockOptions) Compression() Compression {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compression")
+	ret0, _ := ret[0].(Compression)
+	return ret0
+}
+
+// Compression indicates an expected call of Compression.
+func (mr *MockOptionsMockRecorder) Compression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compression", reflect.TypeOf((*MockOptions)(nil).Compression))
+}
+
 // FilesystemOptions mocks base method.
 func (m *MockOptions) FilesystemOptions() fs.Options {
 	m.ctrl.T.Helper()
@@ -377,6 +406,20 @@ func (mr *MockOptionsMockRecorder) FlushSize() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushSize", reflect.TypeOf((*MockOptions)(nil).FlushSize))
 }
 
+// GroupCommitInterval mocks base method.
+func (m *MockOptions) GroupCommitInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupCommitInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GroupCommitInterval indicates an expected call of GroupCommitInterval.
+func (mr *MockOptionsMockRecorder) GroupCommitInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupCommitInterval", reflect.TypeOf((*MockOptions)(nil).GroupCommitInterval))
+}
+
 // IdentifierPool mocks base method.
 func (m *MockOptions) IdentifierPool() ident.Pool {
 	m.ctrl.T.Helper()
@@ -489,6 +532,20 @@ func (mr *MockOptionsMockRecorder) SetClockOptions(value interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClockOptions", reflect.TypeOf((*MockOptions)(nil).SetClockOptions), value)
 }
 
+// SetCompression mocks base method.
+func (m *MockOptions) SetCompression(value Compression) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompression", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetCompression indicates an expected call of SetCompression.
+func (mr *MockOptionsMockRecorder) SetCompression(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompression", reflect.TypeOf((*MockOptions)(nil).SetCompression), value)
+}
+
 // SetFilesystemOptions mocks base method.
 func (m *MockOptions) SetFilesystemOptions(value fs.Options) Options {
 	m.ctrl.T.Helper()
@@ -531,6 +588,20 @@ func (mr *MockOptionsMockRecorder) SetFlushSize(value interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFlushSize", reflect.TypeOf((*MockOptions)(nil).SetFlushSize), value)
 }
 
+// SetGroupCommitInterval mocks base method.
+func (m *MockOptions) SetGroupCommitInterval(value time.Duration) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetGroupCommitInterval", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetGroupCommitInterval indicates an expected call of SetGroupCommitInterval.
+func (mr *MockOptionsMockRecorder) SetGroupCommitInterval(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetGroupCommitInterval", reflect.TypeOf((*MockOptions)(nil).SetGroupCommitInterval), value)
+}
+
 // SetIdentifierPool mocks base method.
 func (m *MockOptions) SetIdentifierPool(value ident.Pool) Options {
 	m.ctrl.T.Helper()
@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/m3db/bitset"
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -771,6 +772,175 @@ func TestCommitLogWriteBehind(t *testing.T) {
 	assertCommitLogWritesByIterating(t, commitLog, writes)
 }
 
+func TestCommitLogWriteCompression(t *testing.T) {
+	for _, compression := range []Compression{CompressionSnappy, CompressionZstd} {
+		t.Run(compression.String(), func(t *testing.T) {
+			opts, scope := newTestOptions(t, overrides{
+				strategy: StrategyWriteWait,
+			})
+			opts = opts.SetCompression(compression)
+			defer cleanup(t, opts)
+
+			commitLog := newTestCommitLog(t, opts)
+
+			writes := []testWrite{
+				{
+					testSeries(t, opts, 0, "foo.bar", testTags1, 127), xtime.Now(),
+					123.456, xtime.Millisecond, bytes.Repeat([]byte{1}, 3*opts.FlushSize()), nil,
+				},
+				{
+					testSeries(t, opts, 1, "foo.baz", testTags2, 150), xtime.Now(),
+					456.789, xtime.Millisecond, bytes.Repeat([]byte{2}, 3*opts.FlushSize()), nil,
+				},
+				{
+					testSeries(t, opts, 2, "foo.qux", testTags3, 291), xtime.Now(),
+					789.123, xtime.Millisecond, nil, nil,
+				},
+			}
+			writeCommitLogs(t, scope, commitLog, writes).Wait()
+
+			// Close the commit log and consequently flush
+			require.NoError(t, commitLog.Close())
+
+			// Assert the annotations were compressed.
+			files, err := fs.SortedCommitLogFiles(
+				fs.CommitLogsDirPath(opts.FilesystemOptions().FilePathPrefix()))
+			require.NoError(t, err)
+			var size int64
+			for _, file := range files {
+				info, err := os.Stat(file)
+				require.NoError(t, err)
+				size += info.Size()
+			}
+			require.True(t, size < int64(opts.FlushSize()), "size: %d", size)
+
+			// Assert writes occurred by reading the commit log
+			assertCommitLogWritesByIterating(t, commitLog, writes)
+		})
+	}
+}
+
+func TestCommitLogWriteGroupCommit(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteGroupCommit,
+	})
+	// Do not group commit during the test so that only closing the commit
+	// log, which fsyncs it, acknowledges the writes.
+	opts = opts.SetGroupCommitInterval(time.Hour)
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+
+	writes := []testWrite{
+		{
+			testSeries(t, opts, 0, "foo.bar", testTags1, 127), xtime.Now(),
+			123.456, xtime.Millisecond, nil, nil,
+		},
+		{
+			testSeries(t, opts, 1, "foo.baz", testTags2, 150), xtime.Now(),
+			456.789, xtime.Millisecond, randomByteSlice(2 * opts.FlushSize()), nil,
+		},
+	}
+
+	acked := make(chan struct{})
+	wg := writeCommitLogs(t, scope, commitLog, writes)
+	go func() {
+		wg.Wait()
+		close(acked)
+	}()
+
+	// Writes are flushed at the flush interval but not acknowledged until fsync'd.
+	select {
+	case <-acked:
+		require.FailNow(t, "writes acknowledged before fsync")
+	case <-time.After(3 * opts.FlushInterval()):
+	}
+
+	// Close the commit log and consequently fsync
+	require.NoError(t, commitLog.Close())
+	<-acked
+
+	// Assert writes occurred by reading the commit log
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+}
+
+func TestCommitLogWriteGroupCommitInterval(t *testing.T) {
+	opts, scope := newTestOptions(t, overrides{
+		strategy: StrategyWriteGroupCommit,
+	})
+	opts = opts.SetGroupCommitInterval(10 * time.Millisecond)
+	defer cleanup(t, opts)
+
+	commitLog := newTestCommitLog(t, opts)
+
+	writes := []testWrite{
+		{
+			testSeries(t, opts, 0, "foo.bar", testTags1, 127), xtime.Now(),
+			123.456, xtime.Millisecond, nil, nil,
+		},
+		{
+			testSeries(t, opts, 1, "foo.baz", testTags2, 150), xtime.Now(),
+			456.789, xtime.Millisecond, nil, nil,
+		},
+	}
+
+	// Writes are acknowledged by the group commit without closing the commit log.
+	writeCommitLogs(t, scope, commitLog, writes).Wait()
+
+	require.NoError(t, commitLog.Close())
+	assertCommitLogWritesByIterating(t, commitLog, writes)
+}
+
+func TestCommitLogWriteWithDurabilityFsynced(t *testing.T) {
+	opts, _ := newTestOptions(t, overrides{
+		strategy: StrategyWriteBehind,
+	})
+	defer cleanup(t, opts)
+
+	commitLogI, err := NewCommitLog(opts)
+	require.NoError(t, err)
+	commitLog := commitLogI.(*commitLog)
+	writer := newMockCommitLogWriter()
+
+	var numFsyncs int64
+	writer.flushFn = func(sync bool) error {
+		if sync {
+			atomic.AddInt64(&numFsyncs, 1)
+		}
+		return nil
+	}
+
+	commitLog.newCommitLogWriterFn = func(
+		_ flushFn,
+		_ Options,
+	) commitLogWriter {
+		return writer
+	}
+
+	require.NoError(t, commitLog.Open())
+
+	// Opening the commit log fsyncs the headers of the new files, only count
+	// the fsyncs triggered by writes.
+	atomic.StoreInt64(&numFsyncs, 0)
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	series := testSeries(t, opts, 0, "foo.bar", testTags1, 127)
+	datapoint := ts.Datapoint{TimestampNanos: xtime.Now(), Value: 123.456}
+
+	// Writes enqueued to a write behind commit log are not fsync'd.
+	require.NoError(t, commitLog.WriteWithDurability(ctx, series, datapoint,
+		xtime.Millisecond, nil, namespace.CommitLogEnqueuedWriteDurability))
+
+	// Fsynced writes are only acknowledged once the commit log is fsync'd.
+	require.NoError(t, commitLog.WriteWithDurability(ctx, series, datapoint,
+		xtime.Millisecond, nil, namespace.FsyncedWriteDurability))
+	require.Equal(t, int64(1), atomic.LoadInt64(&numFsyncs))
+
+	require.NoError(t, commitLog.Close())
+}
+
 func TestCommitLogWriteErrorOnClosed(t *testing.T) {
 	opts, _ := newTestOptions(t, overrides{})
 	defer cleanup(t, opts)
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package commitlog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression describes the compression applied to each commit log chunk.
+type Compression uint
+
+const (
+	// CompressionNone writes chunks uncompressed.
+	CompressionNone Compression = iota
+	// CompressionSnappy compresses chunks with snappy, trading a lower compression
+	// ratio for cheaper compression than zstd.
+	CompressionSnappy
+	// CompressionZstd compresses chunks with zstd.
+	CompressionZstd
+)
+
+var validCompressions = []Compression{
+	CompressionNone,
+	CompressionSnappy,
+	CompressionZstd,
+}
+
+var (
+	errUnknownCompression = errors.New("unknown commit log chunk compression")
+
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// UnmarshalYAML unmarshals a Compression into a valid type from string.
+func (c *Compression) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	if str == "" {
+		*c = CompressionNone
+		return nil
+	}
+	for _, valid := range validCompressions {
+		if str == valid.String() {
+			*c = valid
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid commit log compression '%s' valid types are: %v",
+		str, validCompressions)
+}
+
+// MarshalYAML marshals a Compression as a string.
+func (c Compression) MarshalYAML() (interface{}, error) {
+	return c.String(), nil
+}
+
+// String returns the compression as a string.
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	}
+	return "unknown"
+}
+
+// Validate validates that the compression is known.
+func (c Compression) Validate() error {
+	for _, valid := range validCompressions {
+		if c == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %d", errUnknownCompression, c)
+}
+
+// initZstd lazily creates the zstd encoder and decoder which are shared by all
+// writers and readers, both are safe for concurrent use when encoding and
+// decoding whole chunks at a time.
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.SpeedFastest))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil)
+	})
+	return zstdErr
+}
+
+// compress returns the compressed src, reusing dst if it is large enough.
+func compress(c Compression, dst, src []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return append(dst[:0], src...), nil
+	case CompressionSnappy:
+		return snappy.Encode(dst[:cap(dst)], src), nil
+	case CompressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	}
+	return nil, fmt.Errorf("%w: %d", errUnknownCompression, c)
+}
+
+// decompress returns the decompressed src, reusing dst if it is large enough.
+func decompress(c Compression, dst, src []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return append(dst[:0], src...), nil
+	case CompressionSnappy:
+		return snappy.Decode(dst[:cap(dst)], src)
+	case CompressionZstd:
+		if err := initZstd(); err != nil {
+			return nil, err
+		}
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	}
+	return nil, fmt.Errorf("%w: %d", errUnknownCompression, c)
+}
@@ -40,6 +40,9 @@ const (
 	// defaultFlushInterval is the default commit log flush interval
 	defaultFlushInterval = time.Second
 
+	// defaultGroupCommitInterval is the default commit log group commit interval
+	defaultGroupCommitInterval = 10 * time.Millisecond
+
 	// defaultFlushSize is the default commit log flush size
 	defaultFlushSize = 65536
 
@@ -63,9 +66,10 @@ var (
 )
 
 var (
-	errFlushIntervalNonNegative = errors.New("flush interval must be non-negative")
-	errBlockSizePositive        = errors.New("block size must be a positive duration")
-	errReadConcurrencyPositive  = errors.New("read concurrency must be a positive integer")
+	errFlushIntervalNonNegative    = errors.New("flush interval must be non-negative")
+	errBlockSizePositive           = errors.New("block size must be a positive duration")
+	errReadConcurrencyPositive     = errors.New("read concurrency must be a positive integer")
+	errGroupCommitIntervalPositive = errors.New("group commit interval must be a positive duration")
 )
 
 type options struct {
@@ -74,6 +78,8 @@ type options struct {
 	blockSize               time.Duration
 	fsOpts                  fs.Options
 	strategy                Strategy
+	groupCommitInterval     time.Duration
+	compression             Compression
 	flushSize               int
 	flushInterval           time.Duration
 	backlogQueueSize        int
@@ -91,6 +97,7 @@ func NewOptions() Options {
 		blockSize:               defaultBlockSize,
 		fsOpts:                  fs.NewOptions(),
 		strategy:                defaultStrategy,
+		groupCommitInterval:     defaultGroupCommitInterval,
 		flushSize:               defaultFlushSize,
 		flushInterval:           defaultFlushInterval,
 		backlogQueueSize:        defaultBacklogQueueSize,
@@ -118,6 +125,14 @@ func (o *options) Validate() error {
 		return errReadConcurrencyPositive
 	}
 
+	if o.Strategy() == StrategyWriteGroupCommit && o.GroupCommitInterval() <= 0 {
+		return errGroupCommitIntervalPositive
+	}
+
+	if err := o.Compression().Validate(); err != nil {
+		return err
+	}
+
 	if float64(o.BacklogQueueSize())/float64(o.BacklogQueueChannelSize()) > MaximumQueueSizeQueueChannelSizeRatio {
 		return fmt.Errorf(
 			"BacklogQueueSize / BacklogQueueChannelSize ratio must be at most: %f, but was: %f",
@@ -177,6 +192,26 @@ func (o *options) Strategy() Strategy {
 	return o.strategy
 }
 
+func (o *options) SetGroupCommitInterval(value time.Duration) Options {
+	opts := *o
+	opts.groupCommitInterval = value
+	return &opts
+}
+
+func (o *options) GroupCommitInterval() time.Duration {
+	return o.groupCommitInterval
+}
+
+func (o *options) SetCompression(value Compression) Options {
+	opts := *o
+	opts.compression = value
+	return &opts
+}
+
+func (o *options) Compression() Compression {
+	return o.compression
+}
+
 func (o *options) SetFlushSize(value int) Options {
 	opts := *o
 	opts.flushSize = value
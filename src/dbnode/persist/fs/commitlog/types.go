@@ -23,6 +23,7 @@ package commitlog
 import (
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -48,6 +49,12 @@ const (
 	// for the buffered commit log chunk that contains a write to flush
 	// before acknowledging a write
 	StrategyWriteBehind
+
+	// StrategyWriteGroupCommit describes the strategy that waits for
+	// the commit log to be fsync'd before acknowledging a write, the
+	// commit log is fsync'd at most every group commit interval so that
+	// a single fsync acknowledges all writes received in the interval
+	StrategyWriteGroupCommit
 )
 
 // CommitLog provides a synchronized commit log
@@ -64,7 +71,20 @@ type CommitLog interface {
 		annotation ts.Annotation,
 	) error
 
-	// WriteBatch is the same as Write, but in batch.
+	// WriteWithDurability is the same as Write, but acknowledges the write
+	// once it reaches the given durability rather than the durability of the
+	// commit log strategy.
+	WriteWithDurability(
+		ctx context.Context,
+		series ts.Series,
+		datapoint ts.Datapoint,
+		unit xtime.Unit,
+		annotation ts.Annotation,
+		durability namespace.WriteDurability,
+	) error
+
+	// WriteBatch is the same as Write, but in batch. The batch is acknowledged
+	// once it reaches the durability of the batch.
 	WriteBatch(
 		ctx context.Context,
 		writes writes.WriteBatch,
@@ -179,6 +199,20 @@ type Options interface {
 	// Strategy returns the strategy.
 	Strategy() Strategy
 
+	// SetGroupCommitInterval sets the max time a write waits for the commit
+	// log to be fsync'd when using the group commit strategy.
+	SetGroupCommitInterval(value time.Duration) Options
+
+	// GroupCommitInterval returns the max time a write waits for the commit
+	// log to be fsync'd when using the group commit strategy.
+	GroupCommitInterval() time.Duration
+
+	// SetCompression sets the compression of commit log chunks.
+	SetCompression(value Compression) Options
+
+	// Compression returns the compression of commit log chunks.
+	Compression() Compression
+
 	// SetFlushInterval sets the flush interval.
 	SetFlushInterval(value time.Duration) Options
 
@@ -51,6 +51,12 @@ const (
 		chunkHeaderChecksumSizeLen +
 		chunkHeaderChecksumDataLen
 
+	// The top bits of the chunk header size hold the compression of the chunk
+	// data, commit logs written before compression was supported never set them
+	// so they remain readable.
+	chunkHeaderSizeCompressionShift = 30
+	chunkHeaderSizeMask             = 1<<chunkHeaderSizeCompressionShift - 1
+
 	defaultBitSetLength = 65536
 
 	defaultEncoderBuffSize = 16384
@@ -59,6 +65,7 @@ const (
 var (
 	errCommitLogWriterAlreadyOpen = errors.New("commit log writer already open")
 	errTagEncoderDataNotAvailable = errors.New("tag iterator data not available")
+	errCommitLogChunkTooLarge     = errors.New("commit log chunk too large")
 
 	endianness = binary.LittleEndian
 )
@@ -114,14 +121,12 @@ func newCommitLogWriter(
 	flushFn flushFn,
 	opts Options,
 ) commitLogWriter {
-	shouldFsync := opts.Strategy() == StrategyWriteWait
-
 	return &writer{
 		filePathPrefix:      opts.FilesystemOptions().FilePathPrefix(),
 		newFileMode:         opts.FilesystemOptions().NewFileMode(),
 		newDirectoryMode:    opts.FilesystemOptions().NewDirectoryMode(),
 		nowFn:               opts.ClockOptions().NowFn(),
-		chunkWriter:         newChunkWriter(flushFn, shouldFsync),
+		chunkWriter:         newChunkWriter(flushFn, opts),
 		chunkReserveHeader:  make([]byte, chunkHeaderLen),
 		buffer:              bufio.NewWriterSize(nil, opts.FlushSize()),
 		sizeBuffer:          make([]byte, binary.MaxVarintLen64),
@@ -290,17 +295,24 @@ func (w *writer) write(data []byte) error {
 }
 
 type fsChunkWriter struct {
-	fd      xos.File
-	flushFn flushFn
-	buff    []byte
-	fsync   bool
+	fd           xos.File
+	flushFn      flushFn
+	buff         []byte
+	compressBuff []byte
+	compression  Compression
+	fsync        bool
+	// groupCommit defers the flush callback of successfully written chunks
+	// until the next sync so that writes are only acknowledged once durable.
+	groupCommit bool
 }
 
-func newChunkWriter(flushFn flushFn, fsync bool) chunkWriter {
+func newChunkWriter(flushFn flushFn, opts Options) chunkWriter {
 	return &fsChunkWriter{
-		flushFn: flushFn,
-		buff:    make([]byte, chunkHeaderLen),
-		fsync:   fsync,
+		flushFn:     flushFn,
+		buff:        make([]byte, chunkHeaderLen),
+		compression: opts.Compression(),
+		fsync:       opts.Strategy() == StrategyWriteWait,
+		groupCommit: opts.Strategy() == StrategyWriteGroupCommit,
 	}
 }
 
@@ -319,14 +331,34 @@ func (w *fsChunkWriter) isOpen() bool {
 }
 
 func (w *fsChunkWriter) sync() error {
-	return w.fd.Sync()
+	err := w.fd.Sync()
+	if w.groupCommit {
+		// Fire flush callback now that all written chunks are durable.
+		w.flushFn(err)
+	}
+	return err
 }
 
 // Writes a custom header in front of p to a file and returns number of bytes of p successfully written to the file.
 // If the header or p is not fully written to the file, then this method returns number of bytes of p actually written
 // to the file and an error explaining the reason of failure to write fully to the file.
 func (w *fsChunkWriter) Write(p []byte) (int, error) {
-	size := len(p)
+	data := p
+	if w.compression != CompressionNone {
+		var err error
+		w.compressBuff, err = compress(w.compression, w.compressBuff, p)
+		if err != nil {
+			w.flushFn(err)
+			return 0, err
+		}
+		data = w.compressBuff
+	}
+
+	size := len(data)
+	if size > chunkHeaderSizeMask {
+		w.flushFn(errCommitLogChunkTooLarge)
+		return 0, errCommitLogChunkTooLarge
+	}
 
 	sizeStart, sizeEnd :=
 		0, chunkHeaderSizeLen
@@ -335,12 +367,13 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 	checksumDataStart, checksumDataEnd :=
 		checksumSizeEnd, checksumSizeEnd+chunkHeaderChecksumDataLen
 
-	// Write size
-	endianness.PutUint32(w.buff[sizeStart:sizeEnd], uint32(size))
+	// Write size and compression
+	endianness.PutUint32(w.buff[sizeStart:sizeEnd],
+		uint32(size)|uint32(w.compression)<<chunkHeaderSizeCompressionShift)
 
 	// Calculate checksums
 	checksumSize := digest.Checksum(w.buff[sizeStart:sizeEnd])
-	checksumData := digest.Checksum(p)
+	checksumData := digest.Checksum(data)
 
 	// Write checksums
 	digest.
@@ -351,13 +384,16 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		WriteDigest(checksumData)
 
 	// Combine buffers to reduce to a single syscall
-	w.buff = append(w.buff[:chunkHeaderLen], p...)
+	w.buff = append(w.buff[:chunkHeaderLen], data...)
 
 	// Write contents to file descriptor
 	n, err := w.fd.Write(w.buff)
-	// Count bytes successfully written from slice p
+	// Count bytes successfully written from slice p, a partially written
+	// compressed chunk is unreadable so none of p counts as written.
 	pBytesWritten := n - chunkHeaderLen
-	if pBytesWritten < 0 {
+	if n == len(w.buff) {
+		pBytesWritten = len(p)
+	} else if pBytesWritten < 0 || w.compression != CompressionNone {
 		pBytesWritten = 0
 	}
 
@@ -371,6 +407,11 @@ func (w *fsChunkWriter) Write(p []byte) (int, error) {
 		err = w.sync()
 	}
 
+	if w.groupCommit && err == nil {
+		// The flush callback fires once the chunk is synced.
+		return pBytesWritten, nil
+	}
+
 	// Fire flush callback
 	w.flushFn(err)
 	return pBytesWritten, err
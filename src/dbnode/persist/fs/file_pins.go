@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type filePins struct {
+	sync.RWMutex
+
+	// Map of cleaned file path -> number of pins held.
+	pins map[string]int
+}
+
+// NewFilePins returns a new set of file pins. Components deleting filesets
+// must share a single instance with the components pinning them.
+func NewFilePins() FilePins {
+	return &filePins{
+		pins: make(map[string]int),
+	}
+}
+
+func (p *filePins) Pin(filePaths []string) func() {
+	cleaned := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		cleaned = append(cleaned, filepath.Clean(filePath))
+	}
+
+	p.Lock()
+	for _, filePath := range cleaned {
+		p.pins[filePath]++
+	}
+	p.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.release(cleaned)
+		})
+	}
+}
+
+func (p *filePins) release(filePaths []string) {
+	p.Lock()
+	defer p.Unlock()
+
+	for _, filePath := range filePaths {
+		count := p.pins[filePath] - 1
+		if count > 0 {
+			p.pins[filePath] = count
+			continue
+		}
+		delete(p.pins, filePath)
+	}
+}
+
+func (p *filePins) Unpinned(filePaths []string) []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	if len(p.pins) == 0 {
+		return filePaths
+	}
+
+	unpinned := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		if _, ok := p.pins[filepath.Clean(filePath)]; ok {
+			continue
+		}
+		unpinned = append(unpinned, filePath)
+	}
+	return unpinned
+}
+
+func (p *filePins) PinnedDirectories(parentDirectoryPath string) []string {
+	p.RLock()
+	defer p.RUnlock()
+
+	var (
+		parent = filepath.Clean(parentDirectoryPath)
+		seen   = make(map[string]struct{})
+		dirs   []string
+	)
+	for filePath := range p.pins {
+		rel, err := filepath.Rel(parent, filePath)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+
+		idx := strings.IndexRune(rel, filepath.Separator)
+		if idx <= 0 {
+			// Pinned file directly within the parent directory.
+			continue
+		}
+
+		dir := rel[:idx]
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+		seen[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilePinsPinAndRelease(t *testing.T) {
+	pins := NewFilePins()
+	files := []string{"/var/lib/m3db/data/ns/0/a.db", "/var/lib/m3db/data/ns/1/b.db"}
+
+	release := pins.Pin(files)
+	require.Empty(t, pins.Unpinned(files))
+	require.Equal(t, []string{"/var/lib/m3db/data/ns/2/c.db"},
+		pins.Unpinned(append(files, "/var/lib/m3db/data/ns/2/c.db")))
+
+	// Pins are reference counted.
+	releaseSecond := pins.Pin(files[:1])
+	release()
+	release()
+	require.Equal(t, files[1:], pins.Unpinned(files))
+
+	releaseSecond()
+	require.Equal(t, files, pins.Unpinned(files))
+}
+
+func TestFilePinsUncleanPaths(t *testing.T) {
+	pins := NewFilePins()
+	release := pins.Pin([]string{"/var/lib/m3db//data/ns/0/a.db"})
+	defer release()
+
+	require.Empty(t, pins.Unpinned([]string{"/var/lib/m3db/data/ns/./0/a.db"}))
+}
+
+func TestFilePinsPinnedDirectories(t *testing.T) {
+	pins := NewFilePins()
+	release := pins.Pin([]string{
+		"/var/lib/m3db/data/ns/0/a.db",
+		"/var/lib/m3db/data/ns/0/b.db",
+		"/var/lib/m3db/data/ns/3/c.db",
+		"/var/lib/m3db/data/other/1/d.db",
+		"/var/lib/m3db/snapshots/ns/5/e.db",
+	})
+
+	dirs := pins.PinnedDirectories("/var/lib/m3db/data/ns")
+	sort.Strings(dirs)
+	require.Equal(t, []string{"0", "3"}, dirs)
+
+	dirs = pins.PinnedDirectories("/var/lib/m3db/data")
+	sort.Strings(dirs)
+	require.Equal(t, []string{"ns", "other"}, dirs)
+
+	require.Empty(t, pins.PinnedDirectories("/var/lib/m3db/data/ns/0"))
+
+	release()
+	require.Empty(t, pins.PinnedDirectories("/var/lib/m3db/data"))
+}
@@ -58,6 +58,7 @@ const (
 	indexDirName      = "index"
 	snapshotDirName   = "snapshots"
 	commitLogsDirName = "commitlogs"
+	tombstonesDirName = "tombstones"
 
 	// The maximum number of delimeters ('-' or '.') that is expected in a
 	// (base) filename.
@@ -1041,6 +1042,18 @@ func SnapshotFiles(filePathPrefix string, namespace ident.ID, shard uint32) (Fil
 	})
 }
 
+// IndexFiles returns a slice of all the names for all the index fileset files
+// for a given namespace.
+func IndexFiles(filePathPrefix string, namespace ident.ID) (FileSetFilesSlice, error) {
+	return filesetFiles(filesetFilesSelector{
+		fileSetType:    persist.FileSetFlushType,
+		contentType:    persist.FileSetIndexContentType,
+		filePathPrefix: filePathPrefix,
+		namespace:      namespace,
+		pattern:        filesetFilePattern,
+	})
+}
+
 // IndexSnapshotFiles returns a slice of all the names for all the index fileset files
 // for a given namespace.
 func IndexSnapshotFiles(filePathPrefix string, namespace ident.ID) (FileSetFilesSlice, error) {
@@ -1515,6 +1528,12 @@ func ShardSnapshotsDirPath(prefix string, namespace ident.ID, shard uint32) stri
 	return path.Join(namespacePath, strconv.Itoa(int(shard)))
 }
 
+// NamespaceTombstonesFilePath returns the path to the series tombstones file
+// for a given namespace.
+func NamespaceTombstonesFilePath(prefix string, namespace ident.ID) string {
+	return path.Join(prefix, tombstonesDirName, namespace.String()+".json")
+}
+
 // CommitLogsDirPath returns the path to commit logs.
 func CommitLogsDirPath(prefix string) string {
 	return path.Join(prefix, commitLogsDirName)
@@ -1715,6 +1734,17 @@ func FileExists(filePath string) (bool, error) {
 	return true, nil
 }
 
+// PathExists returns whether anything exists at the given path. Unlike
+// FileExists it accepts checkpoint files, for callers that only need to know
+// whether the path is taken rather than whether a fileset is complete.
+func PathExists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // OpenWritable opens a file for writing and truncating as necessary.
 func OpenWritable(filePath string, perm os.FileMode) (*os.File, error) {
 	return os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
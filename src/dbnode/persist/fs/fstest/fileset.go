@@ -0,0 +1,70 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package fstest provides test utilities for packages working with filesets.
+package fstest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+// NumSeries is the number of series written to each test fileset.
+const NumSeries = 10
+
+// SeriesID returns the ID of the i-th series written to a test fileset.
+func SeriesID(i int) ident.ID {
+	return ident.StringID(fmt.Sprintf("series.%d", i))
+}
+
+// SeriesData returns the data of the i-th series written to a test fileset.
+func SeriesData(i int) string {
+	return fmt.Sprintf("data-%d", i)
+}
+
+// WriteFileSet writes a fileset with NumSeries series under the file path
+// prefix, opening the writer with the given options.
+func WriteFileSet(
+	t *testing.T,
+	filePathPrefix string,
+	opts fs.DataWriterOpenOptions,
+) {
+	w, err := fs.NewWriter(fs.NewOptions().SetFilePathPrefix(filePathPrefix))
+	require.NoError(t, err)
+	require.NoError(t, w.Open(opts))
+
+	for i := 0; i < NumSeries; i++ {
+		data := checked.NewBytes([]byte(SeriesData(i)), nil)
+		data.IncRef()
+		metadata := persist.NewMetadataFromIDAndTags(SeriesID(i), ident.Tags{},
+			persist.MetadataOptions{})
+		require.NoError(t, w.Write(metadata, data, digest.Checksum(data.Bytes())))
+		data.DecRef()
+	}
+	require.NoError(t, w.Close())
+}
@@ -109,6 +109,7 @@ type options struct {
 	mmapReporter                         mmap.Reporter
 	indexReaderAutovalidateIndexSegments bool
 	encodingOptions                      msgpack.LegacyEncodingOptions
+	tieredFiles                          TieredFiles
 }
 
 // NewOptions creates a new set of fs options
@@ -408,3 +409,13 @@ func (o *options) SetEncodingOptions(value msgpack.LegacyEncodingOptions) Option
 func (o *options) EncodingOptions() msgpack.LegacyEncodingOptions {
 	return o.encodingOptions
 }
+
+func (o *options) SetTieredFiles(value TieredFiles) Options {
+	opts := *o
+	opts.tieredFiles = value
+	return &opts
+}
+
+func (o *options) TieredFiles() TieredFiles {
+	return o.tieredFiles
+}
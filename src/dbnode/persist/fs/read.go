@@ -219,7 +219,7 @@ func (r *reader) Open(opts DataReaderOpenOptions) error {
 		r.digestFdWithDigestContents.Close()
 	}()
 
-	result, err := mmap.Files(os.Open, map[string]mmap.FileDesc{
+	result, err := mmapFiles(r.opts.TieredFiles(), map[string]mmap.FileDesc{
 		indexFilepath: {
 			File:       &r.indexFd,
 			Descriptor: &r.indexMmap,
@@ -590,8 +590,14 @@ func (r *reader) Close() error {
 	multiErr := xerrors.NewMultiError()
 	multiErr = multiErr.Add(mmap.Munmap(r.indexMmap))
 	multiErr = multiErr.Add(mmap.Munmap(r.dataMmap))
-	multiErr = multiErr.Add(r.indexFd.Close())
-	multiErr = multiErr.Add(r.dataFd.Close())
+	if r.indexFd != nil {
+		// NB: The index and data files are not opened if they were read from
+		// the object store they have been tiered to.
+		multiErr = multiErr.Add(r.indexFd.Close())
+	}
+	if r.dataFd != nil {
+		multiErr = multiErr.Add(r.dataFd.Close())
+	}
 	multiErr = multiErr.Add(r.bloomFilterFd.Close())
 	r.indexDecoderStream.Reset(nil)
 	r.dataReader.Reset(nil)
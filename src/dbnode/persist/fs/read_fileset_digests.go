@@ -21,9 +21,27 @@
 package fs
 
 import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
 	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/generated/proto/index"
+	"github.com/m3db/m3/src/dbnode/persist"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
 )
 
+// dataFileSetDigestSuffixes are the suffixes of the data fileset files in the
+// order their digests are written to the digest file.
+var dataFileSetDigestSuffixes = []string{
+	infoFileSuffix,
+	indexFileSuffix,
+	summariesFileSuffix,
+	bloomFilterFileSuffix,
+	dataFileSuffix,
+}
+
 // filesetDigests is a container struct for storing a digest for all of the
 // fileset files for a given shard / block combination
 type filesetDigests struct {
@@ -61,3 +79,95 @@ func readFileSetDigests(
 
 	return fsDigests, nil
 }
+
+// FileSetDigests returns the digests recorded in the digest file of a complete
+// fileset keyed by absolute file path, after validating the digest file against
+// the checkpoint file. The digest file's own digest is the one recorded in the
+// checkpoint file; the checkpoint file has no recorded digest.
+func FileSetDigests(
+	fileset FileSetFile,
+	contentType persist.FileSetContentType,
+) (map[string]uint32, error) {
+	checkpointFilePath, ok := fileSetFilePathWithSuffix(fileset, checkpointFileSuffix)
+	if !ok {
+		return nil, ErrCheckpointFileNotFound
+	}
+	digestFilePath, ok := fileSetFilePathWithSuffix(fileset, digestFileSuffix)
+	if !ok {
+		return nil, fmt.Errorf("digest file not found for fileset: %s", checkpointFilePath)
+	}
+
+	expectedDigestOfDigest, err := readCheckpointFile(checkpointFilePath, digest.NewBuffer())
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(digestFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if actual := digest.Checksum(data); actual != expectedDigestOfDigest {
+		return nil, fmt.Errorf("digest file checksum bad: path=%s, expected=%d, actual=%d",
+			digestFilePath, expectedDigestOfDigest, actual)
+	}
+
+	digests := map[string]uint32{digestFilePath: expectedDigestOfDigest}
+	switch contentType {
+	case persist.FileSetDataContentType:
+		if len(data) < len(dataFileSetDigestSuffixes)*digest.DigestLenBytes {
+			return nil, fmt.Errorf("digest file too short: path=%s, len=%d",
+				digestFilePath, len(data))
+		}
+		for i, suffix := range dataFileSetDigestSuffixes {
+			filePath, ok := fileSetFilePathWithSuffix(fileset, suffix)
+			if !ok {
+				return nil, fmt.Errorf("%s file not found for fileset: %s",
+					suffix, checkpointFilePath)
+			}
+			digests[filePath] = digest.Buffer(data[i*digest.DigestLenBytes:]).ReadDigest()
+		}
+	case persist.FileSetIndexContentType:
+		var indexDigests index.IndexDigests
+		if err := indexDigests.Unmarshal(data); err != nil {
+			return nil, err
+		}
+
+		var (
+			dir         = filepath.Dir(checkpointFilePath)
+			blockStart  = fileset.ID.BlockStart
+			volumeIndex = fileset.ID.VolumeIndex
+		)
+		infoFilePath := filesetPathFromTimeAndIndex(dir, blockStart, volumeIndex, infoFileSuffix)
+		digests[infoFilePath] = indexDigests.InfoDigest
+		for i, segment := range indexDigests.SegmentDigests {
+			for _, file := range segment.Files {
+				fileType := idxpersist.IndexSegmentFileType(file.SegmentFileType)
+				filePath := filesetIndexSegmentFilePathFromTime(dir, blockStart, volumeIndex, i, fileType)
+				digests[filePath] = file.Digest
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown fileset content type: %s", contentType)
+	}
+
+	return digests, nil
+}
+
+// SnapshotMetadataDigests returns the digest recorded in the checkpoint file of
+// a snapshot metadata file keyed by the absolute path of the metadata file.
+func SnapshotMetadataDigests(metadata SnapshotMetadata) (map[string]uint32, error) {
+	expected, err := readCheckpointFile(metadata.CheckpointFilePath, digest.NewBuffer())
+	if err != nil {
+		return nil, err
+	}
+	return map[string]uint32{metadata.MetadataFilePath: expected}, nil
+}
+
+func fileSetFilePathWithSuffix(fileset FileSetFile, suffix string) (string, bool) {
+	fileNameSuffix := separator + suffix + fileSuffix
+	for _, filePath := range fileset.AbsoluteFilePaths {
+		if strings.HasSuffix(filePath, fileNameSuffix) {
+			return filePath, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	idxpersist "github.com/m3db/m3/src/m3ninx/persist"
+)
+
+func requireDigestsMatchFiles(t *testing.T, digests map[string]uint32) {
+	for filePath, expected := range digests {
+		data, err := ioutil.ReadFile(filePath)
+		require.NoError(t, err)
+		require.Equal(t, expected, digest.Checksum(data), filePath)
+	}
+}
+
+func TestFileSetDigestsData(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", map[string]string{"baz": "qux"}, []byte{4, 5, 6}},
+	}
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	filesets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+
+	digests, err := FileSetDigests(filesets[0], persist.FileSetDataContentType)
+	require.NoError(t, err)
+
+	// Every file except the checkpoint file has a recorded digest.
+	require.Len(t, digests, len(filesets[0].AbsoluteFilePaths)-1)
+	requireDigestsMatchFiles(t, digests)
+}
+
+func TestFileSetDigestsDataCorruptDigestFile(t *testing.T) {
+	dir := createTempDir(t)
+	filePathPrefix := filepath.Join(dir, "")
+	defer os.RemoveAll(dir)
+
+	w := newTestWriter(t, filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}, persist.FileSetFlushType)
+
+	filesets, err := DataFiles(filePathPrefix, testNs1ID, 0)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+
+	digestFilePath, ok := fileSetFilePathWithSuffix(filesets[0], digestFileSuffix)
+	require.True(t, ok)
+	require.NoError(t, ioutil.WriteFile(digestFilePath, []byte("corrupt"), 0600))
+
+	_, err = FileSetDigests(filesets[0], persist.FileSetDataContentType)
+	require.Error(t, err)
+}
+
+func TestFileSetDigestsIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	test := newIndexWriteTestSetup(t)
+	defer test.cleanup()
+
+	writer := newTestIndexWriter(t, test.filePathPrefix)
+	err := writer.Open(IndexWriterOpenOptions{
+		Identifier:  test.fileSetID,
+		BlockSize:   test.blockSize,
+		FileSetType: persist.FileSetFlushType,
+		Shards:      shardsSet(1, 3),
+	})
+	require.NoError(t, err)
+
+	writeTestIndexSegments(t, ctrl, writer, []testIndexSegment{
+		{
+			segmentType:  idxpersist.IndexSegmentType("fst"),
+			majorVersion: 1,
+			minorVersion: 1,
+			files: []testIndexSegmentFile{
+				{idxpersist.IndexSegmentFileType("first"), randDataFactorOfBuffSize(t, 1.5)},
+				{idxpersist.IndexSegmentFileType("second"), randDataFactorOfBuffSize(t, 0.5)},
+			},
+		},
+	})
+	require.NoError(t, writer.Close())
+
+	filesets, err := IndexFiles(test.filePathPrefix, test.fileSetID.Namespace)
+	require.NoError(t, err)
+	require.Len(t, filesets, 1)
+
+	digests, err := FileSetDigests(filesets[0], persist.FileSetIndexContentType)
+	require.NoError(t, err)
+
+	// Info, digest and two segment files.
+	require.Len(t, digests, 4)
+	require.Len(t, filesets[0].AbsoluteFilePaths, 5)
+	requireDigestsMatchFiles(t, digests)
+}
@@ -73,8 +73,8 @@ type seeker struct {
 	blockSize      time.Duration
 	versionChecker schema.VersionChecker
 
-	dataFd        *os.File
-	indexFd       *os.File
+	dataFd        ReadAtFile
+	indexFd       ReadAtFile
 	indexFileSize int64
 
 	unreadBuf []byte
@@ -172,21 +172,26 @@ func (s *seeker) Open(
 		}
 	}
 
-	// Open necessary files
+	// Open necessary files, the index and data files are read on demand with
+	// positional reads and so may be served from the object store once tiered.
+	if err := openReadAtFiles(s.opts.opts.TieredFiles(), map[string]*ReadAtFile{
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix, isLegacy): &s.indexFd,
+		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy):  &s.dataFd,
+	}); err != nil {
+		return err
+	}
 	if err := openFiles(os.Open, map[string]**os.File{
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, infoFileSuffix, isLegacy):        &infoFd,
-		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, indexFileSuffix, isLegacy):       &s.indexFd,
-		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, dataFileSuffix, isLegacy):        &s.dataFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, digestFileSuffix, isLegacy):      &digestFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, bloomFilterFileSuffix, isLegacy): &bloomFilterFd,
 		dataFilesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, summariesFileSuffix, isLegacy):   &summariesFd,
 	}); err != nil {
+		s.Close()
 		return err
 	}
 
 	var (
 		infoFdWithDigest           = resources.seekerOpenResources.infoFDDigestReader
-		indexFdWithDigest          = resources.seekerOpenResources.indexFDDigestReader
 		bloomFilterFdWithDigest    = resources.seekerOpenResources.bloomFilterFDDigestReader
 		summariesFdWithDigest      = resources.seekerOpenResources.summariesFDDigestReader
 		digestFdWithDigestContents = resources.seekerOpenResources.digestFDDigestContentsReader
@@ -200,7 +205,6 @@ func (s *seeker) Open(
 	}()
 
 	infoFdWithDigest.Reset(infoFd)
-	indexFdWithDigest.Reset(s.indexFd)
 	summariesFdWithDigest.Reset(summariesFd)
 	digestFdWithDigestContents.Reset(digestFd)
 
@@ -231,8 +235,7 @@ func (s *seeker) Open(
 	s.blockSize = time.Duration(info.BlockSize)
 	s.versionChecker = schema.NewVersionChecker(int(info.MajorVersion), int(info.MinorVersion))
 
-	err = s.validateIndexFileDigest(
-		indexFdWithDigest, expectedDigests.indexDigest)
+	err = s.validateIndexFileDigest(expectedDigests.indexDigest)
 	if err != nil {
 		s.Close()
 		return fmt.Errorf(
@@ -241,12 +244,7 @@ func (s *seeker) Open(
 		)
 	}
 
-	indexFdStat, err := s.indexFd.Stat()
-	if err != nil {
-		s.Close()
-		return err
-	}
-	s.indexFileSize = indexFdStat.Size()
+	s.indexFileSize = s.indexFd.Size()
 
 	s.bloomFilter, err = newManagedConcurrentBloomFilterFromFile(
 		bloomFilterFd,
@@ -610,7 +608,7 @@ func (s *seeker) ConcurrentClone() (ConcurrentDataFileSetSeeker, error) {
 		indexLookup: indexLookupClone,
 		isClone:     true,
 
-		// Index and data fd's are always accessed via the ReadAt() / pread APIs so
+		// Index and data files are always accessed via the ReadAt() / pread APIs so
 		// they are concurrency safe and can be shared among clones.
 		indexFd: s.indexFd,
 		dataFd:  s.dataFd,
@@ -621,19 +619,20 @@ func (s *seeker) ConcurrentClone() (ConcurrentDataFileSetSeeker, error) {
 	return seeker, nil
 }
 
-func (s *seeker) validateIndexFileDigest(
-	indexFdWithDigest digest.FdWithDigestReader,
-	expectedDigest uint32,
-) error {
+func (s *seeker) validateIndexFileDigest(expectedDigest uint32) error {
 	// If piecemeal checksumming validation enabled for index entries, do not attempt to validate the
 	// checksum of the entire file
 	if s.versionChecker.IndexEntryValidationEnabled() {
 		return nil
 	}
 
-	buf := make([]byte, s.opts.dataBufferSize)
+	var (
+		indexReaderWithDigest = digest.NewReaderWithDigest(
+			io.NewSectionReader(s.indexFd, 0, s.indexFd.Size()))
+		buf = make([]byte, s.opts.dataBufferSize)
+	)
 	for {
-		n, err := indexFdWithDigest.Read(buf)
+		n, err := indexReaderWithDigest.Read(buf)
 		if err != nil && err != io.EOF {
 			return fmt.Errorf("error reading index file: %v", err)
 		}
@@ -641,7 +640,7 @@ func (s *seeker) validateIndexFileDigest(
 			break
 		}
 	}
-	return indexFdWithDigest.Validate(expectedDigest)
+	return indexReaderWithDigest.Validate(expectedDigest)
 }
 
 // ReusableSeekerResources is a collection of reusable resources
@@ -668,7 +667,6 @@ type ReusableSeekerResources struct {
 // reusableSeekerOpenResources contains resources used for the Open() method of the seeker.
 type reusableSeekerOpenResources struct {
 	infoFDDigestReader           digest.FdWithDigestReader
-	indexFDDigestReader          digest.FdWithDigestReader
 	bloomFilterFDDigestReader    digest.FdWithDigestReader
 	summariesFDDigestReader      digest.FdWithDigestReader
 	digestFDDigestContentsReader digest.FdWithDigestContentsReader
@@ -677,7 +675,6 @@ type reusableSeekerOpenResources struct {
 func newReusableSeekerOpenResources(opts Options) reusableSeekerOpenResources {
 	return reusableSeekerOpenResources{
 		infoFDDigestReader:           digest.NewFdWithDigestReader(opts.InfoReaderBufferSize()),
-		indexFDDigestReader:          digest.NewFdWithDigestReader(opts.DataReaderBufferSize()),
 		bloomFilterFDDigestReader:    digest.NewFdWithDigestReader(opts.DataReaderBufferSize()),
 		summariesFDDigestReader:      digest.NewFdWithDigestReader(opts.DataReaderBufferSize()),
 		digestFDDigestContentsReader: digest.NewFdWithDigestContentsReader(opts.InfoReaderBufferSize()),
@@ -756,7 +753,7 @@ var _ io.Reader = &offsetFileReader{}
 // and also allows the fds to be shared among concurrent goroutines since the
 // internal F.D offset managed by the kernel is not being used.
 type offsetFileReader struct {
-	fd     *os.File
+	fd     io.ReaderAt
 	offset int64
 }
 
@@ -770,7 +767,7 @@ func (p *offsetFileReader) Read(b []byte) (n int, err error) {
 	return n, err
 }
 
-func (p *offsetFileReader) reset(fd *os.File, offset int64) {
+func (p *offsetFileReader) reset(fd io.ReaderAt, offset int64) {
 	p.fd = fd
 	p.offset = offset
 }
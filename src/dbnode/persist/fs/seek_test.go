@@ -480,20 +480,17 @@ func TestSeekValidateIndexEntriesFile(t *testing.T) {
 	s.versionChecker = schema.NewVersionChecker(1, 1)
 
 	indexFilePath := dataFilesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, indexFileSuffix, false)
-	indexFd, err := os.Open(indexFilePath)
+	s.indexFd, err = OpenReadAtFile(indexFilePath, nil)
 	assert.NoError(t, err)
-	indexReader := digest.NewFdWithDigestReader(defaultInfoReaderBufferSize)
-	indexReader.Reset(indexFd)
 
-	assert.NoError(t, s.validateIndexFileDigest(indexReader, 0))
+	assert.NoError(t, s.validateIndexFileDigest(0))
 
 	// With full file validation enabled
 	s.versionChecker = schema.NewVersionChecker(1, 0)
-	_, err = indexFd.Seek(0, 0)
-	assert.NoError(t, err)
-	indexReader.Reset(indexFd)
 
-	assert.Error(t, s.validateIndexFileDigest(indexReader, 0))
+	assert.Error(t, s.validateIndexFileDigest(0))
+	assert.NoError(t, s.indexFd.Close())
+	s.indexFd = nil
 
 	// Sanity check -- call seeker#Open and ensure VersionChecker is set correctly
 	err = s.Open(testNs1ID, 0, testWriterStart, 0, newTestReusableSeekerResources())
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/mmap"
+)
+
+// TierableFilePaths returns the paths of the index and data files of a data
+// fileset, which are the files of the fileset that may be moved to an object
+// store. The small info, summaries, bloom filter, digest and checkpoint files
+// always remain on local disk.
+func TierableFilePaths(filePathPrefix string, id FileSetFileIdentifier) ([]string, error) {
+	var (
+		shardDir = ShardDataDirPath(filePathPrefix, id.Namespace, id.Shard)
+		isLegacy bool
+		err      error
+	)
+	if id.VolumeIndex == 0 {
+		isLegacy, err = isFirstVolumeLegacy(shardDir, id.BlockStart, checkpointFileSuffix)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []string{
+		dataFilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex, indexFileSuffix, isLegacy),
+		dataFilesetPathFromTimeAndIndex(shardDir, id.BlockStart, id.VolumeIndex, dataFileSuffix, isLegacy),
+	}, nil
+}
+
+// TieredFileCheckpointFilePath returns the path of the checkpoint file of the
+// data fileset which the index or data file at the given path belongs to.
+func TieredFileCheckpointFilePath(filePath string) (string, error) {
+	blockStart, volumeIndex, err := TimeAndVolumeIndexFromDataFileSetFilename(filePath)
+	if err != nil {
+		return "", err
+	}
+
+	shardDir := filepath.Dir(filePath)
+	for _, suffix := range []string{indexFileSuffix, dataFileSuffix} {
+		if filepath.Clean(filePath) == filesetPathFromTimeLegacy(shardDir, blockStart, suffix) {
+			return filesetPathFromTimeLegacy(shardDir, blockStart, checkpointFileSuffix), nil
+		}
+	}
+	return filesetPathFromTimeAndIndex(shardDir, blockStart, volumeIndex, checkpointFileSuffix), nil
+}
+
+type localReadAtFile struct {
+	*os.File
+
+	size int64
+}
+
+func (f localReadAtFile) Size() int64 {
+	return f.size
+}
+
+// OpenReadAtFile opens a fileset file for positional reads from local disk,
+// falling back to the tiered copy of the file if it has been evicted.
+func OpenReadAtFile(filePath string, tiered TieredFiles) (ReadAtFile, error) {
+	fd, err := os.Open(filePath)
+	if err == nil {
+		stat, err := fd.Stat()
+		if err != nil {
+			fd.Close()
+			return nil, err
+		}
+		return localReadAtFile{File: fd, size: stat.Size()}, nil
+	}
+	if !os.IsNotExist(err) || tiered == nil {
+		return nil, err
+	}
+	return tiered.Open(filePath)
+}
+
+func openReadAtFiles(tiered TieredFiles, files map[string]*ReadAtFile) error {
+	var firstErr error
+	for filePath, filePtr := range files {
+		file, err := OpenReadAtFile(filePath, tiered)
+		if err != nil {
+			firstErr = err
+			break
+		}
+		*filePtr = file
+	}
+
+	if firstErr == nil {
+		return nil
+	}
+
+	// If we have encountered an error when opening the files,
+	// close the ones that have been opened.
+	for _, filePtr := range files {
+		if *filePtr != nil {
+			(*filePtr).Close()
+			*filePtr = nil
+		}
+	}
+
+	return firstErr
+}
+
+// mmapFiles mmaps fileset files from local disk, reading the ones which have
+// been evicted to the object store into anonymous memory instead.
+func mmapFiles(tiered TieredFiles, files map[string]mmap.FileDesc) (mmap.FilesResult, error) {
+	if tiered == nil {
+		return mmap.Files(os.Open, files)
+	}
+
+	var (
+		local   = make(map[string]mmap.FileDesc, len(files))
+		evicted = make(map[string]mmap.FileDesc, len(files))
+	)
+	for filePath, desc := range files {
+		_, err := os.Stat(filePath)
+		if os.IsNotExist(err) {
+			evicted[filePath] = desc
+			continue
+		}
+		if err != nil {
+			return mmap.FilesResult{}, err
+		}
+		local[filePath] = desc
+	}
+
+	result, err := mmap.Files(os.Open, local)
+	if err != nil {
+		return result, err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for filePath, desc := range evicted {
+		if err := mmapTieredFile(tiered, filePath, desc); err != nil {
+			multiErr = multiErr.Add(err)
+			break
+		}
+	}
+	if multiErr.FinalError() == nil {
+		return result, nil
+	}
+
+	// If we have encountered an error when reading the tiered files,
+	// unmap and close the ones that have been opened.
+	for _, desc := range files {
+		if *desc.File != nil {
+			multiErr = multiErr.Add((*desc.File).Close())
+			*desc.File = nil
+		}
+		multiErr = multiErr.Add(mmap.Munmap(*desc.Descriptor))
+		*desc.Descriptor = mmap.Descriptor{}
+	}
+	return result, multiErr.FinalError()
+}
+
+func mmapTieredFile(tiered TieredFiles, filePath string, desc mmap.FileDesc) error {
+	file, err := tiered.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// NB: The region is written once here and only read afterwards, the same
+	// as a region forced into memory for the bloom filter or summaries.
+	opts := desc.Options
+	opts.Write = true
+	mmapped, err := mmap.Bytes(file.Size(), opts)
+	if err != nil {
+		return fmt.Errorf("could not mmap tiered file %s: %v", filePath, err)
+	}
+	if _, err := file.ReadAt(mmapped.Bytes, 0); err != nil && err != io.EOF {
+		mmap.Munmap(mmapped)
+		return fmt.Errorf("could not read tiered file %s: %v", filePath, err)
+	}
+
+	*desc.Descriptor = mmapped
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/digest"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/schema"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testTieredFiles serves tiered files from a directory mirroring the layout
+// of the file path prefix.
+type testTieredFiles struct {
+	filePathPrefix string
+	tieredDir      string
+}
+
+func (f testTieredFiles) Open(filePath string) (ReadAtFile, error) {
+	relPath, err := filepath.Rel(f.filePathPrefix, filePath)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := os.Open(filepath.Join(f.tieredDir, relPath))
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return nil, err
+	}
+	return localReadAtFile{File: fd, size: stat.Size()}, nil
+}
+
+func evictTestFiles(t *testing.T, tiered testTieredFiles, id FileSetFileIdentifier) {
+	filePaths, err := TierableFilePaths(tiered.filePathPrefix, id)
+	require.NoError(t, err)
+	require.Len(t, filePaths, 2)
+
+	for _, filePath := range filePaths {
+		relPath, err := filepath.Rel(tiered.filePathPrefix, filePath)
+		require.NoError(t, err)
+		tieredPath := filepath.Join(tiered.tieredDir, relPath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(tieredPath), 0755))
+		require.NoError(t, os.Rename(filePath, tieredPath))
+	}
+}
+
+func newTestTieredFiles(t *testing.T) (testTieredFiles, func()) {
+	dir, err := ioutil.TempDir("", "testdb")
+	require.NoError(t, err)
+	return testTieredFiles{
+		filePathPrefix: filepath.Join(dir, "local"),
+		tieredDir:      filepath.Join(dir, "tiered"),
+	}, func() { os.RemoveAll(dir) }
+}
+
+func TestSeekTieredFiles(t *testing.T) {
+	tiered, cleanup := newTestTieredFiles(t)
+	defer cleanup()
+
+	entries := []testEntry{
+		{"foo1", nil, []byte{1, 2, 1}},
+		{"foo2", nil, []byte{1, 2, 2}},
+		{"foo3", nil, []byte{1, 2, 3}},
+	}
+	w := newTestWriter(t, tiered.filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+	evictTestFiles(t, tiered, FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	})
+
+	resources := newTestReusableSeekerResources()
+	s := newTestSeeker(tiered.filePathPrefix)
+	err := s.Open(testNs1ID, 0, testWriterStart, 0, resources)
+	require.True(t, os.IsNotExist(err))
+
+	s = NewSeeker(tiered.filePathPrefix, testReaderBufferSize, testReaderBufferSize,
+		testBytesPool, false, testDefaultOpts.SetTieredFiles(tiered))
+	require.NoError(t, s.Open(testNs1ID, 0, testWriterStart, 0, resources))
+
+	for _, entry := range entries {
+		data, err := s.SeekByID(ident.StringID(entry.id), resources)
+		require.NoError(t, err)
+
+		data.IncRef()
+		require.Equal(t, entry.data, data.Bytes())
+		data.DecRef()
+	}
+
+	_, err = s.SeekByID(ident.StringID("foo"), resources)
+	require.Equal(t, errSeekIDNotFound, err)
+	require.NoError(t, s.Close())
+}
+
+func TestSeekValidateTieredIndexFileDigest(t *testing.T) {
+	tiered, cleanup := newTestTieredFiles(t)
+	defer cleanup()
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+	}
+	w := newTestWriter(t, tiered.filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+
+	shardDir := ShardDataDirPath(tiered.filePathPrefix, testNs1ID, 0)
+	indexFilePath := dataFilesetPathFromTimeAndIndex(shardDir, testWriterStart, 0, indexFileSuffix, false)
+	indexData, err := ioutil.ReadFile(indexFilePath)
+	require.NoError(t, err)
+	expectedDigest := digest.Checksum(indexData)
+
+	evictTestFiles(t, tiered, FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	})
+
+	s := newSeeker(seekerOpts{
+		filePathPrefix: tiered.filePathPrefix,
+		dataBufferSize: testReaderBufferSize,
+		infoBufferSize: testReaderBufferSize,
+		bytesPool:      testBytesPool,
+		opts:           testDefaultOpts,
+	}).(*seeker)
+	// Force full file validation, which reads the whole tiered index file.
+	s.versionChecker = schema.NewVersionChecker(1, 0)
+	s.indexFd, err = OpenReadAtFile(indexFilePath, tiered)
+	require.NoError(t, err)
+	defer s.indexFd.Close()
+
+	require.NoError(t, s.validateIndexFileDigest(expectedDigest))
+	require.Error(t, s.validateIndexFileDigest(expectedDigest+1))
+}
+
+func TestReadTieredFiles(t *testing.T) {
+	tiered, cleanup := newTestTieredFiles(t)
+	defer cleanup()
+
+	entries := []testEntry{
+		{"foo", nil, []byte{1, 2, 3}},
+		{"bar", nil, []byte{4, 5, 6}},
+	}
+	w := newTestWriter(t, tiered.filePathPrefix)
+	writeTestData(t, w, 0, testWriterStart, entries, persist.FileSetFlushType)
+	evictTestFiles(t, tiered, FileSetFileIdentifier{
+		Namespace:  testNs1ID,
+		Shard:      0,
+		BlockStart: testWriterStart,
+	})
+
+	r, err := NewReader(testBytesPool, testDefaultOpts.
+		SetFilePathPrefix(tiered.filePathPrefix).
+		SetInfoReaderBufferSize(testReaderBufferSize).
+		SetDataReaderBufferSize(testReaderBufferSize).
+		SetTieredFiles(tiered))
+	require.NoError(t, err)
+	readTestData(t, r, 0, testWriterStart, entries)
+}
+
+func TestTieredFileCheckpointFilePath(t *testing.T) {
+	shardDir := ShardDataDirPath("/var/lib/m3db", testNs1ID, 1)
+
+	filePath := filesetPathFromTimeAndIndex(shardDir, testWriterStart, 2, dataFileSuffix)
+	checkpointFilePath, err := TieredFileCheckpointFilePath(filePath)
+	require.NoError(t, err)
+	require.Equal(t,
+		filesetPathFromTimeAndIndex(shardDir, testWriterStart, 2, checkpointFileSuffix),
+		checkpointFilePath)
+
+	filePath = filesetPathFromTimeLegacy(shardDir, testWriterStart, indexFileSuffix)
+	checkpointFilePath, err = TieredFileCheckpointFilePath(filePath)
+	require.NoError(t, err)
+	require.Equal(t,
+		filesetPathFromTimeLegacy(shardDir, testWriterStart, checkpointFileSuffix),
+		checkpointFilePath)
+
+	_, err = TieredFileCheckpointFilePath(filepath.Join(shardDir, "foo.db"))
+	require.Error(t, err)
+}
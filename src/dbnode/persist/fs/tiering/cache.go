@@ -0,0 +1,332 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	chunkFileSuffix = ".chunk"
+	chunksDirPrefix = "chunks-"
+)
+
+type chunkKey struct {
+	key   string
+	index int64
+}
+
+type cacheEntry struct {
+	chunkKey
+	size int64
+}
+
+type chunkFetch struct {
+	done chan struct{}
+	err  error
+}
+
+type diskCacheMetrics struct {
+	hits      tally.Counter
+	misses    tally.Counter
+	evictions tally.Counter
+	errors    tally.Counter
+}
+
+func newDiskCacheMetrics(scope tally.Scope) diskCacheMetrics {
+	return diskCacheMetrics{
+		hits:      scope.Counter("hits"),
+		misses:    scope.Counter("misses"),
+		evictions: scope.Counter("evictions"),
+		errors:    scope.Counter("errors"),
+	}
+}
+
+// diskCache caches fixed size chunks of objects as files on local disk,
+// evicting the least recently used chunks once it grows beyond its maximum
+// size. Objects are immutable so cached chunks never need to be invalidated
+// unless the object is deleted.
+type diskCache struct {
+	sync.Mutex
+
+	dir       string
+	chunkSize int64
+	maxBytes  int64
+	store     ObjectStore
+	metrics   diskCacheMetrics
+
+	size    int64
+	lru     *list.List
+	entries map[chunkKey]*list.Element
+	fetches map[chunkKey]*chunkFetch
+}
+
+func newDiskCache(opts Options) (*diskCache, error) {
+	scope := opts.InstrumentOptions().MetricsScope().SubScope("cache")
+	// NB: Chunks are kept in a directory per chunk size so chunks cached with
+	// a different chunk size by a previous process are never read.
+	chunksDir := chunksDirPrefix + strconv.FormatInt(opts.CacheChunkSize(), 10)
+	c := &diskCache{
+		dir:       filepath.Join(opts.CacheDirectory(), chunksDir),
+		chunkSize: opts.CacheChunkSize(),
+		maxBytes:  opts.CacheMaxBytes(),
+		store:     opts.ObjectStore(),
+		metrics:   newDiskCacheMetrics(scope),
+		lru:       list.New(),
+		entries:   make(map[chunkKey]*list.Element),
+		fetches:   make(map[chunkKey]*chunkFetch),
+	}
+	if err := os.MkdirAll(c.dir, defaultObjectDirMode); err != nil {
+		return nil, err
+	}
+	if err := removeStaleChunkDirs(opts.CacheDirectory(), chunksDir); err != nil {
+		return nil, err
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func removeStaleChunkDirs(cacheDir, chunksDir string) error {
+	entries, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || !strings.HasPrefix(name, chunksDirPrefix) || name == chunksDir {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(cacheDir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// load adds the chunks cached by a previous process to the cache.
+func (c *diskCache) load() error {
+	err := filepath.Walk(c.dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if strings.HasPrefix(info.Name(), ".") {
+			// Left behind by an interrupted fetch.
+			return os.Remove(filePath)
+		}
+		relPath, err := filepath.Rel(c.dir, filePath)
+		if err != nil {
+			return err
+		}
+		ck, ok := chunkKeyFromPath(filepath.ToSlash(relPath))
+		if !ok {
+			return nil
+		}
+		c.addWithLock(ck, info.Size())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.evictWithLock()
+	return nil
+}
+
+// readAt reads len(p) bytes from a chunk of an object starting at the offset
+// within the chunk, fetching the chunk from the object store if not cached.
+func (c *diskCache) readAt(ck chunkKey, chunkLen int64, p []byte, off int64) (int, error) {
+	for attempt := 0; ; attempt++ {
+		if err := c.ensure(ck, chunkLen); err != nil {
+			return 0, err
+		}
+		n, err := c.readChunkFile(ck, p, off)
+		if os.IsNotExist(err) && attempt == 0 {
+			// Evicted between being fetched and read, fetch it again.
+			continue
+		}
+		return n, err
+	}
+}
+
+func (c *diskCache) ensure(ck chunkKey, chunkLen int64) error {
+	c.Lock()
+	if elem, ok := c.entries[ck]; ok {
+		c.lru.MoveToFront(elem)
+		c.Unlock()
+		c.metrics.hits.Inc(1)
+		return nil
+	}
+	if fetch, ok := c.fetches[ck]; ok {
+		// Another reader is already fetching the chunk.
+		c.Unlock()
+		<-fetch.done
+		return fetch.err
+	}
+	fetch := &chunkFetch{done: make(chan struct{})}
+	c.fetches[ck] = fetch
+	c.Unlock()
+
+	c.metrics.misses.Inc(1)
+	fetch.err = c.fetch(ck, chunkLen)
+	if fetch.err != nil {
+		c.metrics.errors.Inc(1)
+	}
+
+	c.Lock()
+	delete(c.fetches, ck)
+	if fetch.err == nil {
+		c.addWithLock(ck, chunkLen)
+		c.evictWithLock()
+	}
+	c.Unlock()
+
+	close(fetch.done)
+	return fetch.err
+}
+
+func (c *diskCache) fetch(ck chunkKey, chunkLen int64) error {
+	r, err := c.store.GetRange(ck.key, ck.index*c.chunkSize, chunkLen)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	filePath := c.chunkFilePath(ck)
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, defaultObjectDirMode); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	if n != chunkLen {
+		tmp.Close()
+		return fmt.Errorf("object %s chunk %d is %d bytes, expected %d bytes",
+			ck.key, ck.index, n, chunkLen)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (c *diskCache) readChunkFile(ck chunkKey, p []byte, off int64) (int, error) {
+	fd, err := os.Open(c.chunkFilePath(ck))
+	if err != nil {
+		return 0, err
+	}
+	defer fd.Close()
+
+	n, err := fd.ReadAt(p, off)
+	if err == io.EOF && n == len(p) {
+		err = nil
+	}
+	return n, err
+}
+
+// remove removes all cached chunks of an object.
+func (c *diskCache) remove(key string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var firstErr error
+	for ck, elem := range c.entries {
+		if ck.key != key {
+			continue
+		}
+		if err := c.removeWithLock(elem); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (c *diskCache) addWithLock(ck chunkKey, size int64) {
+	if _, ok := c.entries[ck]; ok {
+		return
+	}
+	c.entries[ck] = c.lru.PushFront(&cacheEntry{chunkKey: ck, size: size})
+	c.size += size
+}
+
+func (c *diskCache) evictWithLock() {
+	for c.size > c.maxBytes && c.lru.Len() > 0 {
+		c.removeWithLock(c.lru.Back())
+		c.metrics.evictions.Inc(1)
+	}
+}
+
+func (c *diskCache) removeWithLock(elem *list.Element) error {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.chunkKey)
+	c.size -= entry.size
+
+	err := os.Remove(c.chunkFilePath(entry.chunkKey))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *diskCache) chunkFilePath(ck chunkKey) string {
+	name := ck.key + "." + strconv.FormatInt(ck.index, 10) + chunkFileSuffix
+	return filepath.Join(c.dir, filepath.FromSlash(name))
+}
+
+func chunkKeyFromPath(name string) (chunkKey, bool) {
+	if !strings.HasSuffix(name, chunkFileSuffix) {
+		return chunkKey{}, false
+	}
+	name = strings.TrimSuffix(name, chunkFileSuffix)
+	idx := strings.LastIndex(name, ".")
+	if idx <= 0 {
+		return chunkKey{}, false
+	}
+	index, err := strconv.ParseInt(name[idx+1:], 10, 64)
+	if err != nil || index < 0 {
+		return chunkKey{}, false
+	}
+	return chunkKey{key: name[:idx], index: index}, true
+}
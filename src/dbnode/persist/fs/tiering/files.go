@@ -0,0 +1,148 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+)
+
+var errNegativeOffset = errors.New("negative offset")
+
+type tieredFiles struct {
+	filePathPrefix string
+	keyPrefix      string
+	store          ObjectStore
+	cache          *diskCache
+}
+
+func newTieredFiles(opts Options, cache *diskCache) *tieredFiles {
+	return &tieredFiles{
+		filePathPrefix: opts.FilesystemOptions().FilePathPrefix(),
+		keyPrefix:      opts.KeyPrefix(),
+		store:          opts.ObjectStore(),
+		cache:          cache,
+	}
+}
+
+func (f *tieredFiles) Open(filePath string) (fs.ReadAtFile, error) {
+	key, err := f.objectKey(filePath)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.store.Stat(key)
+	if err != nil {
+		return nil, err
+	}
+	return &tieredFile{
+		key:   key,
+		size:  info.Size,
+		cache: f.cache,
+	}, nil
+}
+
+// objectKey returns the key of the object a file under the file path prefix
+// is tiered to, keys mirror the layout of the files under the prefix.
+func (f *tieredFiles) objectKey(filePath string) (string, error) {
+	relPath, err := filepath.Rel(f.filePathPrefix, filePath)
+	if err != nil {
+		return "", err
+	}
+	relPath = filepath.ToSlash(relPath)
+	if relPath == ".." || strings.HasPrefix(relPath, "../") {
+		return "", fmt.Errorf("file %s is not under file path prefix %s", filePath, f.filePathPrefix)
+	}
+	return path.Join(f.keyPrefix, relPath), nil
+}
+
+// filePath returns the path of the file under the file path prefix which an
+// object was tiered from.
+func (f *tieredFiles) filePath(key string) (string, bool) {
+	relPath := key
+	if f.keyPrefix != "" {
+		prefix := strings.TrimSuffix(f.keyPrefix, "/") + "/"
+		if !strings.HasPrefix(key, prefix) {
+			return "", false
+		}
+		relPath = strings.TrimPrefix(key, prefix)
+	}
+	return filepath.Join(f.filePathPrefix, filepath.FromSlash(relPath)), true
+}
+
+// tieredFile reads a tiered file one cached chunk at a time, it holds no
+// resources of its own so that it can be shared freely between seekers.
+type tieredFile struct {
+	key   string
+	size  int64
+	cache *diskCache
+}
+
+func (f *tieredFile) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errNegativeOffset
+	}
+	if off >= f.size {
+		return 0, io.EOF
+	}
+
+	chunkSize := f.cache.chunkSize
+	n := 0
+	for n < len(p) && off < f.size {
+		var (
+			index    = off / chunkSize
+			chunkOff = off - index*chunkSize
+			chunkLen = chunkSize
+		)
+		if remaining := f.size - index*chunkSize; remaining < chunkLen {
+			chunkLen = remaining
+		}
+		end := int64(len(p) - n)
+		if remaining := chunkLen - chunkOff; remaining < end {
+			end = remaining
+		}
+
+		read, err := f.cache.readAt(chunkKey{key: f.key, index: index},
+			chunkLen, p[n:n+int(end)], chunkOff)
+		n += read
+		off += int64(read)
+		if err != nil {
+			return n, err
+		}
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *tieredFile) Size() int64 {
+	return f.size
+}
+
+func (f *tieredFile) Close() error {
+	return nil
+}
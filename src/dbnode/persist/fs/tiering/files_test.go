@@ -0,0 +1,205 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testChunkSize = 4
+
+type testFilesSetup struct {
+	dir            string
+	filePathPrefix string
+	store          ObjectStore
+	opts           Options
+}
+
+func newTestFilesSetup(t *testing.T) testFilesSetup {
+	dir, err := ioutil.TempDir("", "tiering-files")
+	require.NoError(t, err)
+
+	store, err := NewFilesystemObjectStore(filepath.Join(dir, "store"), "m3db")
+	require.NoError(t, err)
+
+	filePathPrefix := filepath.Join(dir, "node")
+	opts := NewOptions().
+		SetFilesystemOptions(fs.NewOptions().SetFilePathPrefix(filePathPrefix)).
+		SetObjectStore(store).
+		SetKeyPrefix("node-a").
+		SetCacheDirectory(filepath.Join(dir, "cache")).
+		SetCacheChunkSize(testChunkSize).
+		SetCacheMaxBytes(3 * testChunkSize)
+	return testFilesSetup{
+		dir:            dir,
+		filePathPrefix: filePathPrefix,
+		store:          store,
+		opts:           opts,
+	}
+}
+
+func (s testFilesSetup) newTieredFiles(t *testing.T) *tieredFiles {
+	cache, err := newDiskCache(s.opts)
+	require.NoError(t, err)
+	return newTieredFiles(s.opts, cache)
+}
+
+func TestTieredFilesObjectKey(t *testing.T) {
+	s := newTestFilesSetup(t)
+	defer os.RemoveAll(s.dir)
+
+	files := s.newTieredFiles(t)
+	filePath := filepath.Join(s.filePathPrefix, "data", "ns", "0", "a.db")
+	key, err := files.objectKey(filePath)
+	require.NoError(t, err)
+	require.Equal(t, "node-a/data/ns/0/a.db", key)
+
+	actual, ok := files.filePath(key)
+	require.True(t, ok)
+	require.Equal(t, filePath, actual)
+
+	_, ok = files.filePath("node-b/data/ns/0/a.db")
+	require.False(t, ok)
+
+	_, err = files.objectKey(filepath.Join(s.dir, "other", "a.db"))
+	require.Error(t, err)
+}
+
+func TestTieredFilesOpenNotExist(t *testing.T) {
+	s := newTestFilesSetup(t)
+	defer os.RemoveAll(s.dir)
+
+	files := s.newTieredFiles(t)
+	_, err := files.Open(filepath.Join(s.filePathPrefix, "data", "ns", "0", "a.db"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestTieredFilesReadAt(t *testing.T) {
+	s := newTestFilesSetup(t)
+	defer os.RemoveAll(s.dir)
+
+	data := []byte("0123456789abcdefghij-")
+	require.NoError(t, s.store.Put("node-a/data/ns/0/a.db", bytes.NewReader(data)))
+
+	files := s.newTieredFiles(t)
+	f, err := files.Open(filepath.Join(s.filePathPrefix, "data", "ns", "0", "a.db"))
+	require.NoError(t, err)
+	defer f.Close()
+	require.Equal(t, int64(len(data)), f.Size())
+
+	// Reads which span chunks, including the short last chunk.
+	for _, tc := range []struct {
+		off int64
+		n   int
+	}{
+		{0, 1},
+		{2, 7},
+		{3, 1},
+		{0, len(data)},
+		{17, 4},
+		{20, 1},
+	} {
+		p := make([]byte, tc.n)
+		n, err := f.ReadAt(p, tc.off)
+		require.NoError(t, err)
+		require.Equal(t, tc.n, n)
+		require.Equal(t, data[tc.off:tc.off+int64(tc.n)], p)
+	}
+
+	// Reads past the end of the file.
+	p := make([]byte, 4)
+	n, err := f.ReadAt(p, 19)
+	require.Equal(t, io.EOF, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, data[19:], p[:n])
+
+	_, err = f.ReadAt(p, int64(len(data)))
+	require.Equal(t, io.EOF, err)
+
+	// The cache stays within its maximum size.
+	require.True(t, files.cache.size <= 3*testChunkSize)
+	require.Equal(t, files.cache.size, cachedBytes(t, files.cache.dir))
+}
+
+func TestTieredFilesCacheReload(t *testing.T) {
+	s := newTestFilesSetup(t)
+	defer os.RemoveAll(s.dir)
+
+	data := []byte("0123456789")
+	require.NoError(t, s.store.Put("node-a/data/ns/0/a.db", bytes.NewReader(data)))
+
+	filePath := filepath.Join(s.filePathPrefix, "data", "ns", "0", "a.db")
+	files := s.newTieredFiles(t)
+	f, err := files.Open(filePath)
+	require.NoError(t, err)
+	p := make([]byte, 6)
+	_, err = f.ReadAt(p, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2*testChunkSize), files.cache.size)
+
+	// Chunks cached by a previous process are reused, they are served even
+	// once the object is gone.
+	files = s.newTieredFiles(t)
+	require.Equal(t, int64(2*testChunkSize), files.cache.size)
+	require.NoError(t, s.store.Delete("node-a/data/ns/0/a.db"))
+
+	f = &tieredFile{key: "node-a/data/ns/0/a.db", size: int64(len(data)), cache: files.cache}
+	p = make([]byte, 6)
+	_, err = f.ReadAt(p, 0)
+	require.NoError(t, err)
+	require.Equal(t, data[:6], p)
+
+	require.NoError(t, files.cache.remove("node-a/data/ns/0/a.db"))
+	require.Equal(t, int64(0), files.cache.size)
+	require.Equal(t, int64(0), cachedBytes(t, files.cache.dir))
+
+	// Chunks cached with a different chunk size are discarded.
+	s.opts = s.opts.SetCacheChunkSize(2 * testChunkSize)
+	files = s.newTieredFiles(t)
+	entries, err := ioutil.ReadDir(filepath.Join(s.dir, "cache"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "chunks-8", entries[0].Name())
+}
+
+func cachedBytes(t *testing.T, dir string) int64 {
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return size
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+type managerMetrics struct {
+	uploadedFiles  tally.Counter
+	uploadedBytes  tally.Counter
+	evictedFiles   tally.Counter
+	deletedObjects tally.Counter
+	errors         tally.Counter
+}
+
+func newManagerMetrics(scope tally.Scope) managerMetrics {
+	return managerMetrics{
+		uploadedFiles:  scope.Counter("uploaded-files"),
+		uploadedBytes:  scope.Counter("uploaded-bytes"),
+		evictedFiles:   scope.Counter("evicted-files"),
+		deletedObjects: scope.Counter("deleted-objects"),
+		errors:         scope.Counter("errors"),
+	}
+}
+
+type manager struct {
+	opts           Options
+	filePathPrefix string
+	store          ObjectStore
+	files          *tieredFiles
+	metrics        managerMetrics
+	logger         *zap.Logger
+}
+
+// NewManager returns a new tiering manager.
+func NewManager(opts Options) (Manager, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	iOpts := opts.InstrumentOptions()
+	cache, err := newDiskCache(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &manager{
+		opts:           opts,
+		filePathPrefix: opts.FilesystemOptions().FilePathPrefix(),
+		store:          opts.ObjectStore(),
+		files:          newTieredFiles(opts, cache),
+		metrics:        newManagerMetrics(iOpts.MetricsScope()),
+		logger:         iOpts.Logger(),
+	}, nil
+}
+
+func (m *manager) TieredFiles() fs.TieredFiles {
+	return m.files
+}
+
+func (m *manager) Tier(md namespace.Metadata, shards []uint32, now xtime.UnixNano) error {
+	var (
+		multiErr  = xerrors.NewMultiError()
+		tierAfter = md.Options().TierAfter()
+		blockSize = md.Options().RetentionOptions().BlockSize()
+	)
+	if tierAfter > 0 {
+		// Only blocks which ended at least tierAfter ago are tiered.
+		latestBlockEnd := now.Add(-tierAfter)
+		for _, shard := range shards {
+			if err := m.tierShard(md.ID(), shard, blockSize, latestBlockEnd); err != nil {
+				multiErr = multiErr.Add(err)
+			}
+		}
+	}
+
+	// NB: Delete the objects of filesets which no longer exist locally even
+	// if tiering was disabled for the namespace since they were tiered.
+	if err := m.deleteOrphanedObjects(md.ID()); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
+	if err := multiErr.FinalError(); err != nil {
+		m.metrics.errors.Inc(1)
+		return err
+	}
+	return nil
+}
+
+func (m *manager) tierShard(
+	nsID ident.ID,
+	shard uint32,
+	blockSize time.Duration,
+	latestBlockEnd xtime.UnixNano,
+) error {
+	filesets, err := fs.DataFiles(m.filePathPrefix, nsID, shard)
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	seen := make(map[xtime.UnixNano]struct{}, len(filesets))
+	for _, fileset := range filesets {
+		blockStart := fileset.ID.BlockStart
+		if _, ok := seen[blockStart]; ok {
+			continue
+		}
+		seen[blockStart] = struct{}{}
+
+		if blockStart.Add(blockSize).After(latestBlockEnd) {
+			continue
+		}
+
+		// Earlier volumes are deleted once compacted into the latest volume,
+		// so only the latest volume is worth tiering.
+		latest, ok := filesets.LatestVolumeForBlock(blockStart)
+		if !ok {
+			continue
+		}
+		if err := m.tierFileSet(latest); err != nil {
+			multiErr = multiErr.Add(fmt.Errorf(
+				"could not tier fileset: namespace=%s, shard=%d, blockStart=%d, volume=%d: %w",
+				nsID.String(), shard, blockStart, latest.ID.VolumeIndex, err))
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (m *manager) tierFileSet(fileset fs.FileSetFile) error {
+	filePaths, err := fs.TierableFilePaths(m.filePathPrefix, fileset.ID)
+	if err != nil {
+		return err
+	}
+
+	var digests map[string]uint32
+	evicted := false
+	for _, filePath := range filePaths {
+		if _, err := os.Stat(filePath); os.IsNotExist(err) {
+			// Already evicted.
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if digests == nil {
+			digests, err = fs.FileSetDigests(fileset, persist.FileSetDataContentType)
+			if err != nil {
+				return err
+			}
+		}
+		expectedDigest, ok := digests[filePath]
+		if !ok {
+			return fmt.Errorf("no digest for file: %s", filePath)
+		}
+		if err := m.upload(filePath, expectedDigest); err != nil {
+			return err
+		}
+
+		if pins := m.opts.FilePins(); pins != nil && len(pins.Unpinned([]string{filePath})) == 0 {
+			// Pinned by an in progress backup, evict it on a later run.
+			continue
+		}
+		if err := os.Remove(filePath); err != nil {
+			return err
+		}
+		m.metrics.evictedFiles.Inc(1)
+		evicted = true
+	}
+
+	if !evicted {
+		return nil
+	}
+
+	leaseManager := m.opts.BlockLeaseManager()
+	if leaseManager == nil {
+		return nil
+	}
+
+	// Reopen the seekers of the block so that they read from the object store
+	// and release the evicted files, otherwise their disk space would not be
+	// reclaimed until the seekers are next closed.
+	_, err = leaseManager.UpdateOpenLeases(block.LeaseDescriptor{
+		Namespace:  fileset.ID.Namespace,
+		Shard:      fileset.ID.Shard,
+		BlockStart: fileset.ID.BlockStart,
+	}, block.LeaseState{
+		Volume: fileset.ID.VolumeIndex,
+	})
+	return err
+}
+
+func (m *manager) upload(filePath string, expectedDigest uint32) error {
+	key, err := m.files.objectKey(filePath)
+	if err != nil {
+		return err
+	}
+
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	reader := fs.NewChecksumReader(fd)
+	if err := m.store.Put(key, reader); err != nil {
+		return err
+	}
+
+	// Verify what was uploaded before the local copy is evicted.
+	if actual := reader.Checksum(); actual != expectedDigest {
+		return m.deleteInvalidObject(key, fmt.Errorf(
+			"file checksum does not match digest: path=%s, expected=%d, actual=%d",
+			filePath, expectedDigest, actual))
+	}
+	info, err := m.store.Stat(key)
+	if err != nil {
+		return err
+	}
+	if info.Size != reader.Size() {
+		return m.deleteInvalidObject(key, fmt.Errorf(
+			"object size does not match file size: key=%s, expected=%d, actual=%d",
+			key, reader.Size(), info.Size))
+	}
+
+	m.metrics.uploadedFiles.Inc(1)
+	m.metrics.uploadedBytes.Inc(reader.Size())
+	return nil
+}
+
+func (m *manager) deleteInvalidObject(key string, err error) error {
+	if deleteErr := m.store.Delete(key); deleteErr != nil {
+		m.logger.Error("could not delete invalid object",
+			zap.String("key", key), zap.Error(deleteErr))
+	}
+	return err
+}
+
+func (m *manager) deleteOrphanedObjects(nsID ident.ID) error {
+	prefix, err := m.files.objectKey(fs.NamespaceDataDirPath(m.filePathPrefix, nsID))
+	if err != nil {
+		return err
+	}
+
+	objects, err := m.store.List(prefix + "/")
+	if err != nil {
+		return err
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, object := range objects {
+		filePath, ok := m.files.filePath(object.Key)
+		if !ok {
+			continue
+		}
+		checkpointFilePath, err := fs.TieredFileCheckpointFilePath(filePath)
+		if err != nil {
+			m.logger.Warn("skipping unexpected object",
+				zap.String("key", object.Key), zap.Error(err))
+			continue
+		}
+		exists, err := fs.PathExists(checkpointFilePath)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		if err := m.store.Delete(object.Key); err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+		if err := m.files.cache.remove(object.Key); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+		m.metrics.deletedObjects.Inc(1)
+	}
+	return multiErr.FinalError()
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/fstest"
+	"github.com/m3db/m3/src/dbnode/retention"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/pool"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBlockSize = 2 * time.Hour
+	testTierAfter = 6 * time.Hour
+)
+
+var (
+	testNamespace  = ident.StringID("metrics")
+	testBlockStart = xtime.Now().Truncate(testBlockSize).Add(-10 * testBlockSize)
+)
+
+type testManagerSetup struct {
+	testFilesSetup
+
+	pins    fs.FilePins
+	manager Manager
+}
+
+func newTestManagerSetup(t *testing.T, leaseManager block.LeaseManager) testManagerSetup {
+	s := newTestFilesSetup(t)
+	pins := fs.NewFilePins()
+	s.opts = s.opts.
+		SetCacheChunkSize(defaultCacheChunkSize).
+		SetCacheMaxBytes(defaultCacheChunkSize).
+		SetFilePins(pins).
+		SetBlockLeaseManager(leaseManager)
+
+	manager, err := NewManager(s.opts)
+	require.NoError(t, err)
+	return testManagerSetup{
+		testFilesSetup: s,
+		pins:           pins,
+		manager:        manager,
+	}
+}
+
+func newTestMetadata(t *testing.T, tierAfter time.Duration) namespace.Metadata {
+	md, err := namespace.NewMetadata(testNamespace, namespace.NewOptions().
+		SetRetentionOptions(retention.NewOptions().
+			SetBlockSize(testBlockSize).
+			SetRetentionPeriod(48*time.Hour)).
+		SetTierAfter(tierAfter))
+	require.NoError(t, err)
+	return md
+}
+
+func writeTestFileSet(t *testing.T, filePathPrefix string, blockStart xtime.UnixNano) fs.FileSetFileIdentifier {
+	id := fs.FileSetFileIdentifier{
+		Namespace:  testNamespace,
+		Shard:      1,
+		BlockStart: blockStart,
+	}
+	fstest.WriteFileSet(t, filePathPrefix, fs.DataWriterOpenOptions{
+		FileSetType: persist.FileSetFlushType,
+		BlockSize:   testBlockSize,
+		Identifier:  id,
+	})
+	return id
+}
+
+func requireTieredFiles(t *testing.T, s testManagerSetup, id fs.FileSetFileIdentifier, evicted bool) {
+	filePaths, err := fs.TierableFilePaths(s.filePathPrefix, id)
+	require.NoError(t, err)
+	for _, filePath := range filePaths {
+		_, err := os.Stat(filePath)
+		require.Equal(t, evicted, os.IsNotExist(err), filePath)
+
+		rel, err := filepath.Rel(s.filePathPrefix, filePath)
+		require.NoError(t, err)
+		_, err = s.store.Stat("node-a/" + filepath.ToSlash(rel))
+		require.Equal(t, evicted, err == nil, filePath)
+	}
+}
+
+func TestManagerTier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	leaseManager := block.NewMockLeaseManager(ctrl)
+	s := newTestManagerSetup(t, leaseManager)
+	defer os.RemoveAll(s.dir)
+
+	var (
+		md       = newTestMetadata(t, testTierAfter)
+		now      = xtime.Now()
+		oldID    = writeTestFileSet(t, s.filePathPrefix, testBlockStart)
+		recentID = writeTestFileSet(t, s.filePathPrefix,
+			now.Add(-testTierAfter).Truncate(testBlockSize))
+	)
+
+	leaseManager.EXPECT().
+		UpdateOpenLeases(gomock.Any(), block.LeaseState{Volume: 0}).
+		DoAndReturn(func(descriptor block.LeaseDescriptor, _ block.LeaseState) (block.UpdateLeasesResult, error) {
+			require.True(t, descriptor.Namespace.Equal(testNamespace))
+			require.Equal(t, uint32(1), descriptor.Shard)
+			require.Equal(t, testBlockStart, descriptor.BlockStart)
+			return block.UpdateLeasesResult{}, nil
+		})
+	require.NoError(t, s.manager.Tier(md, []uint32{1}, now))
+
+	requireTieredFiles(t, s, oldID, true)
+	requireTieredFiles(t, s, recentID, false)
+
+	// Tiering again is a no-op.
+	require.NoError(t, s.manager.Tier(md, []uint32{1}, now))
+
+	// The evicted files are read from the object store.
+	fsOpts := s.opts.FilesystemOptions().SetTieredFiles(s.manager.TieredFiles())
+	bytesPool := pool.NewCheckedBytesPool([]pool.Bucket{{
+		Capacity: 1024,
+		Count:    10,
+	}}, nil, func(s []pool.Bucket) pool.BytesPool {
+		return pool.NewBytesPool(s, nil)
+	})
+	bytesPool.Init()
+	seeker := fs.NewSeeker(s.filePathPrefix, 1024, 1024, bytesPool, false, fsOpts)
+	resources := fs.NewReusableSeekerResources(fsOpts)
+	require.NoError(t, seeker.Open(testNamespace, 1, testBlockStart, 0, resources))
+	for i := 0; i < fstest.NumSeries; i++ {
+		data, err := seeker.SeekByID(fstest.SeriesID(i), resources)
+		require.NoError(t, err)
+		data.IncRef()
+		require.Equal(t, fstest.SeriesData(i), string(data.Bytes()))
+		data.DecRef()
+	}
+	require.NoError(t, seeker.Close())
+}
+
+func TestManagerTierDisabled(t *testing.T) {
+	s := newTestManagerSetup(t, nil)
+	defer os.RemoveAll(s.dir)
+
+	id := writeTestFileSet(t, s.filePathPrefix, testBlockStart)
+	require.NoError(t, s.manager.Tier(newTestMetadata(t, 0), []uint32{1}, xtime.Now()))
+	requireTieredFiles(t, s, id, false)
+}
+
+func TestManagerTierPinnedFiles(t *testing.T) {
+	s := newTestManagerSetup(t, nil)
+	defer os.RemoveAll(s.dir)
+
+	id := writeTestFileSet(t, s.filePathPrefix, testBlockStart)
+	filePaths, err := fs.TierableFilePaths(s.filePathPrefix, id)
+	require.NoError(t, err)
+
+	md := newTestMetadata(t, testTierAfter)
+	release := s.pins.Pin(filePaths)
+	require.NoError(t, s.manager.Tier(md, []uint32{1}, xtime.Now()))
+	for _, filePath := range filePaths {
+		_, err := os.Stat(filePath)
+		require.NoError(t, err)
+	}
+
+	release()
+	require.NoError(t, s.manager.Tier(md, []uint32{1}, xtime.Now()))
+	requireTieredFiles(t, s, id, true)
+}
+
+func TestManagerTierCorruptFile(t *testing.T) {
+	s := newTestManagerSetup(t, nil)
+	defer os.RemoveAll(s.dir)
+
+	id := writeTestFileSet(t, s.filePathPrefix, testBlockStart)
+	filePaths, err := fs.TierableFilePaths(s.filePathPrefix, id)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filePaths[0], []byte("corrupt"), 0644))
+
+	require.Error(t, s.manager.Tier(newTestMetadata(t, testTierAfter), []uint32{1}, xtime.Now()))
+
+	// Nothing is evicted and the invalid object is deleted.
+	_, err = os.Stat(filePaths[0])
+	require.NoError(t, err)
+	objects, err := s.store.List("node-a/")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
+
+func TestManagerDeleteOrphanedObjects(t *testing.T) {
+	s := newTestManagerSetup(t, nil)
+	defer os.RemoveAll(s.dir)
+
+	id := writeTestFileSet(t, s.filePathPrefix, testBlockStart)
+	md := newTestMetadata(t, testTierAfter)
+	require.NoError(t, s.manager.Tier(md, []uint32{1}, xtime.Now()))
+	requireTieredFiles(t, s, id, true)
+
+	// Objects are kept while the fileset's checkpoint file exists.
+	objects, err := s.store.List("node-a/")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+
+	// Once cleanup removes the fileset its objects are deleted, even with
+	// tiering disabled for the namespace.
+	require.NoError(t, fs.DeleteFileSetAt(s.filePathPrefix, testNamespace, 1, testBlockStart, 0))
+	require.NoError(t, s.manager.Tier(newTestMetadata(t, 0), []uint32{1}, xtime.Now()))
+
+	objects, err = s.store.List("node-a/")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
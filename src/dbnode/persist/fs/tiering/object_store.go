@@ -0,0 +1,182 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultObjectFileMode = os.FileMode(0666)
+	defaultObjectDirMode  = os.ModeDir | os.FileMode(0755)
+)
+
+type filesystemObjectStore struct {
+	bucketDir string
+}
+
+// NewFilesystemObjectStore returns an object store which keeps objects in a
+// local directory, using the same layout as a minio server in filesystem mode
+// where the objects of a bucket are stored as files at <rootDir>/<bucket>/<key>.
+// The directory may be a network mount, or be served by minio itself.
+func NewFilesystemObjectStore(rootDir, bucket string) (ObjectStore, error) {
+	if bucket == "" || strings.ContainsAny(bucket, `/\`) || strings.HasPrefix(bucket, ".") {
+		return nil, fmt.Errorf("invalid bucket name: %s", bucket)
+	}
+	bucketDir := filepath.Join(rootDir, bucket)
+	if err := os.MkdirAll(bucketDir, defaultObjectDirMode); err != nil {
+		return nil, err
+	}
+	return &filesystemObjectStore{bucketDir: bucketDir}, nil
+}
+
+func (s *filesystemObjectStore) Put(key string, r io.Reader) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, defaultObjectDirMode); err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it so that a partially written
+	// object is never visible under the key.
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(defaultObjectFileMode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (s *filesystemObjectStore) GetRange(key string, offset, length int64) (io.ReadCloser, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return rangeReadCloser{
+		Reader: io.NewSectionReader(fd, offset, length),
+		Closer: fd,
+	}, nil
+}
+
+func (s *filesystemObjectStore) Stat(key string) (ObjectInfo, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if stat.IsDir() {
+		return ObjectInfo{}, &os.PathError{Op: "stat", Path: filePath, Err: os.ErrNotExist}
+	}
+	return ObjectInfo{Key: key, Size: stat.Size()}, nil
+}
+
+func (s *filesystemObjectStore) List(prefix string) ([]ObjectInfo, error) {
+	// Only walk the deepest directory which contains all keys with the prefix.
+	walkDir := filepath.Join(s.bucketDir, filepath.FromSlash(path.Dir("/"+prefix+"_")))
+
+	var result []ObjectInfo
+	err := filepath.Walk(walkDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			return nil
+		}
+		relPath, err := filepath.Rel(s.bucketDir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relPath)
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, ObjectInfo{Key: key, Size: info.Size()})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result, nil
+}
+
+func (s *filesystemObjectStore) Delete(key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *filesystemObjectStore) filePath(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || cleaned != "/"+key {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+	return filepath.Join(s.bucketDir, filepath.FromSlash(cleaned)), nil
+}
+
+type rangeReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestObjectStore(t *testing.T) (ObjectStore, string) {
+	dir, err := ioutil.TempDir("", "tiering-store")
+	require.NoError(t, err)
+
+	store, err := NewFilesystemObjectStore(dir, "m3db")
+	require.NoError(t, err)
+	return store, dir
+}
+
+func TestFilesystemObjectStoreInvalidBucket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tiering-store")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, bucket := range []string{"", ".hidden", "a/b"} {
+		_, err := NewFilesystemObjectStore(dir, bucket)
+		require.Error(t, err, bucket)
+	}
+}
+
+func TestFilesystemObjectStorePutGetRange(t *testing.T) {
+	store, dir := newTestObjectStore(t)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, store.Put("data/ns/0/a.db", bytes.NewReader([]byte("0123456789"))))
+
+	// Objects use the minio filesystem layout.
+	_, err := os.Stat(filepath.Join(dir, "m3db", "data", "ns", "0", "a.db"))
+	require.NoError(t, err)
+
+	info, err := store.Stat("data/ns/0/a.db")
+	require.NoError(t, err)
+	require.Equal(t, ObjectInfo{Key: "data/ns/0/a.db", Size: 10}, info)
+
+	r, err := store.GetRange("data/ns/0/a.db", 3, 4)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "3456", string(data))
+
+	// Overwriting replaces the object.
+	require.NoError(t, store.Put("data/ns/0/a.db", bytes.NewReader([]byte("abc"))))
+	info, err = store.Stat("data/ns/0/a.db")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), info.Size)
+}
+
+func TestFilesystemObjectStoreStatNotExist(t *testing.T) {
+	store, dir := newTestObjectStore(t)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, store.Put("data/ns/0/a.db", bytes.NewReader(nil)))
+
+	_, err := store.Stat("data/ns/0/b.db")
+	require.True(t, os.IsNotExist(err))
+
+	// Directories are not objects.
+	_, err = store.Stat("data/ns/0")
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFilesystemObjectStoreListDelete(t *testing.T) {
+	store, dir := newTestObjectStore(t)
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{
+		"data/ns/0/a.db",
+		"data/ns/1/b.db",
+		"data/ns2/0/c.db",
+		"other/d.db",
+	} {
+		require.NoError(t, store.Put(key, bytes.NewReader([]byte(key))))
+	}
+
+	objects, err := store.List("data/ns/")
+	require.NoError(t, err)
+	require.Equal(t, []ObjectInfo{
+		{Key: "data/ns/0/a.db", Size: 14},
+		{Key: "data/ns/1/b.db", Size: 14},
+	}, objects)
+
+	objects, err = store.List("data/ns")
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	objects, err = store.List("missing/")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	require.NoError(t, store.Delete("data/ns/0/a.db"))
+	// Deleting a missing object is not an error.
+	require.NoError(t, store.Delete("data/ns/0/a.db"))
+
+	objects, err = store.List("data/ns/")
+	require.NoError(t, err)
+	require.Equal(t, []ObjectInfo{{Key: "data/ns/1/b.db", Size: 14}}, objects)
+}
+
+func TestFilesystemObjectStoreInvalidKey(t *testing.T) {
+	store, dir := newTestObjectStore(t)
+	defer os.RemoveAll(dir)
+
+	for _, key := range []string{"", "/a", "a/../../b", "a//b", "a/"} {
+		require.Error(t, store.Put(key, bytes.NewReader(nil)), key)
+		_, err := store.Stat(key)
+		require.Error(t, err, key)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tiering
+
+import (
+	"errors"
+
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+const (
+	// defaultCacheChunkSize is large enough that a single fetch usually
+	// covers a series and the index entries scanned to find it.
+	defaultCacheChunkSize = 1 << 20 // 1mb
+
+	defaultCacheMaxBytes = 10 << 30 // 10gb
+)
+
+var (
+	errFilesystemOptionsNotSet = errors.New("filesystem options not set")
+	errObjectStoreNotSet       = errors.New("object store not set")
+	errCacheDirectoryNotSet    = errors.New("cache directory not set")
+	errCacheChunkSizeInvalid   = errors.New("cache chunk size must be positive")
+	errCacheMaxBytesInvalid    = errors.New("cache max bytes must be at least the cache chunk size")
+)
+
+type options struct {
+	fsOpts         fs.Options
+	objectStore    ObjectStore
+	keyPrefix      string
+	cacheDir       string
+	cacheChunkSize int64
+	cacheMaxBytes  int64
+	filePins       fs.FilePins
+	leaseManager   block.LeaseManager
+	instrumentOpts instrument.Options
+}
+
+// NewOptions returns new tiering options.
+func NewOptions() Options {
+	return &options{
+		fsOpts:         fs.NewOptions(),
+		cacheChunkSize: defaultCacheChunkSize,
+		cacheMaxBytes:  defaultCacheMaxBytes,
+		instrumentOpts: instrument.NewOptions(),
+	}
+}
+
+func (o *options) Validate() error {
+	if o.fsOpts == nil {
+		return errFilesystemOptionsNotSet
+	}
+	if o.objectStore == nil {
+		return errObjectStoreNotSet
+	}
+	if o.cacheDir == "" {
+		return errCacheDirectoryNotSet
+	}
+	if o.cacheChunkSize <= 0 {
+		return errCacheChunkSizeInvalid
+	}
+	if o.cacheMaxBytes < o.cacheChunkSize {
+		return errCacheMaxBytesInvalid
+	}
+	return nil
+}
+
+func (o *options) SetFilesystemOptions(value fs.Options) Options {
+	opts := *o
+	opts.fsOpts = value
+	return &opts
+}
+
+func (o *options) FilesystemOptions() fs.Options {
+	return o.fsOpts
+}
+
+func (o *options) SetObjectStore(value ObjectStore) Options {
+	opts := *o
+	opts.objectStore = value
+	return &opts
+}
+
+func (o *options) ObjectStore() ObjectStore {
+	return o.objectStore
+}
+
+func (o *options) SetKeyPrefix(value string) Options {
+	opts := *o
+	opts.keyPrefix = value
+	return &opts
+}
+
+func (o *options) KeyPrefix() string {
+	return o.keyPrefix
+}
+
+func (o *options) SetCacheDirectory(value string) Options {
+	opts := *o
+	opts.cacheDir = value
+	return &opts
+}
+
+func (o *options) CacheDirectory() string {
+	return o.cacheDir
+}
+
+func (o *options) SetCacheChunkSize(value int64) Options {
+	opts := *o
+	opts.cacheChunkSize = value
+	return &opts
+}
+
+func (o *options) CacheChunkSize() int64 {
+	return o.cacheChunkSize
+}
+
+func (o *options) SetCacheMaxBytes(value int64) Options {
+	opts := *o
+	opts.cacheMaxBytes = value
+	return &opts
+}
+
+func (o *options) CacheMaxBytes() int64 {
+	return o.cacheMaxBytes
+}
+
+func (o *options) SetFilePins(value fs.FilePins) Options {
+	opts := *o
+	opts.filePins = value
+	return &opts
+}
+
+func (o *options) FilePins() fs.FilePins {
+	return o.filePins
+}
+
+func (o *options) SetBlockLeaseManager(value block.LeaseManager) Options {
+	opts := *o
+	opts.leaseManager = value
+	return &opts
+}
+
+func (o *options) BlockLeaseManager() block.LeaseManager {
+	return o.leaseManager
+}
+
+func (o *options) SetInstrumentOptions(value instrument.Options) Options {
+	opts := *o
+	opts.instrumentOpts = value
+	return &opts
+}
+
+func (o *options) InstrumentOptions() instrument.Options {
+	return o.instrumentOpts
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tiering moves the filesets of cold blocks from local disk to an
+// object store and serves reads of them on demand through a local disk cache.
+package tiering
+
+import (
+	"io"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// ObjectStore is a bucket of an object store, such as S3 or minio, which
+// holds tiered fileset files. Keys are slash separated paths.
+type ObjectStore interface {
+	// Put uploads the contents of the reader as an object, replacing any
+	// object already stored under the key.
+	Put(key string, r io.Reader) error
+
+	// GetRange returns a reader over length bytes of an object starting at
+	// the offset.
+	GetRange(key string, offset, length int64) (io.ReadCloser, error)
+
+	// Stat returns the info of an object, returning an error which satisfies
+	// os.IsNotExist if there is no object stored under the key.
+	Stat(key string) (ObjectInfo, error)
+
+	// List returns the info of all objects with keys beginning with the prefix.
+	List(prefix string) ([]ObjectInfo, error)
+
+	// Delete deletes an object, deleting an object which does not exist is
+	// not an error.
+	Delete(key string) error
+}
+
+// ObjectInfo describes an object in an object store.
+type ObjectInfo struct {
+	Key  string
+	Size int64
+}
+
+// Manager moves the filesets of blocks older than the tiering threshold of
+// their namespace to the object store.
+type Manager interface {
+	// Tier uploads the index and data files of the filesets of the shards of
+	// a namespace whose blocks ended at least the namespace TierAfter ago and
+	// evicts them from local disk. It also deletes the objects of filesets
+	// which no longer exist on local disk, such as expired filesets.
+	Tier(md namespace.Metadata, shards []uint32, now xtime.UnixNano) error
+
+	// TieredFiles returns the tiered files which read evicted fileset files
+	// from the object store through the local disk cache.
+	TieredFiles() fs.TieredFiles
+}
+
+// Options represents the options for tiering.
+type Options interface {
+	// Validate validates the options.
+	Validate() error
+
+	// SetFilesystemOptions sets the filesystem options.
+	SetFilesystemOptions(value fs.Options) Options
+
+	// FilesystemOptions returns the filesystem options.
+	FilesystemOptions() fs.Options
+
+	// SetObjectStore sets the object store which filesets are tiered to.
+	SetObjectStore(value ObjectStore) Options
+
+	// ObjectStore returns the object store which filesets are tiered to.
+	ObjectStore() ObjectStore
+
+	// SetKeyPrefix sets the prefix of the keys of tiered objects, which
+	// must be unique per node when nodes share a bucket.
+	SetKeyPrefix(value string) Options
+
+	// KeyPrefix returns the prefix of the keys of tiered objects.
+	KeyPrefix() string
+
+	// SetCacheDirectory sets the directory of the local disk cache.
+	SetCacheDirectory(value string) Options
+
+	// CacheDirectory returns the directory of the local disk cache.
+	CacheDirectory() string
+
+	// SetCacheChunkSize sets the size of the ranges of tiered files which
+	// are fetched and cached at a time.
+	SetCacheChunkSize(value int64) Options
+
+	// CacheChunkSize returns the size of the ranges of tiered files which
+	// are fetched and cached at a time.
+	CacheChunkSize() int64
+
+	// SetCacheMaxBytes sets the maximum size of the local disk cache.
+	SetCacheMaxBytes(value int64) Options
+
+	// CacheMaxBytes returns the maximum size of the local disk cache.
+	CacheMaxBytes() int64
+
+	// SetFilePins sets the file pins, pinned files are not evicted.
+	SetFilePins(value fs.FilePins) Options
+
+	// FilePins returns the file pins.
+	FilePins() fs.FilePins
+
+	// SetBlockLeaseManager sets the block lease manager used to reopen the
+	// seekers of blocks once their files have been evicted.
+	SetBlockLeaseManager(value block.LeaseManager) Options
+
+	// BlockLeaseManager returns the block lease manager.
+	BlockLeaseManager() block.LeaseManager
+
+	// SetInstrumentOptions sets the instrument options.
+	SetInstrumentOptions(value instrument.Options) Options
+
+	// InstrumentOptions returns the instrument options.
+	InstrumentOptions() instrument.Options
+}
@@ -555,6 +555,14 @@ type Options interface {
 
 	// EncodingOptions returns the encoder options used by the encoder.
 	EncodingOptions() msgpack.LegacyEncodingOptions
+
+	// SetTieredFiles sets the tiered files used to read fileset files that
+	// have been moved from local disk to an object store.
+	SetTieredFiles(value TieredFiles) Options
+
+	// TieredFiles returns the tiered files used to read fileset files that
+	// have been moved from local disk to an object store.
+	TieredFiles() TieredFiles
 }
 
 // BlockRetrieverOptions represents the options for block retrieval.
@@ -688,6 +696,38 @@ type IndexClaimsManager interface {
 	) (int, error)
 }
 
+// FilePins tracks files which must not be deleted, such as the filesets being
+// exported by an in progress backup, until the pins on them are released.
+type FilePins interface {
+	// Pin pins the files until the returned release func is called.
+	Pin(filePaths []string) func()
+
+	// Unpinned returns the subset of the files which are not pinned.
+	Unpinned(filePaths []string) []string
+
+	// PinnedDirectories returns the names of the directories within the parent
+	// directory which contain pinned files.
+	PinnedDirectories(parentDirectoryPath string) []string
+}
+
+// ReadAtFile is a read only fileset file which is read using positional
+// reads, backed either by a local file or by a tiered copy of the file.
+type ReadAtFile interface {
+	io.ReaderAt
+	io.Closer
+
+	// Size returns the size of the file in bytes.
+	Size() int64
+}
+
+// TieredFiles provides access to fileset files which have been moved from
+// local disk to an object store.
+type TieredFiles interface {
+	// Open opens the tiered copy of the file at the given local file path,
+	// returning an error which satisfies os.IsNotExist if it was not tiered.
+	Open(filePath string) (ReadAtFile, error)
+}
+
 // StreamedDataEntry contains the data of single entry returned by streaming method.
 // The underlying data slices are reused and invalidated on every read.
 type StreamedDataEntry struct {
@@ -45,6 +45,7 @@ import (
 	queryconfig "github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
 	"github.com/m3db/m3/src/dbnode/encoding/proto"
 	"github.com/m3db/m3/src/dbnode/environment"
@@ -56,7 +57,9 @@ import (
 	ttcluster "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/cluster"
 	ttnode "github.com/m3db/m3/src/dbnode/network/server/tchannelthrift/node"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/persist/fs/backup"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/persist/fs/tiering"
 	"github.com/m3db/m3/src/dbnode/ratelimit"
 	"github.com/m3db/m3/src/dbnode/retention"
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
@@ -68,6 +71,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/limits"
 	"github.com/m3db/m3/src/dbnode/storage/limits/permits"
+	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -588,6 +592,24 @@ func Run(runOpts RunOptions) {
 		SetIndexBloomFilterFalsePositivePercent(cfg.Filesystem.BloomFilterFalsePositivePercentOrDefault()).
 		SetMmapReporter(mmapReporter)
 
+	if cfg.Tiering != nil {
+		tieringOpts, err := cfg.Tiering.NewOptions(hostID, fsopts,
+			opts.InstrumentOptions().SetMetricsScope(scope.SubScope("database.tiering")))
+		if err != nil {
+			logger.Fatal("could not create tiering options", zap.Error(err))
+		}
+		tieringManager, err := tiering.NewManager(tieringOpts.
+			SetFilePins(opts.FilePins()).
+			SetBlockLeaseManager(blockLeaseManager))
+		if err != nil {
+			logger.Fatal("could not create tiering manager", zap.Error(err))
+		}
+		// NB: Every reader of filesets must be able to read tiered files so
+		// set them on the filesystem options before they are passed on.
+		fsopts = fsopts.SetTieredFiles(tieringManager.TieredFiles())
+		opts = opts.SetTieringManager(tieringManager)
+	}
+
 	var commitLogQueueSize int
 	cfgCommitLog := cfg.CommitLogOrDefault()
 	specified := cfgCommitLog.Queue.Size
@@ -628,14 +650,21 @@ func Run(runOpts RunOptions) {
 	}
 
 	opts = withEncodingAndPoolingOptions(cfg, logger, opts, poolingPolicy)
-	opts = opts.SetCommitLogOptions(opts.CommitLogOptions().
+	commitLogOpts := opts.CommitLogOptions().
 		SetInstrumentOptions(opts.InstrumentOptions()).
 		SetFilesystemOptions(fsopts).
 		SetStrategy(commitlog.StrategyWriteBehind).
+		SetCompression(cfgCommitLog.Compression).
 		SetFlushSize(cfgCommitLog.FlushMaxBytes).
 		SetFlushInterval(cfgCommitLog.FlushEvery).
 		SetBacklogQueueSize(commitLogQueueSize).
-		SetBacklogQueueChannelSize(commitLogQueueChannelSize))
+		SetBacklogQueueChannelSize(commitLogQueueChannelSize)
+	if groupCommit := cfgCommitLog.GroupCommit; groupCommit != nil {
+		commitLogOpts = commitLogOpts.
+			SetStrategy(commitlog.StrategyWriteGroupCommit).
+			SetGroupCommitInterval(groupCommit.Interval)
+	}
+	opts = opts.SetCommitLogOptions(commitLogOpts)
 
 	// Setup the block retriever
 	switch seriesCachePolicy {
@@ -824,6 +853,16 @@ func Run(runOpts RunOptions) {
 			}
 		}
 
+		backupOpts := backup.NewOptions().
+			SetFilesystemOptions(fsopts).
+			SetFilePins(opts.FilePins()).
+			SetClockOptions(opts.ClockOptions()).
+			SetInstrumentOptions(iOpts)
+		backupManager, err := backup.NewManager(backupOpts)
+		if err != nil {
+			logger.Error("unable to create backup manager", zap.Error(err))
+		}
+
 		go func() {
 			mux := http.DefaultServeMux
 			if debugWriter != nil {
@@ -831,6 +870,9 @@ func Run(runOpts RunOptions) {
 					logger.Error("unable to register debug writer endpoint", zap.Error(err))
 				}
 			}
+			if backupManager != nil {
+				mux.Handle(backup.BackupURL, backup.NewHandler(backupManager, backupOpts))
+			}
 
 			if err := http.ListenAndServe(debugListenAddress, mux); err != nil {
 				logger.Error("debug server could not listen",
@@ -852,6 +894,7 @@ func Run(runOpts RunOptions) {
 	if cfg.Proto != nil && cfg.Proto.Enabled {
 		protoEnabled = true
 	}
+	nativeHistogramsEnabled := cfg.NativeHistograms != nil && cfg.NativeHistograms.Enabled
 	schemaRegistry := namespace.NewSchemaRegistry(protoEnabled, logger)
 	// For application m3db client integration test convenience (where a local dbnode is started as a docker container),
 	// we allow loading user schema from local file into schema registry.
@@ -869,7 +912,7 @@ func Run(runOpts RunOptions) {
 	origin := topology.NewHost(hostID, "")
 	m3dbClient, err := newAdminClient(
 		cfg.Client, opts.ClockOptions(), iOpts, tchannelOpts, syncCfg.TopologyInitializer,
-		runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
+		runtimeOptsMgr, origin, protoEnabled, nativeHistogramsEnabled, schemaRegistry,
 		syncCfg.KVStore, logger, runOpts.CustomOptions)
 	if err != nil {
 		logger.Fatal("could not create m3db client", zap.Error(err))
@@ -906,7 +949,7 @@ func Run(runOpts RunOptions) {
 			clientCfg := *cluster.Client
 			clusterClient, err := newAdminClient(
 				clientCfg, opts.ClockOptions(), iOpts, tchannelOpts, topologyInitializer,
-				runtimeOptsMgr, origin, protoEnabled, schemaRegistry,
+				runtimeOptsMgr, origin, protoEnabled, nativeHistogramsEnabled, schemaRegistry,
 				syncCfg.KVStore, logger, runOpts.CustomOptions)
 			if err != nil {
 				logger.Fatal(
@@ -938,6 +981,9 @@ func Run(runOpts RunOptions) {
 				// Set conditionally to avoid stomping on the default value of 1.0.
 				repairOpts = repairOpts.SetDebugShadowComparisonsPercentage(cfg.Repair.DebugShadowComparisonsPercentage)
 			}
+			if cfg.Repair.StreamBatchSize > 0 {
+				repairOpts = repairOpts.SetStreamBatchSize(cfg.Repair.StreamBatchSize)
+			}
 		}
 
 		opts = opts.
@@ -1014,6 +1060,10 @@ func Run(runOpts RunOptions) {
 	// Now that we've initialized the database we can set it on the service.
 	service.SetDatabase(db)
 
+	if debugListenAddress != "" {
+		http.DefaultServeMux.Handle(repair.RepairURL, repair.NewHandler(db, iOpts))
+	}
+
 	go func() {
 		if runOpts.BootstrapCh != nil {
 			// Notify on bootstrap chan if specified.
@@ -1683,11 +1733,15 @@ func withEncodingAndPoolingOptions(
 		SetSegmentReaderPool(segmentReaderPool).
 		SetCheckedBytesWrapperPool(bytesWrapperPool)
 
+	nativeHistogramsEnabled := cfg.NativeHistograms != nil && cfg.NativeHistograms.Enabled
 	encoderPool.Init(func() encoding.Encoder {
 		if cfg.Proto != nil && cfg.Proto.Enabled {
 			enc := proto.NewEncoder(0, encodingOpts)
 			return enc
 		}
+		if nativeHistogramsEnabled {
+			return histogram.NewEncoder(0, nil, encodingOpts)
+		}
 
 		return m3tsz.NewEncoder(0, nil, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
@@ -1696,6 +1750,9 @@ func withEncodingAndPoolingOptions(
 		if cfg.Proto != nil && cfg.Proto.Enabled {
 			return proto.NewIterator(r, descr, encodingOpts)
 		}
+		if nativeHistogramsEnabled {
+			return histogram.NewReaderIterator(r, encodingOpts)
+		}
 		return m3tsz.NewReaderIterator(r, m3tsz.DefaultIntOptimizationEnabled, encodingOpts)
 	})
 
@@ -1854,6 +1911,7 @@ func newAdminClient(
 	runtimeOptsMgr m3dbruntime.OptionsManager,
 	origin topology.Host,
 	protoEnabled bool,
+	nativeHistogramsEnabled bool,
 	schemaRegistry namespace.SchemaRegistry,
 	kvStore kv.Store,
 	logger *zap.Logger,
@@ -1885,6 +1943,13 @@ func newAdminClient(
 			}
 			return opts
 		},
+		func(opts client.AdminOptions) client.AdminOptions {
+			if nativeHistogramsEnabled {
+				return opts.SetReaderIteratorAllocate(
+					histogram.DefaultReaderIteratorAllocFn(encoding.NewOptions())).(client.AdminOptions)
+			}
+			return opts
+		},
 		func(opts client.AdminOptions) client.AdminOptions {
 			return opts.SetSchemaRegistry(schemaRegistry).(client.AdminOptions)
 		},
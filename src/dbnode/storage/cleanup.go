@@ -56,6 +56,32 @@ type deleteFilesFn func(files []string) error
 
 type deleteInactiveDirectoriesFn func(parentDirPath string, activeDirNames []string) error
 
+// newDeleteUnpinnedFilesFn returns a deleteFilesFn which skips pinned files,
+// leaving them to be deleted by a later cleanup once they are released.
+func newDeleteUnpinnedFilesFn(pins fs.FilePins, fn deleteFilesFn) deleteFilesFn {
+	return func(files []string) error {
+		return fn(pins.Unpinned(files))
+	}
+}
+
+// newDeleteInactiveUnpinnedDirectoriesFn returns a deleteInactiveDirectoriesFn
+// which treats directories containing pinned files as active.
+func newDeleteInactiveUnpinnedDirectoriesFn(
+	pins fs.FilePins, fn deleteInactiveDirectoriesFn,
+) deleteInactiveDirectoriesFn {
+	return func(parentDirPath string, activeDirNames []string) error {
+		pinned := pins.PinnedDirectories(parentDirPath)
+		if len(pinned) == 0 {
+			return fn(parentDirPath, activeDirNames)
+		}
+
+		active := make([]string, 0, len(activeDirNames)+len(pinned))
+		active = append(active, activeDirNames...)
+		active = append(active, pinned...)
+		return fn(parentDirPath, active)
+	}
+}
+
 // Narrow interface so as not to expose all the functionality of the commitlog
 // to the cleanup manager.
 type activeCommitlogs interface {
@@ -128,8 +154,8 @@ func newCleanupManager(
 		commitLogFilesFn:            commitlog.Files,
 		snapshotMetadataFilesFn:     fs.SortedSnapshotMetadataFiles,
 		snapshotFilesFn:             fs.SnapshotFiles,
-		deleteFilesFn:               fs.DeleteFiles,
-		deleteInactiveDirectoriesFn: fs.DeleteInactiveDirectories,
+		deleteFilesFn:               newDeleteUnpinnedFilesFn(opts.FilePins(), fs.DeleteFiles),
+		deleteInactiveDirectoriesFn: newDeleteInactiveUnpinnedDirectoriesFn(opts.FilePins(), fs.DeleteInactiveDirectories),
 		metrics:                     newCleanupManagerMetrics(scope),
 		logger:                      opts.InstrumentOptions().Logger(),
 	}
@@ -212,6 +238,13 @@ func (m *cleanupManager) ColdFlushCleanup(t xtime.UnixNano) error {
 			"encountered errors when deleting inactive data files for %v: %v", t, err))
 	}
 
+	// NB: Tier after cleaning up data files so that the objects of filesets
+	// which were just deleted are deleted too.
+	if err := m.tierDataFiles(t, namespaces); err != nil {
+		multiErr = multiErr.Add(fmt.Errorf(
+			"encountered errors when tiering data files for %v: %v", t, err))
+	}
+
 	return multiErr.FinalError()
 }
 
@@ -292,6 +325,24 @@ func (m *cleanupManager) cleanupDataFiles(t xtime.UnixNano, namespaces []databas
 	return multiErr.FinalError()
 }
 
+func (m *cleanupManager) tierDataFiles(t xtime.UnixNano, namespaces []databaseNamespace) error {
+	tieringManager := m.opts.TieringManager()
+	if tieringManager == nil {
+		return nil
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, n := range namespaces {
+		shards := n.OwnedShards()
+		shardIDs := make([]uint32, 0, len(shards))
+		for _, shard := range shards {
+			shardIDs = append(shardIDs, shard.ID())
+		}
+		multiErr = multiErr.Add(tieringManager.Tier(n.Metadata(), shardIDs, t))
+	}
+	return multiErr.FinalError()
+}
+
 func (m *cleanupManager) cleanupExpiredIndexFiles(
 	t xtime.UnixNano, namespaces []databaseNamespace,
 ) error {
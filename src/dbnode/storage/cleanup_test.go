@@ -537,6 +537,105 @@ func TestDeleteInactiveDataAndSnapshotFileSetFiles(t *testing.T) {
 	}
 }
 
+func TestDeleteUnpinnedFilesSkipsPinnedFiles(t *testing.T) {
+	pins := fs.NewFilePins()
+	release := pins.Pin([]string{"data/nsID/0/fileset-1-0-data.db"})
+
+	var deleted []string
+	deleteFn := newDeleteUnpinnedFilesFn(pins, func(files []string) error {
+		deleted = append(deleted, files...)
+		return nil
+	})
+
+	files := []string{
+		"data/nsID/0/fileset-1-0-data.db",
+		"data/nsID/0/fileset-2-0-data.db",
+	}
+	require.NoError(t, deleteFn(files))
+	require.Equal(t, files[1:], deleted)
+
+	release()
+	deleted = nil
+	require.NoError(t, deleteFn(files))
+	require.Equal(t, files, deleted)
+}
+
+func TestDeleteInactiveUnpinnedDirectoriesKeepsPinnedDirectories(t *testing.T) {
+	pins := fs.NewFilePins()
+	release := pins.Pin([]string{"data/nsID/3/fileset-1-0-data.db"})
+	defer release()
+
+	var calls []deleteInactiveDirectoriesCall
+	deleteFn := newDeleteInactiveUnpinnedDirectoriesFn(pins,
+		func(parentDirPath string, activeDirNames []string) error {
+			calls = append(calls, deleteInactiveDirectoriesCall{
+				parentDirPath:  parentDirPath,
+				activeDirNames: activeDirNames,
+			})
+			return nil
+		})
+
+	require.NoError(t, deleteFn("data/nsID", []string{"0"}))
+	require.NoError(t, deleteFn("snapshots/nsID", []string{"0"}))
+	require.Equal(t, []deleteInactiveDirectoriesCall{
+		{parentDirPath: "data/nsID", activeDirNames: []string{"0", "3"}},
+		{parentDirPath: "snapshots/nsID", activeDirNames: []string{"0"}},
+	}, calls)
+}
+
+type tierCall struct {
+	namespace ident.ID
+	shards    []uint32
+	now       xtime.UnixNano
+}
+
+type fakeTieringManager struct {
+	calls []tierCall
+}
+
+func (f *fakeTieringManager) Tier(md namespace.Metadata, shards []uint32, now xtime.UnixNano) error {
+	f.calls = append(f.calls, tierCall{namespace: md.ID(), shards: shards, now: now})
+	return nil
+}
+
+func (f *fakeTieringManager) TieredFiles() fs.TieredFiles {
+	return nil
+}
+
+func TestCleanupManagerTiersDataFiles(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+	ts := timeFor()
+
+	md, err := namespace.NewMetadata(ident.StringID("nsID"), namespaceOptions)
+	require.NoError(t, err)
+
+	ns := NewMockdatabaseNamespace(ctrl)
+	ns.EXPECT().Metadata().Return(md).AnyTimes()
+	shards := make([]databaseShard, 0, 2)
+	for i := 0; i < 2; i++ {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ID().Return(uint32(i)).AnyTimes()
+		shards = append(shards, shard)
+	}
+	ns.EXPECT().OwnedShards().Return(shards).AnyTimes()
+	namespaces := []databaseNamespace{ns}
+
+	db := newMockdatabase(ctrl, namespaces...)
+	mgr := newCleanupManager(db, newNoopFakeActiveLogs(), tally.NoopScope).(*cleanupManager)
+
+	// Tiering is disabled without a tiering manager.
+	require.NoError(t, mgr.tierDataFiles(ts, namespaces))
+
+	tieringManager := &fakeTieringManager{}
+	mgr.opts = mgr.opts.SetTieringManager(tieringManager)
+	require.NoError(t, mgr.tierDataFiles(ts, namespaces))
+	require.Len(t, tieringManager.calls, 1)
+	require.True(t, tieringManager.calls[0].namespace.Equal(md.ID()))
+	require.Equal(t, []uint32{0, 1}, tieringManager.calls[0].shards)
+	require.Equal(t, ts, tieringManager.calls[0].now)
+}
+
 func TestCleanupManagerPropagatesOwnedNamespacesError(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
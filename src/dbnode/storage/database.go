@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/storage/index/convert"
 	"github.com/m3db/m3/src/dbnode/storage/limits"
+	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/storage/series"
 	"github.com/m3db/m3/src/dbnode/tracepoint"
 	"github.com/m3db/m3/src/dbnode/ts"
@@ -681,7 +682,10 @@ func (d *db) Write(
 		return err
 	}
 
-	if !n.Options().WritesToCommitLog() || !seriesWrite.WasWritten {
+	nsOpts := n.Options()
+	durability, writeToCommitLog := commitLogWriteDurability(nsOpts,
+		nsOpts.WriteDurability())
+	if !writeToCommitLog || !seriesWrite.WasWritten {
 		return nil
 	}
 
@@ -690,7 +694,8 @@ func (d *db) Write(
 		Value:          value,
 	}
 
-	return d.commitLog.Write(ctx, seriesWrite.Series, dp, unit, annotation)
+	return d.commitLog.WriteWithDurability(ctx, seriesWrite.Series, dp, unit,
+		annotation, durability)
 }
 
 func (d *db) WriteTagged(
@@ -714,7 +719,10 @@ func (d *db) WriteTagged(
 		return err
 	}
 
-	if !n.Options().WritesToCommitLog() || !seriesWrite.WasWritten {
+	nsOpts := n.Options()
+	durability, writeToCommitLog := commitLogWriteDurability(nsOpts,
+		nsOpts.WriteDurability())
+	if !writeToCommitLog || !seriesWrite.WasWritten {
 		return nil
 	}
 
@@ -723,7 +731,8 @@ func (d *db) WriteTagged(
 		Value:          value,
 	}
 
-	return d.commitLog.Write(ctx, seriesWrite.Series, dp, unit, annotation)
+	return d.commitLog.WriteWithDurability(ctx, seriesWrite.Series, dp, unit,
+		annotation, durability)
 }
 
 func (d *db) BatchWriter(namespace ident.ID, batchSize int) (writes.BatchWriter, error) {
@@ -861,14 +870,36 @@ func (d *db) writeBatch(
 		}
 	}
 
-	if !n.Options().WritesToCommitLog() {
-		// Finalize here because we can't rely on the commitlog to do it since
-		// we're not using it.
-		writes.Finalize()
-		return nil
+	nsOpts := n.Options()
+	if nsOpts.WritesToCommitLog() {
+		durability, writeToCommitLog := commitLogWriteDurability(nsOpts,
+			writes.Durability())
+		if writeToCommitLog {
+			writes.SetDurability(durability)
+			return d.commitLog.WriteBatch(ctx, writes)
+		}
 	}
 
-	return d.commitLog.WriteBatch(ctx, writes)
+	// Finalize here because we can't rely on the commitlog to do it since
+	// we're not using it.
+	writes.Finalize()
+	return nil
+}
+
+// commitLogWriteDurability returns the durability a write to a namespace must
+// reach before it is acknowledged and whether it needs to go to the commit log,
+// a durability requested for the write takes precedence over the namespace's.
+func commitLogWriteDurability(
+	nsOpts namespace.Options,
+	requested namespace.WriteDurability,
+) (namespace.WriteDurability, bool) {
+	durability := requested
+	if durability == namespace.DefaultWriteDurability {
+		durability = nsOpts.WriteDurability()
+	}
+	writeToCommitLog := nsOpts.WritesToCommitLog() &&
+		durability != namespace.NoneWriteDurability
+	return durability, writeToCommitLog
 }
 
 func (d *db) QueryIDs(
@@ -1191,6 +1222,14 @@ func (d *db) Repair() error {
 	return d.repairer.Repair()
 }
 
+func (d *db) StartRepair(req repair.Request) (repair.Progress, error) {
+	return d.repairer.StartRepair(req)
+}
+
+func (d *db) RepairProgress() (repair.Progress, bool) {
+	return d.repairer.RepairProgress()
+}
+
 func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	n, err := d.namespaceFor(namespace)
 	if err != nil {
@@ -1199,6 +1238,21 @@ func (d *db) Truncate(namespace ident.ID) (int64, error) {
 	return n.Truncate()
 }
 
+func (d *db) DeleteSeries(
+	ctx context.Context,
+	namespace ident.ID,
+	query index.Query,
+	start xtime.UnixNano,
+	end xtime.UnixNano,
+) (int64, error) {
+	n, err := d.namespaceFor(namespace)
+	if err != nil {
+		d.metrics.unknownNamespaceRead.Inc(1)
+		return 0, err
+	}
+	return n.DeleteSeries(ctx, query, start, end)
+}
+
 func (d *db) IsOverloaded() bool {
 	queueSize := float64(d.commitLog.QueueLength())
 	queueCapacity := float64(d.opts.CommitLogOptions().BacklogQueueSize())
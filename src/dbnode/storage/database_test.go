@@ -1116,6 +1116,93 @@ func TestDatabaseWrite(t *testing.T) {
 	}
 }
 
+func TestDatabaseWriteTaggedBatchWriteDurability(t *testing.T) {
+	tests := []struct {
+		name           string
+		nsDurability   namespace.WriteDurability
+		requested      namespace.WriteDurability
+		expected       namespace.WriteDurability
+		expectedCommit bool
+	}{
+		{
+			name:           "default",
+			nsDurability:   namespace.DefaultWriteDurability,
+			requested:      namespace.DefaultWriteDurability,
+			expected:       namespace.DefaultWriteDurability,
+			expectedCommit: true,
+		},
+		{
+			name:           "namespace durability",
+			nsDurability:   namespace.FsyncedWriteDurability,
+			requested:      namespace.DefaultWriteDurability,
+			expected:       namespace.FsyncedWriteDurability,
+			expectedCommit: true,
+		},
+		{
+			name:           "requested durability",
+			nsDurability:   namespace.FsyncedWriteDurability,
+			requested:      namespace.CommitLogEnqueuedWriteDurability,
+			expected:       namespace.CommitLogEnqueuedWriteDurability,
+			expectedCommit: true,
+		},
+		{
+			name:           "requested none",
+			nsDurability:   namespace.FsyncedWriteDurability,
+			requested:      namespace.NoneWriteDurability,
+			expectedCommit: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := xtest.NewController(t)
+			defer ctrl.Finish()
+
+			d, mapCh, _ := defaultTestDatabase(t, ctrl, BootstrapNotStarted)
+			defer func() {
+				close(mapCh)
+			}()
+
+			mockCL := commitlog.NewMockCommitLog(ctrl)
+			d.commitLog = mockCL
+
+			ns := dbAddNewMockNamespace(ctrl, d, "testns")
+			ns.EXPECT().Options().
+				Return(namespace.NewOptions().SetWriteDurability(tt.nsDurability)).
+				AnyTimes()
+
+			var (
+				ctx  = context.NewBackground()
+				nsID = ident.StringID("testns")
+			)
+			batchWriter, err := d.BatchWriter(nsID, 1)
+			require.NoError(t, err)
+			require.NoError(t, batchWriter.AddTagged(0, ident.StringID("foo"),
+				nil, xtime.UnixNano(10*time.Second), 1.0, xtime.Second, nil))
+			batchWriter.SetDurability(tt.requested)
+
+			ns.EXPECT().
+				WriteTagged(ctx, ident.NewIDMatcher("foo"), gomock.Any(),
+					xtime.UnixNano(10*time.Second), 1.0, xtime.Second, nil).
+				Return(SeriesWrite{
+					Series:     ts.Series{ID: ident.StringID("foo"), Namespace: nsID},
+					WasWritten: true,
+				}, nil)
+			if tt.expectedCommit {
+				mockCL.EXPECT().WriteBatch(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, batch writes.WriteBatch) error {
+						require.Equal(t, tt.expected, batch.Durability())
+						return nil
+					})
+			}
+
+			err = d.WriteTaggedBatch(ctx, nsID, batchWriter.(writes.WriteBatch),
+				&fakeIndexedErrorHandler{})
+			require.NoError(t, err)
+		})
+	}
+}
+
 type fakeIndexedErrorHandler struct {
 	errs []indexedErr
 }
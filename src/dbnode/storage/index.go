@@ -113,6 +113,7 @@ type nsIndex struct {
 	readIndexInfoFilesFn    readIndexInfoFilesFn
 
 	newBlockFn            index.NewBlockFn
+	tombstones            *seriesTombstones
 	logger                *zap.Logger
 	opts                  Options
 	nsMetadata            namespace.Metadata
@@ -199,6 +200,7 @@ type newNamespaceIndexOpts struct {
 	opts                    Options
 	newIndexQueueFn         newNamespaceIndexInsertQueueFn
 	newBlockFn              index.NewBlockFn
+	tombstones              *seriesTombstones
 }
 
 // execBlockQueryFn executes a query against the given block whilst tracking state.
@@ -360,9 +362,10 @@ func newNamespaceIndexWithOptions(
 		namespaceRuntimeOptsMgr: newIndexOpts.namespaceRuntimeOptsMgr,
 		indexFilesetsBeforeFn:   fs.IndexFileSetsBefore,
 		readIndexInfoFilesFn:    fs.ReadIndexInfoFiles,
-		deleteFilesFn:           fs.DeleteFiles,
+		deleteFilesFn:           newDeleteUnpinnedFilesFn(newIndexOpts.opts.FilePins(), fs.DeleteFiles),
 
 		newBlockFn: newBlockFn,
+		tombstones: newIndexOpts.tombstones,
 		opts:       newIndexOpts.opts,
 		logger:     logger,
 		nsMetadata: nsMD,
@@ -1340,6 +1343,25 @@ func (i *nsIndex) shardsFilterID() func(id ident.ID) bool {
 	return v
 }
 
+// queryFilterID returns the filter for the IDs returned by a query, which
+// filters out series of shards not owned by this node and series deleted for
+// the entire query range. Deleted series remain indexed until the segments of
+// their blocks are compacted.
+func (i *nsIndex) queryFilterID(opts index.QueryOptions) func(id ident.ID) bool {
+	filterID := i.shardsFilterID()
+	if i.tombstones.Len() == 0 {
+		return filterID
+	}
+
+	queryRange := xtime.Range{Start: opts.StartInclusive, End: opts.EndExclusive}
+	return func(id ident.ID) bool {
+		if filterID != nil && !filterID(id) {
+			return false
+		}
+		return !i.tombstones.Covers(id.Bytes(), queryRange)
+	}
+}
+
 func (i *nsIndex) shardForID() func(id ident.ID) (uint32, bool) {
 	i.state.RLock()
 	v := i.state.shardFilteredForID
@@ -1369,7 +1391,7 @@ func (i *nsIndex) Query(
 	results := i.resultsPool.Get()
 	results.Reset(i.nsMetadata.ID(), index.QueryResultsOptions{
 		SizeLimit: opts.SeriesLimit,
-		FilterID:  i.shardsFilterID(),
+		FilterID:  i.queryFilterID(opts),
 	})
 	ctx.RegisterFinalizer(results)
 	queryRes, err := i.query(ctx, query, results, opts, i.execBlockQueryFn,
@@ -1454,6 +1476,12 @@ func (i *nsIndex) AggregateQuery(
 		FieldFilter:           opts.FieldFilter,
 		Type:                  opts.Type,
 		AggregateUsageMetrics: metrics,
+		// NB: deleted series remain indexed until the segments of their blocks
+		// are compacted, so exclude their fields and terms until then.
+		ExcludeIDs: i.tombstones.CoveredIDs(xtime.Range{
+			Start: opts.StartInclusive,
+			End:   opts.EndExclusive,
+		}),
 	}
 	ctx.RegisterFinalizer(results)
 	// use appropriate fn to query underlying blocks.
@@ -2005,8 +2033,16 @@ func (i *nsIndex) ensureBlockPresentWithRLock(blockStart xtime.UnixNano) (index.
 	}
 
 	// ok now we know for sure we have to alloc
+	// Drop the documents of series deleted for the entire block when
+	// compacting the block's segments.
+	blockOpts := index.BlockOptions{
+		DocumentsFilter: i.tombstones.DocumentsFilter(xtime.Range{
+			Start: blockStart,
+			End:   blockStart.Add(i.blockSize),
+		}),
+	}
 	block, err := i.newBlockFn(blockStart, i.nsMetadata,
-		index.BlockOptions{}, i.namespaceRuntimeOptsMgr, i.opts.IndexOptions())
+		blockOpts, i.namespaceRuntimeOptsMgr, i.opts.IndexOptions())
 	if err != nil { // unable to allocate the block, should never happen.
 		return nil, i.unableToAllocBlockInvariantError(err)
 	}
@@ -2266,6 +2302,68 @@ func (i *nsIndex) CleanupDuplicateFileSets(activeShards []uint32) error {
 	return multiErr.FinalError()
 }
 
+func (i *nsIndex) CardinalityStats(
+	start, end xtime.UnixNano,
+	shards []uint32,
+) (index.CardinalityStats, error) {
+	i.state.RLock()
+	if !i.isOpenWithRLock() {
+		i.state.RUnlock()
+		return index.CardinalityStats{}, errDbIndexUnableToQueryClosed
+	}
+
+	// Track this as an inflight query that needs to finish
+	// when the index is closed.
+	i.queriesWg.Add(1)
+	defer i.queriesWg.Done()
+
+	blocks, err := i.blocksForQueryWithRLock(xtime.NewRanges(xtime.Range{
+		Start: start,
+		End:   end,
+	}))
+
+	// Can now release the lock and walk the blocks without holding the lock.
+	i.state.RUnlock()
+
+	if err != nil {
+		return index.CardinalityStats{}, err
+	}
+
+	// Only count the series of the requested shards, the index may still
+	// contain series of shards which are no longer owned by this node.
+	filterID := i.shardsFilterID()
+	if len(shards) > 0 {
+		var (
+			shardForID = i.shardForID()
+			requested  = make(map[uint32]struct{}, len(shards))
+		)
+		for _, shard := range shards {
+			requested[shard] = struct{}{}
+		}
+		filterID = func(id ident.ID) bool {
+			shard, owned := shardForID(id)
+			if !owned {
+				return false
+			}
+			_, ok := requested[shard]
+			return ok
+		}
+	}
+
+	// NB: the same series are typically indexed by each block they were
+	// written to, so the stats of each block are merged by taking the
+	// highest count rather than summing them.
+	stats := index.NewCardinalityStats()
+	for _, block := range blocks {
+		blockStats, err := block.CardinalityStats(filterID)
+		if err != nil {
+			return index.CardinalityStats{}, err
+		}
+		stats.Merge(blockStats)
+	}
+	return stats, nil
+}
+
 func (i *nsIndex) DebugMemorySegments(opts DebugMemorySegmentsOptions) error {
 	i.state.RLock()
 	defer i.state.RLock()
@@ -206,6 +206,9 @@ type blockShardRangesSegments struct {
 type BlockOptions struct {
 	ForegroundCompactorMmapDocsData bool
 	BackgroundCompactorMmapDocsData bool
+	// DocumentsFilter if set drops the documents it does not contain
+	// when background compacting the segments of the block.
+	DocumentsFilter segment.DocumentsFilter
 }
 
 // NewBlockFn is a new block constructor.
@@ -588,6 +591,7 @@ func (b *block) AggregateIter(ctx context.Context, aggOpts AggregateResultsOptio
 
 	iterateOpts := fieldsAndTermsIteratorOpts{
 		restrictByQuery: aggOpts.RestrictByQuery,
+		excludeIDs:      aggOpts.ExcludeIDs,
 		iterateTerms:    aggOpts.Type == AggregateTagNamesAndValues,
 		allowFn: func(field []byte) bool {
 			// skip any field names that we shouldn't allow.
@@ -1203,6 +1207,32 @@ func (b *block) MemorySegmentsData(ctx context.Context) ([]fst.SegmentData, erro
 	return data, nil
 }
 
+func (b *block) CardinalityStats(filterID func(id ident.ID) bool) (CardinalityStats, error) {
+	b.RLock()
+	defer b.RUnlock()
+	if b.state == blockStateClosed {
+		return CardinalityStats{}, errBlockAlreadyClosed
+	}
+
+	readers, err := b.segmentReadersWithRLock()
+	if err != nil {
+		return CardinalityStats{}, err
+	}
+	defer func() {
+		for _, reader := range readers {
+			reader.Close()
+		}
+	}()
+
+	stats := NewCardinalityStats()
+	for _, reader := range readers {
+		if err := stats.AddReader(reader, filterID); err != nil {
+			return CardinalityStats{}, err
+		}
+	}
+	return stats, nil
+}
+
 func (b *block) Close() error {
 	b.Lock()
 	defer b.Unlock()
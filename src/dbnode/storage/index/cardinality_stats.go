@@ -0,0 +1,260 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/m3db/m3/src/m3ninx/index/segment"
+	"github.com/m3db/m3/src/m3ninx/postings"
+	"github.com/m3db/m3/src/m3ninx/postings/roaring"
+	"github.com/m3db/m3/src/x/ident"
+)
+
+// DefaultCardinalityStatsMetricNameTag is the tag used to break down series
+// counts by metric name when none is specified.
+var DefaultCardinalityStatsMetricNameTag = []byte("__name__")
+
+// LabelPair is a label name and value pair.
+type LabelPair struct {
+	Name  string
+	Value string
+}
+
+// CardinalityStats describes the cardinality of the series indexed by
+// one or more index blocks.
+type CardinalityStats struct {
+	// NumSeries is the number of series indexed.
+	NumSeries int64
+	// SeriesCountByLabelPair is the number of series indexed for each
+	// label name and value pair.
+	SeriesCountByLabelPair map[LabelPair]int64
+}
+
+// NewCardinalityStats returns a new empty set of cardinality stats.
+func NewCardinalityStats() CardinalityStats {
+	return CardinalityStats{
+		SeriesCountByLabelPair: make(map[LabelPair]int64),
+	}
+}
+
+// AddReader adds the series indexed by the segment reader to the stats, if
+// filterID is not nil only the series whose IDs it accepts are added.
+// NB: series that are indexed by more than one segment in the same block,
+// for instance while a mutable segment is waiting to be evicted after a
+// flush, are counted once per segment.
+func (s *CardinalityStats) AddReader(
+	r segment.Reader,
+	filterID func(id ident.ID) bool,
+) error {
+	all, err := r.MatchAll()
+	if err != nil {
+		return err
+	}
+
+	var filtered postings.List
+	if filterID != nil {
+		filtered, err = filterPostings(r, filterID)
+		if err != nil {
+			return err
+		}
+		if filtered.Len() == all.Len() {
+			// All series are accepted, count postings lists directly.
+			filtered = nil
+		}
+	}
+
+	if filtered == nil {
+		s.NumSeries += int64(all.Len())
+	} else {
+		s.NumSeries += int64(filtered.Len())
+	}
+
+	fieldsIter, err := r.Fields()
+	if err != nil {
+		return err
+	}
+	defer fieldsIter.Close()
+
+	for fieldsIter.Next() {
+		field := fieldsIter.Current()
+		if bytes.Equal(field, ReservedFieldNameID) {
+			// The series ID is indexed as a field but is not a label.
+			continue
+		}
+
+		// NB: the field is only valid until the next call to Next(), take a
+		// copy since it is used as a map key.
+		name := string(field)
+		termsIter, err := r.Terms([]byte(name))
+		if err != nil {
+			return err
+		}
+		for termsIter.Next() {
+			value, pl := termsIter.Current()
+			count := pl.Len()
+			if filtered != nil {
+				count, err = countContained(pl, filtered)
+				if err != nil {
+					termsIter.Close()
+					return err
+				}
+			}
+			if count == 0 {
+				continue
+			}
+			pair := LabelPair{Name: name, Value: string(value)}
+			s.SeriesCountByLabelPair[pair] += int64(count)
+		}
+		if err := termsIter.Err(); err != nil {
+			termsIter.Close()
+			return err
+		}
+		if err := termsIter.Close(); err != nil {
+			return err
+		}
+	}
+
+	return fieldsIter.Err()
+}
+
+func filterPostings(
+	r segment.Reader,
+	filterID func(id ident.ID) bool,
+) (postings.List, error) {
+	iter, err := r.AllDocs()
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	result := roaring.NewPostingsList()
+	for iter.Next() {
+		if !filterID(ident.BytesID(iter.Current().ID)) {
+			continue
+		}
+		if err := result.Insert(iter.PostingsID()); err != nil {
+			return nil, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func countContained(pl postings.List, filtered postings.List) (int, error) {
+	var (
+		iter  = pl.Iterator()
+		count int
+	)
+	defer iter.Close()
+
+	for iter.Next() {
+		if filtered.Contains(iter.Current()) {
+			count++
+		}
+	}
+	return count, iter.Err()
+}
+
+// Merge merges other stats into the stats, keeping the highest count seen
+// for each label pair. Stats from different index blocks are merged this way
+// since the same series are typically indexed by consecutive blocks.
+func (s *CardinalityStats) Merge(other CardinalityStats) {
+	if other.NumSeries > s.NumSeries {
+		s.NumSeries = other.NumSeries
+	}
+	for pair, count := range other.SeriesCountByLabelPair {
+		if count > s.SeriesCountByLabelPair[pair] {
+			s.SeriesCountByLabelPair[pair] = count
+		}
+	}
+}
+
+// CardinalityStat is a named cardinality statistic.
+type CardinalityStat struct {
+	Name  string
+	Value int64
+}
+
+// CardinalityStatsSummary is a summary of the top entries of cardinality
+// stats.
+type CardinalityStatsSummary struct {
+	NumSeries                   int64
+	NumLabelPairs               int64
+	SeriesCountByMetricName     []CardinalityStat
+	LabelValueCountByLabelName  []CardinalityStat
+	MemoryInBytesByLabelName    []CardinalityStat
+	SeriesCountByLabelValuePair []CardinalityStat
+}
+
+// Summary returns the top limit entries of each statistic, the series
+// count by metric name uses the values of the label named metricNameTag.
+func (s CardinalityStats) Summary(
+	metricNameTag []byte,
+	limit int,
+) CardinalityStatsSummary {
+	var (
+		metricName         = string(metricNameTag)
+		seriesByMetricName = make(map[string]int64)
+		valuesByLabelName  = make(map[string]int64)
+		bytesByLabelName   = make(map[string]int64)
+		seriesByLabelPair  = make(map[string]int64, len(s.SeriesCountByLabelPair))
+	)
+	for pair, count := range s.SeriesCountByLabelPair {
+		if pair.Name == metricName {
+			seriesByMetricName[pair.Value] = count
+		}
+		valuesByLabelName[pair.Name]++
+		bytesByLabelName[pair.Name] += int64(len(pair.Value))
+		seriesByLabelPair[pair.Name+"="+pair.Value] = count
+	}
+
+	return CardinalityStatsSummary{
+		NumSeries:                   s.NumSeries,
+		NumLabelPairs:               int64(len(s.SeriesCountByLabelPair)),
+		SeriesCountByMetricName:     TopCardinalityStats(seriesByMetricName, limit),
+		LabelValueCountByLabelName:  TopCardinalityStats(valuesByLabelName, limit),
+		MemoryInBytesByLabelName:    TopCardinalityStats(bytesByLabelName, limit),
+		SeriesCountByLabelValuePair: TopCardinalityStats(seriesByLabelPair, limit),
+	}
+}
+
+// TopCardinalityStats returns the limit entries with the highest values,
+// in descending order of value and then ascending order of name.
+func TopCardinalityStats(values map[string]int64, limit int) []CardinalityStat {
+	stats := make([]CardinalityStat, 0, len(values))
+	for name, value := range values {
+		stats = append(stats, CardinalityStat{Name: name, Value: value})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Value != stats[j].Value {
+			return stats[i].Value > stats[j].Value
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	if limit >= 0 && len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats
+}
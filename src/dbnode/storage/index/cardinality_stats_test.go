@@ -0,0 +1,155 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index/segment/mem"
+	"github.com/m3db/m3/src/x/ident"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCardinalityDoc(id string, fields ...string) doc.Metadata {
+	d := doc.Metadata{ID: []byte(id)}
+	for i := 0; i < len(fields); i += 2 {
+		d.Fields = append(d.Fields, doc.Field{
+			Name:  []byte(fields[i]),
+			Value: []byte(fields[i+1]),
+		})
+	}
+	return d
+}
+
+func TestCardinalityStatsAddReader(t *testing.T) {
+	seg, err := mem.NewSegment(testOpts.MemSegmentOptions())
+	require.NoError(t, err)
+	for _, d := range []doc.Metadata{
+		testCardinalityDoc("a", "__name__", "up", "job", "api"),
+		testCardinalityDoc("b", "__name__", "up", "job", "db"),
+		testCardinalityDoc("c", "__name__", "requests", "job", "api"),
+	} {
+		_, err = seg.Insert(d)
+		require.NoError(t, err)
+	}
+	// NB: block segments are always compacted, seal the segment so that its
+	// fields and terms can be iterated the same way.
+	require.NoError(t, seg.Seal())
+
+	reader, err := seg.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	stats := NewCardinalityStats()
+	require.NoError(t, stats.AddReader(reader, nil))
+
+	assert.Equal(t, int64(3), stats.NumSeries)
+	assert.Equal(t, map[LabelPair]int64{
+		{Name: "__name__", Value: "up"}:       2,
+		{Name: "__name__", Value: "requests"}: 1,
+		{Name: "job", Value: "api"}:           2,
+		{Name: "job", Value: "db"}:            1,
+	}, stats.SeriesCountByLabelPair)
+}
+
+func TestCardinalityStatsAddReaderFilterID(t *testing.T) {
+	seg, err := mem.NewSegment(testOpts.MemSegmentOptions())
+	require.NoError(t, err)
+	for _, d := range []doc.Metadata{
+		testCardinalityDoc("a", "__name__", "up", "job", "api"),
+		testCardinalityDoc("b", "__name__", "up", "job", "db"),
+		testCardinalityDoc("c", "__name__", "requests", "job", "api"),
+	} {
+		_, err = seg.Insert(d)
+		require.NoError(t, err)
+	}
+	require.NoError(t, seg.Seal())
+
+	reader, err := seg.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	stats := NewCardinalityStats()
+	require.NoError(t, stats.AddReader(reader, func(id ident.ID) bool {
+		return id.String() != "b"
+	}))
+
+	assert.Equal(t, int64(2), stats.NumSeries)
+	assert.Equal(t, map[LabelPair]int64{
+		{Name: "__name__", Value: "up"}:       1,
+		{Name: "__name__", Value: "requests"}: 1,
+		{Name: "job", Value: "api"}:           2,
+	}, stats.SeriesCountByLabelPair)
+}
+
+func TestCardinalityStatsMerge(t *testing.T) {
+	stats := NewCardinalityStats()
+	stats.NumSeries = 2
+	stats.SeriesCountByLabelPair[LabelPair{Name: "job", Value: "api"}] = 2
+
+	other := NewCardinalityStats()
+	other.NumSeries = 3
+	other.SeriesCountByLabelPair[LabelPair{Name: "job", Value: "api"}] = 1
+	other.SeriesCountByLabelPair[LabelPair{Name: "job", Value: "db"}] = 3
+
+	stats.Merge(other)
+	assert.Equal(t, int64(3), stats.NumSeries)
+	assert.Equal(t, map[LabelPair]int64{
+		{Name: "job", Value: "api"}: 2,
+		{Name: "job", Value: "db"}:  3,
+	}, stats.SeriesCountByLabelPair)
+}
+
+func TestCardinalityStatsSummary(t *testing.T) {
+	stats := NewCardinalityStats()
+	stats.NumSeries = 3
+	stats.SeriesCountByLabelPair = map[LabelPair]int64{
+		{Name: "__name__", Value: "up"}:       2,
+		{Name: "__name__", Value: "requests"}: 1,
+		{Name: "job", Value: "api"}:           2,
+		{Name: "job", Value: "db"}:            1,
+		{Name: "instance", Value: "a:1"}:      3,
+	}
+
+	assert.Equal(t, CardinalityStatsSummary{
+		NumSeries:     3,
+		NumLabelPairs: 5,
+		SeriesCountByMetricName: []CardinalityStat{
+			{Name: "up", Value: 2},
+			{Name: "requests", Value: 1},
+		},
+		LabelValueCountByLabelName: []CardinalityStat{
+			{Name: "__name__", Value: 2},
+			{Name: "job", Value: 2},
+		},
+		MemoryInBytesByLabelName: []CardinalityStat{
+			{Name: "__name__", Value: 10},
+			{Name: "job", Value: 5},
+		},
+		SeriesCountByLabelValuePair: []CardinalityStat{
+			{Name: "instance=a:1", Value: 3},
+			{Name: "__name__=up", Value: 2},
+		},
+	}, stats.Summary([]byte("__name__"), 2))
+}
@@ -96,7 +96,7 @@ func TestCompactorSingleMutableSegment(t *testing.T) {
 
 	compacted, err := compactor.Compact([]segment.Segment{
 		mustSeal(t, seg),
-	}, mmap.ReporterOptions{})
+	}, nil, mmap.ReporterOptions{})
 	require.NoError(t, err)
 
 	assertContents(t, compacted, testDocuments)
@@ -122,7 +122,7 @@ func TestCompactorSingleMutableSegmentWithMmapDocsData(t *testing.T) {
 
 	compacted, err := compactor.Compact([]segment.Segment{
 		mustSeal(t, seg),
-	}, mmap.ReporterOptions{})
+	}, nil, mmap.ReporterOptions{})
 	require.NoError(t, err)
 
 	assertContents(t, compacted, testDocuments)
@@ -150,7 +150,7 @@ func TestCompactorManySegments(t *testing.T) {
 	compacted, err := compactor.Compact([]segment.Segment{
 		mustSeal(t, seg1),
 		mustSeal(t, seg2),
-	}, mmap.ReporterOptions{})
+	}, nil, mmap.ReporterOptions{})
 	require.NoError(t, err)
 
 	assertContents(t, compacted, testDocuments)
@@ -181,7 +181,7 @@ func TestCompactorCompactDuplicateIDsNoError(t *testing.T) {
 	compacted, err := compactor.Compact([]segment.Segment{
 		mustSeal(t, seg1),
 		mustSeal(t, seg2),
-	}, mmap.ReporterOptions{})
+	}, nil, mmap.ReporterOptions{})
 	require.NoError(t, err)
 
 	assertContents(t, compacted, testDocuments)
@@ -189,6 +189,58 @@ func TestCompactorCompactDuplicateIDsNoError(t *testing.T) {
 	require.NoError(t, compactor.Close())
 }
 
+func TestCompactorCompactWithFilter(t *testing.T) {
+	seg1, err := mem.NewSegment(testMemSegmentOptions)
+	require.NoError(t, err)
+
+	_, err = seg1.Insert(testDocuments[0])
+	require.NoError(t, err)
+
+	seg2, err := mem.NewSegment(testMemSegmentOptions)
+	require.NoError(t, err)
+
+	_, err = seg2.Insert(testDocuments[1])
+	require.NoError(t, err)
+
+	compactor, err := NewCompactor(testMetadataPool, testMetadataMaxBatch,
+		testBuilderSegmentOptions, testFSTSegmentOptions, CompactorOptions{})
+	require.NoError(t, err)
+
+	filter := testDocumentsFilter(func(d doc.Metadata) bool {
+		return string(d.ID) != "one"
+	})
+	compacted, err := compactor.Compact([]segment.Segment{
+		mustSeal(t, seg1),
+		mustSeal(t, seg2),
+	}, filter, mmap.ReporterOptions{})
+	require.NoError(t, err)
+
+	assertContents(t, compacted, testDocuments[1:])
+
+	// Terms only referenced by filtered documents are dropped.
+	reader, err := compacted.Reader()
+	require.NoError(t, err)
+	terms, err := reader.Terms([]byte("fruit"))
+	require.NoError(t, err)
+	var values []string
+	for terms.Next() {
+		value, _ := terms.Current()
+		values = append(values, string(value))
+	}
+	require.NoError(t, terms.Err())
+	require.NoError(t, terms.Close())
+	require.NoError(t, reader.Close())
+	require.Equal(t, []string{"apple"}, values)
+
+	require.NoError(t, compactor.Close())
+}
+
+type testDocumentsFilter func(d doc.Metadata) bool
+
+func (f testDocumentsFilter) Contains(d doc.Metadata) bool {
+	return f(d)
+}
+
 func assertContents(t *testing.T, seg segment.Segment, docs []doc.Metadata) {
 	// Ensure has contents
 	require.Equal(t, int64(len(docs)), seg.Size())
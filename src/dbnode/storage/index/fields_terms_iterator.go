@@ -26,6 +26,7 @@ import (
 	pilosaroaring "github.com/m3dbx/pilosa/roaring"
 
 	"github.com/m3db/m3/src/dbnode/tracepoint"
+	"github.com/m3db/m3/src/m3ninx/doc"
 	"github.com/m3db/m3/src/m3ninx/index/segment"
 	"github.com/m3db/m3/src/m3ninx/postings"
 	"github.com/m3db/m3/src/m3ninx/postings/roaring"
@@ -38,6 +39,7 @@ var errUnpackBitmapFromPostingsList = errors.New("unable to unpack bitmap from p
 // fieldsAndTermsIteratorOpts configures the fieldsAndTermsIterator.
 type fieldsAndTermsIteratorOpts struct {
 	restrictByQuery *Query
+	excludeIDs      [][]byte
 	iterateTerms    bool
 	allowFn         allowFn
 	fieldIterFn     newFieldIterFn
@@ -105,6 +107,9 @@ func newFieldsAndTermsIterator(
 
 	if opts.restrictByQuery == nil {
 		// No need to restrict results by query.
+		if err := iter.excludeIDs(); err != nil {
+			return nil, err
+		}
 		return iter, nil
 	}
 
@@ -128,9 +133,60 @@ func newFieldsAndTermsIterator(
 	}
 
 	iter.restrictByPostings = bitmap
+	if err := iter.excludeIDs(); err != nil {
+		return nil, err
+	}
 	return iter, nil
 }
 
+// excludeIDs removes the documents of the excluded IDs from the documents
+// the iterator is restricted to, restricting it to all documents of the
+// segment first if it is not yet restricted.
+func (fti *fieldsAndTermsIter) excludeIDs() error {
+	if len(fti.opts.excludeIDs) == 0 {
+		return nil
+	}
+
+	excluded := roaring.NewPostingsList()
+	for _, id := range fti.opts.excludeIDs {
+		pl, err := fti.reader.MatchTerm(doc.IDReservedFieldName, id)
+		if err != nil {
+			return err
+		}
+		if err := excluded.Union(pl); err != nil {
+			return err
+		}
+	}
+
+	if excluded.IsEmpty() {
+		// None of the excluded IDs are in this segment.
+		return nil
+	}
+
+	if fti.restrictByPostings == nil {
+		all, err := fti.reader.MatchAll()
+		if err != nil {
+			return err
+		}
+
+		bitmap, ok := roaring.BitmapFromPostingsList(all)
+		if !ok {
+			return errUnpackBitmapFromPostingsList
+		}
+		fti.restrictByPostings = bitmap
+	}
+
+	excludedBitmap, ok := roaring.BitmapFromPostingsList(excluded)
+	if !ok {
+		return errUnpackBitmapFromPostingsList
+	}
+
+	// NB: Difference returns a new bitmap, leaving the postings lists of the
+	// segment untouched.
+	fti.restrictByPostings = fti.restrictByPostings.Difference(excludedBitmap)
+	return nil
+}
+
 func (fti *fieldsAndTermsIter) setNextField() bool {
 	fieldIter := fti.fieldIter
 	if fieldIter == nil {
@@ -271,6 +271,66 @@ func TestFieldsTermsIteratorIterateTermsAndRestrictByQuery(t *testing.T) {
 	}, slice)
 }
 
+func TestFieldsTermsIteratorExcludeIDs(t *testing.T) {
+	ctx := context.NewBackground()
+
+	testDocs := []doc.Metadata{
+		{
+			ID: []byte("banana"),
+			Fields: []doc.Field{
+				{Name: []byte("fruit"), Value: []byte("banana")},
+				{Name: []byte("color"), Value: []byte("yellow")},
+			},
+		},
+		{
+			ID: []byte("apple"),
+			Fields: []doc.Field{
+				{Name: []byte("fruit"), Value: []byte("apple")},
+				{Name: []byte("color"), Value: []byte("red")},
+				{Name: []byte("shape"), Value: []byte("round")},
+			},
+		},
+	}
+
+	seg, err := mem.NewSegment(mem.NewOptions())
+	require.NoError(t, err)
+	require.NoError(t, seg.InsertBatch(m3ninxindex.Batch{
+		Docs:                testDocs,
+		AllowPartialUpdates: true,
+	}))
+	require.NoError(t, seg.Seal())
+
+	reader, err := seg.Reader()
+	require.NoError(t, err)
+
+	allowFn := func(field []byte) bool {
+		return !bytes.Equal(field, doc.IDReservedFieldName)
+	}
+
+	iter, err := newFieldsAndTermsIterator(ctx, reader, fieldsAndTermsIteratorOpts{
+		iterateTerms: true,
+		allowFn:      allowFn,
+		excludeIDs:   [][]byte{[]byte("apple"), []byte("unknown")},
+	})
+	require.NoError(t, err)
+	slice, err := toSlice(iter)
+	require.NoError(t, err)
+	requireSlicesEqual(t, []pair{
+		{"color", "yellow"},
+		{"fruit", "banana"},
+	}, slice)
+
+	// Fields only present in the excluded documents are excluded too.
+	iter, err = newFieldsAndTermsIterator(ctx, reader, fieldsAndTermsIteratorOpts{
+		allowFn:    allowFn,
+		excludeIDs: [][]byte{[]byte("apple")},
+	})
+	require.NoError(t, err)
+	slice, err = toSlice(iter)
+	require.NoError(t, err)
+	requireSlicesEqual(t, []pair{{"color", ""}, {"fruit", ""}}, slice)
+}
+
 type terms struct {
 	values   []term
 	postings postings.List
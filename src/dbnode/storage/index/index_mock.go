@@ -929,6 +929,21 @@ func (mr *MockBlockMockRecorder) AggregateWithIter(ctx, iter, opts, results, dea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWithIter", reflect.TypeOf((*MockBlock)(nil).AggregateWithIter), ctx, iter, opts, results, deadline, logFields)
 }
 
+// CardinalityStats mocks base method.
+func (m *MockBlock) CardinalityStats(filterID func(ident.ID) bool) (CardinalityStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityStats", filterID)
+	ret0, _ := ret[0].(CardinalityStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityStats indicates an expected call of CardinalityStats.
+func (mr *MockBlockMockRecorder) CardinalityStats(filterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityStats", reflect.TypeOf((*MockBlock)(nil).CardinalityStats), filterID)
+}
+
 // Close mocks base method.
 func (m *MockBlock) Close() error {
 	m.ctrl.T.Helper()
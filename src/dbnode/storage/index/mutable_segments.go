@@ -462,12 +462,13 @@ func (m *mutableSegments) backgroundCompactWithTask(
 	}
 
 	start := time.Now()
-	compacted, err := m.compact.backgroundCompactor.Compact(segments, mmap.ReporterOptions{
-		Context: mmap.Context{
-			Name: mmapIndexBlockName,
-		},
-		Reporter: m.opts.MmapReporter(),
-	})
+	compacted, err := m.compact.backgroundCompactor.Compact(segments,
+		m.blockOpts.DocumentsFilter, mmap.ReporterOptions{
+			Context: mmap.Context{
+				Name: mmapIndexBlockName,
+			},
+			Reporter: m.opts.MmapReporter(),
+		})
 	took := time.Since(start)
 	m.metrics.backgroundCompactionTaskRunLatency.Record(took)
 
@@ -309,6 +309,10 @@ type AggregateResultsOptions struct {
 	// be present for an aggregated term to be returned.
 	RestrictByQuery *Query
 
+	// ExcludeIDs, if provided, are the IDs of series whose documents must not
+	// contribute fields or terms to the results, e.g. deleted series.
+	ExcludeIDs [][]byte
+
 	// AggregateUsageMetrics are aggregate usage metrics that track field
 	// and term counts for aggregate queries.
 	AggregateUsageMetrics AggregateUsageMetrics
@@ -478,6 +482,10 @@ type Block interface {
 	// MemorySegmentsData returns all in memory segments data.
 	MemorySegmentsData(ctx context.Context) ([]fst.SegmentData, error)
 
+	// CardinalityStats returns the cardinality of the series in the block,
+	// only the series accepted by filterID are counted unless it is nil.
+	CardinalityStats(filterID func(id ident.ID) bool) (CardinalityStats, error)
+
 	// Close will release any held resources and close the Block.
 	Close() error
 }
@@ -22,6 +22,7 @@ package storage
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -395,6 +396,102 @@ func TestNamespaceIndexInsertAggregateQuery(t *testing.T) {
 	assert.True(t, vMap.Contains(ident.StringID("value")))
 }
 
+func TestNamespaceIndexQueryAfterDeleteSeries(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+	defer leaktest.CheckTimeout(t, 2*time.Second)()
+
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	now := xtime.Now()
+	idx := setupIndex(t, ctrl, now)
+	defer idx.Close()
+
+	var (
+		nsIdx        = idx.(*nsIndex)
+		ts           = nsIdx.state.latestBlock.StartTime()
+		lifecycleFns = index.NewMockOnIndexSeries(ctrl)
+	)
+	lifecycleFns.EXPECT().OnIndexFinalize(ts)
+	lifecycleFns.EXPECT().OnIndexSuccess(ts)
+
+	entry, d := testWriteBatchEntry(ident.StringID("bar"), ident.NewTags(
+		ident.StringTag("name", "other"),
+		ident.StringTag("job", "api"),
+	), now, lifecycleFns)
+	require.NoError(t, idx.WriteBatch(testWriteBatch(entry, d,
+		testWriteBatchBlockSizeOption(nsIdx.blockSize))))
+
+	// Delete "foo" without compacting the index segments, as DeleteSeries does.
+	tombstones, _, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+	nsIdx.tombstones = tombstones
+
+	deleted := xtime.Range{Start: now.Add(-time.Minute), End: now.Add(time.Minute)}
+	require.NoError(t, tombstones.Add([]tombstonedSeries{
+		{id: []byte("foo"), shard: testShardSet.Lookup(ident.StringID("foo"))},
+	}, deleted))
+
+	reQuery, err := m3ninxidx.NewRegexpQuery([]byte("name"), []byte(".*"))
+	require.NoError(t, err)
+
+	queryIDs := func(r xtime.Range) []string {
+		res, err := idx.Query(ctx, index.Query{Query: reQuery}, index.QueryOptions{
+			StartInclusive: r.Start,
+			EndExclusive:   r.End,
+		})
+		require.NoError(t, err)
+
+		var ids []string
+		for _, entry := range res.Results.Map().Iter() {
+			ids = append(ids, string(entry.Key()))
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	assert.Equal(t, []string{"bar"}, queryIDs(deleted))
+	// The series is only deleted for part of a wider query range.
+	assert.Equal(t, []string{"bar", "foo"}, queryIDs(xtime.Range{
+		Start: deleted.Start.Add(-time.Minute),
+		End:   deleted.End,
+	}))
+
+	aggregate := func(query m3ninxidx.Query) map[string][]string {
+		res, err := idx.AggregateQuery(ctx, index.Query{Query: query},
+			index.AggregationOptions{
+				QueryOptions: index.QueryOptions{
+					StartInclusive: deleted.Start,
+					EndExclusive:   deleted.End,
+				},
+				Type: index.AggregateTagNamesAndValues,
+			})
+		require.NoError(t, err)
+
+		result := make(map[string][]string)
+		for _, entry := range res.Results.Map().Iter() {
+			var (
+				values    []string
+				valuesMap = entry.Value()
+			)
+			for _, value := range valuesMap.Map().Iter() {
+				values = append(values, value.Key().String())
+			}
+			sort.Strings(values)
+			result[entry.Key().String()] = values
+		}
+		return result
+	}
+
+	expected := map[string][]string{
+		"job":  {"api"},
+		"name": {"other"},
+	}
+	assert.Equal(t, expected, aggregate(m3ninxidx.NewAllQuery()))
+	assert.Equal(t, expected, aggregate(reQuery))
+}
+
 func TestNamespaceIndexInsertWideQuery(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/schema"
+	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/sharding"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap"
@@ -134,6 +135,7 @@ type dbNamespace struct {
 	increasingIndex increasingIndex
 	commitLogWriter commitLogWriter
 	reverseIndex    NamespaceIndex
+	tombstones      *seriesTombstones
 
 	tickWorkers            xsync.WorkerPool
 	tickWorkersConcurrency int
@@ -170,6 +172,7 @@ type databaseNamespaceMetrics struct {
 	queryIDs            instrument.MethodMetrics
 	wideQuery           instrument.MethodMetrics
 	aggregateQuery      instrument.MethodMetrics
+	deleteSeries        instrument.MethodMetrics
 
 	unfulfilled             tally.Counter
 	bootstrapStart          tally.Counter
@@ -260,6 +263,7 @@ func newDatabaseNamespaceMetrics(
 		queryIDs:            instrument.NewMethodMetrics(scope, "queryIDs", opts),
 		wideQuery:           instrument.NewMethodMetrics(scope, "wideQuery", opts),
 		aggregateQuery:      instrument.NewMethodMetrics(scope, "aggregateQuery", opts),
+		deleteSeries:        instrument.NewMethodMetrics(scope, "deleteSeries", opts),
 
 		unfulfilled:             bootstrapScope.Counter("unfulfilled"),
 		bootstrapStart:          bootstrapScope.Counter("start"),
@@ -345,13 +349,26 @@ func newDatabaseNamespace(
 			metadata.ID().String(), err)
 	}
 
-	var (
-		index NamespaceIndex
-		err   error
-	)
+	fsOpts := opts.CommitLogOptions().FilesystemOptions()
+	tombstones, err := newSeriesTombstones(
+		fs.NamespaceTombstonesFilePath(fsOpts.FilePathPrefix(), id), fsOpts)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"unable to load series tombstones for namespace %v, error: %v",
+			metadata.ID().String(), err)
+	}
+
+	var reverseIndex NamespaceIndex
 	if metadata.Options().IndexOptions().Enabled() {
-		index, err = newNamespaceIndex(metadata, namespaceRuntimeOptsMgr,
-			shardSet, opts)
+		reverseIndex, err = newNamespaceIndexWithOptions(newNamespaceIndexOpts{
+			md:                      metadata,
+			namespaceRuntimeOptsMgr: namespaceRuntimeOptsMgr,
+			shardSet:                shardSet,
+			opts:                    opts,
+			newIndexQueueFn:         newNamespaceIndexInsertQueue,
+			newBlockFn:              index.NewBlock,
+			tombstones:              tombstones,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -372,7 +389,8 @@ func newDatabaseNamespace(
 		log:                    logger,
 		increasingIndex:        increasingIndex,
 		commitLogWriter:        commitLogWriter,
-		reverseIndex:           index,
+		reverseIndex:           reverseIndex,
+		tombstones:             tombstones,
 		tickWorkers:            tickWorkers,
 		tickWorkersConcurrency: tickWorkersConcurrency,
 		metrics:                newDatabaseNamespaceMetrics(scope, iops.TimerOptions()),
@@ -533,7 +551,7 @@ func (n *dbNamespace) assignShardSet(
 		// shard created for this shard ID.
 		n.shards[shard] = newDatabaseShard(metadata, shard, n.blockRetriever,
 			n.namespaceReaderMgr, n.increasingIndex, n.reverseIndex,
-			opts.needsBootstrap, n.opts, n.seriesOpts)
+			n.tombstones, opts.needsBootstrap, n.opts, n.seriesOpts)
 		createdShardIds = append(createdShardIds, shard)
 		// NB(bodu): We only record shard add metrics for shards created in non
 		// initial assignments.
@@ -653,6 +671,12 @@ func (n *dbNamespace) Tick(c context.Cancellable, startTime xtime.UnixNano) erro
 		}
 	}
 
+	// Drop the tombstones of deleted series that are out of retention.
+	earliest := retention.FlushTimeStart(n.nopts.RetentionOptions(), startTime)
+	if err := n.tombstones.Expire(earliest); err != nil {
+		multiErr = multiErr.Add(err)
+	}
+
 	// NB: we early terminate here to ensure we are not reporting metrics
 	// based on in-accurate/partial tick results.
 	if err := multiErr.FinalError(); err != nil || c.IsCancelled() {
@@ -1517,6 +1541,51 @@ func (n *dbNamespace) Truncate() (int64, error) {
 	return totalNumSeries, nil
 }
 
+func (n *dbNamespace) DeleteSeries(
+	ctx context.Context,
+	query index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	callStart := n.nowFn()
+	if n.reverseIndex == nil {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return 0, errNamespaceIndexingDisabled
+	}
+
+	if !n.reverseIndex.Bootstrapped() {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return 0, xerrors.NewRetryableError(errIndexNotBootstrappedToRead)
+	}
+
+	res, err := n.reverseIndex.Query(ctx, query, index.QueryOptions{
+		StartInclusive: start,
+		EndExclusive:   end,
+	})
+	if err != nil {
+		n.metrics.deleteSeries.ReportError(n.nowFn().Sub(callStart))
+		return 0, err
+	}
+
+	series := make([]tombstonedSeries, 0, res.Results.Size())
+	for _, entry := range res.Results.Map().Iter() {
+		// Copy the ID since the query results are finalized with the context.
+		id := append([]byte(nil), entry.Key()...)
+		series = append(series, tombstonedSeries{
+			id:    id,
+			shard: n.shardSet.Lookup(ident.BytesID(id)),
+		})
+	}
+
+	// NB: the tombstones are persisted before returning so that the deletes
+	// are not lost if the node restarts before the next flush.
+	err = n.tombstones.Add(series, xtime.Range{Start: start, End: end})
+	n.metrics.deleteSeries.ReportSuccessOrError(err, n.nowFn().Sub(callStart))
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(series)), nil
+}
+
 func (n *dbNamespace) Repair(
 	repairer databaseShardRepairer,
 	tr xtime.Range,
@@ -1552,6 +1621,9 @@ func (n *dbNamespace) Repair(
 
 	multiErr := xerrors.NewMultiError()
 	shards := n.OwnedShards()
+	if len(opts.Shards) > 0 {
+		shards = filterShards(shards, opts.Shards)
+	}
 	numShards := len(shards)
 	if numShards > 0 {
 		throttlePerShard = time.Duration(
@@ -1577,6 +1649,9 @@ func (n *dbNamespace) Repair(
 			defer ctx.Close()
 
 			metadataRes, err := shard.Repair(ctx, nsCtx, nsMeta, tr, repairer)
+			if opts.OnShardRepaired != nil {
+				opts.OnShardRepaired(shard.ID(), metadataRes, err)
+			}
 
 			mutex.Lock()
 			if err != nil {
@@ -1615,6 +1690,19 @@ func (n *dbNamespace) Repair(
 	return multiErr.FinalError()
 }
 
+func filterShards(shards []databaseShard, ids []uint32) []databaseShard {
+	filtered := make([]databaseShard, 0, len(ids))
+	for _, shard := range shards {
+		for _, id := range ids {
+			if shard.ID() == id {
+				filtered = append(filtered, shard)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func (n *dbNamespace) OwnedShards() []databaseShard {
 	n.RLock()
 	shards := n.shardSet.AllIDs()
@@ -869,6 +869,52 @@ func TestNamespaceRepair(t *testing.T) {
 	require.Equal(t, "foo", ns.Repair(repairer, repairTimeRange, NamespaceRepairOptions{}).Error())
 }
 
+func TestNamespaceRepairShards(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ns, closer := newTestNamespaceWithIDOpts(t, defaultTestNs1ID, namespace.NewOptions())
+	defer closer()
+	now := xtime.Now()
+	repairTimeRange := xtime.Range{Start: now, End: now.Add(time.Hour)}
+	opts := repair.NewOptions().SetRepairThrottle(time.Duration(0))
+	repairer := NewMockdatabaseShardRepairer(ctrl)
+	repairer.EXPECT().Options().Return(opts).AnyTimes()
+
+	res := repair.MetadataComparisonResult{
+		NumSeries:           1,
+		NumBlocks:           2,
+		SizeDifferences:     repair.NewReplicaSeriesMetadata(),
+		ChecksumDifferences: repair.NewReplicaSeriesMetadata(),
+	}
+	for i := range testShardIDs {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ID().Return(testShardIDs[i].ID()).AnyTimes()
+		if i == 1 {
+			// Only the requested shard should be repaired.
+			shard.EXPECT().
+				Repair(gomock.Any(), gomock.Any(), gomock.Any(), repairTimeRange, repairer).
+				Return(res, nil)
+		}
+		ns.shards[testShardIDs[i].ID()] = shard
+	}
+
+	var (
+		repaired []uint32
+		results  []repair.MetadataComparisonResult
+	)
+	require.NoError(t, ns.Repair(repairer, repairTimeRange, NamespaceRepairOptions{
+		Force:  true,
+		Shards: []uint32{testShardIDs[1].ID()},
+		OnShardRepaired: func(shard uint32, shardRes repair.MetadataComparisonResult, err error) {
+			repaired = append(repaired, shard)
+			results = append(results, shardRes)
+		},
+	}))
+	require.Equal(t, []uint32{testShardIDs[1].ID()}, repaired)
+	require.Equal(t, []repair.MetadataComparisonResult{res}, results)
+}
+
 func TestNamespaceShardAt(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
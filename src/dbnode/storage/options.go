@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/persist/fs/tiering"
 	"github.com/m3db/m3/src/dbnode/retention"
 	m3dbruntime "github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/storage/block"
@@ -95,6 +96,7 @@ var (
 	errIndexOptionsNotSet         = errors.New("index enabled but index options are not set")
 	errPersistManagerNotSet       = errors.New("persist manager is not set")
 	errIndexClaimsManagerNotSet   = errors.New("index claims manager is not set")
+	errFilePinsNotSet             = errors.New("file pins are not set")
 	errBlockLeaserNotSet          = errors.New("block leaser is not set")
 	errOnColdFlushNotSet          = errors.New("on cold flush is not set, requires at least a no-op implementation")
 	errLimitsOptionsNotSet        = errors.New("limits options are not set")
@@ -141,6 +143,8 @@ type options struct {
 	bootstrapProcessProvider        bootstrap.ProcessProvider
 	persistManager                  persist.Manager
 	indexClaimsManager              fs.IndexClaimsManager
+	filePins                        fs.FilePins
+	tieringManager                  tiering.Manager
 	blockRetrieverManager           block.DatabaseBlockRetrieverManager
 	poolOpts                        pool.ObjectPoolOptions
 	contextPool                     context.Pool
@@ -252,6 +256,7 @@ func newOptions(poolOpts pool.ObjectPoolOptions) Options {
 		namespaceHooks:                  &noopNamespaceHooks{},
 		tileAggregator:                  &noopTileAggregator{},
 		permitsOptions:                  permits.NewOptions(),
+		filePins:                        fs.NewFilePins(),
 		limitsOptions:                   limits.DefaultLimitsOptions(iOpts),
 	}
 	return o.SetEncodingM3TSZPooled()
@@ -302,6 +307,11 @@ func (o *options) Validate() error {
 		return errIndexClaimsManagerNotSet
 	}
 
+	// validate that file pins are present
+	if o.filePins == nil {
+		return errFilePinsNotSet
+	}
+
 	// validate series cache policy
 	if err := series.ValidateCachePolicy(o.seriesCachePolicy); err != nil {
 		return err
@@ -570,6 +580,26 @@ func (o *options) IndexClaimsManager() fs.IndexClaimsManager {
 	return o.indexClaimsManager
 }
 
+func (o *options) SetFilePins(value fs.FilePins) Options {
+	opts := *o
+	opts.filePins = value
+	return &opts
+}
+
+func (o *options) FilePins() fs.FilePins {
+	return o.filePins
+}
+
+func (o *options) SetTieringManager(value tiering.Manager) Options {
+	opts := *o
+	opts.tieringManager = value
+	return &opts
+}
+
+func (o *options) TieringManager() tiering.Manager {
+	return o.tieringManager
+}
+
 func (o *options) SetDatabaseBlockRetrieverManager(value block.DatabaseBlockRetrieverManager) Options {
 	opts := *o
 	opts.blockRetrieverManager = value
@@ -35,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/retention"
 	"github.com/m3db/m3/src/dbnode/storage/block"
 	"github.com/m3db/m3/src/dbnode/storage/bootstrap/result"
+	dberrors "github.com/m3db/m3/src/dbnode/storage/errors"
 	"github.com/m3db/m3/src/dbnode/storage/repair"
 	"github.com/m3db/m3/src/dbnode/topology"
 	"github.com/m3db/m3/src/dbnode/x/xio"
@@ -51,8 +52,10 @@ import (
 )
 
 var (
-	errNoRepairOptions  = errors.New("no repair options")
-	errRepairInProgress = errors.New("repair already in progress")
+	errNoRepairOptions       = errors.New("no repair options")
+	errRepairInProgress      = repair.ErrRepairInProgress
+	errRepairNotEnabled      = errors.New("repair is not enabled")
+	errRepairNotBootstrapped = errors.New("database is not bootstrapped")
 )
 
 type recordFn func(
@@ -75,11 +78,16 @@ type shardRepairer struct {
 }
 
 type shardRepairerMetrics struct {
-	runDefault     tally.Counter
-	runOnlyCompare tally.Counter
+	runDefault        tally.Counter
+	runOnlyCompare    tally.Counter
+	runMismatchOnly   tally.Counter
+	mismatchedFetched tally.Counter
 }
 
 func newShardRepairerMetrics(scope tally.Scope) shardRepairerMetrics {
+	mismatchOnlyScope := scope.Tagged(map[string]string{
+		"repair_type": "mismatch_only",
+	})
 	return shardRepairerMetrics{
 		runDefault: scope.Tagged(map[string]string{
 			"repair_type": "default",
@@ -87,6 +95,8 @@ func newShardRepairerMetrics(scope tally.Scope) shardRepairerMetrics {
 		runOnlyCompare: scope.Tagged(map[string]string{
 			"repair_type": "only_compare",
 		}).Counter("run"),
+		runMismatchOnly:   mismatchOnlyScope.Counter("run"),
+		mismatchedFetched: mismatchOnlyScope.Counter("fetched-blocks"),
 	}
 }
 
@@ -125,6 +135,8 @@ func (r shardRepairer) Repair(
 		defer r.metrics.runDefault.Inc(1)
 	case repair.OnlyCompareRepair:
 		defer r.metrics.runOnlyCompare.Inc(1)
+	case repair.MismatchOnlyRepair:
+		defer r.metrics.runMismatchOnly.Inc(1)
 	default:
 		// Unknown repair type.
 		err := fmt.Errorf("unknown repair type: %v", repairType)
@@ -248,6 +260,12 @@ func (r shardRepairer) Repair(
 		// Early return if repair type doesn't require executing repairing the data step.
 		return metadataRes, nil
 	}
+	if repairType == repair.MismatchOnlyRepair {
+		if err := r.repairMismatches(nsMeta, tr, shard, origin, sessions, metadataRes); err != nil {
+			return repair.MetadataComparisonResult{}, err
+		}
+		return metadataRes, nil
+	}
 
 	originID := origin.ID()
 	for _, e := range seriesWithChecksumMismatches.Iter() {
@@ -338,6 +356,137 @@ func (r shardRepairer) Repair(
 	return metadataRes, nil
 }
 
+// repairMismatches fetches the blocks with checksum differences from only the peers whose
+// checksums differ from the local checksum, fetching each distinct checksum once. Blocks are
+// fetched and loaded into the shard in batches so that the data held in memory, and the burst
+// of data fetched from peers, is bounded regardless of how many series mismatch.
+func (r shardRepairer) repairMismatches(
+	nsMeta namespace.Metadata,
+	tr xtime.Range,
+	shard databaseShard,
+	origin topology.Host,
+	sessions []sessionAndTopo,
+	metadataRes repair.MetadataComparisonResult,
+) error {
+	var (
+		rsOpts    = r.opts.RepairOptions().ResultOptions()
+		level     = r.rpopts.RepairConsistencyLevel()
+		batchSize = r.rpopts.StreamBatchSize()
+		batches   = make([][]block.ReplicaMetadata, len(sessions))
+	)
+
+	fetchBatch := func(i int) error {
+		batch := batches[i]
+		if len(batch) == 0 {
+			return nil
+		}
+		batches[i] = nil
+
+		perSeriesReplicaIter, err := sessions[i].session.FetchBlocksFromPeers(nsMeta, shard.ID(),
+			level, batch, rsOpts)
+		if err != nil {
+			return err
+		}
+
+		results := result.NewShardResult(rsOpts)
+		for perSeriesReplicaIter.Next() {
+			_, id, block := perSeriesReplicaIter.Current()
+			if existing, ok := results.BlockAt(id, block.StartTime()); ok {
+				if err := existing.Merge(block); err != nil {
+					return err
+				}
+			} else {
+				results.AddBlock(id, ident.Tags{}, block)
+			}
+		}
+		r.metrics.mismatchedFetched.Inc(int64(len(batch)))
+		return r.loadDataIntoShard(shard, results)
+	}
+
+	for _, e := range metadataRes.ChecksumDifferences.Series().Iter() {
+		for blockStart, replicaMetadataBlocks := range e.Value().Metadata.Blocks() {
+			if !tr.Contains(xtime.Range{Start: blockStart, End: blockStart}) {
+				continue
+			}
+
+			for _, replicaMetadata := range mismatchedPeerMetadata(origin, replicaMetadataBlocks.Metadata()) {
+				i, ok := sessionIndexForHost(sessions, replicaMetadata.Host.ID())
+				if !ok {
+					r.logger.Debug(
+						"could not identify which session mismatched metadata belong to",
+						zap.String("hostID", replicaMetadata.Host.ID()),
+						zap.Time("blockStart", blockStart.ToTime()),
+					)
+					continue
+				}
+
+				batches[i] = append(batches[i], replicaMetadata)
+				if len(batches[i]) < batchSize {
+					continue
+				}
+				if err := fetchBatch(i); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for i := range batches {
+		if err := fetchBatch(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mismatchedPeerMetadata returns the peer metadata of a block whose checksum differs from the
+// origin's checksum, with one metadata per distinct checksum. Metadata without a checksum is
+// skipped for the same reason it is skipped by the metadata comparison.
+func mismatchedPeerMetadata(
+	origin topology.Host,
+	metadata []block.ReplicaMetadata,
+) []block.ReplicaMetadata {
+	var originChecksum *uint32
+	for _, m := range metadata {
+		if m.Host.ID() == origin.ID() {
+			originChecksum = m.Metadata.Checksum
+			break
+		}
+	}
+
+	var (
+		mismatched []block.ReplicaMetadata
+		seen       = make(map[uint32]struct{}, len(metadata))
+	)
+	for _, m := range metadata {
+		if m.Host.ID() == origin.ID() || m.Metadata.Checksum == nil {
+			continue
+		}
+		checksum := *m.Metadata.Checksum
+		if originChecksum != nil && checksum == *originChecksum {
+			continue
+		}
+		if _, ok := seen[checksum]; ok {
+			continue
+		}
+		seen[checksum] = struct{}{}
+		mismatched = append(mismatched, m)
+	}
+	return mismatched
+}
+
+func sessionIndexForHost(sessions []sessionAndTopo, hostID string) (int, bool) {
+	if len(sessions) == 1 {
+		return 0, true
+	}
+	for i, sesTopo := range sessions {
+		if _, ok := sesTopo.topo.LookupHostShardSet(hostID); ok {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 // TODO(rartoul): Currently throttling via the MemoryTracker can only occur at the level of an entire
 // block for a given namespace/shard/blockStart. For almost all practical use-cases this is fine, but
 // this could be improved and made more granular by breaking data that is being loaded into the shard
@@ -560,6 +709,9 @@ type dbRepairer struct {
 	closedLock sync.Mutex
 	running    int32
 	closed     bool
+
+	progressLock sync.RWMutex
+	progress     *repair.Progress
 }
 
 func newDatabaseRepairer(database database, opts Options) (databaseRepairer, error) {
@@ -784,6 +936,138 @@ func (r *dbRepairer) markRepairAttempt(
 	r.repairStatesByNs.setRepairState(namespace, blockStart, repairState)
 }
 
+// StartRepair starts an on demand repair of a namespace in the background. It shares the
+// running state of the background repair so at most one of either runs at a time.
+func (r *dbRepairer) StartRepair(req repair.Request) (repair.Progress, error) {
+	if !r.database.IsBootstrapped() {
+		return repair.Progress{}, errRepairNotBootstrapped
+	}
+
+	n, shards, err := r.repairTargets(req)
+	if err != nil {
+		return repair.Progress{}, err
+	}
+
+	blockSize := n.Options().RetentionOptions().BlockSize()
+	req.Range = xtime.Range{
+		Start: req.Range.Start.Truncate(blockSize),
+		End:   req.Range.End.Add(blockSize - 1).Truncate(blockSize),
+	}
+	req.Shards = shards
+
+	if !atomic.CompareAndSwapInt32(&r.running, 0, 1) {
+		return repair.Progress{}, errRepairInProgress
+	}
+
+	progress := &repair.Progress{
+		Request:   req,
+		State:     repair.StateRunning,
+		StartedAt: r.nowFn(),
+		NumShards: len(shards),
+	}
+	r.progressLock.Lock()
+	r.progress = progress
+	started := *progress
+	r.progressLock.Unlock()
+
+	shardRepairer := newShardRepairer(r.opts, r.ropts.SetType(req.Type))
+	go r.runRepair(n, req, shardRepairer, progress)
+
+	return started, nil
+}
+
+// repairTargets returns the namespace and the owned shards to repair, validating that every
+// requested shard is owned.
+func (r *dbRepairer) repairTargets(req repair.Request) (databaseNamespace, []uint32, error) {
+	namespaces, err := r.database.OwnedNamespaces()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, n := range namespaces {
+		if !n.ID().Equal(req.Namespace) {
+			continue
+		}
+
+		owned := make(map[uint32]struct{})
+		var shards []uint32
+		for _, shard := range n.OwnedShards() {
+			owned[shard.ID()] = struct{}{}
+			shards = append(shards, shard.ID())
+		}
+		if len(req.Shards) == 0 {
+			return n, shards, nil
+		}
+
+		for _, shard := range req.Shards {
+			if _, ok := owned[shard]; !ok {
+				return nil, nil, xerrors.NewInvalidParamsError(
+					fmt.Errorf("shard %d is not owned by this node", shard))
+			}
+		}
+		return n, req.Shards, nil
+	}
+
+	return nil, nil, dberrors.NewUnknownNamespaceError(req.Namespace.String())
+}
+
+func (r *dbRepairer) runRepair(
+	n databaseNamespace,
+	req repair.Request,
+	shardRepairer databaseShardRepairer,
+	progress *repair.Progress,
+) {
+	defer atomic.StoreInt32(&r.running, 0)
+
+	err := n.Repair(shardRepairer, req.Range, NamespaceRepairOptions{
+		Force:  true,
+		Shards: req.Shards,
+		OnShardRepaired: func(shard uint32, res repair.MetadataComparisonResult, err error) {
+			r.progressLock.Lock()
+			defer r.progressLock.Unlock()
+
+			if err != nil {
+				progress.NumShardsFailed++
+				progress.Errors = append(progress.Errors, fmt.Sprintf("shard %d: %v", shard, err))
+				return
+			}
+
+			progress.NumShardsRepaired++
+			progress.NumSeries += res.NumSeries
+			progress.NumBlocks += res.NumBlocks
+			progress.NumChecksumDiffSeries += res.ChecksumDifferences.NumSeries()
+			progress.NumChecksumDiffBlocks += res.ChecksumDifferences.NumBlocks()
+			limit := req.MaxReportedSeries - len(progress.MismatchedSeries)
+			progress.MismatchedSeries = append(progress.MismatchedSeries,
+				repair.MismatchedSeriesFromComparison(shard, res, limit)...)
+		},
+	})
+
+	r.progressLock.Lock()
+	progress.CompletedAt = r.nowFn()
+	if err != nil {
+		progress.State = repair.StateFailed
+	} else {
+		progress.State = repair.StateSucceeded
+	}
+	r.progressLock.Unlock()
+
+	if err != nil {
+		r.logger.Error("on demand repair failed",
+			zap.String("namespace", req.Namespace.String()), zap.Error(err))
+	}
+}
+
+func (r *dbRepairer) RepairProgress() (repair.Progress, bool) {
+	r.progressLock.RLock()
+	defer r.progressLock.RUnlock()
+
+	if r.progress == nil {
+		return repair.Progress{}, false
+	}
+	return *r.progress, true
+}
+
 var noOpRepairer databaseRepairer = repairerNoOp{}
 
 type repairerNoOp struct{}
@@ -795,6 +1079,14 @@ func (r repairerNoOp) Stop()         {}
 func (r repairerNoOp) Repair() error { return nil }
 func (r repairerNoOp) Report()       {}
 
+func (r repairerNoOp) StartRepair(repair.Request) (repair.Progress, error) {
+	return repair.Progress{}, errRepairNotEnabled
+}
+
+func (r repairerNoOp) RepairProgress() (repair.Progress, bool) {
+	return repair.Progress{}, false
+}
+
 func (r shardRepairer) shadowCompare(
 	start xtime.UnixNano,
 	end xtime.UnixNano,
@@ -0,0 +1,259 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package repair
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RepairURL is the URL of the repair handler.
+	RepairURL = "/repair"
+
+	defaultMaxReportedSeries = 1000
+)
+
+var (
+	// ErrRepairInProgress is returned when a repair is started while another
+	// repair is running.
+	ErrRepairInProgress = errors.New("repair already in progress")
+
+	errRepairNamespaceRequired = errors.New("namespace is required")
+	errRepairRangeInvalid      = errors.New("start must be before end")
+)
+
+// RepairHTTPRequest is the body of a request to start a repair.
+type RepairHTTPRequest struct {
+	// Namespace is the namespace to repair.
+	Namespace string `json:"namespace"`
+	// Shards are the shards to repair, defaulting to all owned shards.
+	Shards []uint32 `json:"shards"`
+	// Start is the start of the time range to repair.
+	Start time.Time `json:"start"`
+	// End is the end of the time range to repair.
+	End time.Time `json:"end"`
+	// Type is the type of repair, defaulting to mismatch_only.
+	Type string `json:"type"`
+	// MaxReportedSeries is the maximum number of mismatched series reported,
+	// defaulting to 1000.
+	MaxReportedSeries int `json:"maxReportedSeries"`
+}
+
+// RepairHTTPResponse is the progress and report of a repair.
+type RepairHTTPResponse struct {
+	Namespace   string     `json:"namespace"`
+	Shards      []uint32   `json:"shards,omitempty"`
+	Start       time.Time  `json:"start"`
+	End         time.Time  `json:"end"`
+	Type        string     `json:"type"`
+	State       string     `json:"state"`
+	StartedAt   time.Time  `json:"startedAt"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	NumShards         int `json:"numShards"`
+	NumShardsRepaired int `json:"numShardsRepaired"`
+	NumShardsFailed   int `json:"numShardsFailed"`
+
+	NumSeries             int64 `json:"numSeries"`
+	NumBlocks             int64 `json:"numBlocks"`
+	NumChecksumDiffSeries int64 `json:"numChecksumDiffSeries"`
+	NumChecksumDiffBlocks int64 `json:"numChecksumDiffBlocks"`
+
+	MismatchedSeries []MismatchedSeriesJSON `json:"mismatchedSeries"`
+	Errors           []string               `json:"errors,omitempty"`
+}
+
+// MismatchedSeriesJSON is a mismatched series in a repair report.
+type MismatchedSeriesJSON struct {
+	Shard  uint32                `json:"shard"`
+	ID     string                `json:"id"`
+	Blocks []MismatchedBlockJSON `json:"blocks"`
+}
+
+// MismatchedBlockJSON is a mismatched block in a repair report.
+type MismatchedBlockJSON struct {
+	Start    time.Time          `json:"start"`
+	Replicas []ReplicaBlockJSON `json:"replicas"`
+}
+
+// ReplicaBlockJSON is the metadata of a block on a replica in a repair report.
+type ReplicaBlockJSON struct {
+	Host     string  `json:"host"`
+	Size     int64   `json:"size"`
+	Checksum *uint32 `json:"checksum,omitempty"`
+}
+
+type handler struct {
+	repairer Repairer
+	logger   *zap.Logger
+}
+
+// NewHandler returns a handler which starts repairs with a POST request and
+// returns the progress of the most recent repair with a GET request.
+func NewHandler(repairer Repairer, iOpts instrument.Options) http.Handler {
+	return &handler{
+		repairer: repairer,
+		logger:   iOpts.Logger(),
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		progress, ok := h.repairer.RepairProgress()
+		if !ok {
+			xhttp.WriteError(w, xhttp.NewError(
+				errors.New("no repair has been started"), http.StatusNotFound))
+			return
+		}
+		xhttp.WriteJSONResponse(w, newRepairHTTPResponse(progress), h.logger)
+	case http.MethodPost:
+		h.startRepair(w, r)
+	default:
+		xhttp.WriteError(w, xhttp.NewError(
+			fmt.Errorf("unsupported method: %s", r.Method), http.StatusMethodNotAllowed))
+	}
+}
+
+func (h *handler) startRepair(w http.ResponseWriter, r *http.Request) {
+	var body RepairHTTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	req, err := newRequest(body)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	progress, err := h.repairer.StartRepair(req)
+	if errors.Is(err, ErrRepairInProgress) {
+		xhttp.WriteError(w, xhttp.NewError(err, http.StatusConflict))
+		return
+	}
+	if err != nil {
+		h.logger.Error("could not start repair",
+			zap.String("namespace", body.Namespace), zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+	xhttp.WriteJSONResponse(w, newRepairHTTPResponse(progress), h.logger)
+}
+
+func newRequest(body RepairHTTPRequest) (Request, error) {
+	if body.Namespace == "" {
+		return Request{}, errRepairNamespaceRequired
+	}
+	if !body.Start.Before(body.End) {
+		return Request{}, errRepairRangeInvalid
+	}
+
+	repairType := MismatchOnlyRepair
+	if body.Type != "" {
+		found := false
+		for _, valid := range validTypes {
+			if body.Type == valid.String() {
+				repairType = valid
+				found = true
+				break
+			}
+		}
+		if !found {
+			return Request{}, fmt.Errorf("invalid repair type '%s' valid types are: %s",
+				body.Type, validTypes)
+		}
+	}
+
+	maxReportedSeries := body.MaxReportedSeries
+	if maxReportedSeries <= 0 {
+		maxReportedSeries = defaultMaxReportedSeries
+	}
+
+	return Request{
+		Namespace: ident.StringID(body.Namespace),
+		Shards:    body.Shards,
+		Range: xtime.Range{
+			Start: xtime.ToUnixNano(body.Start),
+			End:   xtime.ToUnixNano(body.End),
+		},
+		Type:              repairType,
+		MaxReportedSeries: maxReportedSeries,
+	}, nil
+}
+
+func newRepairHTTPResponse(progress Progress) RepairHTTPResponse {
+	resp := RepairHTTPResponse{
+		Namespace:             progress.Request.Namespace.String(),
+		Shards:                progress.Request.Shards,
+		Start:                 progress.Request.Range.Start.ToTime().UTC(),
+		End:                   progress.Request.Range.End.ToTime().UTC(),
+		Type:                  progress.Request.Type.String(),
+		State:                 progress.State.String(),
+		StartedAt:             progress.StartedAt.UTC(),
+		NumShards:             progress.NumShards,
+		NumShardsRepaired:     progress.NumShardsRepaired,
+		NumShardsFailed:       progress.NumShardsFailed,
+		NumSeries:             progress.NumSeries,
+		NumBlocks:             progress.NumBlocks,
+		NumChecksumDiffSeries: progress.NumChecksumDiffSeries,
+		NumChecksumDiffBlocks: progress.NumChecksumDiffBlocks,
+		MismatchedSeries:      make([]MismatchedSeriesJSON, 0, len(progress.MismatchedSeries)),
+		Errors:                progress.Errors,
+	}
+	if !progress.CompletedAt.IsZero() {
+		completedAt := progress.CompletedAt.UTC()
+		resp.CompletedAt = &completedAt
+	}
+
+	for _, series := range progress.MismatchedSeries {
+		s := MismatchedSeriesJSON{
+			Shard:  series.Shard,
+			ID:     series.ID,
+			Blocks: make([]MismatchedBlockJSON, 0, len(series.Blocks)),
+		}
+		for _, b := range series.Blocks {
+			replicas := make([]ReplicaBlockJSON, 0, len(b.Replicas))
+			for _, replica := range b.Replicas {
+				replicas = append(replicas, ReplicaBlockJSON(replica))
+			}
+			s.Blocks = append(s.Blocks, MismatchedBlockJSON{
+				Start:    b.Start.ToTime().UTC(),
+				Replicas: replicas,
+			})
+		}
+		resp.MismatchedSeries = append(resp.MismatchedSeries, s)
+	}
+	return resp
+}
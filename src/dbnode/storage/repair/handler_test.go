@@ -0,0 +1,194 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package repair
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepairer struct {
+	requests []Request
+	progress *Progress
+	err      error
+}
+
+func (r *fakeRepairer) StartRepair(req Request) (Progress, error) {
+	if r.err != nil {
+		return Progress{}, r.err
+	}
+	r.requests = append(r.requests, req)
+	r.progress = &Progress{
+		Request:   req,
+		State:     StateRunning,
+		StartedAt: time.Unix(100, 0),
+		NumShards: len(req.Shards),
+	}
+	return *r.progress, nil
+}
+
+func (r *fakeRepairer) RepairProgress() (Progress, bool) {
+	if r.progress == nil {
+		return Progress{}, false
+	}
+	return *r.progress, true
+}
+
+func TestHandlerStartRepair(t *testing.T) {
+	repairer := &fakeRepairer{}
+	handler := NewHandler(repairer, instrument.NewOptions())
+
+	body := `{"namespace":"metrics","shards":[1,2],` +
+		`"start":"2021-04-01T10:00:00Z","end":"2021-04-01T12:00:00Z"}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, RepairURL,
+		strings.NewReader(body)))
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	start := time.Date(2021, 4, 1, 10, 0, 0, 0, time.UTC)
+	require.Equal(t, []Request{
+		{
+			Namespace: ident.StringID("metrics"),
+			Shards:    []uint32{1, 2},
+			Range: xtime.Range{
+				Start: xtime.ToUnixNano(start),
+				End:   xtime.ToUnixNano(start.Add(2 * time.Hour)),
+			},
+			Type:              MismatchOnlyRepair,
+			MaxReportedSeries: defaultMaxReportedSeries,
+		},
+	}, repairer.requests)
+
+	var resp RepairHTTPResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	require.Equal(t, "metrics", resp.Namespace)
+	require.Equal(t, "running", resp.State)
+	require.Equal(t, "mismatch_only", resp.Type)
+	require.Equal(t, 2, resp.NumShards)
+	require.Nil(t, resp.CompletedAt)
+}
+
+func TestHandlerRepairProgress(t *testing.T) {
+	repairer := &fakeRepairer{}
+	handler := NewHandler(repairer, instrument.NewOptions())
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, RepairURL, nil))
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	now := xtime.Now().Truncate(time.Hour)
+	checksum := uint32(5)
+	repairer.progress = &Progress{
+		Request: Request{
+			Namespace: ident.StringID("metrics"),
+			Range:     xtime.Range{Start: now, End: now.Add(time.Hour)},
+			Type:      MismatchOnlyRepair,
+		},
+		State:             StateSucceeded,
+		StartedAt:         now.ToTime(),
+		CompletedAt:       now.ToTime().Add(time.Minute),
+		NumShards:         1,
+		NumShardsRepaired: 1,
+		MismatchedSeries: []MismatchedSeries{
+			{
+				Shard: 1,
+				ID:    "foo",
+				Blocks: []MismatchedBlock{
+					{
+						Start: now,
+						Replicas: []ReplicaBlock{
+							{Host: "a", Size: 10, Checksum: &checksum},
+							{Host: "b", Size: 0},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, RepairURL, nil))
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+
+	var resp RepairHTTPResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+	require.Equal(t, "succeeded", resp.State)
+	require.NotNil(t, resp.CompletedAt)
+	require.Equal(t, 1, resp.NumShardsRepaired)
+	require.Equal(t, []MismatchedSeriesJSON{
+		{
+			Shard: 1,
+			ID:    "foo",
+			Blocks: []MismatchedBlockJSON{
+				{
+					Start: now.ToTime().UTC(),
+					Replicas: []ReplicaBlockJSON{
+						{Host: "a", Size: 10, Checksum: &checksum},
+						{Host: "b", Size: 0},
+					},
+				},
+			},
+		},
+	}, resp.MismatchedSeries)
+}
+
+func TestHandlerRepairInProgress(t *testing.T) {
+	repairer := &fakeRepairer{err: ErrRepairInProgress}
+	handler := NewHandler(repairer, instrument.NewOptions())
+
+	body := `{"namespace":"metrics","start":"2021-04-01T10:00:00Z","end":"2021-04-01T12:00:00Z"}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, RepairURL,
+		strings.NewReader(body)))
+	require.Equal(t, http.StatusConflict, recorder.Code)
+}
+
+func TestHandlerBadRequests(t *testing.T) {
+	repairer := &fakeRepairer{}
+	handler := NewHandler(repairer, instrument.NewOptions())
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodDelete, RepairURL, nil))
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	for _, body := range []string{
+		`{`,
+		`{"start":"2021-04-01T10:00:00Z","end":"2021-04-01T12:00:00Z"}`,
+		`{"namespace":"metrics","start":"2021-04-01T12:00:00Z","end":"2021-04-01T10:00:00Z"}`,
+		`{"namespace":"metrics","start":"2021-04-01T10:00:00Z","end":"2021-04-01T12:00:00Z","type":"foo"}`,
+	} {
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, RepairURL,
+			strings.NewReader(body)))
+		require.Equal(t, http.StatusBadRequest, recorder.Code, body)
+	}
+	require.Empty(t, repairer.requests)
+}
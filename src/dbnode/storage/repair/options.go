@@ -37,6 +37,7 @@ const (
 	defaultRepairCheckInterval              = time.Minute
 	defaultRepairThrottle                   = 90 * time.Second
 	defaultRepairShardConcurrency           = 1
+	defaultStreamBatchSize                  = 1024
 	defaultDebugShadowComparisonsEnabled    = false
 	defaultDebugShadowComparisonsPercentage = 1.0
 )
@@ -45,6 +46,7 @@ var (
 	errNoAdminClient                           = errors.New("no admin client in repair options")
 	errInvalidRepairCheckInterval              = errors.New("invalid repair check interval in repair options")
 	errInvalidRepairThrottle                   = errors.New("invalid repair throttle in repair options")
+	errInvalidStreamBatchSize                  = errors.New("invalid stream batch size in repair options")
 	errNoReplicaMetadataSlicePool              = errors.New("no replica metadata pool in repair options")
 	errNoResultOptions                         = errors.New("no result options in repair options")
 	errInvalidDebugShadowComparisonsPercentage = errors.New("debug shadow comparisons percentage must be between 0 and 1")
@@ -58,6 +60,7 @@ type options struct {
 	repairShardConcurrency           int
 	repairCheckInterval              time.Duration
 	repairThrottle                   time.Duration
+	streamBatchSize                  int
 	replicaMetadataSlicePool         ReplicaMetadataSlicePool
 	resultOptions                    result.Options
 	debugShadowComparisonsEnabled    bool
@@ -71,6 +74,7 @@ func NewOptions() Options {
 		repairShardConcurrency:           defaultRepairShardConcurrency,
 		repairCheckInterval:              defaultRepairCheckInterval,
 		repairThrottle:                   defaultRepairThrottle,
+		streamBatchSize:                  defaultStreamBatchSize,
 		replicaMetadataSlicePool:         NewReplicaMetadataSlicePool(nil, 0),
 		resultOptions:                    result.NewOptions(),
 		debugShadowComparisonsEnabled:    defaultDebugShadowComparisonsEnabled,
@@ -148,6 +152,16 @@ func (o *options) RepairThrottle() time.Duration {
 	return o.repairThrottle
 }
 
+func (o *options) SetStreamBatchSize(value int) Options {
+	opts := *o
+	opts.streamBatchSize = value
+	return &opts
+}
+
+func (o *options) StreamBatchSize() int {
+	return o.streamBatchSize
+}
+
 func (o *options) SetReplicaMetadataSlicePool(value ReplicaMetadataSlicePool) Options {
 	opts := *o
 	opts.replicaMetadataSlicePool = value
@@ -214,6 +228,9 @@ func (o *options) Validate() error {
 	if o.repairThrottle < 0 {
 		return errInvalidRepairThrottle
 	}
+	if o.streamBatchSize <= 0 {
+		return errInvalidStreamBatchSize
+	}
 	if o.replicaMetadataSlicePool == nil {
 		return errNoReplicaMetadataSlicePool
 	}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package repair
+
+import "sort"
+
+// MismatchedSeriesFromComparison returns up to limit of the series with
+// checksum differences in a comparison result, sorted by ID. The result does
+// not reference the comparison result so it remains valid once finalized.
+func MismatchedSeriesFromComparison(
+	shard uint32,
+	res MetadataComparisonResult,
+	limit int,
+) []MismatchedSeries {
+	if limit <= 0 || res.ChecksumDifferences == nil {
+		return nil
+	}
+
+	entries := res.ChecksumDifferences.Series().Iter()
+	series := make([]ReplicaSeriesBlocksMetadata, 0, len(entries))
+	for _, entry := range entries {
+		series = append(series, entry.Value())
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].ID.String() < series[j].ID.String()
+	})
+	if len(series) > limit {
+		series = series[:limit]
+	}
+
+	result := make([]MismatchedSeries, 0, len(series))
+	for _, s := range series {
+		blocks := s.Metadata.Blocks()
+		mismatched := MismatchedSeries{
+			Shard:  shard,
+			ID:     s.ID.String(),
+			Blocks: make([]MismatchedBlock, 0, len(blocks)),
+		}
+		for start, b := range blocks {
+			replicas := make([]ReplicaBlock, 0, len(b.Metadata()))
+			for _, m := range b.Metadata() {
+				replica := ReplicaBlock{
+					Host: m.Host.ID(),
+					Size: m.Metadata.Size,
+				}
+				if m.Metadata.Checksum != nil {
+					checksum := *m.Metadata.Checksum
+					replica.Checksum = &checksum
+				}
+				replicas = append(replicas, replica)
+			}
+			sort.Slice(replicas, func(i, j int) bool {
+				return replicas[i].Host < replicas[j].Host
+			})
+			mismatched.Blocks = append(mismatched.Blocks, MismatchedBlock{
+				Start:    start,
+				Replicas: replicas,
+			})
+		}
+		sort.Slice(mismatched.Blocks, func(i, j int) bool {
+			return mismatched.Blocks[i].Start < mismatched.Blocks[j].Start
+		})
+		result = append(result, mismatched)
+	}
+	return result
+}
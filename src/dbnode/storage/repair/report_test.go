@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package repair
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/topology"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testChecksumDifferences(now xtime.UnixNano) ReplicaSeriesMetadata {
+	var (
+		foo       = topology.NewHost("foo", "addrFoo")
+		bar       = topology.NewHost("bar", "addrBar")
+		checksum1 = uint32(1)
+		checksum2 = uint32(2)
+		diffs     = NewReplicaSeriesMetadata()
+	)
+	for _, id := range []string{"c", "a", "b"} {
+		blocks := diffs.GetOrAdd(ident.StringID(id))
+		for _, start := range []xtime.UnixNano{now.Add(time.Hour), now} {
+			b := blocks.GetOrAdd(start, testReplicaMetadataSlicePool())
+			b.Add(block.ReplicaMetadata{
+				Host: foo,
+				Metadata: block.NewMetadata(ident.StringID(id), ident.Tags{},
+					start, 1, &checksum1, 0),
+			})
+			b.Add(block.ReplicaMetadata{
+				Host: bar,
+				Metadata: block.NewMetadata(ident.StringID(id), ident.Tags{},
+					start, 2, &checksum2, 0),
+			})
+		}
+	}
+	return diffs
+}
+
+func TestMismatchedSeriesFromComparison(t *testing.T) {
+	now := xtime.Now().Truncate(time.Hour)
+	res := MetadataComparisonResult{
+		ChecksumDifferences: testChecksumDifferences(now),
+	}
+
+	series := MismatchedSeriesFromComparison(3, res, 2)
+	require.Len(t, series, 2)
+	require.Equal(t, "a", series[0].ID)
+	require.Equal(t, "b", series[1].ID)
+
+	checksum1, checksum2 := uint32(1), uint32(2)
+	require.Equal(t, MismatchedSeries{
+		Shard: 3,
+		ID:    "a",
+		Blocks: []MismatchedBlock{
+			{
+				Start: now,
+				Replicas: []ReplicaBlock{
+					{Host: "bar", Size: 2, Checksum: &checksum2},
+					{Host: "foo", Size: 1, Checksum: &checksum1},
+				},
+			},
+			{
+				Start: now.Add(time.Hour),
+				Replicas: []ReplicaBlock{
+					{Host: "bar", Size: 2, Checksum: &checksum2},
+					{Host: "foo", Size: 1, Checksum: &checksum1},
+				},
+			},
+		},
+	}, series[0])
+
+	require.Len(t, MismatchedSeriesFromComparison(3, res, 10), 3)
+	require.Nil(t, MismatchedSeriesFromComparison(3, res, 0))
+	require.Nil(t, MismatchedSeriesFromComparison(3, MetadataComparisonResult{}, 10))
+}
@@ -41,11 +41,16 @@ const (
 	// OnlyCompareRepair will compare node's integrity to other replicas without repairing blocks,
 	// this is useful for looking at the metrics emitted by the comparison.
 	OnlyCompareRepair
+	// MismatchOnlyRepair will compare node's integrity to other replicas and then fetch only the
+	// mismatched blocks from the peers whose checksums differ, streaming them into the shard in
+	// batches. This bounds the data fetched after a short outage to what was actually missed.
+	MismatchOnlyRepair
 )
 
 var validTypes = []Type{
 	DefaultRepair,
 	OnlyCompareRepair,
+	MismatchOnlyRepair,
 }
 
 // UnmarshalYAML unmarshals an Type into a valid type from string.
@@ -78,6 +83,8 @@ func (t Type) String() string {
 		return "default"
 	case OnlyCompareRepair:
 		return "only_compare"
+	case MismatchOnlyRepair:
+		return "mismatch_only"
 	}
 	return "unknown"
 }
@@ -240,6 +247,14 @@ type Options interface {
 	// RepairThrottle returns the repair throttle.
 	RepairThrottle() time.Duration
 
+	// SetStreamBatchSize sets the maximum number of blocks a mismatch only
+	// repair fetches from peers and loads into a shard at a time.
+	SetStreamBatchSize(value int) Options
+
+	// StreamBatchSize returns the maximum number of blocks a mismatch only
+	// repair fetches from peers and loads into a shard at a time.
+	StreamBatchSize() int
+
 	// SetReplicaMetadataSlicePool sets the replicaMetadataSlice pool.
 	SetReplicaMetadataSlicePool(value ReplicaMetadataSlicePool) Options
 
@@ -267,3 +282,102 @@ type Options interface {
 	// Validate checks if the options are valid.
 	Validate() error
 }
+
+// Request is a request to repair a namespace on demand.
+type Request struct {
+	// Namespace is the namespace to repair.
+	Namespace ident.ID
+
+	// Shards are the shards to repair, all owned shards are repaired if empty.
+	Shards []uint32
+
+	// Range is the time range to repair, it is expanded to block boundaries.
+	Range xtime.Range
+
+	// Type is the type of repair to run.
+	Type Type
+
+	// MaxReportedSeries is the maximum number of mismatched series included
+	// in the report, all mismatched series are still counted.
+	MaxReportedSeries int
+}
+
+// State is the state of an on demand repair.
+type State int
+
+const (
+	// StateRunning means the repair is still running.
+	StateRunning State = iota
+	// StateSucceeded means every shard was repaired.
+	StateSucceeded
+	// StateFailed means at least one shard failed to repair.
+	StateFailed
+)
+
+// String returns the state as a string.
+func (s State) String() string {
+	switch s {
+	case StateRunning:
+		return "running"
+	case StateSucceeded:
+		return "succeeded"
+	case StateFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// Progress is the progress and report of an on demand repair.
+type Progress struct {
+	Request     Request
+	State       State
+	StartedAt   time.Time
+	CompletedAt time.Time
+
+	NumShards         int
+	NumShardsRepaired int
+	NumShardsFailed   int
+
+	NumSeries             int64
+	NumBlocks             int64
+	NumChecksumDiffSeries int64
+	NumChecksumDiffBlocks int64
+
+	// MismatchedSeries are the series whose blocks mismatched between the
+	// node and its peers, up to the maximum reported series of the request.
+	MismatchedSeries []MismatchedSeries
+
+	// Errors are the errors of the shards which failed to repair.
+	Errors []string
+}
+
+// MismatchedSeries is a series with blocks which mismatched between replicas.
+type MismatchedSeries struct {
+	Shard  uint32
+	ID     string
+	Blocks []MismatchedBlock
+}
+
+// MismatchedBlock is a block which mismatched between replicas.
+type MismatchedBlock struct {
+	Start    xtime.UnixNano
+	Replicas []ReplicaBlock
+}
+
+// ReplicaBlock is the metadata of a block on a replica.
+type ReplicaBlock struct {
+	Host     string
+	Size     int64
+	Checksum *uint32
+}
+
+// Repairer runs repairs on demand.
+type Repairer interface {
+	// StartRepair starts a repair in the background, it fails if a repair is
+	// already running.
+	StartRepair(req Request) (Progress, error)
+
+	// RepairProgress returns the progress of the most recently started on
+	// demand repair, if any.
+	RepairProgress() (Progress, bool)
+}
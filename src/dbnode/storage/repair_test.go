@@ -22,6 +22,7 @@ package storage
 
 import (
 	"errors"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -836,3 +837,302 @@ func TestDatabaseRepairSkipsPoisonShard(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseShardRepairerRepairMismatchOnly(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		origin = topology.NewHost("0", "addr0")
+		peer1  = topology.NewHost("1", "addr1")
+		peer2  = topology.NewHost("2", "addr2")
+	)
+	session := client.NewMockAdminSession(ctrl)
+	session.EXPECT().Origin().Return(origin).AnyTimes()
+	session.EXPECT().TopologyMap().AnyTimes()
+
+	mockClient := client.NewMockAdminClient(ctrl)
+	mockClient.EXPECT().DefaultAdminSession().Return(session, nil).AnyTimes()
+
+	var (
+		rpOpts = testRepairOptions(ctrl).
+			SetAdminClients([]client.AdminClient{mockClient}).
+			SetType(repair.MismatchOnlyRepair).
+			SetStreamBatchSize(2)
+		now    = xtime.Now()
+		nowFn  = func() time.Time { return now.ToTime() }
+		opts   = DefaultTestOptions()
+		rtopts = defaultTestRetentionOpts
+	)
+	opts = opts.
+		SetClockOptions(opts.ClockOptions().SetNowFn(nowFn)).
+		SetInstrumentOptions(opts.InstrumentOptions().SetMetricsScope(tally.NoopScope))
+
+	var (
+		namespaceID     = ident.StringID("testNamespace")
+		start           = now
+		end             = now.Add(rtopts.BlockSize())
+		blockStart      = now.Add(30 * time.Minute)
+		repairTimeRange = xtime.Range{Start: start, End: end}
+		fetchOpts       = block.FetchBlocksMetadataOptions{
+			IncludeSizes:     true,
+			IncludeChecksums: true,
+			IncludeLastRead:  false,
+		}
+		checksums = []uint32{1, 2, 3}
+		shardID   = uint32(0)
+		shard     = NewMockdatabaseShard(ctrl)
+	)
+	shard.EXPECT().ID().Return(shardID).AnyTimes()
+
+	localResults := block.NewFetchBlocksMetadataResults()
+	for _, id := range []string{"foo", "bar", "baz"} {
+		results := block.NewFetchBlockMetadataResults()
+		results.Add(block.NewFetchBlockMetadataResult(blockStart, 1, &checksums[0], 0, nil))
+		localResults.Add(block.NewFetchBlocksMetadataResult(ident.StringID(id), nil, results))
+	}
+	shard.EXPECT().
+		FetchBlocksMetadataV2(gomock.Any(), start, end, gomock.Any(), nil, fetchOpts).
+		Return(localResults, nil, nil)
+
+	peerMetadata := func(host topology.Host, id string, checksum *uint32) block.ReplicaMetadata {
+		return block.ReplicaMetadata{
+			Host:     host,
+			Metadata: block.NewMetadata(ident.StringID(id), ident.Tags{}, blockStart, 1, checksum, 0),
+		}
+	}
+	inputBlocks := []block.ReplicaMetadata{
+		// Matches so should not be fetched.
+		peerMetadata(peer1, "foo", &checksums[0]),
+		peerMetadata(peer2, "foo", &checksums[0]),
+		// Both peers have the same mismatched checksum so should be fetched once.
+		peerMetadata(peer1, "bar", &checksums[1]),
+		peerMetadata(peer2, "bar", &checksums[1]),
+		// Both peers have different mismatched checksums so should be fetched from both.
+		peerMetadata(peer1, "baz", &checksums[1]),
+		peerMetadata(peer2, "baz", &checksums[2]),
+	}
+
+	peerIter := client.NewMockPeerBlockMetadataIter(ctrl)
+	calls := make([]*gomock.Call, 0, 2*len(inputBlocks)+2)
+	for _, input := range inputBlocks {
+		calls = append(calls,
+			peerIter.EXPECT().Next().Return(true),
+			peerIter.EXPECT().Current().Return(input.Host, input.Metadata))
+	}
+	calls = append(calls,
+		peerIter.EXPECT().Next().Return(false),
+		peerIter.EXPECT().Err().Return(nil))
+	gomock.InOrder(calls...)
+	session.EXPECT().
+		FetchBlocksMetadataFromPeers(namespaceID, shardID, start, end,
+			rpOpts.RepairConsistencyLevel(), gomock.Any()).
+		Return(peerIter, nil)
+
+	nsMeta, err := namespace.NewMetadata(namespaceID, namespace.NewOptions())
+	require.NoError(t, err)
+
+	var fetched [][]block.ReplicaMetadata
+	session.EXPECT().
+		FetchBlocksFromPeers(nsMeta, shardID, rpOpts.RepairConsistencyLevel(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ namespace.Metadata,
+			_ uint32,
+			_ topology.ReadConsistencyLevel,
+			metadatas []block.ReplicaMetadata,
+			_ result.Options,
+		) (client.PeerBlocksIter, error) {
+			fetched = append(fetched, metadatas)
+
+			peerBlocksIter := client.NewMockPeerBlocksIter(ctrl)
+			for _, m := range metadatas {
+				dbBlock := block.NewMockDatabaseBlock(ctrl)
+				dbBlock.EXPECT().StartTime().Return(blockStart).AnyTimes()
+				dbBlock.EXPECT().Merge(gomock.Any()).AnyTimes()
+				peerBlocksIter.EXPECT().Next().Return(true)
+				peerBlocksIter.EXPECT().Current().Return(m.Host, m.Metadata.ID, dbBlock)
+			}
+			peerBlocksIter.EXPECT().Next().Return(false)
+			return peerBlocksIter, nil
+		}).
+		Times(2)
+	shard.EXPECT().LoadBlocks(gomock.Any()).Return(nil).Times(2)
+
+	repairer := newShardRepairer(opts, rpOpts).(shardRepairer)
+	repairer.recordFn = func(topology.Host, ident.ID, databaseShard, repair.MetadataComparisonResult) {}
+
+	var (
+		ctx   = context.NewBackground()
+		nsCtx = namespace.Context{ID: namespaceID}
+	)
+	res, err := repairer.Repair(ctx, nsCtx, nsMeta, repairTimeRange, shard)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), res.ChecksumDifferences.NumSeries())
+
+	// Batches are bounded by the stream batch size.
+	require.Len(t, fetched, 2)
+	require.Len(t, fetched[0], 2)
+	require.Len(t, fetched[1], 1)
+
+	fetchedByHost := make(map[string][]string)
+	for _, batch := range fetched {
+		for _, m := range batch {
+			fetchedByHost[m.Metadata.ID.String()] = append(fetchedByHost[m.Metadata.ID.String()], m.Host.ID())
+		}
+	}
+	require.Equal(t, []string{"1"}, fetchedByHost["bar"])
+	sort.Strings(fetchedByHost["baz"])
+	require.Equal(t, []string{"1", "2"}, fetchedByHost["baz"])
+	require.NotContains(t, fetchedByHost, "foo")
+}
+
+func TestMismatchedPeerMetadata(t *testing.T) {
+	var (
+		origin    = topology.NewHost("0", "addr0")
+		peer1     = topology.NewHost("1", "addr1")
+		peer2     = topology.NewHost("2", "addr2")
+		checksums = []uint32{1, 2}
+	)
+	replicaMetadata := func(host topology.Host, checksum *uint32) block.ReplicaMetadata {
+		return block.ReplicaMetadata{
+			Host:     host,
+			Metadata: block.NewMetadata(ident.StringID("foo"), ident.Tags{}, 0, 1, checksum, 0),
+		}
+	}
+
+	// Peers without a checksum are skipped.
+	require.Empty(t, mismatchedPeerMetadata(origin, []block.ReplicaMetadata{
+		replicaMetadata(origin, &checksums[0]),
+		replicaMetadata(peer1, nil),
+	}))
+
+	// Peers with the same checksum as the origin are skipped.
+	require.Equal(t, []block.ReplicaMetadata{
+		replicaMetadata(peer2, &checksums[1]),
+	}, mismatchedPeerMetadata(origin, []block.ReplicaMetadata{
+		replicaMetadata(origin, &checksums[0]),
+		replicaMetadata(peer1, &checksums[0]),
+		replicaMetadata(peer2, &checksums[1]),
+	}))
+
+	// All peers mismatch when the origin is missing the block, but each
+	// checksum is only fetched once.
+	require.Equal(t, []block.ReplicaMetadata{
+		replicaMetadata(peer1, &checksums[1]),
+	}, mismatchedPeerMetadata(origin, []block.ReplicaMetadata{
+		replicaMetadata(peer1, &checksums[1]),
+		replicaMetadata(peer2, &checksums[1]),
+	}))
+}
+
+func TestDatabaseRepairerStartRepair(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		opts        = DefaultTestOptions().SetRepairOptions(testRepairOptions(ctrl))
+		nsOpts      = namespace.NewOptions()
+		blockSize   = nsOpts.RetentionOptions().BlockSize()
+		start       = xtime.Now().Truncate(blockSize)
+		namespaceID = ident.StringID("testNamespace")
+		mockDB      = NewMockdatabase(ctrl)
+		ns          = NewMockdatabaseNamespace(ctrl)
+		shards      []databaseShard
+	)
+	for i := uint32(0); i < 3; i++ {
+		shard := NewMockdatabaseShard(ctrl)
+		shard.EXPECT().ID().Return(i).AnyTimes()
+		shards = append(shards, shard)
+	}
+	mockDB.EXPECT().IsBootstrapped().Return(true).AnyTimes()
+	mockDB.EXPECT().OwnedNamespaces().Return([]databaseNamespace{ns}, nil).AnyTimes()
+	ns.EXPECT().ID().Return(namespaceID).AnyTimes()
+	ns.EXPECT().Options().Return(nsOpts).AnyTimes()
+	ns.EXPECT().OwnedShards().Return(shards).AnyTimes()
+
+	databaseRepairer, err := newDatabaseRepairer(mockDB, opts)
+	require.NoError(t, err)
+	repairer := databaseRepairer.(*dbRepairer)
+
+	_, ok := repairer.RepairProgress()
+	require.False(t, ok)
+
+	_, err = repairer.StartRepair(repair.Request{
+		Namespace: ident.StringID("unknown"),
+		Range:     xtime.Range{Start: start, End: start.Add(blockSize)},
+	})
+	require.Error(t, err)
+
+	_, err = repairer.StartRepair(repair.Request{
+		Namespace: namespaceID,
+		Shards:    []uint32{5},
+		Range:     xtime.Range{Start: start, End: start.Add(blockSize)},
+	})
+	require.Error(t, err)
+
+	var (
+		release     = make(chan struct{})
+		expectRange = xtime.Range{Start: start, End: start.Add(2 * blockSize)}
+		checksum    = uint32(1)
+		repairOpts  NamespaceRepairOptions
+	)
+	ns.EXPECT().
+		Repair(gomock.Any(), expectRange, gomock.Any()).
+		DoAndReturn(func(_ databaseShardRepairer, _ xtime.Range, opts NamespaceRepairOptions) error {
+			<-release
+			repairOpts = opts
+
+			diffs := repair.NewReplicaSeriesMetadata()
+			blocks := diffs.GetOrAdd(ident.StringID("foo"))
+			blocks.GetOrAdd(start, repair.NewReplicaMetadataSlicePool(nil, 0)).Add(block.ReplicaMetadata{
+				Host:     topology.NewHost("1", "addr1"),
+				Metadata: block.NewMetadata(ident.StringID("foo"), ident.Tags{}, start, 1, &checksum, 0),
+			})
+			opts.OnShardRepaired(0, repair.MetadataComparisonResult{
+				NumSeries:           2,
+				NumBlocks:           2,
+				ChecksumDifferences: diffs,
+			}, nil)
+			opts.OnShardRepaired(2, repair.MetadataComparisonResult{}, errors.New("an error"))
+			return errors.New("an error")
+		})
+
+	progress, err := repairer.StartRepair(repair.Request{
+		Namespace:         namespaceID,
+		Shards:            []uint32{0, 2},
+		Range:             xtime.Range{Start: start.Add(time.Minute), End: start.Add(blockSize + time.Minute)},
+		Type:              repair.MismatchOnlyRepair,
+		MaxReportedSeries: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, repair.StateRunning, progress.State)
+	require.Equal(t, expectRange, progress.Request.Range)
+	require.Equal(t, 2, progress.NumShards)
+
+	_, err = repairer.StartRepair(repair.Request{
+		Namespace: namespaceID,
+		Range:     xtime.Range{Start: start, End: start.Add(blockSize)},
+	})
+	require.Equal(t, errRepairInProgress, err)
+
+	close(release)
+	for {
+		progress, ok = repairer.RepairProgress()
+		require.True(t, ok)
+		if progress.State != repair.StateRunning {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	require.True(t, repairOpts.Force)
+	require.Equal(t, []uint32{0, 2}, repairOpts.Shards)
+	require.Equal(t, repair.StateFailed, progress.State)
+	require.Equal(t, 1, progress.NumShardsRepaired)
+	require.Equal(t, 1, progress.NumShardsFailed)
+	require.Equal(t, int64(2), progress.NumSeries)
+	require.Equal(t, int64(1), progress.NumChecksumDiffSeries)
+	require.Len(t, progress.MismatchedSeries, 1)
+	require.Equal(t, "foo", progress.MismatchedSeries[0].ID)
+	require.Equal(t, []string{"shard 2: an error"}, progress.Errors)
+}
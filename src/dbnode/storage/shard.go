@@ -128,6 +128,8 @@ type dbShard struct {
 	increasingIndex          increasingIndex
 	seriesPool               series.DatabaseSeriesPool
 	reverseIndex             NamespaceIndex
+	tombstones               *seriesTombstones
+	tombstonesFilter         tombstonesFilter
 	insertQueue              *dbShardInsertQueue
 	lookup                   *shardMap
 	list                     *list.List
@@ -248,6 +250,7 @@ func newDatabaseShard(
 	namespaceReaderMgr databaseNamespaceReaderManager,
 	increasingIndex increasingIndex,
 	reverseIndex NamespaceIndex,
+	tombstones *seriesTombstones,
 	needsBootstrap bool,
 	opts Options,
 	seriesOpts series.Options,
@@ -266,13 +269,15 @@ func newDatabaseShard(
 		increasingIndex:      increasingIndex,
 		seriesPool:           opts.DatabaseSeriesPool(),
 		reverseIndex:         reverseIndex,
+		tombstones:           tombstones,
+		tombstonesFilter:     newTombstonesFilter(tombstones, opts),
 		lookup:               newShardMap(shardMapOptions{}),
 		list:                 list.New(),
 		newMergerFn:          fs.NewMerger,
 		newFSMergeWithMemFn:  newFSMergeWithMem,
 		filesetsFn:           fs.DataFiles,
 		filesetPathsBeforeFn: fs.DataFileSetsBefore,
-		deleteFilesFn:        fs.DeleteFiles,
+		deleteFilesFn:        newDeleteUnpinnedFilesFn(opts.FilePins(), fs.DeleteFiles),
 		snapshotFilesFn:      fs.SnapshotFiles,
 		sleepFn:              time.Sleep,
 		newReaderFn:          fs.NewReader,
@@ -1081,15 +1086,27 @@ func (s *dbShard) ReadEncoded(
 		return nil, err
 	}
 
+	var iter series.BlockReaderIter
 	if entry != nil {
-		return entry.Series.ReadEncoded(ctx, start, end, nsCtx)
+		iter, err = entry.Series.ReadEncoded(ctx, start, end, nsCtx)
+	} else {
+		retriever := s.seriesBlockRetriever
+		onRetrieve := s.seriesOnRetrieveBlock
+		opts := s.seriesOpts
+		reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, nil, opts)
+		iter, err = reader.ReadEncoded(ctx, start, end, nsCtx)
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	retriever := s.seriesBlockRetriever
-	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
-	reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, nil, opts)
-	return reader.ReadEncoded(ctx, start, end, nsCtx)
+	// Filter out any datapoints of the series that have been deleted but
+	// not yet removed from memory or disk.
+	ranges, ok := s.tombstones.Ranges(id.Bytes(), xtime.Range{Start: start, End: end})
+	if !ok {
+		return iter, nil
+	}
+	return newTombstonesBlockReaderIter(iter, s.tombstonesFilter, ranges, nsCtx), nil
 }
 
 func (s *dbShard) FetchWideEntry(
@@ -1589,18 +1606,26 @@ func (s *dbShard) FetchBlocks(
 		return nil, err
 	}
 
+	var results []block.FetchBlockResult
 	if entry != nil {
-		return entry.Series.FetchBlocks(ctx, starts, nsCtx)
+		results, err = entry.Series.FetchBlocks(ctx, starts, nsCtx)
+	} else {
+		retriever := s.seriesBlockRetriever
+		onRetrieve := s.seriesOnRetrieveBlock
+		opts := s.seriesOpts
+		// Nil for onRead callback because we don't want peer bootstrapping to impact
+		// the behavior of the LRU
+		var onReadCb block.OnReadBlock
+		reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, onReadCb, opts)
+		results, err = reader.FetchBlocks(ctx, starts, nsCtx)
+	}
+	if err != nil || s.tombstones.Len() == 0 {
+		return results, err
 	}
 
-	retriever := s.seriesBlockRetriever
-	onRetrieve := s.seriesOnRetrieveBlock
-	opts := s.seriesOpts
-	// Nil for onRead callback because we don't want peer bootstrapping to impact
-	// the behavior of the LRU
-	var onReadCb block.OnReadBlock
-	reader := series.NewReaderUsingRetriever(id, retriever, onRetrieve, onReadCb, opts)
-	return reader.FetchBlocks(ctx, starts, nsCtx)
+	blockSize := s.namespace.Options().RetentionOptions().BlockSize()
+	return s.tombstonesFilter.filterFetchBlockResults(ctx, id.Bytes(), results,
+		blockSize, nsCtx)
 }
 
 func (s *dbShard) FetchBlocksForColdFlush(
@@ -2188,6 +2213,10 @@ func (s *dbShard) WarmFlush(
 		DeleteIfExists: false,
 		FileSetType:    persist.FileSetFlushType,
 	}
+	tombstonesVersion := s.tombstones.Version()
+	if s.tombstones.Len() > 0 {
+		flushPreparer = s.tombstonesFilter.flushPreparer(flushPreparer, nsCtx)
+	}
 	prepared, err := flushPreparer.PrepareData(prepareOpts)
 	if err != nil {
 		return s.markWarmFlushStateSuccessOrError(blockStart, err)
@@ -2224,6 +2253,14 @@ func (s *dbShard) WarmFlush(
 		multiErr = multiErr.Add(err)
 	}
 
+	if multiErr.Empty() {
+		// The tombstones added before the flush have been applied to the
+		// fileset written for the block.
+		if err := s.markTombstonesApplied(blockStart, tombstonesVersion); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+
 	return s.markWarmFlushStateSuccessOrError(blockStart, multiErr.FinalError())
 }
 
@@ -2301,7 +2338,28 @@ func (s *dbShard) ColdFlush(
 		return shardColdFlush{}, loopErr
 	}
 
-	if dirtySeries.Len() == 0 {
+	// Blocks that were flushed before series in them were deleted need to be
+	// rewritten so that the deletes are physically applied, even if they do
+	// not have any cold writes.
+	tombstonesVersion := s.tombstones.Version()
+	numTombstonedBlocks := 0
+	blockSize := s.namespace.Options().RetentionOptions().BlockSize()
+	for _, t := range s.tombstones.UnappliedBlockStarts(s.ID(), blockSize) {
+		hasWarmFlushed, err := s.hasWarmFlushed(t)
+		if err != nil {
+			return shardColdFlush{}, err
+		}
+		if !hasWarmFlushed {
+			continue
+		}
+
+		if dirtySeriesToWrite[t] == nil {
+			dirtySeriesToWrite[t] = newIDList(idElementPool)
+		}
+		numTombstonedBlocks++
+	}
+
+	if dirtySeries.Len() == 0 && numTombstonedBlocks == 0 {
 		// Early exit if there is nothing dirty to merge. dirtySeriesToWrite
 		// may be non-empty when dirtySeries is empty because we purposely
 		// leave empty seriesLists in the dirtySeriesToWrite map to avoid having
@@ -2309,9 +2367,14 @@ func (s *dbShard) ColdFlush(
 		return shardColdFlush{}, nil
 	}
 
+	if s.tombstones.Len() > 0 {
+		flushPreparer = s.tombstonesFilter.flushPreparer(flushPreparer, nsCtx)
+	}
+
 	flush := shardColdFlush{
-		shard:   s,
-		doneFns: make([]shardColdFlushDone, 0, len(dirtySeriesToWrite)),
+		shard:             s,
+		doneFns:           make([]shardColdFlushDone, 0, len(dirtySeriesToWrite)),
+		tombstonesVersion: tombstonesVersion,
 	}
 	merger := s.newMergerFn(resources.fsReader, s.opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
 		s.opts.SegmentReaderPool(), s.opts.MultiReaderIteratorPool(),
@@ -2351,6 +2414,19 @@ func (s *dbShard) ColdFlush(
 	return flush, multiErr.FinalError()
 }
 
+// markTombstonesApplied marks the tombstones added up to the given version
+// as physically applied to the fileset of the block.
+func (s *dbShard) markTombstonesApplied(
+	blockStart xtime.UnixNano,
+	tombstonesVersion uint64,
+) error {
+	blockSize := s.namespace.Options().RetentionOptions().BlockSize()
+	return s.tombstones.MarkApplied(s.ID(), xtime.Range{
+		Start: blockStart,
+		End:   blockStart.Add(blockSize),
+	}, tombstonesVersion)
+}
+
 func (s *dbShard) Snapshot(
 	blockStart xtime.UnixNano,
 	snapshotTime xtime.UnixNano,
@@ -2746,8 +2822,9 @@ type shardColdFlushDone struct {
 }
 
 type shardColdFlush struct {
-	shard   *dbShard
-	doneFns []shardColdFlushDone
+	shard             *dbShard
+	doneFns           []shardColdFlushDone
+	tombstonesVersion uint64
 }
 
 func (s shardColdFlush) Done() error {
@@ -2762,6 +2839,12 @@ func (s shardColdFlush) Done() error {
 		}
 
 		err := s.shard.finishWriting(startTime, nextVersion, false)
+		if err != nil {
+			multiErr = multiErr.Add(err)
+			continue
+		}
+
+		err = s.shard.markTombstonesApplied(startTime, s.tombstonesVersion)
 		if err != nil {
 			multiErr = multiErr.Add(err)
 		}
@@ -35,6 +35,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/persist/fs/tiering"
 	"github.com/m3db/m3/src/dbnode/persist/schema"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -233,6 +234,21 @@ func (mr *MockDatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDatabase)(nil).Close))
 }
 
+// DeleteSeries mocks base method.
+func (m *MockDatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockDatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockDatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *MockDatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -422,6 +438,21 @@ func (mr *MockDatabaseMockRecorder) Repair() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockDatabase)(nil).Repair))
 }
 
+// RepairProgress mocks base method.
+func (m *MockDatabase) RepairProgress() (repair.Progress, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairProgress")
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RepairProgress indicates an expected call of RepairProgress.
+func (mr *MockDatabaseMockRecorder) RepairProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairProgress", reflect.TypeOf((*MockDatabase)(nil).RepairProgress))
+}
+
 // ShardSet mocks base method.
 func (m *MockDatabase) ShardSet() sharding.ShardSet {
 	m.ctrl.T.Helper()
@@ -436,6 +467,21 @@ func (mr *MockDatabaseMockRecorder) ShardSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardSet", reflect.TypeOf((*MockDatabase)(nil).ShardSet))
 }
 
+// StartRepair mocks base method.
+func (m *MockDatabase) StartRepair(req repair.Request) (repair.Progress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRepair", req)
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRepair indicates an expected call of StartRepair.
+func (mr *MockDatabaseMockRecorder) StartRepair(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRepair", reflect.TypeOf((*MockDatabase)(nil).StartRepair), req)
+}
+
 // Terminate mocks base method.
 func (m *MockDatabase) Terminate() error {
 	m.ctrl.T.Helper()
@@ -672,6 +718,21 @@ func (mr *MockdatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*Mockdatabase)(nil).Close))
 }
 
+// DeleteSeries mocks base method.
+func (m *Mockdatabase) DeleteSeries(ctx context.Context, namespace ident.ID, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, namespace, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseMockRecorder) DeleteSeries(ctx, namespace, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*Mockdatabase)(nil).DeleteSeries), ctx, namespace, query, start, end)
+}
+
 // FetchBlocks mocks base method.
 func (m *Mockdatabase) FetchBlocks(ctx context.Context, namespace ident.ID, shard uint32, id ident.ID, starts []time0.UnixNano) ([]block.FetchBlockResult, error) {
 	m.ctrl.T.Helper()
@@ -876,6 +937,21 @@ func (mr *MockdatabaseMockRecorder) Repair() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*Mockdatabase)(nil).Repair))
 }
 
+// RepairProgress mocks base method.
+func (m *Mockdatabase) RepairProgress() (repair.Progress, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairProgress")
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RepairProgress indicates an expected call of RepairProgress.
+func (mr *MockdatabaseMockRecorder) RepairProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairProgress", reflect.TypeOf((*Mockdatabase)(nil).RepairProgress))
+}
+
 // ShardSet mocks base method.
 func (m *Mockdatabase) ShardSet() sharding.ShardSet {
 	m.ctrl.T.Helper()
@@ -890,6 +966,21 @@ func (mr *MockdatabaseMockRecorder) ShardSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardSet", reflect.TypeOf((*Mockdatabase)(nil).ShardSet))
 }
 
+// StartRepair mocks base method.
+func (m *Mockdatabase) StartRepair(req repair.Request) (repair.Progress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRepair", req)
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRepair indicates an expected call of StartRepair.
+func (mr *MockdatabaseMockRecorder) StartRepair(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRepair", reflect.TypeOf((*Mockdatabase)(nil).StartRepair), req)
+}
+
 // Terminate mocks base method.
 func (m *Mockdatabase) Terminate() error {
 	m.ctrl.T.Helper()
@@ -1362,6 +1453,21 @@ func (mr *MockdatabaseNamespaceMockRecorder) ColdFlush(flush interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColdFlush", reflect.TypeOf((*MockdatabaseNamespace)(nil).ColdFlush), flush)
 }
 
+// DeleteSeries mocks base method.
+func (m *MockdatabaseNamespace) DeleteSeries(ctx context.Context, query index.Query, start, end time0.UnixNano) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, query, start, end)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockdatabaseNamespaceMockRecorder) DeleteSeries(ctx, query, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockdatabaseNamespace)(nil).DeleteSeries), ctx, query, start, end)
+}
+
 // DocRef mocks base method.
 func (m *MockdatabaseNamespace) DocRef(id ident.ID) (doc.Metadata, bool, error) {
 	m.ctrl.T.Helper()
@@ -2551,6 +2657,21 @@ func (mr *MockNamespaceIndexMockRecorder) Bootstrapped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bootstrapped", reflect.TypeOf((*MockNamespaceIndex)(nil).Bootstrapped))
 }
 
+// CardinalityStats mocks base method.
+func (m *MockNamespaceIndex) CardinalityStats(start, end time0.UnixNano, shards []uint32) (index.CardinalityStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CardinalityStats", start, end, shards)
+	ret0, _ := ret[0].(index.CardinalityStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CardinalityStats indicates an expected call of CardinalityStats.
+func (mr *MockNamespaceIndexMockRecorder) CardinalityStats(start, end, shards interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CardinalityStats", reflect.TypeOf((*MockNamespaceIndex)(nil).CardinalityStats), start, end, shards)
+}
+
 // CleanupCorruptedFileSets mocks base method.
 func (m *MockNamespaceIndex) CleanupCorruptedFileSets() error {
 	m.ctrl.T.Helper()
@@ -3445,6 +3566,21 @@ func (mr *MockdatabaseRepairerMockRecorder) Repair() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Repair", reflect.TypeOf((*MockdatabaseRepairer)(nil).Repair))
 }
 
+// RepairProgress mocks base method.
+func (m *MockdatabaseRepairer) RepairProgress() (repair.Progress, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepairProgress")
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// RepairProgress indicates an expected call of RepairProgress.
+func (mr *MockdatabaseRepairerMockRecorder) RepairProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepairProgress", reflect.TypeOf((*MockdatabaseRepairer)(nil).RepairProgress))
+}
+
 // Report mocks base method.
 func (m *MockdatabaseRepairer) Report() {
 	m.ctrl.T.Helper()
@@ -3469,6 +3605,21 @@ func (mr *MockdatabaseRepairerMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockdatabaseRepairer)(nil).Start))
 }
 
+// StartRepair mocks base method.
+func (m *MockdatabaseRepairer) StartRepair(req repair.Request) (repair.Progress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRepair", req)
+	ret0, _ := ret[0].(repair.Progress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRepair indicates an expected call of StartRepair.
+func (mr *MockdatabaseRepairerMockRecorder) StartRepair(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRepair", reflect.TypeOf((*MockdatabaseRepairer)(nil).StartRepair), req)
+}
+
 // Stop mocks base method.
 func (m *MockdatabaseRepairer) Stop() {
 	m.ctrl.T.Helper()
@@ -4191,6 +4342,20 @@ func (mr *MockOptionsMockRecorder) FetchBlocksMetadataResultsPool() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBlocksMetadataResultsPool", reflect.TypeOf((*MockOptions)(nil).FetchBlocksMetadataResultsPool))
 }
 
+// FilePins mocks base method.
+func (m *MockOptions) FilePins() fs.FilePins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilePins")
+	ret0, _ := ret[0].(fs.FilePins)
+	return ret0
+}
+
+// FilePins indicates an expected call of FilePins.
+func (mr *MockOptionsMockRecorder) FilePins() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilePins", reflect.TypeOf((*MockOptions)(nil).FilePins))
+}
+
 // ForceColdWritesEnabled mocks base method.
 func (m *MockOptions) ForceColdWritesEnabled() bool {
 	m.ctrl.T.Helper()
@@ -4849,6 +5014,20 @@ func (mr *MockOptionsMockRecorder) SetFetchBlocksMetadataResultsPool(value inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetchBlocksMetadataResultsPool", reflect.TypeOf((*MockOptions)(nil).SetFetchBlocksMetadataResultsPool), value)
 }
 
+// SetFilePins mocks base method.
+func (m *MockOptions) SetFilePins(value fs.FilePins) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFilePins", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetFilePins indicates an expected call of SetFilePins.
+func (mr *MockOptionsMockRecorder) SetFilePins(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilePins", reflect.TypeOf((*MockOptions)(nil).SetFilePins), value)
+}
+
 // SetForceColdWritesEnabled mocks base method.
 func (m *MockOptions) SetForceColdWritesEnabled(value bool) Options {
 	m.ctrl.T.Helper()
@@ -5241,6 +5420,20 @@ func (mr *MockOptionsMockRecorder) SetTileAggregator(aggregator interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTileAggregator", reflect.TypeOf((*MockOptions)(nil).SetTileAggregator), aggregator)
 }
 
+// SetTieringManager mocks base method.
+func (m *MockOptions) SetTieringManager(value tiering.Manager) Options {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTieringManager", value)
+	ret0, _ := ret[0].(Options)
+	return ret0
+}
+
+// SetTieringManager indicates an expected call of SetTieringManager.
+func (mr *MockOptionsMockRecorder) SetTieringManager(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTieringManager", reflect.TypeOf((*MockOptions)(nil).SetTieringManager), value)
+}
+
 // SetTruncateType mocks base method.
 func (m *MockOptions) SetTruncateType(value series.TruncateType) Options {
 	m.ctrl.T.Helper()
@@ -5325,6 +5518,20 @@ func (mr *MockOptionsMockRecorder) TileAggregator() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TileAggregator", reflect.TypeOf((*MockOptions)(nil).TileAggregator))
 }
 
+// TieringManager mocks base method.
+func (m *MockOptions) TieringManager() tiering.Manager {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TieringManager")
+	ret0, _ := ret[0].(tiering.Manager)
+	return ret0
+}
+
+// TieringManager indicates an expected call of TieringManager.
+func (mr *MockOptionsMockRecorder) TieringManager() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TieringManager", reflect.TypeOf((*MockOptions)(nil).TieringManager))
+}
+
 // TruncateType mocks base method.
 func (m *MockOptions) TruncateType() series.TruncateType {
 	m.ctrl.T.Helper()
@@ -0,0 +1,736 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/storage/block"
+	"github.com/m3db/m3/src/dbnode/storage/series"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/m3ninx/index/segment"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// seriesTombstones tracks the time ranges of series that have been deleted.
+// Reads filter out the datapoints covered by a tombstone as soon as it is
+// added, and flushes filter them out of the filesets they write so that the
+// deletes are physically applied to disk. Tombstones are persisted so that
+// they survive restarts and are only dropped once they fall out of retention.
+// Unlike Prometheus, where a delete only removes the samples present at the
+// time of the delete, a tombstone also hides datapoints written to its range
+// after the delete until the tombstone falls out of retention.
+type seriesTombstones struct {
+	sync.RWMutex
+
+	filePath string
+	fileMode os.FileMode
+	dirMode  os.FileMode
+	version  uint64
+	series   map[string]*seriesTombstone
+}
+
+type seriesTombstone struct {
+	shard  uint32
+	ranges xtime.Ranges
+	// unapplied are the ranges that may still be present in filesets
+	// flushed before the tombstone was added.
+	unapplied []unappliedTombstone
+}
+
+type unappliedTombstone struct {
+	xtime.Range
+	version uint64
+}
+
+// tombstonedSeries is a series to add a tombstone for.
+type tombstonedSeries struct {
+	id    []byte
+	shard uint32
+}
+
+func newSeriesTombstones(
+	filePath string,
+	fsOpts fs.Options,
+) (*seriesTombstones, error) {
+	t := &seriesTombstones{
+		filePath: filePath,
+		fileMode: fsOpts.NewFileMode(),
+		dirMode:  fsOpts.NewDirectoryMode(),
+		series:   make(map[string]*seriesTombstone),
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Add adds a tombstone for the time range to each of the series.
+func (t *seriesTombstones) Add(series []tombstonedSeries, r xtime.Range) error {
+	if len(series) == 0 || r.IsEmpty() {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	t.version++
+	for _, s := range series {
+		entry, ok := t.series[string(s.id)]
+		if !ok {
+			entry = &seriesTombstone{
+				shard:  s.shard,
+				ranges: xtime.NewRanges(),
+			}
+			t.series[string(s.id)] = entry
+		}
+		entry.ranges.AddRange(r)
+		entry.unapplied = append(entry.unapplied, unappliedTombstone{
+			Range:   r,
+			version: t.version,
+		})
+	}
+
+	return t.persistWithLock()
+}
+
+// Len returns the number of series with tombstones.
+func (t *seriesTombstones) Len() int {
+	if t == nil {
+		return 0
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+	return len(t.series)
+}
+
+// Ranges returns a copy of the tombstoned ranges of a series that overlap
+// with the given time range.
+func (t *seriesTombstones) Ranges(id []byte, r xtime.Range) (xtime.Ranges, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	entry, ok := t.series[string(id)]
+	if !ok || !entry.ranges.Overlaps(r) {
+		return nil, false
+	}
+	return entry.ranges.Clone(), true
+}
+
+// Covers returns whether the tombstones of a series cover the entire time
+// range given.
+func (t *seriesTombstones) Covers(id []byte, r xtime.Range) bool {
+	if t == nil {
+		return false
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	entry, ok := t.series[string(id)]
+	if !ok {
+		return false
+	}
+	remaining := xtime.NewRanges(r)
+	remaining.RemoveRanges(entry.ranges)
+	return remaining.IsEmpty()
+}
+
+// CoveredIDs returns the IDs of the series whose tombstones cover the entire
+// time range given.
+func (t *seriesTombstones) CoveredIDs(r xtime.Range) [][]byte {
+	if t == nil {
+		return nil
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	var ids [][]byte
+	for id, entry := range t.series {
+		remaining := xtime.NewRanges(r)
+		remaining.RemoveRanges(entry.ranges)
+		if remaining.IsEmpty() {
+			ids = append(ids, []byte(id))
+		}
+	}
+	return ids
+}
+
+// Version returns the version of the tombstones, which is incremented each
+// time tombstones are added.
+func (t *seriesTombstones) Version() uint64 {
+	if t == nil {
+		return 0
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+	return t.version
+}
+
+// UnappliedBlockStarts returns the block starts of a shard that may still
+// contain tombstoned datapoints in filesets flushed before the tombstones
+// were added.
+func (t *seriesTombstones) UnappliedBlockStarts(
+	shard uint32,
+	blockSize time.Duration,
+) []xtime.UnixNano {
+	if t == nil {
+		return nil
+	}
+
+	t.RLock()
+	defer t.RUnlock()
+
+	blockStarts := make(map[xtime.UnixNano]struct{})
+	for _, entry := range t.series {
+		if entry.shard != shard {
+			continue
+		}
+		for _, u := range entry.unapplied {
+			for blockStart := u.Start.Truncate(blockSize); blockStart.Before(u.End); blockStart = blockStart.Add(blockSize) {
+				blockStarts[blockStart] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]xtime.UnixNano, 0, len(blockStarts))
+	for blockStart := range blockStarts {
+		result = append(result, blockStart)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// MarkApplied marks the tombstones of a shard added up to and including the
+// given version as physically applied to the fileset of the block range.
+func (t *seriesTombstones) MarkApplied(
+	shard uint32,
+	blockRange xtime.Range,
+	version uint64,
+) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	changed := false
+	for _, entry := range t.series {
+		if entry.shard != shard || len(entry.unapplied) == 0 {
+			continue
+		}
+		unapplied := entry.unapplied[:0]
+		for _, u := range entry.unapplied {
+			if u.version > version || !u.Overlaps(blockRange) {
+				unapplied = append(unapplied, u)
+				continue
+			}
+			changed = true
+			for _, r := range u.Subtract(blockRange) {
+				unapplied = append(unapplied, unappliedTombstone{
+					Range:   r,
+					version: u.version,
+				})
+			}
+		}
+		entry.unapplied = unapplied
+	}
+
+	if !changed {
+		return nil
+	}
+	return t.persistWithLock()
+}
+
+// Expire removes the tombstoned ranges before the earliest time retained.
+func (t *seriesTombstones) Expire(earliest xtime.UnixNano) error {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	var (
+		expired = xtime.Range{End: earliest}
+		changed = false
+	)
+	for id, entry := range t.series {
+		if !entry.ranges.Overlaps(expired) {
+			continue
+		}
+		changed = true
+		entry.ranges.RemoveRange(expired)
+		if entry.ranges.IsEmpty() {
+			delete(t.series, id)
+			continue
+		}
+		unapplied := entry.unapplied[:0]
+		for _, u := range entry.unapplied {
+			if u.End.After(earliest) {
+				u.Range = u.Since(earliest)
+				unapplied = append(unapplied, u)
+			}
+		}
+		entry.unapplied = unapplied
+	}
+
+	if !changed {
+		return nil
+	}
+	return t.persistWithLock()
+}
+
+// DocumentsFilter returns a filter for the index documents of the series
+// that have not been deleted for the entire block range.
+func (t *seriesTombstones) DocumentsFilter(blockRange xtime.Range) segment.DocumentsFilter {
+	if t == nil {
+		return nil
+	}
+	return tombstonesDocumentsFilter{
+		tombstones: t,
+		blockRange: blockRange,
+	}
+}
+
+type tombstonesDocumentsFilter struct {
+	tombstones *seriesTombstones
+	blockRange xtime.Range
+}
+
+func (f tombstonesDocumentsFilter) Contains(d doc.Metadata) bool {
+	return !f.tombstones.Covers(d.ID, f.blockRange)
+}
+
+type tombstonesFile struct {
+	Version uint64                 `json:"version"`
+	Series  []tombstonesFileSeries `json:"series"`
+}
+
+type tombstonesFileSeries struct {
+	ID        []byte                `json:"id"`
+	Shard     uint32                `json:"shard"`
+	Ranges    []tombstonesFileRange `json:"ranges"`
+	Unapplied []tombstonesFileRange `json:"unapplied,omitempty"`
+}
+
+type tombstonesFileRange struct {
+	Start   int64  `json:"start"`
+	End     int64  `json:"end"`
+	Version uint64 `json:"version,omitempty"`
+}
+
+func (t *seriesTombstones) load() error {
+	data, err := ioutil.ReadFile(t.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var file tombstonesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return err
+	}
+
+	t.version = file.Version
+	for _, s := range file.Series {
+		entry := &seriesTombstone{
+			shard:  s.Shard,
+			ranges: xtime.NewRanges(),
+		}
+		for _, r := range s.Ranges {
+			entry.ranges.AddRange(xtime.Range{
+				Start: xtime.UnixNano(r.Start),
+				End:   xtime.UnixNano(r.End),
+			})
+		}
+		for _, r := range s.Unapplied {
+			entry.unapplied = append(entry.unapplied, unappliedTombstone{
+				Range: xtime.Range{
+					Start: xtime.UnixNano(r.Start),
+					End:   xtime.UnixNano(r.End),
+				},
+				version: r.Version,
+			})
+		}
+		t.series[string(s.ID)] = entry
+	}
+	return nil
+}
+
+func (t *seriesTombstones) persistWithLock() error {
+	file := tombstonesFile{
+		Version: t.version,
+		Series:  make([]tombstonesFileSeries, 0, len(t.series)),
+	}
+	for id, entry := range t.series {
+		s := tombstonesFileSeries{
+			ID:    []byte(id),
+			Shard: entry.shard,
+		}
+		for iter := entry.ranges.Iter(); iter.Next(); {
+			r := iter.Value()
+			s.Ranges = append(s.Ranges, tombstonesFileRange{
+				Start: int64(r.Start),
+				End:   int64(r.End),
+			})
+		}
+		for _, u := range entry.unapplied {
+			s.Unapplied = append(s.Unapplied, tombstonesFileRange{
+				Start:   int64(u.Start),
+				End:     int64(u.End),
+				Version: u.version,
+			})
+		}
+		file.Series = append(file.Series, s)
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.filePath), t.dirMode); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first and rename it so that the tombstones
+	// file is replaced atomically, syncing the file before the rename and the
+	// directory after it so that the tombstones also survive a power loss.
+	tmpFilePath := t.filePath + ".tmp"
+	tmpFile, err := fs.OpenWritable(tmpFilePath, t.fileMode)
+	if err != nil {
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFilePath, t.filePath); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(filepath.Dir(t.filePath))
+	if err != nil {
+		return err
+	}
+	if err := dir.Sync(); err != nil {
+		dir.Close()
+		return err
+	}
+	return dir.Close()
+}
+
+// tombstonesFilter re-encodes series data without the datapoints covered by
+// the tombstones of the series.
+type tombstonesFilter struct {
+	tombstones     *seriesTombstones
+	encoderPool    encoding.EncoderPool
+	multiIterPool  encoding.MultiReaderIteratorPool
+	blockAllocSize int
+}
+
+func newTombstonesFilter(
+	tombstones *seriesTombstones,
+	opts Options,
+) tombstonesFilter {
+	return tombstonesFilter{
+		tombstones:     tombstones,
+		encoderPool:    opts.EncoderPool(),
+		multiIterPool:  opts.MultiReaderIteratorPool(),
+		blockAllocSize: opts.DatabaseBlockOptions().DatabaseBlockAllocSize(),
+	}
+}
+
+// filterBlockReaders merges the block readers of a single block into one,
+// excluding the tombstoned datapoints. It returns false if all the
+// datapoints of the block were tombstoned.
+func (f tombstonesFilter) filterBlockReaders(
+	ctx context.Context,
+	readers []xio.BlockReader,
+	ranges xtime.Ranges,
+	nsCtx namespace.Context,
+) (xio.BlockReader, bool, error) {
+	var (
+		blockStart = readers[0].Start
+		blockSize  = readers[0].BlockSize
+		segReaders = make([]xio.SegmentReader, 0, len(readers))
+	)
+	for _, reader := range readers {
+		segReaders = append(segReaders, reader.SegmentReader)
+	}
+
+	iter := f.multiIterPool.Get()
+	iter.Reset(segReaders, blockStart, blockSize, nsCtx.Schema)
+	defer iter.Close()
+
+	segment, err := f.encode(iter, blockStart, ranges, nsCtx.Schema)
+	if err != nil {
+		return xio.EmptyBlockReader, false, err
+	}
+	if segment.Len() == 0 {
+		segment.Finalize()
+		return xio.EmptyBlockReader, false, nil
+	}
+
+	reader := xio.NewSegmentReader(segment)
+	ctx.RegisterFinalizer(reader)
+	return xio.BlockReader{
+		SegmentReader: reader,
+		Start:         blockStart,
+		BlockSize:     blockSize,
+	}, true, nil
+}
+
+// filterFetchBlockResults filters the tombstoned datapoints out of the
+// blocks fetched for a series.
+func (f tombstonesFilter) filterFetchBlockResults(
+	ctx context.Context,
+	id []byte,
+	results []block.FetchBlockResult,
+	blockSize time.Duration,
+	nsCtx namespace.Context,
+) ([]block.FetchBlockResult, error) {
+	for i, result := range results {
+		if len(result.Blocks) == 0 {
+			continue
+		}
+		ranges, ok := f.tombstones.Ranges(id, xtime.Range{
+			Start: result.Start,
+			End:   result.Start.Add(blockSize),
+		})
+		if !ok {
+			continue
+		}
+		reader, ok, err := f.filterBlockReaders(ctx, result.Blocks, ranges, nsCtx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			results[i].Blocks = nil
+			continue
+		}
+		results[i].Blocks = []xio.BlockReader{reader}
+	}
+	return results, nil
+}
+
+func (f tombstonesFilter) encode(
+	iter encoding.Iterator,
+	blockStart xtime.UnixNano,
+	ranges xtime.Ranges,
+	schema namespace.SchemaDescr,
+) (ts.Segment, error) {
+	encoder := f.encoderPool.Get()
+	encoder.Reset(blockStart, f.blockAllocSize, schema)
+	for iter.Next() {
+		dp, unit, annotation := iter.Current()
+		if ranges.Overlaps(xtime.Range{
+			Start: dp.TimestampNanos,
+			End:   dp.TimestampNanos + 1,
+		}) {
+			continue
+		}
+		if err := encoder.Encode(dp, unit, annotation); err != nil {
+			encoder.Close()
+			return ts.Segment{}, err
+		}
+	}
+	if err := iter.Err(); err != nil {
+		encoder.Close()
+		return ts.Segment{}, err
+	}
+	return encoder.Discard(), nil
+}
+
+// flushPreparer wraps a flush preparer so that the tombstoned datapoints
+// are filtered out of the series persisted by the flush.
+func (f tombstonesFilter) flushPreparer(
+	preparer persist.FlushPreparer,
+	nsCtx namespace.Context,
+) persist.FlushPreparer {
+	return tombstonesFlushPreparer{
+		FlushPreparer: preparer,
+		filter:        f,
+		nsCtx:         nsCtx,
+	}
+}
+
+type tombstonesFlushPreparer struct {
+	persist.FlushPreparer
+
+	filter tombstonesFilter
+	nsCtx  namespace.Context
+}
+
+func (p tombstonesFlushPreparer) PrepareData(
+	opts persist.DataPrepareOptions,
+) (persist.PreparedDataPersist, error) {
+	prepared, err := p.FlushPreparer.PrepareData(opts)
+	if err != nil {
+		return prepared, err
+	}
+
+	var (
+		persistFn  = prepared.Persist
+		blockSize  = opts.NamespaceMetadata.Options().RetentionOptions().BlockSize()
+		blockRange = xtime.Range{
+			Start: opts.BlockStart,
+			End:   opts.BlockStart.Add(blockSize),
+		}
+	)
+	prepared.Persist = func(
+		metadata persist.Metadata,
+		segment ts.Segment,
+		checksum uint32,
+	) error {
+		ranges, ok := p.filter.tombstones.Ranges(metadata.BytesID(), blockRange)
+		if !ok {
+			return persistFn(metadata, segment, checksum)
+		}
+
+		iter := p.filter.multiIterPool.Get()
+		iter.Reset([]xio.SegmentReader{xio.NewSegmentReader(segment)},
+			opts.BlockStart, blockSize, p.nsCtx.Schema)
+		filtered, err := p.filter.encode(iter, opts.BlockStart, ranges, p.nsCtx.Schema)
+		iter.Close()
+		if err != nil {
+			return err
+		}
+		defer filtered.Finalize()
+
+		if filtered.Len() == 0 {
+			// All the datapoints of the series in this block were deleted.
+			return nil
+		}
+		return persistFn(metadata, filtered, filtered.CalculateChecksum())
+	}
+	return prepared, nil
+}
+
+// tombstonesBlockReaderIter filters the tombstoned datapoints out of the
+// blocks returned by a block reader iterator, skipping blocks that have
+// all of their datapoints tombstoned.
+type tombstonesBlockReaderIter struct {
+	iter   series.BlockReaderIter
+	filter tombstonesFilter
+	ranges xtime.Ranges
+	nsCtx  namespace.Context
+	curr   []xio.BlockReader
+	err    error
+}
+
+func newTombstonesBlockReaderIter(
+	iter series.BlockReaderIter,
+	filter tombstonesFilter,
+	ranges xtime.Ranges,
+	nsCtx namespace.Context,
+) series.BlockReaderIter {
+	return &tombstonesBlockReaderIter{
+		iter:   iter,
+		filter: filter,
+		ranges: ranges,
+		nsCtx:  nsCtx,
+	}
+}
+
+func (i *tombstonesBlockReaderIter) Next(ctx context.Context) bool {
+	if i.err != nil {
+		return false
+	}
+
+	for i.iter.Next(ctx) {
+		readers := i.iter.Current()
+		blockRange := xtime.Range{
+			Start: readers[0].Start,
+			End:   readers[0].Start.Add(readers[0].BlockSize),
+		}
+		if !i.ranges.Overlaps(blockRange) {
+			i.curr = readers
+			return true
+		}
+
+		reader, ok, err := i.filter.filterBlockReaders(ctx, readers, i.ranges, i.nsCtx)
+		if err != nil {
+			i.err = err
+			return false
+		}
+		if ok {
+			i.curr = []xio.BlockReader{reader}
+			return true
+		}
+	}
+	return false
+}
+
+func (i *tombstonesBlockReaderIter) Current() []xio.BlockReader {
+	return i.curr
+}
+
+func (i *tombstonesBlockReaderIter) Err() error {
+	if i.err != nil {
+		return i.err
+	}
+	return i.iter.Err()
+}
+
+func (i *tombstonesBlockReaderIter) ToSlices(ctx context.Context) ([][]xio.BlockReader, error) {
+	var results [][]xio.BlockReader
+	for i.Next(ctx) {
+		results = append(results, i.Current())
+	}
+	if err := i.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
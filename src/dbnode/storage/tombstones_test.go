@@ -0,0 +1,238 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package storage
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/namespace"
+	"github.com/m3db/m3/src/dbnode/persist/fs"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/m3ninx/doc"
+	"github.com/m3db/m3/src/x/context"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSeriesTombstones(t *testing.T) (*seriesTombstones, string, func()) {
+	dir, err := ioutil.TempDir("", "tombstones")
+	require.NoError(t, err)
+
+	filePath := filepath.Join(dir, "tombstones", "testns.json")
+	tombstones, err := newSeriesTombstones(filePath, fs.NewOptions())
+	require.NoError(t, err)
+
+	return tombstones, filePath, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSeriesTombstonesAdd(t *testing.T) {
+	tombstones, _, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	start := xtime.Now().Truncate(time.Hour)
+	require.NoError(t, tombstones.Add([]tombstonedSeries{
+		{id: []byte("foo"), shard: 1},
+		{id: []byte("bar"), shard: 2},
+	}, xtime.Range{Start: start, End: start.Add(time.Hour)}))
+
+	assert.Equal(t, 2, tombstones.Len())
+	assert.Equal(t, uint64(1), tombstones.Version())
+
+	_, ok := tombstones.Ranges([]byte("foo"),
+		xtime.Range{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)})
+	assert.False(t, ok)
+
+	ranges, ok := tombstones.Ranges([]byte("foo"),
+		xtime.Range{Start: start.Add(-time.Hour), End: start.Add(time.Minute)})
+	require.True(t, ok)
+	assert.True(t, ranges.Overlaps(xtime.Range{Start: start, End: start.Add(1)}))
+
+	_, ok = tombstones.Ranges([]byte("baz"),
+		xtime.Range{Start: start, End: start.Add(time.Hour)})
+	assert.False(t, ok)
+
+	assert.True(t, tombstones.Covers([]byte("bar"),
+		xtime.Range{Start: start, End: start.Add(time.Hour)}))
+	assert.False(t, tombstones.Covers([]byte("bar"),
+		xtime.Range{Start: start, End: start.Add(2 * time.Hour)}))
+
+	covered := tombstones.CoveredIDs(xtime.Range{Start: start, End: start.Add(time.Minute)})
+	sort.Slice(covered, func(i, j int) bool {
+		return bytes.Compare(covered[i], covered[j]) < 0
+	})
+	assert.Equal(t, [][]byte{[]byte("bar"), []byte("foo")}, covered)
+	assert.Empty(t, tombstones.CoveredIDs(
+		xtime.Range{Start: start, End: start.Add(2 * time.Hour)}))
+
+	filter := tombstones.DocumentsFilter(xtime.Range{Start: start, End: start.Add(time.Hour)})
+	assert.False(t, filter.Contains(doc.Metadata{ID: []byte("foo")}))
+	assert.True(t, filter.Contains(doc.Metadata{ID: []byte("baz")}))
+}
+
+func TestSeriesTombstonesMarkApplied(t *testing.T) {
+	tombstones, _, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	var (
+		blockSize = time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+	)
+	require.NoError(t, tombstones.Add([]tombstonedSeries{{id: []byte("foo"), shard: 1}},
+		xtime.Range{Start: start.Add(30 * time.Minute), End: start.Add(150 * time.Minute)}))
+
+	assert.Equal(t, []xtime.UnixNano{start, start.Add(time.Hour), start.Add(2 * time.Hour)},
+		tombstones.UnappliedBlockStarts(1, blockSize))
+	assert.Empty(t, tombstones.UnappliedBlockStarts(2, blockSize))
+
+	// Flushes that started before the tombstones were added do not apply them.
+	block := xtime.Range{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}
+	require.NoError(t, tombstones.MarkApplied(1, block, 0))
+	assert.Len(t, tombstones.UnappliedBlockStarts(1, blockSize), 3)
+
+	require.NoError(t, tombstones.MarkApplied(1, block, tombstones.Version()))
+	assert.Equal(t, []xtime.UnixNano{start, start.Add(2 * time.Hour)},
+		tombstones.UnappliedBlockStarts(1, blockSize))
+
+	// Reads still honour the tombstones once applied.
+	assert.True(t, tombstones.Covers([]byte("foo"), block))
+}
+
+func TestSeriesTombstonesExpire(t *testing.T) {
+	tombstones, _, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	start := xtime.Now().Truncate(time.Hour)
+	require.NoError(t, tombstones.Add([]tombstonedSeries{{id: []byte("foo"), shard: 1}},
+		xtime.Range{Start: start, End: start.Add(time.Hour)}))
+	require.NoError(t, tombstones.Add([]tombstonedSeries{{id: []byte("bar"), shard: 1}},
+		xtime.Range{Start: start, End: start.Add(3 * time.Hour)}))
+
+	require.NoError(t, tombstones.Expire(start.Add(2*time.Hour)))
+	assert.Equal(t, 1, tombstones.Len())
+	assert.False(t, tombstones.Covers([]byte("foo"),
+		xtime.Range{Start: start, End: start.Add(time.Hour)}))
+	assert.True(t, tombstones.Covers([]byte("bar"),
+		xtime.Range{Start: start.Add(2 * time.Hour), End: start.Add(3 * time.Hour)}))
+	assert.Equal(t, []xtime.UnixNano{start.Add(2 * time.Hour)},
+		tombstones.UnappliedBlockStarts(1, time.Hour))
+}
+
+func TestSeriesTombstonesPersisted(t *testing.T) {
+	tombstones, filePath, cleanup := newTestSeriesTombstones(t)
+	defer cleanup()
+
+	start := xtime.Now().Truncate(time.Hour)
+	blockRange := xtime.Range{Start: start, End: start.Add(time.Hour)}
+	require.NoError(t, tombstones.Add([]tombstonedSeries{{id: []byte("foo"), shard: 3}},
+		xtime.Range{Start: start, End: start.Add(2 * time.Hour)}))
+	require.NoError(t, tombstones.MarkApplied(3, blockRange, tombstones.Version()))
+
+	reloaded, err := newSeriesTombstones(filePath, fs.NewOptions())
+	require.NoError(t, err)
+	assert.Equal(t, tombstones.Version(), reloaded.Version())
+	assert.True(t, reloaded.Covers([]byte("foo"),
+		xtime.Range{Start: start, End: start.Add(2 * time.Hour)}))
+	assert.Equal(t, []xtime.UnixNano{start.Add(time.Hour)},
+		reloaded.UnappliedBlockStarts(3, time.Hour))
+}
+
+func TestSeriesTombstonesNil(t *testing.T) {
+	var tombstones *seriesTombstones
+
+	r := xtime.Range{Start: 0, End: xtime.UnixNano(time.Hour)}
+	assert.Equal(t, 0, tombstones.Len())
+	_, ok := tombstones.Ranges([]byte("foo"), r)
+	assert.False(t, ok)
+	assert.False(t, tombstones.Covers([]byte("foo"), r))
+	assert.Nil(t, tombstones.CoveredIDs(r))
+	assert.Nil(t, tombstones.UnappliedBlockStarts(0, time.Hour))
+	assert.NoError(t, tombstones.MarkApplied(0, r, 1))
+	assert.NoError(t, tombstones.Expire(r.End))
+	assert.Nil(t, tombstones.DocumentsFilter(r))
+}
+
+func TestTombstonesFilterBlockReaders(t *testing.T) {
+	ctx := context.NewBackground()
+	defer ctx.Close()
+
+	var (
+		opts      = DefaultTestOptions()
+		blockSize = time.Hour
+		start     = xtime.Now().Truncate(blockSize)
+		encoder   = opts.EncoderPool().Get()
+	)
+	encoder.Reset(start, 0, nil)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, encoder.Encode(ts.Datapoint{
+			TimestampNanos: start.Add(time.Duration(i) * 10 * time.Minute),
+			Value:          float64(i),
+		}, xtime.Second, nil))
+	}
+	stream, ok := encoder.Stream(ctx)
+	require.True(t, ok)
+	readers := []xio.BlockReader{{
+		SegmentReader: stream,
+		Start:         start,
+		BlockSize:     blockSize,
+	}}
+
+	filter := newTombstonesFilter(nil, opts)
+	ranges := xtime.NewRanges(xtime.Range{
+		Start: start.Add(10 * time.Minute),
+		End:   start.Add(30 * time.Minute),
+	})
+	reader, ok, err := filter.filterBlockReaders(ctx, readers, ranges, namespace.Context{})
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	iter := opts.MultiReaderIteratorPool().Get()
+	iter.Reset([]xio.SegmentReader{reader.SegmentReader}, start, blockSize, nil)
+	defer iter.Close()
+
+	var values []float64
+	for iter.Next() {
+		dp, _, _ := iter.Current()
+		values = append(values, dp.Value)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []float64{0, 3}, values)
+
+	// Blocks that are entirely tombstoned are dropped.
+	stream, ok = encoder.Stream(ctx)
+	require.True(t, ok)
+	readers[0].SegmentReader = stream
+	_, ok, err = filter.filterBlockReaders(ctx, readers,
+		xtime.NewRanges(xtime.Range{Start: start, End: start.Add(blockSize)}),
+		namespace.Context{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
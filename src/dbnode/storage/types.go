@@ -31,6 +31,7 @@ import (
 	"github.com/m3db/m3/src/dbnode/persist"
 	"github.com/m3db/m3/src/dbnode/persist/fs"
 	"github.com/m3db/m3/src/dbnode/persist/fs/commitlog"
+	"github.com/m3db/m3/src/dbnode/persist/fs/tiering"
 	"github.com/m3db/m3/src/dbnode/persist/schema"
 	"github.com/m3db/m3/src/dbnode/runtime"
 	"github.com/m3db/m3/src/dbnode/sharding"
@@ -238,9 +239,29 @@ type Database interface {
 	// Repair will issue a repair and return nil on success or error on error.
 	Repair() error
 
+	// StartRepair starts an asynchronous repair scoped to a namespace, a set
+	// of shards and a time range, returning the initial progress.
+	StartRepair(req repair.Request) (repair.Progress, error)
+
+	// RepairProgress returns the progress of the last requested repair and
+	// whether a repair has been requested.
+	RepairProgress() (repair.Progress, bool)
+
 	// Truncate truncates data for the given namespace.
 	Truncate(namespace ident.ID) (int64, error)
 
+	// DeleteSeries tombstones the data of the series matching the query for
+	// the time range [start, end), returning the number of series deleted.
+	// Datapoints written to the range after the delete are also hidden until
+	// the tombstone falls out of retention.
+	DeleteSeries(
+		ctx context.Context,
+		namespace ident.ID,
+		query index.Query,
+		start xtime.UnixNano,
+		end xtime.UnixNano,
+	) (int64, error)
+
 	// BootstrapState captures and returns a snapshot of the databases'
 	// bootstrap state.
 	BootstrapState() DatabaseBootstrapState
@@ -448,6 +469,17 @@ type databaseNamespace interface {
 	// Truncate truncates the in-memory data for this namespace.
 	Truncate() (int64, error)
 
+	// DeleteSeries tombstones the data of the series matching the query for
+	// the time range [start, end), returning the number of series deleted.
+	// Datapoints written to the range after the delete are also hidden until
+	// the tombstone falls out of retention.
+	DeleteSeries(
+		ctx context.Context,
+		query index.Query,
+		start xtime.UnixNano,
+		end xtime.UnixNano,
+	) (int64, error)
+
 	// Repair repairs the namespace data for a given time range.
 	Repair(repairer databaseShardRepairer, tr xtime.Range, opts NamespaceRepairOptions) error
 
@@ -483,6 +515,15 @@ type databaseNamespace interface {
 // NamespaceRepairOptions is a set of repair options for repairing a namespace.
 type NamespaceRepairOptions struct {
 	Force bool
+
+	// Shards restricts the repair to the given shards, when empty all owned
+	// shards are repaired.
+	Shards []uint32
+
+	// OnShardRepaired is an optional callback invoked after each shard has
+	// been repaired with the metadata comparison result of the shard, the
+	// result is only valid for the duration of the callback.
+	OnShardRepaired func(shard uint32, res repair.MetadataComparisonResult, err error)
 }
 
 // Shard is a time series database shard.
@@ -765,6 +806,14 @@ type NamespaceIndex interface {
 	// cold flushing completes to perform houskeeping.
 	ColdFlush(shards []databaseShard) (OnColdFlushDone, error)
 
+	// CardinalityStats returns the cardinality of the series indexed by the
+	// blocks that overlap the given time range, only counting the series
+	// which belong to the given shards or all owned shards if none are given.
+	CardinalityStats(
+		start, end xtime.UnixNano,
+		shards []uint32,
+	) (index.CardinalityStats, error)
+
 	// DebugMemorySegments allows for debugging memory segments.
 	DebugMemorySegments(opts DebugMemorySegmentsOptions) error
 
@@ -996,6 +1045,12 @@ type databaseRepairer interface {
 
 	// Repair repairs in-memory data.
 	Repair() error
+
+	// StartRepair starts an asynchronous scoped repair.
+	StartRepair(req repair.Request) (repair.Progress, error)
+
+	// RepairProgress returns the progress of the last requested repair.
+	RepairProgress() (repair.Progress, bool)
 }
 
 // databaseTickManager performs periodic ticking.
@@ -1181,6 +1236,22 @@ type Options interface {
 	// IndexClaimsManager returns the index claims manager.
 	IndexClaimsManager() fs.IndexClaimsManager
 
+	// SetFilePins sets the file pins which prevent pinned filesets, such as
+	// those being backed up, from being deleted by cleanup.
+	SetFilePins(value fs.FilePins) Options
+
+	// FilePins returns the file pins which prevent pinned filesets, such as
+	// those being backed up, from being deleted by cleanup.
+	FilePins() fs.FilePins
+
+	// SetTieringManager sets the tiering manager which moves cold filesets to
+	// an object store during cleanup, nil disables tiering.
+	SetTieringManager(value tiering.Manager) Options
+
+	// TieringManager returns the tiering manager which moves cold filesets to
+	// an object store during cleanup, nil disables tiering.
+	TieringManager() tiering.Manager
+
 	// SetDatabaseBlockRetrieverManager sets the block retriever manager to
 	// use when bootstrapping retrievable blocks instead of blocks
 	// containing data.
@@ -21,6 +21,7 @@
 package writes
 
 import (
+	dbnamespace "github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/storage/index"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/m3ninx/doc"
@@ -86,6 +87,9 @@ type WriteBatch interface {
 	SetError(idx int, err error)
 	SetSeries(idx int, series ts.Series)
 	SetSkipWrite(idx int)
+	// Durability returns the durability the batch must reach before it is
+	// acknowledged, the namespace write durability applies when left as default.
+	Durability() dbnamespace.WriteDurability
 	Reset(batchSize int, ns ident.ID)
 	Finalize()
 
@@ -119,4 +123,8 @@ type BatchWriter interface {
 	SetFinalizeEncodedTagsFn(f FinalizeEncodedTagsFn)
 
 	SetFinalizeAnnotationFn(f FinalizeAnnotationFn)
+
+	// SetDurability sets the durability the batch must reach before it is
+	// acknowledged, overriding the write durability of the namespace.
+	SetDurability(value dbnamespace.WriteDurability)
 }
@@ -23,6 +23,7 @@ package writes
 import (
 	"errors"
 
+	dbnamespace "github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/x/ident"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -43,6 +44,7 @@ type writeBatch struct {
 	writes       []BatchWrite
 	pendingIndex []PendingIndexInsert
 	ns           ident.ID
+	durability   dbnamespace.WriteDurability
 	// Enables callers to pool encoded tags by allowing them to
 	// provide a function to finalize all encoded tags once the
 	// writeBatch itself gets finalized.
@@ -150,6 +152,7 @@ func (b *writeBatch) Reset(
 	}
 
 	b.ns = ns
+	b.durability = dbnamespace.DefaultWriteDurability
 	b.finalizeEncodedTagsFn = nil
 	b.finalizeAnnotationFn = nil
 }
@@ -195,6 +198,16 @@ func (b *writeBatch) SetFinalizeAnnotationFn(f FinalizeAnnotationFn) {
 	b.finalizeAnnotationFn = f
 }
 
+// SetDurability sets the durability the batch must reach before it is
+// acknowledged, overriding the write durability of the namespace.
+func (b *writeBatch) SetDurability(value dbnamespace.WriteDurability) {
+	b.durability = value
+}
+
+func (b *writeBatch) Durability() dbnamespace.WriteDurability {
+	return b.durability
+}
+
 func (b *writeBatch) Finalize() {
 	if b.finalizeEncodedTagsFn != nil {
 		for _, write := range b.writes {
@@ -27,6 +27,7 @@ package writes
 import (
 	"reflect"
 
+	dbnamespace "github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/dbnode/ts"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/time"
@@ -85,6 +86,20 @@ func (mr *MockWriteBatchMockRecorder) AddTagged(originalIndex, id, encodedTags,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagged", reflect.TypeOf((*MockWriteBatch)(nil).AddTagged), originalIndex, id, encodedTags, timestamp, value, unit, annotation)
 }
 
+// Durability mocks base method.
+func (m *MockWriteBatch) Durability() dbnamespace.WriteDurability {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Durability")
+	ret0, _ := ret[0].(dbnamespace.WriteDurability)
+	return ret0
+}
+
+// Durability indicates an expected call of Durability.
+func (mr *MockWriteBatchMockRecorder) Durability() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Durability", reflect.TypeOf((*MockWriteBatch)(nil).Durability))
+}
+
 // Finalize mocks base method.
 func (m *MockWriteBatch) Finalize() {
 	m.ctrl.T.Helper()
@@ -137,6 +152,18 @@ func (mr *MockWriteBatchMockRecorder) Reset(batchSize, ns interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockWriteBatch)(nil).Reset), batchSize, ns)
 }
 
+// SetDurability mocks base method.
+func (m *MockWriteBatch) SetDurability(value dbnamespace.WriteDurability) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDurability", value)
+}
+
+// SetDurability indicates an expected call of SetDurability.
+func (mr *MockWriteBatchMockRecorder) SetDurability(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDurability", reflect.TypeOf((*MockWriteBatch)(nil).SetDurability), value)
+}
+
 // SetError mocks base method.
 func (m *MockWriteBatch) SetError(idx int, err error) {
 	m.ctrl.T.Helper()
@@ -274,6 +301,18 @@ func (mr *MockBatchWriterMockRecorder) AddTagged(originalIndex, id, encodedTags,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagged", reflect.TypeOf((*MockBatchWriter)(nil).AddTagged), originalIndex, id, encodedTags, timestamp, value, unit, annotation)
 }
 
+// SetDurability mocks base method.
+func (m *MockBatchWriter) SetDurability(value dbnamespace.WriteDurability) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDurability", value)
+}
+
+// SetDurability indicates an expected call of SetDurability.
+func (mr *MockBatchWriterMockRecorder) SetDurability(value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDurability", reflect.TypeOf((*MockBatchWriter)(nil).SetDurability), value)
+}
+
 // SetFinalizeAnnotationFn mocks base method.
 func (m *MockBatchWriter) SetFinalizeAnnotationFn(f FinalizeAnnotationFn) {
 	m.ctrl.T.Helper()
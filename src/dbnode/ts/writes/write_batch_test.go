@@ -27,6 +27,7 @@ import (
 	"sync"
 	"testing"
 
+	dbnamespace "github.com/m3db/m3/src/dbnode/namespace"
 	"github.com/m3db/m3/src/x/checked"
 	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/pool"
@@ -238,6 +239,17 @@ func TestWriteBatchReset(t *testing.T) {
 	}
 }
 
+func TestWriteBatchResetDurability(t *testing.T) {
+	writeBatch := NewWriteBatch(batchSize, namespace, nil)
+	require.Equal(t, dbnamespace.DefaultWriteDurability, writeBatch.Durability())
+
+	writeBatch.SetDurability(dbnamespace.FsyncedWriteDurability)
+	require.Equal(t, dbnamespace.FsyncedWriteDurability, writeBatch.Durability())
+
+	writeBatch.Reset(batchSize, namespace)
+	require.Equal(t, dbnamespace.DefaultWriteDurability, writeBatch.Durability())
+}
+
 func assertDataPresent(t *testing.T, writes []testWrite, batchWriter WriteBatch) {
 	for _, write := range writes {
 		var (
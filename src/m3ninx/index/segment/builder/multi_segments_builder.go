@@ -37,6 +37,7 @@ type builderFromSegments struct {
 	segments       []segmentMetadata
 	termsIter      *termsIterFromSegments
 	segmentsOffset postings.ID
+	filter         segment.DocumentsFilter
 }
 
 type segmentMetadata struct {
@@ -44,8 +45,8 @@ type segmentMetadata struct {
 	offset  postings.ID
 	// duplicatesAsc is a lookup of document IDs are duplicates
 	// in this segment, that is documents that are already
-	// contained by other segments and hence should not be
-	// returned when looking up documents.
+	// contained by other segments or excluded by the filter and
+	// hence should not be returned when looking up documents.
 	duplicatesAsc []postings.ID
 }
 
@@ -79,6 +80,13 @@ func (b *builderFromSegments) Reset() {
 	b.segments = b.segments[:0]
 
 	b.termsIter.clear()
+
+	// Reset the filter
+	b.filter = nil
+}
+
+func (b *builderFromSegments) SetFilter(keep segment.DocumentsFilter) {
+	b.filter = keep
 }
 
 func (b *builderFromSegments) AddSegments(segments []segment.Segment) error {
@@ -123,6 +131,12 @@ func (b *builderFromSegments) AddSegments(segments []segment.Segment) error {
 				duplicates = append(duplicates, iter.PostingsID())
 				continue
 			}
+			if b.filter != nil && !b.filter.Contains(d) {
+				// Filtered documents are skipped in the same way as
+				// duplicates so that their postings are not carried over.
+				duplicates = append(duplicates, iter.PostingsID())
+				continue
+			}
 			b.idSet.SetUnsafe(d.ID, struct{}{}, IDsMapSetUnsafeOptions{
 				NoCopyKey:     true,
 				NoFinalizeKey: true,
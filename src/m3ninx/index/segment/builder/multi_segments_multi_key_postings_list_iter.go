@@ -149,7 +149,8 @@ func (i *multiKeyPostingsListIterator) Next() bool {
 			return false
 		}
 
-		if fieldsKeyIter.segment.offset == 0 {
+		if fieldsKeyIter.segment.offset == 0 &&
+			len(fieldsKeyIter.segment.duplicatesAsc) == 0 {
 			// No offset, which means is first segment we are combining from
 			// so can just direct union
 			i.currFieldPostingsList.Union(pl)
@@ -131,17 +131,28 @@ func (i *termsIterFromSegments) Next() bool {
 		return false
 	}
 
-	if !i.keyIter.Next() {
-		return false
+	for i.keyIter.Next() {
+		if !i.computeCurrPostingsList() {
+			return false
+		}
+		// Skip terms whose documents were all excluded by a filter.
+		if !i.currPostingsList.IsEmpty() {
+			return true
+		}
 	}
 
+	return false
+}
+
+func (i *termsIterFromSegments) computeCurrPostingsList() bool {
 	// Create the overlayed postings list for this term
 	i.currPostingsList.Reset()
 	for _, iter := range i.keyIter.CurrentIters() {
 		termsKeyIter := iter.(*termsKeyIter)
 		_, list := termsKeyIter.iter.Current()
 
-		if termsKeyIter.segment.offset == 0 {
+		if termsKeyIter.segment.offset == 0 &&
+			len(termsKeyIter.segment.duplicatesAsc) == 0 {
 			// No offset, which means is first segment we are combining from
 			// so can just direct union
 			i.currPostingsList.Union(list)
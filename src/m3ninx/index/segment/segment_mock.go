@@ -1817,6 +1817,18 @@ func (mr *MockSegmentsBuilderMockRecorder) Reset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockSegmentsBuilder)(nil).Reset))
 }
 
+// SetFilter mocks base method.
+func (m *MockSegmentsBuilder) SetFilter(keep DocumentsFilter) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFilter", keep)
+}
+
+// SetFilter indicates an expected call of SetFilter.
+func (mr *MockSegmentsBuilderMockRecorder) SetFilter(keep interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFilter", reflect.TypeOf((*MockSegmentsBuilder)(nil).SetFilter), keep)
+}
+
 // Terms mocks base method.
 func (m *MockSegmentsBuilder) Terms(field []byte) (TermsIterator, error) {
 	m.ctrl.T.Helper()
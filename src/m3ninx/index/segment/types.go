@@ -222,4 +222,14 @@ type SegmentsBuilder interface {
 
 	// AddSegments adds segments to build from.
 	AddSegments(segments []Segment) error
+
+	// SetFilter sets a filter on the documents added from segments, documents
+	// not contained by the filter are excluded from the built segment.
+	SetFilter(keep DocumentsFilter)
+}
+
+// DocumentsFilter is a filter for documents.
+type DocumentsFilter interface {
+	// Contains returns whether the document is contained by the filter.
+	Contains(d doc.Metadata) bool
 }
@@ -22,6 +22,7 @@ package carbon
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -49,11 +50,16 @@ const (
 
 	initScannerBufferSize = 2 << 15 // ~ 65KiB
 	maxScannerBufferSize  = 2 << 17 // ~ 0.25iB
+
+	tagSeparator      = ';'
+	tagValueSeparator = '='
 )
 
 var (
 	errInvalidLine = errors.New("invalid line")
 	errNotUTF8     = errors.New("not valid UTF8 string")
+	errInvalidTag  = errors.New("invalid tag, expected tag=value")
+	errEmptyPath   = errors.New("tagged name has empty path")
 	mathNan        = math.NaN()
 )
 
@@ -152,6 +158,53 @@ func ParseName(line []byte) (name []byte, rest []byte, err error) {
 	return
 }
 
+// Tag is a tag of a carbon metric name using the graphite tag syntax.
+type Tag struct {
+	Name  []byte
+	Value []byte
+}
+
+// ParseTaggedName splits a metric name using the graphite tag syntax of
+// "path;tag1=value1;tag2=value2" into its path and tags, appending the tags
+// to the provided slice. Names without tags are returned as the path.
+func ParseTaggedName(name []byte, tags []Tag) ([]byte, []Tag, error) {
+	idx := bytes.IndexByte(name, tagSeparator)
+	if idx == -1 {
+		return name, tags, nil
+	}
+
+	path := name[:idx]
+	if len(path) == 0 {
+		return nil, nil, errEmptyPath
+	}
+
+	rest := name[idx+1:]
+	for len(rest) > 0 {
+		end := bytes.IndexByte(rest, tagSeparator)
+		if end == -1 {
+			end = len(rest)
+		}
+
+		tag := rest[:end]
+		valueIdx := bytes.IndexByte(tag, tagValueSeparator)
+		if valueIdx <= 0 || valueIdx == len(tag)-1 {
+			return nil, nil, errInvalidTag
+		}
+
+		tags = append(tags, Tag{
+			Name:  tag[:valueIdx],
+			Value: tag[valueIdx+1:],
+		})
+
+		if end == len(rest) {
+			break
+		}
+		rest = rest[end+1:]
+	}
+
+	return path, tags, nil
+}
+
 // ParseRemainder parses a line's components (name and remainder) and returns
 // all but the name and returns the timestamp of the metric, its value, the
 // time it was received and any error encountered.
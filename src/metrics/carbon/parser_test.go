@@ -120,6 +120,33 @@ func TestParseName(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestParseTaggedName(t *testing.T) {
+	path, tags, err := ParseTaggedName([]byte("foo.bar;dc=east;env=prod"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "foo.bar", string(path))
+	require.Equal(t, 2, len(tags))
+	assert.Equal(t, "dc", string(tags[0].Name))
+	assert.Equal(t, "east", string(tags[0].Value))
+	assert.Equal(t, "env", string(tags[1].Name))
+	assert.Equal(t, "prod", string(tags[1].Value))
+
+	path, tags, err = ParseTaggedName([]byte("foo.bar"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "foo.bar", string(path))
+	assert.Equal(t, 0, len(tags))
+
+	for _, name := range []string{
+		";dc=east",
+		"foo;dc",
+		"foo;=east",
+		"foo;dc=",
+		"foo;dc=east;;env=prod",
+	} {
+		_, _, err := ParseTaggedName([]byte(name), nil)
+		assert.Error(t, err, name)
+	}
+}
+
 func TestParseErrors(t *testing.T) {
 	assertParseError(t, " ")
 	assertParseError(t, "  ")
@@ -0,0 +1,207 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package carbon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/hydrogen18/stalecucumber"
+	"go.uber.org/zap"
+)
+
+const (
+	pickleHeaderLength = 4
+	// MaxPickleFrameSize is the maximum size of a single pickle frame, which
+	// matches the limit enforced by carbon's own pickle receiver.
+	MaxPickleFrameSize = 1 << 20 // 1MiB
+)
+
+var (
+	errPickleFrameTooLarge = errors.New("pickle frame exceeds max size")
+	errPickleMetric        = errors.New("pickle metric is not a (path, (timestamp, value)) tuple")
+)
+
+// PickleScanner scans the carbon pickle protocol as sent by carbon relays,
+// where each frame is a 4 byte big endian length followed by a pickled list
+// of (path, (timestamp, value)) tuples.
+type PickleScanner struct {
+	r         io.Reader
+	header    [pickleHeaderLength]byte
+	frame     []byte
+	metrics   []Metric
+	idx       int
+	err       error
+	timestamp time.Time
+	path      []byte
+	value     float64
+
+	// The number of malformed metrics encountered.
+	MalformedCount int
+
+	iOpts instrument.Options
+}
+
+// NewPickleScanner creates a new carbon pickle scanner.
+func NewPickleScanner(r io.Reader, iOpts instrument.Options) *PickleScanner {
+	return &PickleScanner{r: r, iOpts: iOpts}
+}
+
+// Scan scans for the next carbon metric. Malformed metrics are skipped but
+// counted, frames that cannot be decoded are skipped entirely and counted once.
+func (s *PickleScanner) Scan() bool {
+	for s.idx >= len(s.metrics) {
+		if !s.readFrame() {
+			return false
+		}
+	}
+
+	m := s.metrics[s.idx]
+	s.idx++
+	s.path, s.timestamp, s.value = m.Name, m.Time, m.Val
+	return true
+}
+
+func (s *PickleScanner) readFrame() bool {
+	s.metrics = s.metrics[:0]
+	s.idx = 0
+
+	if _, err := io.ReadFull(s.r, s.header[:]); err != nil {
+		if err != io.EOF {
+			s.err = err
+		}
+		return false
+	}
+
+	size := binary.BigEndian.Uint32(s.header[:])
+	if size > MaxPickleFrameSize {
+		s.err = errPickleFrameTooLarge
+		return false
+	}
+
+	if cap(s.frame) < int(size) {
+		s.frame = make([]byte, size)
+	}
+	s.frame = s.frame[:size]
+	if _, err := io.ReadFull(s.r, s.frame); err != nil {
+		s.err = err
+		return false
+	}
+
+	var err error
+	s.metrics, err = ParseAndAppendPickle(s.metrics, s.frame, &s.MalformedCount)
+	if err != nil {
+		s.iOpts.Logger().Error("error trying to unpickle carbon frame", zap.Error(err))
+		s.MalformedCount++
+	}
+
+	return true
+}
+
+// Metric returns the path, timestamp, and value of the last parsed metric.
+func (s *PickleScanner) Metric() ([]byte, time.Time, float64) {
+	return s.path, s.timestamp, s.value
+}
+
+// Err returns any errors in the scan.
+func (s *PickleScanner) Err() error { return s.err }
+
+// ParseAndAppendPickle unpickles a single pickle protocol payload and appends
+// the metrics it contains to the provided slice. Malformed metrics are skipped
+// and added to the malformed count, while an error is returned if the payload
+// itself cannot be unpickled.
+func ParseAndAppendPickle(
+	metrics []Metric,
+	payload []byte,
+	malformed *int,
+) ([]Metric, error) {
+	items, err := stalecucumber.ListOrTuple(
+		stalecucumber.Unpickle(bytes.NewReader(payload)))
+	if err != nil {
+		return metrics, err
+	}
+
+	for _, item := range items {
+		metric, err := parsePickleMetric(item)
+		if err != nil {
+			*malformed++
+			continue
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+func parsePickleMetric(item interface{}) (Metric, error) {
+	tuple, err := stalecucumber.ListOrTuple(item, nil)
+	if err != nil || len(tuple) != 2 {
+		return Metric{}, errPickleMetric
+	}
+
+	path, err := stalecucumber.String(tuple[0], nil)
+	if err != nil || len(path) == 0 {
+		return Metric{}, errPickleMetric
+	}
+
+	datapoint, err := stalecucumber.ListOrTuple(tuple[1], nil)
+	if err != nil || len(datapoint) != 2 {
+		return Metric{}, errPickleMetric
+	}
+
+	ts, err := pickleFloat(datapoint[0])
+	if err != nil || math.IsNaN(ts) || math.IsInf(ts, 0) {
+		return Metric{}, errPickleMetric
+	}
+
+	value, err := pickleFloat(datapoint[1])
+	if err != nil {
+		return Metric{}, errPickleMetric
+	}
+
+	return Metric{
+		Name: []byte(path),
+		// Carbon truncates timestamps to whole seconds.
+		Time: time.Unix(int64(ts), 0),
+		Val:  value,
+	}, nil
+}
+
+func pickleFloat(v interface{}) (float64, error) {
+	switch t := v.(type) {
+	case float64:
+		return t, nil
+	case int64:
+		return float64(t), nil
+	case string:
+		return strconv.ParseFloat(t, 64)
+	default:
+		return 0, fmt.Errorf("unexpected pickle value type %T", v)
+	}
+}
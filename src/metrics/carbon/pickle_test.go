@@ -0,0 +1,108 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package carbon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/hydrogen18/stalecucumber"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePickleFrame(t *testing.T, buf *bytes.Buffer, v interface{}) {
+	var payload bytes.Buffer
+	_, err := stalecucumber.NewPickler(&payload).Pickle(v)
+	require.NoError(t, err)
+
+	var header [pickleHeaderLength]byte
+	binary.BigEndian.PutUint32(header[:], uint32(payload.Len()))
+	buf.Write(header[:])
+	buf.Write(payload.Bytes())
+}
+
+func TestPickleScanner(t *testing.T) {
+	var buf bytes.Buffer
+	writePickleFrame(t, &buf, []interface{}{
+		stalecucumber.NewTuple("foo.bar.zed",
+			stalecucumber.NewTuple(int64(1428951394), 45565.02)),
+		stalecucumber.NewTuple("foo.bar;dc=east",
+			stalecucumber.NewTuple(1428951395.5, int64(10))),
+		stalecucumber.NewTuple("foo.bar.invalid", "invalid"),
+	})
+	writePickleFrame(t, &buf, []interface{}{
+		stalecucumber.NewTuple("foo.bar.baz",
+			stalecucumber.NewTuple(int64(1428951396), "1.5")),
+	})
+
+	scanner := NewPickleScanner(&buf, testIOpts)
+
+	type result struct {
+		path  string
+		ts    time.Time
+		value float64
+	}
+	var results []result
+	for scanner.Scan() {
+		path, ts, value := scanner.Metric()
+		results = append(results, result{string(path), ts, value})
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []result{
+		{"foo.bar.zed", time.Unix(1428951394, 0), 45565.02},
+		{"foo.bar;dc=east", time.Unix(1428951395, 0), 10},
+		{"foo.bar.baz", time.Unix(1428951396, 0), 1.5},
+	}, results)
+	assert.Equal(t, 1, scanner.MalformedCount)
+}
+
+func TestPickleScannerFrameTooLarge(t *testing.T) {
+	var header [pickleHeaderLength]byte
+	binary.BigEndian.PutUint32(header[:], MaxPickleFrameSize+1)
+
+	scanner := NewPickleScanner(bytes.NewReader(header[:]), testIOpts)
+	require.False(t, scanner.Scan())
+	require.Error(t, scanner.Err())
+}
+
+func TestPickleScannerMalformedFrame(t *testing.T) {
+	var buf bytes.Buffer
+	var header [pickleHeaderLength]byte
+	binary.BigEndian.PutUint32(header[:], 3)
+	buf.Write(header[:])
+	buf.Write([]byte("bad"))
+	writePickleFrame(t, &buf, []interface{}{
+		stalecucumber.NewTuple("foo", stalecucumber.NewTuple(int64(1), 2.0)),
+	})
+
+	scanner := NewPickleScanner(&buf, testIOpts)
+	require.True(t, scanner.Scan())
+	path, _, value := scanner.Metric()
+	assert.Equal(t, "foo", string(path))
+	assert.Equal(t, 2.0, value)
+	require.False(t, scanner.Scan())
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, 1, scanner.MalformedCount)
+}
@@ -165,6 +165,8 @@ func testLocalType(t *testing.T, providedType string, placementExists bool) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -341,6 +343,8 @@ func TestLocalTypeWithNumShards(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -470,6 +474,8 @@ func TestLocalWithBlockSizeNanos(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -604,6 +610,8 @@ func TestLocalWithBlockSizeExpectedSeriesDatapointsPerHour(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -868,6 +876,8 @@ func testClusterTypeHosts(t *testing.T, placementExists bool) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -1025,6 +1035,8 @@ func TestClusterTypeHostsWithIsolationGroup(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -1244,6 +1256,8 @@ func TestLocalTypeWithAggregatedNamespace(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -1286,6 +1300,8 @@ func TestLocalTypeWithAggregatedNamespace(t *testing.T) {
 						"bootstrapEnabled": true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled": true,
+						"tierAfterNanos": "0",
+						"writeDurability": "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"cleanupEnabled": true,
 						"repairEnabled": false,
@@ -22,7 +22,6 @@ package graphite
 
 import (
 	"fmt"
-	"math"
 	"net/http"
 	"sort"
 	"sync"
@@ -167,16 +166,10 @@ func (h *renderHandler) serveHTTP(
 				return
 			}
 
+			// Consolidate each series using its consolidateBy function to
+			// honor maxDataPoints.
 			for i, s := range targetSeries.Values {
-				if s.Len() <= int(p.MaxDataPoints) {
-					continue
-				}
-
-				var (
-					samplingMultiplier = math.Ceil(float64(s.Len()) / float64(p.MaxDataPoints))
-					newMillisPerStep   = int(samplingMultiplier * float64(s.MillisPerStep()))
-				)
-				targetSeries.Values[i] = ts.LTTB(s, s.StartTime(), s.EndTime(), newMillisPerStep)
+				targetSeries.Values[i] = s.ConsolidateToMaxDataPoints(int(p.MaxDataPoints))
 			}
 
 			mu.Lock()
@@ -224,5 +217,6 @@ func (h *renderHandler) serveHTTP(
 
 	return WriteRenderResponse(w, response, p.Format, renderResultsJSONOptions{
 		renderSeriesAllNaNs: h.graphiteOpts.RenderSeriesAllNaNs,
+		noNullPoints:        p.NoNullPoints,
 	})
 }
@@ -22,12 +22,14 @@ package graphite
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/query/api/v1/handler/graphite/pickle"
@@ -38,6 +40,8 @@ import (
 	"github.com/m3db/m3/src/query/util/json"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"gopkg.in/vmihailenco/msgpack.v2"
 )
 
 const (
@@ -45,6 +49,10 @@ const (
 	queryRangeShiftThreshold = 55 * time.Minute
 	queryRangeShift          = 15 * time.Second
 	pickleFormat             = "pickle"
+	csvFormat                = "csv"
+	rawFormat                = "raw"
+	msgpackFormat            = "msgpack"
+	csvTimeFormat            = "2006-01-02 15:04:05"
 )
 
 var (
@@ -59,12 +67,22 @@ func WriteRenderResponse(
 	format string,
 	opts renderResultsJSONOptions,
 ) error {
-	if format == pickleFormat {
+	switch format {
+	case pickleFormat:
 		w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeOctetStream)
 		return renderResultsPickle(w, series.Values)
+	case csvFormat:
+		w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeCSV)
+		return renderResultsCSV(w, series.Values)
+	case rawFormat:
+		w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypePlainTextUTF8)
+		return renderResultsRaw(w, series.Values)
+	case msgpackFormat:
+		w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeMsgpack)
+		return renderResultsMsgpack(w, series.Values)
 	}
 
-	// NB: return json unless requesting specifically `pickleFormat`
+	// NB: return json unless requesting specifically one of the other formats.
 	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
 	return renderResultsJSON(w, series.Values, opts)
 }
@@ -83,6 +101,7 @@ type RenderRequest struct {
 	MaxDataPoints int64
 	Compare       time.Duration
 	Timeout       time.Duration
+	NoNullPoints  bool
 }
 
 // ParseRenderRequest parses the arguments to a render call from an incoming request.
@@ -111,6 +130,13 @@ func ParseRenderRequest(
 		return nil, p, nil, errNoTarget
 	}
 
+	p.Format = r.FormValue("format")
+	if noNullPoints := r.FormValue("noNullPoints"); len(noNullPoints) != 0 {
+		if p.NoNullPoints, err = strconv.ParseBool(noNullPoints); err != nil {
+			return nil, p, nil, xerrors.NewInvalidParamsError(fmt.Errorf("invalid 'noNullPoints': %s", noNullPoints))
+		}
+	}
+
 	fromString, untilString := r.FormValue("from"), r.FormValue("until")
 	if len(fromString) == 0 {
 		fromString = "-30min"
@@ -195,6 +221,7 @@ func ParseRenderRequest(
 
 type renderResultsJSONOptions struct {
 	renderSeriesAllNaNs bool
+	noNullPoints        bool
 }
 
 func renderResultsJSON(
@@ -205,6 +232,11 @@ func renderResultsJSON(
 	jw := json.NewWriter(w)
 	jw.BeginArray()
 	for _, s := range series {
+		if opts.noNullPoints && s.AllNaN() {
+			// Series without any points are omitted entirely.
+			continue
+		}
+
 		jw.BeginObject()
 		jw.BeginObjectField("target")
 		jw.WriteString(s.Name())
@@ -214,6 +246,10 @@ func renderResultsJSON(
 		if !s.AllNaN() || opts.renderSeriesAllNaNs {
 			for i := 0; i < s.Len(); i++ {
 				timestamp, val := s.StartTimeForStep(i), s.ValueAt(i)
+				if opts.noNullPoints && math.IsNaN(val) {
+					continue
+				}
+
 				jw.BeginArray()
 				jw.WriteFloat64(val)
 				jw.WriteInt(int(timestamp.Unix()))
@@ -263,3 +299,128 @@ func renderResultsPickle(w io.Writer, series []*ts.Series) error {
 
 	return pw.Close()
 }
+
+func renderResultsCSV(w io.Writer, series []*ts.Series) error {
+	cw := csv.NewWriter(w)
+	for _, s := range series {
+		for i := 0; i < s.Len(); i++ {
+			var (
+				timestamp = s.StartTimeForStep(i).UTC().Format(csvTimeFormat)
+				value     string
+			)
+			if v := s.ValueAt(i); !math.IsNaN(v) {
+				value = strconv.FormatFloat(v, 'f', -1, 64)
+			}
+
+			if err := cw.Write([]string{s.Name(), timestamp, value}); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func renderResultsRaw(w io.Writer, series []*ts.Series) error {
+	var b strings.Builder
+	for _, s := range series {
+		b.Reset()
+		b.WriteString(s.Name())
+		b.WriteByte(',')
+		b.WriteString(strconv.FormatInt(s.StartTime().Unix(), 10))
+		b.WriteByte(',')
+		b.WriteString(strconv.FormatInt(s.EndTime().Unix(), 10))
+		b.WriteByte(',')
+		b.WriteString(strconv.Itoa(s.MillisPerStep() / 1000))
+		b.WriteByte('|')
+		for i := 0; i < s.Len(); i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			if v := s.ValueAt(i); math.IsNaN(v) {
+				b.WriteString("None")
+			} else {
+				b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+			}
+		}
+		b.WriteByte('\n')
+
+		if _, err := io.WriteString(w, b.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderResultsMsgpack(w io.Writer, series []*ts.Series) error {
+	enc := msgpack.NewEncoder(w)
+	if err := enc.EncodeArrayLen(len(series)); err != nil {
+		return err
+	}
+
+	for _, s := range series {
+		if err := renderSeriesMsgpack(enc, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderSeriesMsgpack(enc *msgpack.Encoder, s *ts.Series) error {
+	// Series are encoded the same as with the pickle format.
+	if err := enc.EncodeMapLen(5); err != nil {
+		return err
+	}
+
+	if err := enc.EncodeString("name"); err != nil {
+		return err
+	}
+	if err := enc.EncodeString(s.Name()); err != nil {
+		return err
+	}
+
+	if err := enc.EncodeString("start"); err != nil {
+		return err
+	}
+	if err := enc.EncodeInt64(s.StartTime().UTC().Unix()); err != nil {
+		return err
+	}
+
+	if err := enc.EncodeString("end"); err != nil {
+		return err
+	}
+	if err := enc.EncodeInt64(s.EndTime().UTC().Unix()); err != nil {
+		return err
+	}
+
+	if err := enc.EncodeString("step"); err != nil {
+		return err
+	}
+	if err := enc.EncodeInt64(int64(s.MillisPerStep() / 1000)); err != nil {
+		return err
+	}
+
+	if err := enc.EncodeString("values"); err != nil {
+		return err
+	}
+	if err := enc.EncodeArrayLen(s.Len()); err != nil {
+		return err
+	}
+	for i := 0; i < s.Len(); i++ {
+		var err error
+		if v := s.ValueAt(i); math.IsNaN(v) {
+			err = enc.EncodeNil()
+		} else {
+			err = enc.EncodeFloat64(v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -32,19 +32,23 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/block"
+	graphiteContext "github.com/m3db/m3/src/query/graphite/context"
 	"github.com/m3db/m3/src/query/graphite/graphite"
 	graphiteStorage "github.com/m3db/m3/src/query/graphite/storage"
+	graphiteTS "github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/mock"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/x/headers"
+	xhttp "github.com/m3db/m3/src/x/net/http"
 	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/vmihailenco/msgpack.v2"
 )
 
 func testHandlerOptions(t *testing.T) options.HandlerOptions {
@@ -392,6 +396,109 @@ func TestParseQueryResultsAllNaN(t *testing.T) {
 	require.Equal(t, expected, string(buf))
 }
 
+func TestParseQueryResultsNoNullPoints(t *testing.T) {
+	resolution := 10 * time.Second
+	truncateStart := xtime.Now().Add(-30 * time.Minute).Truncate(resolution)
+	start := truncateStart.Add(time.Second)
+	vals := ts.NewFixedStepValues(resolution, 3, 3, start)
+	seriesList := ts.SeriesList{
+		ts.NewSeries([]byte("series_name"), vals, models.NewTags(0, nil)),
+		ts.NewSeries([]byte("all_nan"),
+			ts.NewFixedStepValues(resolution, 3, math.NaN(), start),
+			models.NewTags(0, nil)),
+	}
+
+	meta := block.NewResultMetadata()
+	meta.Resolutions = []time.Duration{resolution, resolution}
+	fr := &storage.FetchResult{
+		SeriesList: seriesList,
+		Metadata:   meta,
+	}
+
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	blockResult := makeBlockResult(ctrl, fr)
+	store.EXPECT().FetchBlocks(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(blockResult, nil)
+
+	opts := testHandlerOptions(t).SetStorage(store)
+	handler := NewRenderHandler(opts)
+
+	req := newGraphiteReadHTTPRequest(t)
+	req.URL.RawQuery = fmt.Sprintf("target=foo.bar&from=%d&until=%d&noNullPoints=true",
+		start.Seconds(), start.Seconds()+30)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	res := recorder.Result()
+	assert.Equal(t, 200, res.StatusCode)
+
+	buf, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	exTimestamp := truncateStart.Seconds() + 10
+	expected := fmt.Sprintf(
+		`[{"target":"series_name","datapoints":[[3.000000,%d],`+
+			`[3.000000,%d]],"step_size_ms":%d}]`,
+		exTimestamp, exTimestamp+10, resolution/time.Millisecond)
+
+	require.Equal(t, expected, string(buf))
+}
+
+func TestWriteRenderResponseFormats(t *testing.T) {
+	ctx := graphiteContext.New()
+	defer ctx.Close()
+
+	vals := graphiteTS.NewValues(ctx, 10000, 3)
+	vals.SetValueAt(0, 1)
+	vals.SetValueAt(1, math.NaN())
+	vals.SetValueAt(2, 2.5)
+	series := graphiteTS.SeriesList{
+		Values: []*graphiteTS.Series{
+			graphiteTS.NewSeries(ctx, "foo.bar", time.Unix(1000, 0), vals),
+		},
+	}
+
+	render := func(format string) (string, []byte) {
+		recorder := httptest.NewRecorder()
+		err := WriteRenderResponse(recorder, series, format, renderResultsJSONOptions{})
+		require.NoError(t, err)
+		return recorder.Header().Get(xhttp.HeaderContentType), recorder.Body.Bytes()
+	}
+
+	contentType, body := render("csv")
+	assert.Equal(t, xhttp.ContentTypeCSV, contentType)
+	assert.Equal(t, "foo.bar,1970-01-01 00:16:40,1\n"+
+		"foo.bar,1970-01-01 00:16:50,\n"+
+		"foo.bar,1970-01-01 00:17:00,2.5\n", string(body))
+
+	contentType, body = render("raw")
+	assert.Equal(t, xhttp.ContentTypePlainTextUTF8, contentType)
+	assert.Equal(t, "foo.bar,1000,1030,10|1,None,2.5\n", string(body))
+
+	contentType, body = render("msgpack")
+	assert.Equal(t, xhttp.ContentTypeMsgpack, contentType)
+
+	var decoded []struct {
+		Name   string        `msgpack:"name"`
+		Start  int64         `msgpack:"start"`
+		End    int64         `msgpack:"end"`
+		Step   int64         `msgpack:"step"`
+		Values []interface{} `msgpack:"values"`
+	}
+	require.NoError(t, msgpack.Unmarshal(body, &decoded))
+	require.Equal(t, 1, len(decoded))
+	assert.Equal(t, "foo.bar", decoded[0].Name)
+	assert.Equal(t, int64(1000), decoded[0].Start)
+	assert.Equal(t, int64(1030), decoded[0].End)
+	assert.Equal(t, int64(10), decoded[0].Step)
+	assert.Equal(t, []interface{}{1.0, nil, 2.5}, decoded[0].Values)
+
+	contentType, _ = render("unknown")
+	assert.Equal(t, xhttp.ContentTypeJSON, contentType)
+}
+
 func newGraphiteReadHTTPRequest(t *testing.T) *http.Request {
 	req, err := http.NewRequest(ReadHTTPMethods[0], ReadURL, nil)
 	require.NoError(t, err)
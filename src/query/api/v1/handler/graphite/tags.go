@@ -0,0 +1,440 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/graphite/graphite"
+	graphitestorage "github.com/m3db/m3/src/query/graphite/storage"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/util/json"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	// TagsURL is the url for listing graphite tags.
+	TagsURL = handler.RoutePrefixV1 + "/graphite/tags"
+
+	// TagValuesURL is the url for listing the values of a graphite tag.
+	TagValuesURL = TagsURL + "/{" + tagNameVar + "}"
+
+	// TagsAutoCompleteTagsURL is the url for auto completing graphite tags.
+	TagsAutoCompleteTagsURL = TagsURL + "/autoComplete/tags"
+
+	// TagsAutoCompleteValuesURL is the url for auto completing graphite tag
+	// values.
+	TagsAutoCompleteValuesURL = TagsURL + "/autoComplete/values"
+
+	tagNameVar = "tag"
+
+	// defaultAutoCompleteLimit matches the default graphite-web auto
+	// complete limit.
+	defaultAutoCompleteLimit = 100
+)
+
+// TagsHTTPMethods are the HTTP methods for the tags handlers.
+var TagsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+type tagsRequestType uint
+
+const (
+	tagsRequest tagsRequestType = iota
+	tagValuesRequest
+	autoCompleteTagsRequest
+	autoCompleteValuesRequest
+)
+
+type graphiteTagsHandler struct {
+	storage             graphitestorage.Storage
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	instrumentOpts      instrument.Options
+	requestType         tagsRequestType
+}
+
+// NewTagsHandler returns a new handler listing graphite tags.
+func NewTagsHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, tagsRequest)
+}
+
+// NewTagValuesHandler returns a new handler listing the values of a
+// graphite tag.
+func NewTagValuesHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, tagValuesRequest)
+}
+
+// NewTagsAutoCompleteTagsHandler returns a new handler auto completing
+// graphite tags.
+func NewTagsAutoCompleteTagsHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, autoCompleteTagsRequest)
+}
+
+// NewTagsAutoCompleteValuesHandler returns a new handler auto completing
+// graphite tag values.
+func NewTagsAutoCompleteValuesHandler(opts options.HandlerOptions) http.Handler {
+	return newTagsHandler(opts, autoCompleteValuesRequest)
+}
+
+func newTagsHandler(
+	opts options.HandlerOptions,
+	requestType tagsRequestType,
+) http.Handler {
+	wrappedStore := graphitestorage.NewM3WrappedStorage(opts.Storage(),
+		opts.M3DBOptions(), opts.InstrumentOpts(), opts.GraphiteStorageOptions())
+	return &graphiteTagsHandler{
+		storage:             wrappedStore,
+		fetchOptionsBuilder: opts.GraphiteFindFetchOptionsBuilder(),
+		instrumentOpts:      opts.InstrumentOpts(),
+		requestType:         requestType,
+	}
+}
+
+// tagsQuery is a parsed graphite tags request.
+type tagsQuery struct {
+	// tag is the tag to list values of, empty when listing tags.
+	tag      string
+	matchers models.Matchers
+	filter   func(string) bool
+	limit    int
+	start    xtime.UnixNano
+	end      xtime.UnixNano
+}
+
+func (h *graphiteTagsHandler) ServeHTTP(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx, opts, err := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	logger := logging.WithContext(ctx, h.instrumentOpts)
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+
+	query, err := h.parseTagsQuery(r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var (
+		results []string
+		meta    block.ResultMetadata
+	)
+	switch {
+	case query.tag == "":
+		results, meta, err = h.completeTagNames(ctx, query, opts)
+	case query.tag == graphite.NameTag:
+		results, meta, err = h.completeSeriesNames(ctx, query, opts)
+	default:
+		results, meta, err = h.completeTagValues(ctx, query, opts)
+	}
+	if err != nil {
+		logger.Error("unable to complete graphite tags", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	err = handleroptions.AddDBResultResponseHeaders(w, meta, opts)
+	if err != nil {
+		logger.Error("unable to render tags header", zap.Error(err))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	results = filterTagResults(results, query.filter, query.limit)
+	switch h.requestType {
+	case tagsRequest:
+		err = tagsResultsJSON(w, results)
+	case tagValuesRequest:
+		err = tagValuesResultsJSON(w, query.tag, results)
+	default:
+		err = autoCompleteResultsJSON(w, results)
+	}
+	if err != nil {
+		logger.Error("unable to render tags results", zap.Error(err))
+	}
+}
+
+func (h *graphiteTagsHandler) parseTagsQuery(r *http.Request) (tagsQuery, error) {
+	from, until, err := parseFromUntil(r)
+	if err != nil {
+		return tagsQuery{}, err
+	}
+
+	query := tagsQuery{
+		start: xtime.ToUnixNano(from),
+		end:   xtime.ToUnixNano(until),
+	}
+
+	if limit := r.FormValue("limit"); limit != "" {
+		query.limit, err = strconv.Atoi(limit)
+		if err != nil || query.limit < 0 {
+			return tagsQuery{}, xerrors.NewInvalidParamsError(
+				fmt.Errorf("invalid 'limit': %s", limit))
+		}
+	}
+
+	switch h.requestType {
+	case tagsRequest, tagValuesRequest:
+		if h.requestType == tagValuesRequest {
+			query.tag = mux.Vars(r)[tagNameVar]
+			if query.tag == "" {
+				return tagsQuery{}, errors.ErrNoName
+			}
+		}
+
+		if filter := r.FormValue("filter"); filter != "" {
+			re, err := regexp.Compile(filter)
+			if err != nil {
+				return tagsQuery{}, xerrors.NewInvalidParamsError(
+					fmt.Errorf("invalid 'filter': %v", err))
+			}
+			query.filter = re.MatchString
+		}
+	case autoCompleteTagsRequest, autoCompleteValuesRequest:
+		prefixParam := "tagPrefix"
+		if h.requestType == autoCompleteValuesRequest {
+			prefixParam = "valuePrefix"
+			query.tag = r.FormValue("tag")
+			if query.tag == "" {
+				return tagsQuery{}, xerrors.NewInvalidParamsError(
+					fmt.Errorf("missing 'tag' parameter"))
+			}
+		}
+
+		if prefix := r.FormValue(prefixParam); prefix != "" {
+			query.filter = func(value string) bool {
+				return strings.HasPrefix(value, prefix)
+			}
+		}
+
+		if query.limit == 0 {
+			query.limit = defaultAutoCompleteLimit
+		}
+	}
+
+	// NB: graphite-web accepts expressions as either expr or expr[].
+	var exprs []string
+	exprs = append(exprs, r.Form["expr"]...)
+	exprs = append(exprs, r.Form["expr[]"]...)
+	if len(exprs) == 0 {
+		// NB: match all graphite series, see convertMetricPartToMatcher for
+		// why a regexp rather than a field matcher is used.
+		query.matchers = models.Matchers{{
+			Type:  models.MatchRegexp,
+			Name:  graphite.TagName(0),
+			Value: []byte(graphite.MatchAllPattern),
+		}}
+	} else {
+		parsed, err := graphite.ParseTagExpressions(exprs)
+		if err != nil {
+			return tagsQuery{}, xerrors.NewInvalidParamsError(err)
+		}
+		query.matchers, err = graphitestorage.TranslateTagExpressionsToMatchers(parsed)
+		if err != nil {
+			return tagsQuery{}, xerrors.NewInvalidParamsError(err)
+		}
+	}
+
+	return query, nil
+}
+
+// completeTagNames returns the tag names of matching series, with the path
+// tags of a series reported as the name tag.
+func (h *graphiteTagsHandler) completeTagNames(
+	ctx context.Context,
+	query tagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	result, err := h.storage.CompleteTags(ctx, &storage.CompleteTagsQuery{
+		CompleteNameOnly: true,
+		TagMatchers:      query.matchers,
+		Start:            query.start,
+		End:              query.end,
+	}, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{}, len(result.CompletedTags))
+	for _, tag := range result.CompletedTags {
+		name := string(tag.Name)
+		if _, ok := graphite.TagIndex(tag.Name); ok {
+			name = graphite.NameTag
+		}
+		seen[name] = struct{}{}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+// completeTagValues returns the values of a tag of matching series.
+func (h *graphiteTagsHandler) completeTagValues(
+	ctx context.Context,
+	query tagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	name := []byte(query.tag)
+	matchers := make(models.Matchers, 0, len(query.matchers)+1)
+	matchers = append(matchers, query.matchers...)
+	matchers = append(matchers, models.Matcher{
+		Type: models.MatchField,
+		Name: name,
+	})
+	result, err := h.storage.CompleteTags(ctx, &storage.CompleteTagsQuery{
+		CompleteNameOnly: false,
+		FilterNameTags:   [][]byte{name},
+		TagMatchers:      matchers,
+		Start:            query.start,
+		End:              query.end,
+	}, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{})
+	for _, tag := range result.CompletedTags {
+		if string(tag.Name) != query.tag {
+			continue
+		}
+		for _, value := range tag.Values {
+			seen[string(value)] = struct{}{}
+		}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+// completeSeriesNames returns the values of the name tag of matching series,
+// which are not indexed as a single tag so are taken from the series IDs.
+func (h *graphiteTagsHandler) completeSeriesNames(
+	ctx context.Context,
+	query tagsQuery,
+	opts *storage.FetchOptions,
+) ([]string, block.ResultMetadata, error) {
+	result, err := h.storage.SearchSeries(ctx, &storage.FetchQuery{
+		TagMatchers: query.matchers,
+		Start:       query.start.ToTime(),
+		End:         query.end.ToTime(),
+	}, opts)
+	if err != nil {
+		return nil, block.ResultMetadata{}, err
+	}
+
+	seen := make(map[string]struct{}, len(result.Metrics))
+	for _, metric := range result.Metrics {
+		tags := graphite.ParseSeriesTags(string(metric.ID))
+		seen[tags[graphite.NameTag]] = struct{}{}
+	}
+
+	return sortedKeys(seen), result.Metadata, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func filterTagResults(
+	results []string,
+	filter func(string) bool,
+	limit int,
+) []string {
+	filtered := results[:0]
+	for _, result := range results {
+		if limit > 0 && len(filtered) >= limit {
+			break
+		}
+		if filter != nil && !filter(result) {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+	return filtered
+}
+
+func tagsResultsJSON(w io.Writer, tags []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+	for _, tag := range tags {
+		jw.BeginObject()
+		jw.BeginObjectField("tag")
+		jw.WriteString(tag)
+		jw.EndObject()
+	}
+	jw.EndArray()
+	return jw.Close()
+}
+
+func tagValuesResultsJSON(w io.Writer, tag string, values []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginObject()
+	jw.BeginObjectField("tag")
+	jw.WriteString(tag)
+	jw.BeginObjectField("values")
+	jw.BeginArray()
+	for _, value := range values {
+		jw.BeginObject()
+		jw.BeginObjectField("value")
+		jw.WriteString(value)
+		jw.EndObject()
+	}
+	jw.EndArray()
+	jw.EndObject()
+	return jw.Close()
+}
+
+func autoCompleteResultsJSON(w io.Writer, results []string) error {
+	jw := json.NewWriter(w)
+	jw.BeginArray()
+	for _, result := range results {
+		jw.WriteString(result)
+	}
+	jw.EndArray()
+	return jw.Close()
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTagsHandlerOptions(t *testing.T, store storage.Storage) options.HandlerOptions {
+	builder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{
+			Timeout: 15 * time.Second,
+		})
+	require.NoError(t, err)
+	return options.EmptyHandlerOptions().
+		SetGraphiteFindFetchOptionsBuilder(builder).
+		SetStorage(store)
+}
+
+func serveTags(h http.Handler, params url.Values, vars map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, TagsURL+"?"+params.Encode(), nil)
+	if vars != nil {
+		req = mux.SetURLVars(req, vars)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestTagsHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.True(t, q.CompleteNameOnly)
+			assert.Equal(t, models.Matchers{{
+				Type:  models.MatchRegexp,
+				Name:  b("__g0__"),
+				Value: b(".*"),
+			}}, q.TagMatchers)
+			return &consolidators.CompleteTagsResult{
+				CompleteNameOnly: true,
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("__g0__")},
+					{Name: b("__g1__")},
+					{Name: b("dc")},
+					{Name: b("env")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsHandler(newTestTagsHandlerOptions(t, store))
+	w := serveTags(h, url.Values{"filter": []string{"e"}}, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"tag":"env"},{"tag":"name"}]`, w.Body.String())
+}
+
+func TestTagValuesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.False(t, q.CompleteNameOnly)
+			assert.Equal(t, [][]byte{b("dc")}, q.FilterNameTags)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("dc"), Values: bs("west", "east")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagValuesHandler(newTestTagsHandlerOptions(t, store))
+	w := serveTags(h, url.Values{}, map[string]string{tagNameVar: "dc"})
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"tag":"dc","values":[{"value":"east"},{"value":"west"}]}`,
+		w.Body.String())
+}
+
+func TestTagsAutoCompleteValuesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			q *storage.CompleteTagsQuery,
+			_ *storage.FetchOptions,
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.Equal(t, models.Matchers{
+				{Type: models.MatchEqual, Name: b("env"), Value: b("prod")},
+				{Type: models.MatchField, Name: b("dc")},
+			}, q.TagMatchers)
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("dc"), Values: bs("west", "eu", "east")},
+				},
+				Metadata: block.NewResultMetadata(),
+			}, nil
+		})
+
+	h := NewTagsAutoCompleteValuesHandler(newTestTagsHandlerOptions(t, store))
+	w := serveTags(h, url.Values{
+		"tag":         []string{"dc"},
+		"expr":        []string{"env=prod"},
+		"valuePrefix": []string{"e"},
+	}, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `["east","eu"]`, w.Body.String())
+
+	w = serveTags(h, url.Values{"expr": []string{"env=prod"}}, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTagsAutoCompleteNameValuesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().SearchSeries(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&storage.SearchResults{
+			Metrics: models.Metrics{
+				{ID: b("foo.bar;dc=east")},
+				{ID: b("foo.baz;dc=west")},
+				{ID: b("foo.bar;dc=west")},
+			},
+			Metadata: block.NewResultMetadata(),
+		}, nil)
+
+	h := NewTagsAutoCompleteValuesHandler(newTestTagsHandlerOptions(t, store))
+	w := serveTags(h, url.Values{
+		"tag":   []string{"name"},
+		"expr":  []string{"dc=~.+"},
+		"limit": []string{"1"},
+	}, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `["foo.bar"]`, w.Body.String())
+}
+
+func TestTagsAutoCompleteTagsHandlerInvalidExpr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewTagsAutoCompleteTagsHandler(
+		newTestTagsHandlerOptions(t, storage.NewMockStorage(ctrl)))
+	w := serveTags(h, url.Values{"expr": []string{"dc!=east"}}, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/metrics/policy"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var errDatabaseRequired = errors.New("database is required")
+
+// databases resolves the write options for InfluxDB databases and their
+// retention policies from the namespaces they are configured to map to.
+type databases struct {
+	names     []string
+	databases map[string]database
+}
+
+type database struct {
+	defaultRetentionPolicy string
+	retentionPolicies      []retentionPolicy
+}
+
+type retentionPolicy struct {
+	name          string
+	storagePolicy policy.StoragePolicy
+	writeOpts     ingest.WriteOptions
+}
+
+func newDatabases(cfg config.InfluxDBConfiguration) databases {
+	result := databases{
+		names:     make([]string, 0, len(cfg.Databases)),
+		databases: make(map[string]database, len(cfg.Databases)),
+	}
+	for _, db := range cfg.Databases {
+		rps := make([]retentionPolicy, 0, len(db.RetentionPolicies))
+		for _, rp := range db.RetentionPolicies {
+			storagePolicy := policy.NewStoragePolicy(rp.Resolution, xtime.Second, rp.Retention)
+			rps = append(rps, retentionPolicy{
+				name:          rp.Name,
+				storagePolicy: storagePolicy,
+				// Write directly to the namespace of the storage policy
+				// with no downsampling rules applied, the same as writes
+				// that specify a storage policy with headers.
+				writeOpts: ingest.WriteOptions{
+					DownsampleOverride:   true,
+					WriteOverride:        true,
+					WriteStoragePolicies: policy.StoragePolicies{storagePolicy},
+				},
+			})
+		}
+		result.names = append(result.names, db.Name)
+		result.databases[db.Name] = database{
+			defaultRetentionPolicy: db.DefaultRetentionPolicy,
+			retentionPolicies:      rps,
+		}
+	}
+	return result
+}
+
+// enabled returns whether any databases are configured, if not then writes
+// to any database take the default write path.
+func (d databases) enabled() bool {
+	return len(d.databases) > 0
+}
+
+func (d databases) exists(name string) bool {
+	_, ok := d.databases[name]
+	return !d.enabled() || ok
+}
+
+// writeOptions returns the write options for the database and retention
+// policy of a write.
+func (d databases) writeOptions(dbName, rpName string) (ingest.WriteOptions, error) {
+	if !d.enabled() {
+		return ingest.WriteOptions{}, nil
+	}
+	if dbName == "" {
+		return ingest.WriteOptions{}, xerrors.NewInvalidParamsError(errDatabaseRequired)
+	}
+
+	db, ok := d.databases[dbName]
+	if !ok {
+		return ingest.WriteOptions{}, xhttp.NewError(
+			fmt.Errorf("database not found: %q", dbName), http.StatusNotFound)
+	}
+
+	if rpName == "" {
+		rpName = db.defaultRetentionPolicy
+	}
+	if rpName == "" {
+		return ingest.WriteOptions{}, nil
+	}
+
+	for _, rp := range db.retentionPolicies {
+		if rp.name == rpName {
+			return rp.writeOpts, nil
+		}
+	}
+	return ingest.WriteOptions{}, xerrors.NewInvalidParamsError(
+		fmt.Errorf("retention policy not found: %s", rpName))
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// InfluxQueryURL is the Influx DB query handler URL, it only answers the
+	// statements clients such as Telegraf issue to discover and create
+	// databases.
+	InfluxQueryURL = handler.RoutePrefixV1 + "/influxdb/query"
+
+	// InfluxPingURL is the Influx DB ping handler URL.
+	InfluxPingURL = handler.RoutePrefixV1 + "/influxdb/ping"
+
+	queryParam = "q"
+
+	influxVersionHeader = "X-Influxdb-Version"
+	influxVersion       = "1.8-m3"
+)
+
+var (
+	errMissingQuery = errors.New(`missing required parameter "q"`)
+
+	createDatabaseRegexp = regexp.MustCompile(
+		`(?i)^CREATE\s+DATABASE\s+("(?:[^"\\]|\\.)+"|\w+)(\s+WITH\s+.*)?$`)
+	showDatabasesRegexp         = regexp.MustCompile(`(?i)^SHOW\s+DATABASES$`)
+	showRetentionPoliciesRegexp = regexp.MustCompile(
+		`(?i)^SHOW\s+RETENTION\s+POLICIES(?:\s+ON\s+("(?:[^"\\]|\\.)+"|\w+))?$`)
+)
+
+type queryResponse struct {
+	Results []queryResult `json:"results"`
+}
+
+type queryResult struct {
+	StatementID int           `json:"statement_id"`
+	Series      []querySeries `json:"series,omitempty"`
+	Err         string        `json:"error,omitempty"`
+}
+
+type querySeries struct {
+	Name    string          `json:"name"`
+	Columns []string        `json:"columns"`
+	Values  [][]interface{} `json:"values,omitempty"`
+}
+
+type queryHandler struct {
+	handlerOpts options.HandlerOptions
+	databases   databases
+}
+
+// NewInfluxQueryHandler returns a new influx query handler which supports
+// the database management statements that clients issue before writing.
+func NewInfluxQueryHandler(options options.HandlerOptions) http.Handler {
+	return &queryHandler{
+		handlerOpts: options,
+		databases:   newDatabases(options.Config().InfluxDB),
+	}
+}
+
+func (h *queryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := strings.TrimSpace(r.FormValue(queryParam))
+	if q == "" {
+		writeInfluxError(w, xerrors.NewInvalidParamsError(errMissingQuery))
+		return
+	}
+
+	var (
+		db   = r.FormValue(databaseParam)
+		resp = queryResponse{Results: []queryResult{}}
+	)
+	for _, stmt := range strings.Split(q, ";") {
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		result := h.execute(stmt, db)
+		result.StatementID = len(resp.Results)
+		resp.Results = append(resp.Results, result)
+	}
+
+	xhttp.WriteJSONResponse(w, resp, h.handlerOpts.InstrumentOpts().Logger())
+}
+
+func (h *queryHandler) execute(stmt, db string) queryResult {
+	if m := createDatabaseRegexp.FindStringSubmatch(stmt); m != nil {
+		// Databases are configured statically so creating one succeeds only
+		// if it already exists.
+		if name := unquoteIdent(m[1]); !h.databases.exists(name) {
+			return queryResult{Err: fmt.Sprintf(
+				"database %q is not configured and cannot be created", name)}
+		}
+		return queryResult{}
+	}
+
+	if showDatabasesRegexp.MatchString(stmt) {
+		values := make([][]interface{}, 0, len(h.databases.names))
+		for _, name := range h.databases.names {
+			values = append(values, []interface{}{name})
+		}
+		return queryResult{Series: []querySeries{{
+			Name:    "databases",
+			Columns: []string{"name"},
+			Values:  values,
+		}}}
+	}
+
+	if m := showRetentionPoliciesRegexp.FindStringSubmatch(stmt); m != nil {
+		if m[1] != "" {
+			db = unquoteIdent(m[1])
+		}
+		return h.showRetentionPolicies(db)
+	}
+
+	h.handlerOpts.InstrumentOpts().Logger().Debug("unsupported influx statement",
+		zap.String("statement", stmt))
+	return queryResult{Err: fmt.Sprintf("statement is not supported: %s", stmt)}
+}
+
+func (h *queryHandler) showRetentionPolicies(dbName string) queryResult {
+	if dbName == "" {
+		return queryResult{Err: errDatabaseRequired.Error()}
+	}
+
+	db, ok := h.databases.databases[dbName]
+	if !ok {
+		return queryResult{Err: fmt.Sprintf("database not found: %s", dbName)}
+	}
+
+	values := make([][]interface{}, 0, len(db.retentionPolicies))
+	for _, rp := range db.retentionPolicies {
+		values = append(values, []interface{}{
+			rp.name,
+			rp.storagePolicy.Retention().Duration().String(),
+			rp.storagePolicy.Resolution().Window.String(),
+			1,
+			rp.name == db.defaultRetentionPolicy,
+		})
+	}
+	return queryResult{Series: []querySeries{{
+		Columns: []string{"name", "duration", "shardGroupDuration", "replicaN", "default"},
+		Values:  values,
+	}}}
+}
+
+// unquoteIdent returns the identifier with its double quotes and escapes
+// removed.
+func unquoteIdent(ident string) string {
+	if len(ident) < 2 || ident[0] != '"' {
+		return ident
+	}
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(ident[1 : len(ident)-1])
+}
+
+type pingHandler struct{}
+
+// NewInfluxPingHandler returns a new influx ping handler which clients use
+// to check the server is up.
+func NewInfluxPingHandler() http.Handler {
+	return pingHandler{}
+}
+
+func (pingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(influxVersionHeader, influxVersion)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package influxdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/query/api/v1/options"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueryHandler(cfg config.InfluxDBConfiguration) http.Handler {
+	return NewInfluxQueryHandler(options.EmptyHandlerOptions().
+		SetConfig(config.Configuration{InfluxDB: cfg}))
+}
+
+func runQuery(t *testing.T, h http.Handler, params url.Values) string {
+	req := httptest.NewRequest(http.MethodPost, InfluxQueryURL,
+		strings.NewReader(params.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	writer := httptest.NewRecorder()
+	h.ServeHTTP(writer, req)
+	require.Equal(t, http.StatusOK, writer.Code)
+	return writer.Body.String()
+}
+
+func TestQueryCreateDatabase(t *testing.T) {
+	h := newTestQueryHandler(testInfluxDBConfig)
+	assert.JSONEq(t, `{"results":[{"statement_id":0}]}`,
+		runQuery(t, h, url.Values{queryParam: {`CREATE DATABASE "telegraf"`}}))
+	assert.JSONEq(t,
+		`{"results":[{"statement_id":0,"error":"database \"other\" is not configured and cannot be created"}]}`,
+		runQuery(t, h, url.Values{queryParam: {"create database other"}}))
+
+	// Any database can be created when none are configured.
+	h = newTestQueryHandler(config.InfluxDBConfiguration{})
+	assert.JSONEq(t, `{"results":[{"statement_id":0}]}`,
+		runQuery(t, h, url.Values{queryParam: {"CREATE DATABASE other WITH DURATION 1d"}}))
+}
+
+func TestQueryShowDatabases(t *testing.T) {
+	h := newTestQueryHandler(testInfluxDBConfig)
+	assert.JSONEq(t, `{"results":[{"statement_id":0,"series":[
+		{"name":"databases","columns":["name"],"values":[["telegraf"],["raw"]]}
+	]}]}`, runQuery(t, h, url.Values{queryParam: {"SHOW DATABASES"}}))
+}
+
+func TestQueryShowRetentionPolicies(t *testing.T) {
+	h := newTestQueryHandler(testInfluxDBConfig)
+	expected := `{"results":[{"statement_id":0,"series":[{
+		"name":"",
+		"columns":["name","duration","shardGroupDuration","replicaN","default"],
+		"values":[["1m","720h0m0s","1m0s",1,true],["1h","8760h0m0s","1h0m0s",1,false]]
+	}]}]}`
+	assert.JSONEq(t, expected,
+		runQuery(t, h, url.Values{queryParam: {"SHOW RETENTION POLICIES ON telegraf"}}))
+	assert.JSONEq(t, expected,
+		runQuery(t, h, url.Values{queryParam: {"SHOW RETENTION POLICIES"}, databaseParam: {"telegraf"}}))
+	assert.JSONEq(t, `{"results":[{"statement_id":0,"error":"database not found: unknown"}]}`,
+		runQuery(t, h, url.Values{queryParam: {"SHOW RETENTION POLICIES ON unknown"}}))
+}
+
+func TestQueryMultipleStatements(t *testing.T) {
+	h := newTestQueryHandler(testInfluxDBConfig)
+	assert.JSONEq(t, `{"results":[
+		{"statement_id":0},
+		{"statement_id":1,"error":"statement is not supported: SELECT * FROM cpu"}
+	]}`, runQuery(t, h, url.Values{queryParam: {"CREATE DATABASE raw; SELECT * FROM cpu"}}))
+}
+
+func TestQueryMissingStatement(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, InfluxQueryURL, nil)
+	writer := httptest.NewRecorder()
+	newTestQueryHandler(testInfluxDBConfig).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+}
+
+func TestPing(t *testing.T) {
+	req := httptest.NewRequest(http.MethodHead, InfluxPingURL, nil)
+	writer := httptest.NewRecorder()
+	NewInfluxPingHandler().ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusNoContent, writer.Code)
+	assert.Equal(t, influxVersion, writer.Header().Get(influxVersionHeader))
+}
@@ -22,10 +22,13 @@ package influxdb
 
 import (
 	"bytes"
+	"compress/gzip"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
@@ -49,6 +52,16 @@ const (
 
 	// InfluxWriteHTTPMethod is the HTTP method used with this resource
 	InfluxWriteHTTPMethod = http.MethodPost
+
+	databaseParam        = "db"
+	retentionPolicyParam = "rp"
+	precisionParam       = "precision"
+
+	gzipEncoding = "gzip"
+
+	// influxErrorHeader is the header Influx clients read write errors from.
+	influxErrorHeader    = "X-Influxdb-Error"
+	maxInfluxErrorHeader = 1024
 )
 
 var defaultValue = ingest.IterValue{
@@ -61,6 +74,7 @@ type ingestWriteHandler struct {
 	handlerOpts  options.HandlerOptions
 	tagOpts      models.TagOptions
 	promRewriter *promRewriter
+	databases    databases
 }
 
 type ingestField struct {
@@ -278,34 +292,57 @@ func (ii *ingestIterator) CurrentMetadata() ts.Metadata {
 func NewInfluxWriterHandler(options options.HandlerOptions) http.Handler {
 	return &ingestWriteHandler{handlerOpts: options,
 		tagOpts:      options.TagOptions(),
-		promRewriter: newPromRewriter()}
+		promRewriter: newPromRewriter(),
+		databases:    newDatabases(options.Config().InfluxDB)}
 }
 
 func (iwh *ingestWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	bytes, err := ioutil.ReadAll(r.Body)
+	query := r.URL.Query()
+	precision, err := parsePrecision(query.Get(precisionParam))
+	if err != nil {
+		writeInfluxError(w, xerrors.NewInvalidParamsError(err))
+		return
+	}
+
+	opts, err := iwh.databases.writeOptions(query.Get(databaseParam),
+		query.Get(retentionPolicyParam))
 	if err != nil {
-		xhttp.WriteError(w, err)
+		writeInfluxError(w, err)
 		return
 	}
-	points, err := imodels.ParsePoints(bytes)
+
+	body, err := readBody(r)
 	if err != nil {
-		xhttp.WriteError(w, err)
+		writeInfluxError(w, err)
+		return
+	}
+
+	// Lines that fail to parse are reported once the points that did parse
+	// have been written.
+	points, parseErr := imodels.ParsePointsWithPrecision(body, time.Now().UTC(), precision)
+	if parseErr != nil && len(points) == 0 {
+		writeInfluxError(w, xerrors.NewInvalidParamsError(parseErr))
 		return
 	}
-	opts := ingest.WriteOptions{}
+
 	iter := &ingestIterator{points: points, tagOpts: iwh.tagOpts, promRewriter: iwh.promRewriter}
 	batchErr := iwh.handlerOpts.DownsamplerAndWriter().WriteBatch(r.Context(), iter, opts)
-	if batchErr == nil {
+	if batchErr == nil && parseErr == nil {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
+
 	var (
-		errs              = batchErr.Errors()
+		errs              []error
 		lastRegularErr    string
 		lastBadRequestErr string
 		numRegular        int
 		numBadRequest     int
+		numParseErrors    int
 	)
+	if batchErr != nil {
+		errs = batchErr.Errors()
+	}
 	for _, err := range errs {
 		switch {
 		case client.IsBadRequestError(err):
@@ -319,10 +356,14 @@ func (iwh *ingestWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 			lastRegularErr = err.Error()
 		}
 	}
+	if parseErr != nil {
+		// Each line that failed to parse is reported on its own line.
+		numParseErrors = strings.Count(parseErr.Error(), "\n") + 1
+	}
 
 	var status int
 	switch {
-	case numBadRequest == len(errs):
+	case numRegular == 0:
 		status = http.StatusBadRequest
 	default:
 		status = http.StatusInternalServerError
@@ -334,21 +375,69 @@ func (iwh *ingestWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		zap.Int("httpResponseStatusCode", status),
 		zap.Int("numRegularErrors", numRegular),
 		zap.Int("numBadRequestErrors", numBadRequest),
+		zap.Int("numParseErrors", numParseErrors),
 		zap.String("lastRegularError", lastRegularErr),
 		zap.String("lastBadRequestErr", lastBadRequestErr))
 
-	var resultErr string
+	var reasons []string
 	if lastRegularErr != "" {
-		resultErr = fmt.Sprintf("retryable_errors: count=%d, last=%s",
-			numRegular, lastRegularErr)
+		reasons = append(reasons, fmt.Sprintf("retryable_errors: count=%d, last=%s",
+			numRegular, lastRegularErr))
 	}
 	if lastBadRequestErr != "" {
-		var sep string
-		if lastRegularErr != "" {
-			sep = ", "
+		reasons = append(reasons, fmt.Sprintf("bad_request_errors: count=%d, last=%s",
+			numBadRequest, lastBadRequestErr))
+	}
+	if parseErr != nil {
+		reasons = append(reasons, parseErr.Error())
+	}
+
+	// Report the failures in the same format as InfluxDB partial writes so
+	// that clients such as Telegraf drop the failed lines instead of
+	// retrying the whole batch, unless some of the failures are retryable.
+	resultErr := strings.Join(reasons, ", ")
+	if status == http.StatusBadRequest {
+		resultErr = fmt.Sprintf("partial write: %s dropped=%d",
+			resultErr, numBadRequest+numParseErrors)
+	}
+	writeInfluxError(w, xhttp.NewError(errors.New(resultErr), status))
+}
+
+// parsePrecision returns the precision of the line protocol timestamps in
+// the form expected by the line protocol parser.
+func parsePrecision(precision string) (string, error) {
+	switch precision {
+	case "", "n", "ns":
+		return "n", nil
+	case "u", "us", "µ":
+		return "u", nil
+	case "ms", "s", "m", "h":
+		return precision, nil
+	default:
+		return "", fmt.Errorf("invalid precision %q (use n, u, ms, s, m or h)", precision)
+	}
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	var body io.Reader = r.Body
+	if r.Header.Get(xhttp.HeaderContentEncoding) == gzipEncoding {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xerrors.NewInvalidParamsError(err)
 		}
-		resultErr = fmt.Sprintf("%s%sbad_request_errors: count=%d, last=%s",
-			resultErr, sep, numBadRequest, lastBadRequestErr)
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+	return ioutil.ReadAll(body)
+}
+
+// writeInfluxError writes the error in the JSON error format of InfluxDB,
+// also setting the error header that clients log.
+func writeInfluxError(w http.ResponseWriter, err error) {
+	msg := err.Error()
+	if len(msg) > maxInfluxErrorHeader {
+		msg = msg[:maxInfluxErrorHeader]
 	}
-	xhttp.WriteError(w, xhttp.NewError(errors.New(resultErr), status))
+	w.Header().Set(influxErrorHeader, msg)
+	xhttp.WriteError(w, err)
 }
@@ -21,12 +21,29 @@
 package influxdb
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	imodels "github.com/influxdata/influxdb/models"
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/metrics/policy"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
 	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -127,3 +144,239 @@ func TestDetermineTimeUnit(t *testing.T) {
 	assert.Equal(t, determineTimeUnit(zerot.Add(4*time.Nanosecond)), xtime.Nanosecond)
 
 }
+
+var testInfluxDBConfig = config.InfluxDBConfiguration{
+	Databases: []config.InfluxDBDatabaseConfiguration{
+		{
+			Name:                   "telegraf",
+			DefaultRetentionPolicy: "1m",
+			RetentionPolicies: []config.InfluxDBRetentionPolicyConfiguration{
+				{Name: "1m", Resolution: time.Minute, Retention: 720 * time.Hour},
+				{Name: "1h", Resolution: time.Hour, Retention: 8760 * time.Hour},
+			},
+		},
+		{Name: "raw"},
+	},
+}
+
+func newTestWriteHandler(
+	ds ingest.DownsamplerAndWriter,
+	cfg config.InfluxDBConfiguration,
+) http.Handler {
+	return NewInfluxWriterHandler(options.EmptyHandlerOptions().
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions()).
+		SetConfig(config.Configuration{InfluxDB: cfg}))
+}
+
+func collectDatapoints(t *testing.T, iter ingest.DownsampleAndWriteIter) []string {
+	var result []string
+	for iter.Next() {
+		value := iter.Current()
+		require.Equal(t, 1, len(value.Datapoints))
+		result = append(result, fmt.Sprintf("%s %v %d", value.Tags.String(),
+			value.Datapoints[0].Value, int64(value.Datapoints[0].Timestamp)))
+	}
+	require.NoError(t, iter.Error())
+	return result
+}
+
+func TestWritePrecision(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		precision string
+		timestamp string
+	}{
+		{precision: "", timestamp: "1574838670000000000"},
+		{precision: "ns", timestamp: "1574838670000000000"},
+		{precision: "us", timestamp: "1574838670000000"},
+		{precision: "ms", timestamp: "1574838670000"},
+		{precision: "s", timestamp: "1574838670"},
+	}
+	for _, tt := range tests {
+		ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+		ds.EXPECT().
+			WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context,
+				iter ingest.DownsampleAndWriteIter,
+				_ ingest.WriteOptions,
+			) ingest.BatchError {
+				assert.Equal(t, []string{
+					"__name__: cpu_value, host: a 1 1574838670000000000",
+				}, collectDatapoints(t, iter), tt.precision)
+				return nil
+			})
+
+		req := httptest.NewRequest(InfluxWriteHTTPMethod,
+			InfluxWriteURL+"?precision="+tt.precision,
+			strings.NewReader("cpu,host=a value=1 "+tt.timestamp))
+		writer := httptest.NewRecorder()
+		newTestWriteHandler(ds, config.InfluxDBConfiguration{}).ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusNoContent, writer.Code, tt.precision)
+	}
+}
+
+func TestWriteInvalidPrecision(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL+"?precision=d",
+		strings.NewReader("cpu,host=a value=1 1574838670"))
+	writer := httptest.NewRecorder()
+	newTestWriteHandler(ds, config.InfluxDBConfiguration{}).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+	assert.NotEmpty(t, writer.Header().Get(influxErrorHeader))
+}
+
+func TestWriteGzip(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			assert.Equal(t, 2, len(collectDatapoints(t, iter)))
+			return nil
+		})
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte("cpu,host=a value=1 1574838670000000000\n" +
+		"cpu,host=b value=2 1574838670000000000\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL, &buf)
+	req.Header.Set(xhttp.HeaderContentEncoding, "gzip")
+	writer := httptest.NewRecorder()
+	newTestWriteHandler(ds, config.InfluxDBConfiguration{}).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusNoContent, writer.Code)
+}
+
+func TestWritePartialParseFailure(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			assert.Equal(t, []string{
+				"__name__: cpu_value, host: a 1 1574838670000000000",
+			}, collectDatapoints(t, iter))
+			return nil
+		})
+
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL,
+		strings.NewReader("cpu,host=a value=1 1574838670000000000\n"+
+			"cpu,host=b value= 1574838670000000000\n"))
+	writer := httptest.NewRecorder()
+	newTestWriteHandler(ds, config.InfluxDBConfiguration{}).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+	assert.Contains(t, writer.Body.String(), "partial write")
+	assert.Contains(t, writer.Body.String(), "dropped=1")
+	assert.Contains(t, writer.Header().Get(influxErrorHeader), "partial write")
+}
+
+func TestWriteRetryableFailure(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	multiErr := xerrors.NewMultiError().
+		Add(errors.New("timed out")).
+		Add(xerrors.NewInvalidParamsError(errors.New("bad tag")))
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(ingest.BatchError(multiErr))
+
+	req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL,
+		strings.NewReader("cpu,host=a value=1,other=2 1574838670000000000"))
+	writer := httptest.NewRecorder()
+	newTestWriteHandler(ds, config.InfluxDBConfiguration{}).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusInternalServerError, writer.Code)
+	assert.NotContains(t, writer.Body.String(), "partial write")
+	assert.Contains(t, writer.Body.String(), "retryable_errors: count=1")
+}
+
+func TestWriteDatabaseRouting(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		query    string
+		expected ingest.WriteOptions
+	}{
+		{
+			query: "?db=telegraf",
+			expected: ingest.WriteOptions{
+				DownsampleOverride: true,
+				WriteOverride:      true,
+				WriteStoragePolicies: policy.StoragePolicies{
+					policy.NewStoragePolicy(time.Minute, xtime.Second, 720*time.Hour),
+				},
+			},
+		},
+		{
+			query: "?db=telegraf&rp=1h",
+			expected: ingest.WriteOptions{
+				DownsampleOverride: true,
+				WriteOverride:      true,
+				WriteStoragePolicies: policy.StoragePolicies{
+					policy.NewStoragePolicy(time.Hour, xtime.Second, 8760*time.Hour),
+				},
+			},
+		},
+		{
+			query:    "?db=raw",
+			expected: ingest.WriteOptions{},
+		},
+	}
+	for _, tt := range tests {
+		ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+		ds.EXPECT().
+			WriteBatch(gomock.Any(), gomock.Any(), tt.expected).
+			Return(nil)
+
+		req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL+tt.query,
+			strings.NewReader("cpu,host=a value=1 1574838670000000000"))
+		writer := httptest.NewRecorder()
+		newTestWriteHandler(ds, testInfluxDBConfig).ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusNoContent, writer.Code, tt.query)
+	}
+}
+
+func TestWriteDatabaseRoutingErrors(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		query  string
+		status int
+	}{
+		{query: "", status: http.StatusBadRequest},
+		{query: "?db=unknown", status: http.StatusNotFound},
+		{query: "?db=telegraf&rp=unknown", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+		req := httptest.NewRequest(InfluxWriteHTTPMethod, InfluxWriteURL+tt.query,
+			strings.NewReader("cpu,host=a value=1 1574838670000000000"))
+		writer := httptest.NewRecorder()
+		newTestWriteHandler(ds, testInfluxDBConfig).ServeHTTP(writer, req)
+		assert.Equal(t, tt.status, writer.Code, tt.query)
+	}
+}
@@ -130,6 +130,8 @@ func TestNamespaceAddHandler(t *testing.T) {
 						"bootstrapEnabled":      true,
 						"cacheBlocksOnRetrieve": false,
 						"flushEnabled":          true,
+						"tierAfterNanos":        "0",
+						"writeDurability":       "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog":     true,
 						"cleanupEnabled":        true,
 						"repairEnabled":         true,
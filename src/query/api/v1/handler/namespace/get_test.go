@@ -143,6 +143,8 @@ func TestNamespaceGetHandler(t *testing.T) {
 						"schemaOptions":     nil,
 						"snapshotEnabled":   true,
 						"stagingState":      xjson.Map{"status": "READY"},
+						"tierAfterNanos":    "0",
+						"writeDurability":   "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"extendedOptions":   xtest.NewTestExtendedOptionsJSON("foo"),
 					},
@@ -228,6 +230,8 @@ func TestNamespaceGetHandlerWithDebug(t *testing.T) {
 						"schemaOptions":     nil,
 						"stagingState":      xjson.Map{"status": "UNKNOWN"},
 						"snapshotEnabled":   true,
+						"tierAfterDuration": "0s",
+						"writeDurability":   "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog": true,
 						"extendedOptions":   nil,
 					},
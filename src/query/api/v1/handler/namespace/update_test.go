@@ -178,6 +178,8 @@ func TestNamespaceUpdateHandler(t *testing.T) {
 						"bootstrapEnabled":      true,
 						"cacheBlocksOnRetrieve": true,
 						"flushEnabled":          true,
+						"tierAfterNanos":        "0",
+						"writeDurability":       "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog":     true,
 						"cleanupEnabled":        false,
 						"repairEnabled":         false,
@@ -239,6 +241,8 @@ func TestNamespaceUpdateHandler(t *testing.T) {
 						"bootstrapEnabled":      true,
 						"cacheBlocksOnRetrieve": true,
 						"flushEnabled":          true,
+						"tierAfterNanos":        "0",
+						"writeDurability":       "WRITE_DURABILITY_DEFAULT",
 						"writesToCommitLog":     true,
 						"cleanupEnabled":        false,
 						"repairEnabled":         false,
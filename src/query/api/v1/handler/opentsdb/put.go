@@ -0,0 +1,214 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package opentsdb implements the OpenTSDB HTTP write endpoint.
+package opentsdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	ingestopentsdb "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/opentsdb"
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// PutURL is the url for the OpenTSDB put handler, it matches the
+	// OpenTSDB path so that existing collectors can write to it as is.
+	PutURL = "/api/put"
+
+	// PutHTTPMethod is the HTTP method used with this resource.
+	PutHTTPMethod = http.MethodPost
+
+	detailsParam = "details"
+	summaryParam = "summary"
+
+	gzipEncoding = "gzip"
+)
+
+var errEmptyBody = errors.New("empty request body")
+
+// PutResponse is the response to a put request that asked for a summary
+// or details.
+type PutResponse struct {
+	Success int         `json:"success"`
+	Failed  int         `json:"failed"`
+	Errors  []*PutError `json:"errors,omitempty"`
+}
+
+// PutError is the error for a single datapoint of a put request, the
+// datapoint is omitted for errors that can't be attributed to one.
+type PutError struct {
+	Datapoint json.RawMessage `json:"datapoint,omitempty"`
+	Error     string          `json:"error"`
+}
+
+type putHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	tagOpts              models.TagOptions
+	instrumentOpts       instrument.Options
+}
+
+// NewPutHandler returns a new OpenTSDB put handler which accepts single or
+// batched JSON datapoints.
+func NewPutHandler(opts options.HandlerOptions) http.Handler {
+	return &putHandler{
+		downsamplerAndWriter: opts.DownsamplerAndWriter(),
+		tagOpts:              opts.TagOptions(),
+		instrumentOpts:       opts.InstrumentOpts(),
+	}
+}
+
+func (h *putHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	raw, err := parseRequest(r)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	var (
+		resp       = &PutResponse{}
+		datapoints = make([]ingestopentsdb.Datapoint, 0, len(raw))
+	)
+	for _, b := range raw {
+		var dp ingestopentsdb.Datapoint
+		err := json.Unmarshal(b, &dp)
+		if err == nil {
+			err = dp.Validate(h.tagOpts)
+		}
+		if err != nil {
+			resp.Failed++
+			resp.Errors = append(resp.Errors, &PutError{Datapoint: b, Error: err.Error()})
+			continue
+		}
+		datapoints = append(datapoints, dp)
+	}
+
+	status := http.StatusNoContent
+	if resp.Failed > 0 {
+		status = http.StatusBadRequest
+	}
+
+	if len(datapoints) > 0 {
+		iter := ingestopentsdb.NewDatapointIter(datapoints, h.tagOpts)
+		batchErr := h.downsamplerAndWriter.WriteBatch(r.Context(), iter, ingest.WriteOptions{})
+		resp.Success = len(datapoints)
+		if batchErr != nil {
+			errs := batchErr.Errors()
+			for _, err := range errs {
+				if !client.IsBadRequestError(err) && !xerrors.IsInvalidParams(err) {
+					status = http.StatusInternalServerError
+				} else if status != http.StatusInternalServerError {
+					status = http.StatusBadRequest
+				}
+				resp.Errors = append(resp.Errors, &PutError{Error: err.Error()})
+			}
+			resp.Success -= len(errs)
+			resp.Failed += len(errs)
+		}
+	}
+
+	if resp.Failed > 0 {
+		logger := logging.WithContext(r.Context(), h.instrumentOpts)
+		logger.Error("write error",
+			zap.String("remoteAddr", r.RemoteAddr),
+			zap.Int("httpResponseStatusCode", status),
+			zap.Int("numSuccess", resp.Success),
+			zap.Int("numFailed", resp.Failed),
+			zap.String("lastError", resp.Errors[len(resp.Errors)-1].Error))
+	}
+
+	_, details := r.URL.Query()[detailsParam]
+	_, summary := r.URL.Query()[summaryParam]
+	switch {
+	case details:
+	case summary:
+		resp.Errors = nil
+	case resp.Failed > 0:
+		err := fmt.Errorf("failed to write %d of %d datapoints, last error: %s",
+			resp.Failed, resp.Failed+resp.Success, resp.Errors[len(resp.Errors)-1].Error)
+		xhttp.WriteError(w, xhttp.NewError(err, status))
+		return
+	default:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if status == http.StatusNoContent {
+		status = http.StatusOK
+	}
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp) //nolint:errcheck
+}
+
+// parseRequest returns the raw JSON of each datapoint in the request which
+// may either be a single datapoint or an array of datapoints.
+func parseRequest(r *http.Request) ([]json.RawMessage, error) {
+	if r.Body == nil {
+		return nil, xerrors.NewInvalidParamsError(errEmptyBody)
+	}
+	defer r.Body.Close()
+
+	var body io.Reader = r.Body
+	if r.Header.Get(xhttp.HeaderContentEncoding) == gzipEncoding {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xerrors.NewInvalidParamsError(err)
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil, xerrors.NewInvalidParamsError(errEmptyBody)
+	}
+
+	if b[0] != '[' {
+		return []json.RawMessage{b}, nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+	return raw, nil
+}
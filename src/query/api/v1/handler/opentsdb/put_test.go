@@ -0,0 +1,199 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package opentsdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	validDatapoint   = `{"metric":"sys.cpu.nice","timestamp":1346846400,"value":18,"tags":{"host":"web01"}}`
+	invalidDatapoint = `{"metric":"sys.cpu.nice","timestamp":1346846400,"value":18}`
+)
+
+func newTestHandler(ds ingest.DownsamplerAndWriter) http.Handler {
+	return NewPutHandler(options.EmptyHandlerOptions().
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions()))
+}
+
+func collectNames(t *testing.T, iter ingest.DownsampleAndWriteIter) []string {
+	var names []string
+	for iter.Next() {
+		name, ok := iter.Current().Tags.Name()
+		require.True(t, ok)
+		names = append(names, string(name))
+	}
+	require.NoError(t, iter.Error())
+	return names
+}
+
+func TestPutSingleDatapoint(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			assert.Equal(t, []string{"sys.cpu.nice"}, collectNames(t, iter))
+			return nil
+		})
+
+	req := httptest.NewRequest(PutHTTPMethod, PutURL, strings.NewReader(validDatapoint))
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusNoContent, writer.Code)
+}
+
+func TestPutGzipBatch(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			assert.Equal(t, []string{"sys.cpu.nice", "sys.cpu.nice"},
+				collectNames(t, iter))
+			return nil
+		})
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte("[" + validDatapoint + "," + validDatapoint + "]"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	req := httptest.NewRequest(PutHTTPMethod, PutURL, &buf)
+	req.Header.Set(xhttp.HeaderContentEncoding, "gzip")
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusNoContent, writer.Code)
+}
+
+func TestPutMalformedBody(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	for _, body := range []string{"", "[{", "not json"} {
+		req := httptest.NewRequest(PutHTTPMethod, PutURL, strings.NewReader(body))
+		writer := httptest.NewRecorder()
+		newTestHandler(ds).ServeHTTP(writer, req)
+		assert.Equal(t, http.StatusBadRequest, writer.Code, body)
+	}
+}
+
+func TestPutDetails(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	body := "[" + validDatapoint + "," + invalidDatapoint + "]"
+	req := httptest.NewRequest(PutHTTPMethod, PutURL+"?details",
+		strings.NewReader(body))
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+
+	var resp PutResponse
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+	assert.Equal(t, 1, resp.Success)
+	assert.Equal(t, 1, resp.Failed)
+	require.Equal(t, 1, len(resp.Errors))
+	assert.JSONEq(t, invalidDatapoint, string(resp.Errors[0].Datapoint))
+	assert.NotEmpty(t, resp.Errors[0].Error)
+}
+
+func TestPutSummary(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	req := httptest.NewRequest(PutHTTPMethod, PutURL+"?summary",
+		strings.NewReader(validDatapoint))
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	require.Equal(t, http.StatusOK, writer.Code)
+	assert.JSONEq(t, `{"success":1,"failed":0}`, writer.Body.String())
+}
+
+func TestPutWriteError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	multiErr := xerrors.NewMultiError().Add(errors.New("an error"))
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(ingest.BatchError(multiErr)).
+		Times(2)
+
+	req := httptest.NewRequest(PutHTTPMethod, PutURL,
+		strings.NewReader(validDatapoint))
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	require.Equal(t, http.StatusInternalServerError, writer.Code)
+	assert.Contains(t, writer.Body.String(), "an error")
+
+	req = httptest.NewRequest(PutHTTPMethod, PutURL+"?summary",
+		strings.NewReader(validDatapoint))
+	writer = httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	require.Equal(t, http.StatusInternalServerError, writer.Code)
+	assert.JSONEq(t, `{"success":0,"failed":1}`, writer.Body.String())
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package otlp implements the OpenTelemetry OTLP/HTTP metrics endpoint.
+package otlp
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	ingestotlp "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/otlp"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/util/logging"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/gogo/protobuf/jsonpb"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// WriteURL is the url for the OTLP metrics write handler, exporters
+	// configured with the /api/v1/otlp endpoint append the /v1/metrics path.
+	WriteURL = "/api/v1/otlp/v1/metrics"
+
+	// WriteHTTPMethod is the HTTP method used with this resource.
+	WriteHTTPMethod = http.MethodPost
+
+	gzipEncoding = "gzip"
+)
+
+var errEmptyBody = errors.New("empty request body")
+
+type writeHandler struct {
+	downsamplerAndWriter ingest.DownsamplerAndWriter
+	opts                 ingestotlp.Options
+	instrumentOpts       instrument.Options
+}
+
+// NewWriteHandler returns a new OTLP metrics write handler which accepts
+// protobuf and JSON encoded export requests.
+func NewWriteHandler(opts options.HandlerOptions) http.Handler {
+	return &writeHandler{
+		downsamplerAndWriter: opts.DownsamplerAndWriter(),
+		opts: ingestotlp.Options{
+			InstrumentOptions: opts.InstrumentOpts(),
+			TagOptions:        opts.TagOptions(),
+			StoreMetricsType:  opts.StoreMetricsType(),
+		},
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *writeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	isJSON := isJSONRequest(r)
+	metrics, err := parseRequest(r, isJSON)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	resp, err := ingestotlp.Write(r.Context(), h.downsamplerAndWriter, metrics, h.opts)
+	if err != nil {
+		// Exporters only retry on service unavailable and not on internal
+		// server errors.
+		status := http.StatusServiceUnavailable
+		if xerrors.IsInvalidParams(err) {
+			status = http.StatusBadRequest
+		}
+
+		logger := logging.WithContext(r.Context(), h.instrumentOpts)
+		logger.Error("write error",
+			zap.String("remoteAddr", r.RemoteAddr),
+			zap.Int("httpResponseStatusCode", status),
+			zap.Error(err))
+		xhttp.WriteError(w, xhttp.NewError(err, status))
+		return
+	}
+
+	// Respond with the export response in the encoding of the request, it is
+	// empty unless some of the writes were rejected.
+	if isJSON {
+		w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+		if err := new(jsonpb.Marshaler).Marshal(w, resp); err != nil {
+			logging.WithContext(r.Context(), h.instrumentOpts).
+				Error("unable to encode json response", zap.Error(err))
+		}
+		return
+	}
+	data, err := resp.Marshal()
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+	w.Header().Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	w.Write(data) //nolint:errcheck
+}
+
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(xhttp.HeaderContentType))
+	return err == nil && mediaType == xhttp.ContentTypeJSON
+}
+
+func parseRequest(r *http.Request, isJSON bool) (*metricspb.MetricsData, error) {
+	if r.Body == nil {
+		return nil, xerrors.NewInvalidParamsError(errEmptyBody)
+	}
+	defer r.Body.Close()
+
+	var body io.Reader = r.Body
+	if r.Header.Get(xhttp.HeaderContentEncoding) == gzipEncoding {
+		gzipReader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, xerrors.NewInvalidParamsError(err)
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The export request shares its wire format with MetricsData.
+	metrics := &metricspb.MetricsData{}
+	if isJSON {
+		err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, metrics)
+	} else {
+		err = proto.Unmarshal(b, metrics)
+	}
+	if err != nil {
+		return nil, xerrors.NewInvalidParamsError(err)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const testJSONRequest = `{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{
+	"name":"queue.size",
+	"gauge":{"dataPoints":[{"timeUnixNano":"1600000000000000000","asInt":"3"}]}
+}]}]}]}`
+
+func newTestHandler(ds ingest.DownsamplerAndWriter) http.Handler {
+	return NewWriteHandler(options.EmptyHandlerOptions().
+		SetDownsamplerAndWriter(ds).
+		SetTagOptions(models.NewTagOptions()))
+}
+
+func testProtobufRequest(t *testing.T) []byte {
+	metrics := &metricspb.MetricsData{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Metrics: []*metricspb.Metric{{
+					Name: "queue.size",
+					Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+						DataPoints: []*metricspb.NumberDataPoint{{
+							TimeUnixNano: 1600000000000000000,
+							Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 3},
+						}},
+					}},
+				}},
+			}},
+		}},
+	}
+	b, err := proto.Marshal(metrics)
+	require.NoError(t, err)
+	return b
+}
+
+func expectQueueSizeWrite(t *testing.T, ds *ingest.MockDownsamplerAndWriter) {
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			require.True(t, iter.Next())
+			value := iter.Current()
+			name, ok := value.Tags.Name()
+			require.True(t, ok)
+			assert.Equal(t, "queue_size", string(name))
+			require.Equal(t, 1, len(value.Datapoints))
+			assert.Equal(t, 3.0, value.Datapoints[0].Value)
+			assert.False(t, iter.Next())
+			return nil
+		})
+}
+
+func TestWriteProtobuf(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	expectQueueSizeWrite(t, ds)
+
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL,
+		bytes.NewReader(testProtobufRequest(t)))
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, xhttp.ContentTypeProtobuf, writer.Header().Get(xhttp.HeaderContentType))
+	assert.Equal(t, 0, writer.Body.Len())
+}
+
+func TestWriteGzipProtobuf(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	expectQueueSizeWrite(t, ds)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(testProtobufRequest(t))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL, &buf)
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeProtobuf)
+	req.Header.Set(xhttp.HeaderContentEncoding, "gzip")
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusOK, writer.Code)
+}
+
+func TestWriteJSON(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	expectQueueSizeWrite(t, ds)
+
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL,
+		strings.NewReader(testJSONRequest))
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.JSONEq(t, "{}", writer.Body.String())
+}
+
+func TestWriteJSONPartialSuccess(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(
+			xerrors.NewInvalidParamsError(errors.New("bad"))))
+
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL,
+		strings.NewReader(`{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{
+			"name":"queue.size",
+			"gauge":{"dataPoints":[
+				{"timeUnixNano":"1600000000000000000","asInt":"3"},
+				{"timeUnixNano":"1600000010000000000","asInt":"4"}
+			]}
+		}]}]}]}`))
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeJSON)
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var resp struct {
+		PartialSuccess struct {
+			RejectedDataPoints string `json:"rejectedDataPoints"`
+			ErrorMessage       string `json:"errorMessage"`
+		} `json:"partialSuccess"`
+	}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp))
+	assert.Equal(t, "1", resp.PartialSuccess.RejectedDataPoints)
+	assert.Contains(t, resp.PartialSuccess.ErrorMessage, "bad")
+}
+
+func TestWriteMalformed(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	req := httptest.NewRequest(WriteHTTPMethod, WriteURL,
+		strings.NewReader("not a protobuf"))
+	writer := httptest.NewRecorder()
+	newTestHandler(ds).ServeHTTP(writer, req)
+	assert.Equal(t, http.StatusBadRequest, writer.Code)
+}
+
+func TestWriteErrors(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	ds := ingest.NewMockDownsamplerAndWriter(ctrl)
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(errors.New("an error")))
+	ds.EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(xerrors.NewMultiError().Add(
+			xerrors.NewInvalidParamsError(errors.New("bad"))))
+
+	for _, expected := range []int{
+		http.StatusServiceUnavailable,
+		http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest(WriteHTTPMethod, WriteURL,
+			bytes.NewReader(testProtobufRequest(t)))
+		writer := httptest.NewRecorder()
+		newTestHandler(ds).ServeHTTP(writer, req)
+		assert.Equal(t, expected, writer.Code)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DeleteSeriesURL is the url for the delete series handler, compatible
+	// with the Prometheus TSDB admin API.
+	DeleteSeriesURL = handler.RoutePrefixV1 + "/admin/tsdb/delete_series"
+)
+
+// DeleteSeriesHTTPMethods are the HTTP methods for this handler.
+var DeleteSeriesHTTPMethods = []string{http.MethodPost, http.MethodPut}
+
+var errDeleteSeriesNoClusters = errors.New("delete series requires M3DB cluster namespaces")
+
+// deleteSeriesHandler deletes the data of the series matching a set of
+// selectors from every cluster namespace, the database nodes record the
+// deletes as tombstones that reads honour immediately and which are
+// applied to the data files on the next flush. Unlike Prometheus, datapoints
+// written to a deleted range after the delete are hidden as well until the
+// tombstone falls out of retention.
+type deleteSeriesHandler struct {
+	clusters       m3.Clusters
+	parseOpts      promql.ParseOptions
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewDeleteSeriesHandler returns a new instance of handler.
+func NewDeleteSeriesHandler(opts options.HandlerOptions) http.Handler {
+	return &deleteSeriesHandler{
+		clusters:       opts.Clusters(),
+		parseOpts:      promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		tagOpts:        opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *deleteSeriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := logging.WithContext(r.Context(), h.instrumentOpts)
+
+	queries, err := prometheus.ParseSeriesMatchQuery(r, h.parseOpts, h.tagOpts)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if h.clusters == nil {
+		xhttp.WriteError(w, xhttp.NewError(errDeleteSeriesNoClusters,
+			http.StatusNotImplemented))
+		return
+	}
+
+	for _, query := range queries {
+		m3query, err := storage.FetchQueryToM3Query(query, nil)
+		if err != nil {
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		var (
+			start = xtime.ToUnixNano(query.Start)
+			end   = xtime.ToUnixNano(query.End)
+		)
+		for _, ns := range h.clusters.ClusterNamespaces() {
+			deleted, err := ns.Session().DeleteSeries(ns.NamespaceID(),
+				m3query, start, end)
+			if err != nil {
+				logger.Error("unable to delete series",
+					zap.String("match", query.Raw),
+					zap.Stringer("namespace", ns.NamespaceID()),
+					zap.Error(err))
+				xhttp.WriteError(w, err)
+				return
+			}
+
+			logger.Info("deleted series",
+				zap.String("match", query.Raw),
+				zap.Stringer("namespace", ns.NamespaceID()),
+				zap.Int64("series", deleted))
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
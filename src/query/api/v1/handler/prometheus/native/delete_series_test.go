@@ -0,0 +1,94 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeleteSeriesRequest(values url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, DeleteSeriesURL,
+		strings.NewReader(values.Encode()))
+	req.Header.Set(xhttp.HeaderContentType, xhttp.ContentTypeFormURLEncoded)
+	return req
+}
+
+func TestDeleteSeriesHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start   = time.Unix(1600000000, 0)
+		end     = start.Add(time.Hour)
+		session = client.NewMockSession(ctrl)
+	)
+	session.EXPECT().
+		DeleteSeries(ident.NewIDMatcher("metrics"), gomock.Any(),
+			xtime.ToUnixNano(start), xtime.ToUnixNano(end)).
+		Return(int64(2), nil).
+		Times(2)
+
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	h := NewDeleteSeriesHandler(options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetTagOptions(models.NewTagOptions()))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newDeleteSeriesRequest(url.Values{
+		"match[]": []string{`up{job="api"}`, `requests`},
+		"start":   []string{"1600000000"},
+		"end":     []string{"1600003600"},
+	}))
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestDeleteSeriesHandlerRequiresMatchers(t *testing.T) {
+	h := NewDeleteSeriesHandler(options.EmptyHandlerOptions().
+		SetTagOptions(models.NewTagOptions()))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newDeleteSeriesRequest(url.Values{
+		"start": []string{"1600000000"},
+	}))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
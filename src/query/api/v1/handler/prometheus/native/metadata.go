@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// MetadataURL is the url for the metric metadata handler.
+	MetadataURL = handler.RoutePrefixV1 + "/metadata"
+
+	// MetadataHTTPMethod is the HTTP method used with this resource.
+	MetadataHTTPMethod = http.MethodGet
+
+	metadataLimitParam  = "limit"
+	metadataMetricParam = "metric"
+)
+
+// metadataHandler returns the type, help and unit metadata of metric
+// families, as captured from Prometheus remote write requests.
+type metadataHandler struct {
+	store          metricmetadata.Store
+	instrumentOpts instrument.Options
+}
+
+// NewMetadataHandler returns a new metric metadata handler, which serves the
+// metadata of metric families in the format of the Prometheus metadata API.
+func NewMetadataHandler(opts options.HandlerOptions) http.Handler {
+	return &metadataHandler{
+		store:          opts.MetricMetadataStore(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *metadataHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit := -1
+	if str := r.FormValue(metadataLimitParam); str != "" {
+		value, err := strconv.Atoi(str)
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+				fmt.Errorf(formatErrStr, metadataLimitParam, err)))
+			return
+		}
+		limit = value
+	}
+
+	result := make(map[string][]metricmetadata.Metadata)
+	if h.store != nil && limit != 0 {
+		result = h.store.Metadata(r.FormValue(metadataMetricParam), limit)
+	}
+
+	writeSuccessResponse(w, result, h.instrumentOpts)
+}
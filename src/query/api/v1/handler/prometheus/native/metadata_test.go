@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataHandler(t *testing.T) {
+	store := metricmetadata.NewStore(metricmetadata.StoreOptions{})
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	store.Update([]prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+		{
+			Type:             prompb.MetricType_GAUGE,
+			MetricFamilyName: "memory_bytes",
+			Unit:             "bytes",
+		},
+	})
+
+	h := NewMetadataHandler(options.EmptyHandlerOptions().
+		SetMetricMetadataStore(store))
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name: "all",
+			url:  MetadataURL,
+			expected: `{"status":"success","data":{
+				"http_requests_total":[{"type":"counter","help":"Total HTTP requests.","unit":""}],
+				"memory_bytes":[{"type":"gauge","help":"","unit":"bytes"}]}}`,
+		},
+		{
+			name: "metric",
+			url:  MetadataURL + "?metric=memory_bytes",
+			expected: `{"status":"success","data":{
+				"memory_bytes":[{"type":"gauge","help":"","unit":"bytes"}]}}`,
+		},
+		{
+			name: "limit",
+			url:  MetadataURL + "?limit=1",
+			expected: `{"status":"success","data":{
+				"http_requests_total":[{"type":"counter","help":"Total HTTP requests.","unit":""}]}}`,
+		},
+		{
+			name:     "zero limit",
+			url:      MetadataURL + "?limit=0",
+			expected: `{"status":"success","data":{}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(MetadataHTTPMethod, tt.url, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(body))
+		})
+	}
+}
+
+func TestMetadataHandlerWithoutStore(t *testing.T) {
+	h := NewMetadataHandler(options.EmptyHandlerOptions())
+
+	req := httptest.NewRequest(MetadataHTTPMethod, MetadataURL, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"status":"success","data":{}}`, string(body))
+}
+
+func TestMetadataHandlerInvalidLimit(t *testing.T) {
+	h := NewMetadataHandler(options.EmptyHandlerOptions())
+
+	req := httptest.NewRequest(MetadataHTTPMethod, MetadataURL+"?limit=foo", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/exemplar"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	pql "github.com/prometheus/prometheus/promql/parser"
+)
+
+const (
+	// QueryExemplarsURL is the url for the exemplars query handler.
+	QueryExemplarsURL = handler.RoutePrefixV1 + "/query_exemplars"
+)
+
+// QueryExemplarsHTTPMethods are the HTTP methods for this handler.
+var QueryExemplarsHTTPMethods = []string{http.MethodGet, http.MethodPost}
+
+var errMissingExemplarsQuery = errors.New("missing query")
+
+// queryExemplarsHandler returns the exemplars of the series selected by a
+// PromQL query, as captured from Prometheus remote write requests.
+type queryExemplarsHandler struct {
+	store          exemplar.Store
+	parseOpts      promql.ParseOptions
+	tagOpts        models.TagOptions
+	instrumentOpts instrument.Options
+}
+
+// NewQueryExemplarsHandler returns a new instance of handler.
+func NewQueryExemplarsHandler(opts options.HandlerOptions) http.Handler {
+	return &queryExemplarsHandler{
+		store:          opts.ExemplarStore(),
+		parseOpts:      promql.NewParseOptions().SetNowFn(opts.NowFn()),
+		tagOpts:        opts.TagOptions(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+// ExemplarSeries are the exemplars of a series.
+type ExemplarSeries struct {
+	SeriesLabels map[string]string `json:"seriesLabels"`
+	Exemplars    []Exemplar        `json:"exemplars"`
+}
+
+// Exemplar is an exemplar in the Prometheus API response format.
+type Exemplar struct {
+	Labels    map[string]string `json:"labels"`
+	Value     string            `json:"value"`
+	Timestamp float64           `json:"timestamp"`
+}
+
+func (h *queryExemplarsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.FormValue(queryParam)
+	if query == "" {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+			fmt.Errorf(formatErrStr, queryParam, errMissingExemplarsQuery)))
+		return
+	}
+
+	start, end, err := prometheus.ParseStartAndEnd(r, h.parseOpts)
+	if err != nil {
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	expr, err := h.parseOpts.ParseFn()(query)
+	if err != nil {
+		xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+			fmt.Errorf(formatErrStr, queryParam, err)))
+		return
+	}
+
+	selectors := pql.ExtractSelectors(expr)
+	matchers := make([]models.Matchers, 0, len(selectors))
+	for _, selector := range selectors {
+		m, err := promql.LabelMatchersToModelMatcher(selector, h.tagOpts)
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(err))
+			return
+		}
+
+		matchers = append(matchers, m)
+	}
+
+	result := make([]ExemplarSeries, 0)
+	if h.store != nil && len(matchers) > 0 {
+		series, err := h.store.Query(r.Context(), matchers,
+			xtime.ToUnixNano(start), xtime.ToUnixNano(end))
+		if err != nil {
+			xhttp.WriteError(w, err)
+			return
+		}
+
+		for _, s := range series {
+			result = append(result, toExemplarSeries(s))
+		}
+	}
+
+	writeSuccessResponse(w, result, h.instrumentOpts)
+}
+
+func toExemplarSeries(series exemplar.SeriesExemplars) ExemplarSeries {
+	exemplars := make([]Exemplar, 0, len(series.Exemplars))
+	for _, e := range series.Exemplars {
+		exemplars = append(exemplars, Exemplar{
+			Labels: tagsToLabels(e.Labels),
+			Value:  strconv.FormatFloat(e.Value, 'f', -1, 64),
+			// NB: timestamps are rendered in seconds with millisecond precision.
+			Timestamp: float64(storage.TimeToPromTimestamp(e.Timestamp)) / 1000,
+		})
+	}
+
+	return ExemplarSeries{
+		SeriesLabels: tagsToLabels(series.Tags.Tags),
+		Exemplars:    exemplars,
+	}
+}
+
+func tagsToLabels(tags []models.Tag) map[string]string {
+	labels := make(map[string]string, len(tags))
+	for _, t := range tags {
+		labels[string(t.Name)] = string(t.Value)
+	}
+
+	return labels
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/exemplar"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testExemplarStore struct {
+	matchers   []models.Matchers
+	start, end xtime.UnixNano
+	result     []exemplar.SeriesExemplars
+	err        error
+}
+
+func (s *testExemplarStore) Append(
+	context.Context,
+	[]exemplar.SeriesExemplars,
+) error {
+	return nil
+}
+
+func (s *testExemplarStore) Query(
+	_ context.Context,
+	matchers []models.Matchers,
+	start, end xtime.UnixNano,
+) ([]exemplar.SeriesExemplars, error) {
+	s.matchers, s.start, s.end = matchers, start, end
+	return s.result, s.err
+}
+
+func TestQueryExemplarsHandler(t *testing.T) {
+	var (
+		tagOpts = models.NewTagOptions()
+		start   = xtime.UnixNano(1600000000 * int64(time.Second))
+		store   = &testExemplarStore{
+			result: []exemplar.SeriesExemplars{{
+				Tags: models.NewTags(2, tagOpts).SetName([]byte("latency")).
+					AddTag(models.Tag{Name: []byte("job"), Value: []byte("api")}),
+				Exemplars: []exemplar.Exemplar{{
+					Labels:    []models.Tag{{Name: []byte("trace_id"), Value: []byte("abc")}},
+					Value:     0.5,
+					Timestamp: start.Add(1500 * time.Millisecond),
+				}},
+			}},
+		}
+	)
+
+	h := NewQueryExemplarsHandler(options.EmptyHandlerOptions().
+		SetTagOptions(tagOpts).
+		SetExemplarStore(store))
+
+	tests := []struct {
+		name     string
+		query    string
+		matchers []string
+	}{
+		{
+			name:     "selector",
+			query:    `latency{job="api"}`,
+			matchers: []string{`__name__="latency"`, `job="api"`},
+		},
+		{
+			name:     "expression",
+			query:    `histogram_quantile(0.9, rate(latency[5m]))`,
+			matchers: []string{`__name__="latency"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := url.Values{}
+			params.Set("query", tt.query)
+			params.Set("start", "1600000000")
+			params.Set("end", "1600000060")
+
+			req := httptest.NewRequest(http.MethodGet,
+				QueryExemplarsURL+"?"+params.Encode(), nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			body, err := ioutil.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"status":"success","data":[{
+				"seriesLabels":{"__name__":"latency","job":"api"},
+				"exemplars":[{"labels":{"trace_id":"abc"},"value":"0.5","timestamp":1600000001.5}]}]}`,
+				string(body))
+
+			require.Equal(t, 1, len(store.matchers))
+			matchers := make([]string, 0, len(store.matchers[0]))
+			for _, m := range store.matchers[0] {
+				matchers = append(matchers, m.String())
+			}
+			sort.Strings(matchers)
+			assert.Equal(t, tt.matchers, matchers)
+			assert.Equal(t, start, store.start)
+			assert.Equal(t, start.Add(time.Minute), store.end)
+		})
+	}
+}
+
+func TestQueryExemplarsHandlerStoreError(t *testing.T) {
+	h := NewQueryExemplarsHandler(options.EmptyHandlerOptions().
+		SetTagOptions(models.NewTagOptions()).
+		SetExemplarStore(&testExemplarStore{err: errors.New("fetch error")}))
+
+	params := url.Values{}
+	params.Set("query", "latency")
+	req := httptest.NewRequest(http.MethodGet,
+		QueryExemplarsURL+"?"+params.Encode(), nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+}
+
+func TestQueryExemplarsHandlerMissingQuery(t *testing.T) {
+	h := NewQueryExemplarsHandler(options.EmptyHandlerOptions().
+		SetTagOptions(models.NewTagOptions()))
+
+	req := httptest.NewRequest(http.MethodGet, QueryExemplarsURL, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/query/util/logging"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	pql "github.com/prometheus/prometheus/promql/parser"
+	"go.uber.org/zap"
+)
+
+// readWithCache executes a query, serving the step aligned portion of a range
+// query that is already present in the results cache and only executing the
+// remainder of the range.
+func readWithCache(
+	ctx context.Context,
+	parsed ParsedOptions,
+	handlerOpts options.HandlerOptions,
+) (ReadResult, error) {
+	resultsCache := handlerOpts.ResultsCache()
+	if resultsCache == nil || parsed.QueryOpts.QueryContextOptions.Instantaneous {
+		return read(ctx, parsed, handlerOpts)
+	}
+
+	key, ok := resultsCacheKey(parsed)
+	if !ok {
+		return read(ctx, parsed, handlerOpts)
+	}
+
+	var (
+		params = parsed.Params
+		step   = params.Step
+		last   = params.LastStep()
+		logger = logging.WithContext(ctx, handlerOpts.InstrumentOpts())
+	)
+
+	extent, ok, err := resultsCache.Get(ctx, key)
+	if err != nil {
+		logger.Warn("could not get cached results", zap.Error(err))
+		ok = false
+	}
+
+	// NB: cached results are only usable if they cover the start of the range,
+	// otherwise the whole range must be executed anyway.
+	cachedEnd := params.Start.Add(-step)
+	if ok && extent.Step == step &&
+		!extent.Start.After(params.Start) && !extent.End.Before(params.Start) {
+		cachedEnd = xtime.MinUnixNano(extent.End, last)
+	} else {
+		extent = cache.Extent{}
+	}
+
+	result := ReadResult{
+		Meta:      block.NewResultMetadata(),
+		BlockType: extent.BlockType,
+	}
+
+	executed := cachedEnd.Before(last)
+	if executed {
+		tail := parsed
+		tail.Params.Start = cachedEnd.Add(step)
+		result, err = read(ctx, tail, handlerOpts)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	merged := mergeCachedResults(extent, result.Series, params.Start,
+		cachedEnd, last, step)
+
+	// NB: only cache results that are complete and old enough that no further
+	// data is expected to arrive for them. Results served entirely from the
+	// cache are not written back, to avoid shrinking the cached extent.
+	var (
+		maxFreshness = handlerOpts.Config().Query.ResultsCache.MaxFreshnessOrDefault()
+		freshEnd     = xtime.ToUnixNano(params.Now).Add(-maxFreshness)
+		cacheEnd     = xtime.MinUnixNano(last, freshEnd.Truncate(step))
+	)
+	if executed && result.Meta.Exhaustive && len(result.Meta.Warnings) == 0 &&
+		!cacheEnd.Before(params.Start) {
+		steps := int(cacheEnd.Sub(params.Start)/step) + 1
+		updated := cache.Extent{
+			Start:     params.Start,
+			End:       cacheEnd,
+			Step:      step,
+			BlockType: result.BlockType,
+			Series:    make([]cache.Series, 0, len(merged)),
+		}
+
+		for _, series := range merged {
+			updated.Series = append(updated.Series, cache.Series{
+				Name:   series.Name,
+				Tags:   series.Tags,
+				Values: series.Values[:steps],
+			})
+		}
+
+		if err := resultsCache.Put(ctx, key, updated); err != nil {
+			logger.Warn("could not cache results", zap.Error(err))
+		}
+	}
+
+	numSteps := int(last.Sub(params.Start)/step) + 1
+	result.Series = make([]*ts.Series, 0, len(merged))
+	for _, series := range merged {
+		values := ts.NewFixedStepValues(step, numSteps, math.NaN(), params.Start)
+		for i, v := range series.Values {
+			values.SetValueAt(i, v)
+		}
+
+		result.Series = append(result.Series,
+			ts.NewSeries(series.Name, values, series.Tags))
+	}
+
+	return result, nil
+}
+
+// mergeCachedResults combines the cached values up to and including cachedEnd
+// with the executed values after cachedEnd, producing series with a value at
+// every step between start and end inclusive.
+func mergeCachedResults(
+	extent cache.Extent,
+	executed []*ts.Series,
+	start xtime.UnixNano,
+	cachedEnd xtime.UnixNano,
+	end xtime.UnixNano,
+	step time.Duration,
+) []cache.Series {
+	var (
+		steps  = int(end.Sub(start)/step) + 1
+		merged = make([]cache.Series, 0, len(extent.Series)+len(executed))
+		byID   = make(map[string]int, len(extent.Series)+len(executed))
+	)
+
+	seriesIndex := func(name []byte, tags models.Tags) int {
+		id := string(tags.ID())
+		if idx, ok := byID[id]; ok {
+			return idx
+		}
+
+		values := make([]float64, steps)
+		for i := range values {
+			values[i] = math.NaN()
+		}
+
+		byID[id] = len(merged)
+		merged = append(merged, cache.Series{
+			Name:   name,
+			Tags:   tags,
+			Values: values,
+		})
+		return len(merged) - 1
+	}
+
+	if !cachedEnd.Before(start) {
+		var (
+			offset = int(start.Sub(extent.Start) / step)
+			count  = int(cachedEnd.Sub(start)/step) + 1
+		)
+
+		for _, series := range extent.Series {
+			idx := seriesIndex(series.Name, series.Tags)
+			copy(merged[idx].Values, series.Values[offset:offset+count])
+		}
+	}
+
+	for _, series := range executed {
+		idx := seriesIndex(series.Name(), series.Tags)
+		values := series.Values()
+		for i := 0; i < values.Len(); i++ {
+			dp := values.DatapointAt(i)
+			if !dp.Timestamp.After(cachedEnd) || dp.Timestamp.After(end) {
+				continue
+			}
+
+			merged[idx].Values[int(dp.Timestamp.Sub(start)/step)] = dp.Value
+		}
+	}
+
+	return merged
+}
+
+// resultsCacheKey returns the key results are cached under for a range query,
+// and whether the query is cacheable at all. Only queries starting at a
+// multiple of their step are cached, so that cached steps line up between
+// requests, and queries whose results depend on the range being evaluated
+// (i.e. those using @ start() or @ end()) are never cached.
+func resultsCacheKey(parsed ParsedOptions) (string, bool) {
+	params := parsed.Params
+	if params.Step <= 0 || params.Debug ||
+		params.Start.Truncate(params.Step) != params.Start ||
+		!params.ExclusiveEnd().After(params.Start) {
+		return "", false
+	}
+
+	expr, err := pql.ParseExpr(params.Query)
+	if err != nil {
+		return "", false
+	}
+
+	rangeDependent := false
+	pql.Inspect(expr, func(node pql.Node, _ []pql.Node) error {
+		switch n := node.(type) {
+		case *pql.VectorSelector:
+			rangeDependent = rangeDependent || n.StartOrEnd != 0
+		case *pql.SubqueryExpr:
+			rangeDependent = rangeDependent || n.StartOrEnd != 0
+		}
+		return nil
+	})
+	if rangeDependent {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s:%v:%v:%d:%s:%s", expr.String(), params.Step,
+		params.LookbackDuration, params.BlockType,
+		fetchOptionsKey(parsed.FetchOpts), restrictKey(parsed.FetchOpts)), true
+}
+
+// fetchOptionsKey returns the part of the key for the per request fetch
+// options that change the results of a query, such as the fanout and limits.
+func fetchOptionsKey(fetchOpts *storage.FetchOptions) string {
+	if fetchOpts == nil {
+		return ""
+	}
+
+	key := fmt.Sprintf("limits=%d/%d/%d/%d/%v/%v", fetchOpts.SeriesLimit,
+		fetchOpts.DocsLimit, fetchOpts.ReturnedSeriesLimit,
+		fetchOpts.ReturnedDatapointsLimit, fetchOpts.RangeLimit,
+		fetchOpts.InstanceMultiple)
+
+	if fanout := fetchOpts.FanoutOptions; fanout != nil {
+		key += fmt.Sprintf("fanout=%d/%d/%d", fanout.FanoutUnaggregated,
+			fanout.FanoutAggregated, fanout.FanoutAggregatedOptimized)
+	}
+
+	return key
+}
+
+func restrictKey(fetchOpts *storage.FetchOptions) string {
+	if fetchOpts == nil || fetchOpts.RestrictQueryOptions == nil {
+		return ""
+	}
+
+	var (
+		restrict = fetchOpts.RestrictQueryOptions
+		key      string
+	)
+
+	if byType := restrict.GetRestrictByType(); byType != nil {
+		key += fmt.Sprintf("type=%v/%s", byType.MetricsType,
+			byType.StoragePolicy.String())
+	}
+
+	if byTag := restrict.GetRestrictByTag(); byTag != nil {
+		key += fmt.Sprintf("tags=%s", byTag.GetMatchers().String())
+		for _, name := range byTag.GetFilterByNames() {
+			key += fmt.Sprintf(",strip=%s", name)
+		}
+	}
+
+	return key
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/cache"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWithCacheExecutesUncachedTail(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		base     = xtime.Now().Truncate(time.Hour).Add(-2 * time.Hour)
+		executed []xtime.UnixNano
+	)
+
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().Options().Return(executor.NewEngineOptions()).AnyTimes()
+	engine.EXPECT().
+		ExecuteExpr(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context,
+			_ parser.Parser,
+			_ *executor.QueryOptions,
+			_ *storage.FetchOptions,
+			params models.RequestParams,
+		) (block.Block, error) {
+			executed = append(executed, params.Start)
+			bounds := models.Bounds{
+				Start:    params.Start,
+				Duration: params.ExclusiveEnd().Sub(params.Start),
+				StepSize: params.Step,
+			}
+
+			// NB: values are the number of steps since base, so results can be
+			// verified regardless of which portion of the range was executed.
+			var (
+				offset = int(bounds.Start.Sub(base) / params.Step)
+				values = make([]float64, bounds.Steps())
+			)
+			for i := range values {
+				values[i] = float64(offset + i)
+			}
+
+			meta := block.Metadata{
+				Bounds:         bounds,
+				Tags:           models.EmptyTags(),
+				ResultMetadata: block.NewResultMetadata(),
+			}
+
+			return test.NewBlockFromValuesWithMetaAndSeriesMeta(meta,
+				test.NewSeriesMeta("dummy", 1), [][]float64{values}), nil
+		}).
+		Times(2)
+
+	setup := newTestSetup(t, engine)
+	opts := setup.options.SetResultsCache(
+		cache.NewLRUResultsCache(cache.LRUResultsCacheOptions{}))
+
+	readRange := func(start, end time.Duration) []float64 {
+		parsed := ParsedOptions{
+			QueryOpts: setup.QueryOpts,
+			FetchOpts: setup.FetchOpts,
+			Params: models.RequestParams{
+				Query:      "dummy",
+				Start:      base.Add(start),
+				End:        base.Add(end),
+				Now:        base.Add(time.Hour).ToTime(),
+				Step:       time.Minute,
+				IncludeEnd: true,
+			},
+		}
+
+		result, err := readWithCache(context.Background(), parsed, opts)
+		require.NoError(t, err)
+		require.Len(t, result.Series, 1)
+
+		values := result.Series[0].Values()
+		actual := make([]float64, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			actual = append(actual, values.ValueAt(i))
+		}
+		return actual
+	}
+
+	expected := func(start, end int) []float64 {
+		values := make([]float64, 0, end-start+1)
+		for i := start; i <= end; i++ {
+			values = append(values, float64(i))
+		}
+		return values
+	}
+
+	assert.Equal(t, expected(0, 10), readRange(0, 10*time.Minute))
+	assert.Equal(t, expected(2, 15), readRange(2*time.Minute, 15*time.Minute))
+	// NB: this range is fully cached, so is served without executing.
+	assert.Equal(t, expected(5, 12), readRange(5*time.Minute, 12*time.Minute))
+
+	assert.Equal(t, []xtime.UnixNano{base, base.Add(11 * time.Minute)}, executed)
+}
+
+func TestMergeCachedResults(t *testing.T) {
+	var (
+		start  = xtime.Now().Truncate(time.Minute)
+		step   = time.Minute
+		tags   = models.MustMakeTags("foo", "bar")
+		extent = cache.Extent{
+			Start: start.Add(-step),
+			End:   start.Add(step),
+			Step:  step,
+			Series: []cache.Series{{
+				Name:   []byte("foo"),
+				Tags:   tags,
+				Values: []float64{0, 1, 2},
+			}},
+		}
+	)
+
+	merged := mergeCachedResults(extent, nil, start, start.Add(step),
+		start.Add(2*step), step)
+	require.Len(t, merged, 1)
+	assert.Equal(t, []byte("foo"), merged[0].Name)
+	test.EqualsWithNans(t, []float64{1, 2, math.NaN()}, merged[0].Values)
+}
+
+func TestResultsCacheKey(t *testing.T) {
+	start := xtime.Now().Truncate(time.Minute)
+	params := models.RequestParams{
+		Query:      "rate(foo[1m])",
+		Start:      start,
+		End:        start.Add(time.Hour),
+		Step:       time.Minute,
+		IncludeEnd: true,
+	}
+
+	key, ok := resultsCacheKey(ParsedOptions{Params: params})
+	require.True(t, ok)
+
+	// NB: queries are normalized before being used as keys.
+	params.Query = "rate( foo[1m] )"
+	normalized, ok := resultsCacheKey(ParsedOptions{Params: params})
+	require.True(t, ok)
+	assert.Equal(t, key, normalized)
+
+	// NB: per request parameters that change results are part of the key.
+	lookback := params
+	lookback.LookbackDuration = 10 * time.Minute
+	lookbackKey, ok := resultsCacheKey(ParsedOptions{Params: lookback})
+	require.True(t, ok)
+	assert.NotEqual(t, key, lookbackKey)
+
+	fetchOpts := storage.NewFetchOptions()
+	fetchOpts.SeriesLimit = 10
+	limitKey, ok := resultsCacheKey(ParsedOptions{
+		Params:    params,
+		FetchOpts: fetchOpts,
+	})
+	require.True(t, ok)
+	assert.NotEqual(t, key, limitKey)
+
+	params.Query = "foo @ end()"
+	_, ok = resultsCacheKey(ParsedOptions{Params: params})
+	assert.False(t, ok)
+
+	params.Query = "foo"
+	params.Start = start.Add(time.Second)
+	_, ok = resultsCacheKey(ParsedOptions{Params: params})
+	assert.False(t, ok)
+}
@@ -0,0 +1,449 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+
+	"github.com/m3db/m3/src/query/api/v1/handler"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/util/logging"
+	"github.com/m3db/m3/src/x/clock"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// BuildInfoURL is the url for the build information handler.
+	BuildInfoURL = handler.RoutePrefixV1 + "/status/buildinfo"
+
+	// RuntimeInfoURL is the url for the runtime information handler.
+	RuntimeInfoURL = handler.RoutePrefixV1 + "/status/runtimeinfo"
+
+	// FlagsURL is the url for the command line flags handler.
+	FlagsURL = handler.RoutePrefixV1 + "/status/flags"
+
+	// TSDBStatusURL is the url for the TSDB status handler.
+	TSDBStatusURL = handler.RoutePrefixV1 + "/status/tsdb"
+
+	// StatusHTTPMethod is the HTTP method used with the status resources.
+	StatusHTTPMethod = http.MethodGet
+
+	// tsdbStatusRange is the range of the index the TSDB status is computed
+	// over, matching the span of a Prometheus head block.
+	tsdbStatusRange = 2 * time.Hour
+
+	// tsdbStatusTopN is the default number of entries returned for each
+	// statistic.
+	tsdbStatusTopN = 10
+
+	tsdbStatusLimitParam = "limit"
+)
+
+type successResponse struct {
+	Status string      `json:"status"`
+	Data   interface{} `json:"data"`
+}
+
+// writeSuccessResponse writes data in the Prometheus API response format.
+func writeSuccessResponse(
+	w http.ResponseWriter,
+	data interface{},
+	instrumentOpts instrument.Options,
+) {
+	xhttp.WriteJSONResponse(w, successResponse{
+		Status: "success",
+		Data:   data,
+	}, instrumentOpts.Logger())
+}
+
+// BuildInfo is the build information of the running binary, in the format
+// of the Prometheus build information API.
+type BuildInfo struct {
+	// Version is the version the binary was built at.
+	Version string `json:"version"`
+	// Revision is the git revision the binary was built at.
+	Revision string `json:"revision"`
+	// Branch is the git branch the binary was built from.
+	Branch string `json:"branch"`
+	// BuildUser is left empty since it is not recorded at build time.
+	BuildUser string `json:"buildUser"`
+	// BuildDate is the date the binary was built.
+	BuildDate string `json:"buildDate"`
+	// GoVersion is the version of Go the binary was built with.
+	GoVersion string `json:"goVersion"`
+}
+
+// buildInfoHandler returns the build information of the running binary.
+type buildInfoHandler struct {
+	instrumentOpts instrument.Options
+}
+
+// NewBuildInfoHandler returns a new build information handler, which serves
+// the version and revision of the running binary.
+func NewBuildInfoHandler(opts options.HandlerOptions) http.Handler {
+	return &buildInfoHandler{
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *buildInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeSuccessResponse(w, BuildInfo{
+		Version:   instrument.Version,
+		Revision:  instrument.Revision,
+		Branch:    instrument.Branch,
+		BuildDate: instrument.BuildDate,
+		GoVersion: runtime.Version(),
+	}, h.instrumentOpts)
+}
+
+// RuntimeInfo is the runtime information of the running process, in the
+// format of the Prometheus runtime information API.
+type RuntimeInfo struct {
+	// StartTime is when the process started serving.
+	StartTime time.Time `json:"startTime"`
+	// CWD is the working directory of the process.
+	CWD string `json:"CWD"`
+	// ReloadConfigSuccess is always true since configuration is only loaded
+	// at startup.
+	ReloadConfigSuccess bool `json:"reloadConfigSuccess"`
+	// LastConfigTime is when the configuration was loaded.
+	LastConfigTime time.Time `json:"lastConfigTime"`
+	// GoroutineCount is the current number of goroutines.
+	GoroutineCount int `json:"goroutineCount"`
+	// GOMAXPROCS is the current GOMAXPROCS setting.
+	GOMAXPROCS int `json:"GOMAXPROCS"`
+	// GOGC is the value of the GOGC environment variable.
+	GOGC string `json:"GOGC"`
+	// GODEBUG is the value of the GODEBUG environment variable.
+	GODEBUG string `json:"GODEBUG"`
+	// StorageRetention is left empty since retention is configured per
+	// namespace.
+	StorageRetention string `json:"storageRetention"`
+}
+
+// runtimeInfoHandler returns the runtime information of the running process.
+type runtimeInfoHandler struct {
+	startTime      time.Time
+	instrumentOpts instrument.Options
+}
+
+// NewRuntimeInfoHandler returns a new runtime information handler, which
+// serves the start time and Go runtime settings of the running process.
+func NewRuntimeInfoHandler(opts options.HandlerOptions) http.Handler {
+	return &runtimeInfoHandler{
+		startTime:      opts.CreatedAt(),
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *runtimeInfoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		cwd = err.Error()
+	}
+
+	writeSuccessResponse(w, RuntimeInfo{
+		StartTime: h.startTime,
+		CWD:       cwd,
+		// Configuration is only loaded at startup.
+		ReloadConfigSuccess: true,
+		LastConfigTime:      h.startTime,
+		GoroutineCount:      runtime.NumGoroutine(),
+		GOMAXPROCS:          runtime.GOMAXPROCS(0),
+		GOGC:                os.Getenv("GOGC"),
+		GODEBUG:             os.Getenv("GODEBUG"),
+		// Retention is configured per namespace rather than globally.
+		StorageRetention: "",
+	}, h.instrumentOpts)
+}
+
+// flagsHandler returns the command line flags of the running process.
+type flagsHandler struct {
+	flags          *flag.FlagSet
+	instrumentOpts instrument.Options
+}
+
+// NewFlagsHandler returns a new flags handler, which serves the values of
+// the command line flags of the running process keyed by flag name.
+func NewFlagsHandler(opts options.HandlerOptions) http.Handler {
+	return &flagsHandler{
+		flags:          flag.CommandLine,
+		instrumentOpts: opts.InstrumentOpts(),
+	}
+}
+
+func (h *flagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flags := make(map[string]string)
+	h.flags.VisitAll(func(f *flag.Flag) {
+		flags[f.Name] = f.Value.String()
+	})
+
+	writeSuccessResponse(w, flags, h.instrumentOpts)
+}
+
+// TSDBStatus is the cardinality statistics of the index, in the format of
+// the Prometheus TSDB status API.
+type TSDBStatus struct {
+	// HeadStats summarizes the range the statistics are computed over.
+	HeadStats TSDBHeadStats `json:"headStats"`
+	// SeriesCountByMetricName is the metric names with the most series.
+	SeriesCountByMetricName []TSDBStat `json:"seriesCountByMetricName"`
+	// LabelValueCountByLabelName is the label names with the most values.
+	LabelValueCountByLabelName []TSDBStat `json:"labelValueCountByLabelName"`
+	// MemoryInBytesByLabelName is the label names whose values take up the
+	// most bytes.
+	MemoryInBytesByLabelName []TSDBStat `json:"memoryInBytesByLabelName"`
+	// SeriesCountByLabelValuePair is the label pairs with the most series.
+	SeriesCountByLabelValuePair []TSDBStat `json:"seriesCountByLabelValuePair"`
+}
+
+// TSDBHeadStats is the summary of the range the TSDB status is computed over.
+type TSDBHeadStats struct {
+	// NumSeries is the number of series in the range.
+	NumSeries uint64 `json:"numSeries"`
+	// NumLabelPairs is the number of distinct label pairs in the range.
+	NumLabelPairs int `json:"numLabelPairs"`
+	// ChunkCount is always zero since chunks are not tracked by the index.
+	ChunkCount int64 `json:"chunkCount"`
+	// MinTime is the start of the range in milliseconds.
+	MinTime int64 `json:"minTime"`
+	// MaxTime is the end of the range in milliseconds.
+	MaxTime int64 `json:"maxTime"`
+}
+
+// TSDBStat is a single named statistic.
+type TSDBStat struct {
+	// Name is what the statistic is for, such as a metric or label name.
+	Name string `json:"name"`
+	// Value is the value of the statistic.
+	Value uint64 `json:"value"`
+}
+
+// tsdbStatusHandler returns the cardinality statistics of the index.
+type tsdbStatusHandler struct {
+	storage             storage.Storage
+	clusters            m3.Clusters
+	fetchOptionsBuilder handleroptions.FetchOptionsBuilder
+	tagOptions          models.TagOptions
+	nowFn               clock.NowFn
+	instrumentOpts      instrument.Options
+}
+
+// NewTSDBStatusHandler returns a new TSDB status handler, which serves the
+// cardinality statistics of the index over the last two hours, computed by
+// the database nodes where possible and from tag completion otherwise.
+func NewTSDBStatusHandler(opts options.HandlerOptions) http.Handler {
+	return &tsdbStatusHandler{
+		storage:             opts.Storage(),
+		clusters:            opts.Clusters(),
+		fetchOptionsBuilder: opts.FetchOptionsBuilder(),
+		tagOptions:          opts.TagOptions(),
+		nowFn:               opts.NowFn(),
+		instrumentOpts:      opts.InstrumentOpts(),
+	}
+}
+
+func (h *tsdbStatusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, opts, rErr := h.fetchOptionsBuilder.NewFetchOptions(r.Context(), r)
+	if rErr != nil {
+		xhttp.WriteError(w, rErr)
+		return
+	}
+
+	limit := tsdbStatusTopN
+	if str := r.FormValue(tsdbStatusLimitParam); str != "" {
+		value, err := strconv.Atoi(str)
+		if err == nil && value <= 0 {
+			err = fmt.Errorf("expected positive limit, instead got: %d", value)
+		}
+		if err != nil {
+			xhttp.WriteError(w, xerrors.NewInvalidParamsError(
+				fmt.Errorf(formatErrStr, tsdbStatusLimitParam, err)))
+			return
+		}
+		limit = value
+	}
+
+	var (
+		end    = h.nowFn()
+		start  = end.Add(-tsdbStatusRange)
+		status = TSDBStatus{
+			HeadStats: TSDBHeadStats{
+				MinTime: start.UnixNano() / int64(time.Millisecond),
+				MaxTime: end.UnixNano() / int64(time.Millisecond),
+			},
+		}
+	)
+
+	if !h.indexStats(ctx, start, end, limit, &status) {
+		if err := h.completeTagsStats(ctx, opts, start, end, limit, &status); err != nil {
+			logger := logging.WithContext(ctx, h.instrumentOpts)
+			logger.Error("unable to complete tags", zap.Error(err))
+			if errors.IsTimeout(err) {
+				err = errors.NewErrQueryTimeout(err)
+			}
+			xhttp.WriteError(w, err)
+			return
+		}
+	}
+
+	writeSuccessResponse(w, status, h.instrumentOpts)
+}
+
+// indexStats fills in the status with the cardinality stats that the
+// database nodes compute from the index of the unaggregated namespace, it
+// returns false if the stats are not available.
+func (h *tsdbStatusHandler) indexStats(
+	ctx context.Context,
+	start, end time.Time,
+	limit int,
+	status *TSDBStatus,
+) bool {
+	if h.clusters == nil {
+		return false
+	}
+
+	ns, ok := h.clusters.UnaggregatedClusterNamespace()
+	if !ok {
+		return false
+	}
+
+	var metricNameTag []byte
+	if h.tagOptions != nil {
+		metricNameTag = h.tagOptions.MetricName()
+	}
+
+	summary, err := ns.Session().IndexStats(ns.NamespaceID(), client.IndexStatsOptions{
+		StartInclusive: xtime.ToUnixNano(start),
+		EndExclusive:   xtime.ToUnixNano(end),
+		Limit:          limit,
+		MetricNameTag:  metricNameTag,
+	})
+	if err != nil {
+		logger := logging.WithContext(ctx, h.instrumentOpts)
+		logger.Warn("unable to fetch index stats, falling back to tag completion",
+			zap.Error(err))
+		return false
+	}
+
+	status.HeadStats.NumSeries = uint64(summary.NumSeries)
+	status.HeadStats.NumLabelPairs = int(summary.NumLabelPairs)
+	status.SeriesCountByMetricName = toTSDBStats(summary.SeriesCountByMetricName)
+	status.LabelValueCountByLabelName = toTSDBStats(summary.LabelValueCountByLabelName)
+	status.MemoryInBytesByLabelName = toTSDBStats(summary.MemoryInBytesByLabelName)
+	status.SeriesCountByLabelValuePair = toTSDBStats(summary.SeriesCountByLabelValuePair)
+	return true
+}
+
+// completeTagsStats fills in the status with the label stats derivable
+// from tag completion, which does not provide series counts.
+func (h *tsdbStatusHandler) completeTagsStats(
+	ctx context.Context,
+	opts *storage.FetchOptions,
+	start, end time.Time,
+	limit int,
+	status *TSDBStatus,
+) error {
+	query := &storage.CompleteTagsQuery{
+		TagMatchers: models.Matchers{{Type: models.MatchAll}},
+		Start:       xtime.ToUnixNano(start),
+		End:         xtime.ToUnixNano(end),
+	}
+
+	result, err := h.storage.CompleteTags(ctx, query, opts)
+	if err != nil {
+		return err
+	}
+
+	var (
+		valueCounts = make([]TSDBStat, 0, len(result.CompletedTags))
+		valueBytes  = make([]TSDBStat, 0, len(result.CompletedTags))
+	)
+	for _, tag := range result.CompletedTags {
+		var size int
+		for _, value := range tag.Values {
+			size += len(value)
+		}
+
+		name := string(tag.Name)
+		valueCounts = append(valueCounts, TSDBStat{
+			Name:  name,
+			Value: uint64(len(tag.Values)),
+		})
+		valueBytes = append(valueBytes, TSDBStat{
+			Name:  name,
+			Value: uint64(size),
+		})
+		status.HeadStats.NumLabelPairs += len(tag.Values)
+	}
+
+	status.SeriesCountByMetricName = []TSDBStat{}
+	status.LabelValueCountByLabelName = topTSDBStats(valueCounts, limit)
+	status.MemoryInBytesByLabelName = topTSDBStats(valueBytes, limit)
+	status.SeriesCountByLabelValuePair = []TSDBStat{}
+	return nil
+}
+
+func toTSDBStats(stats []index.CardinalityStat) []TSDBStat {
+	result := make([]TSDBStat, 0, len(stats))
+	for _, stat := range stats {
+		result = append(result, TSDBStat{
+			Name:  stat.Name,
+			Value: uint64(stat.Value),
+		})
+	}
+	return result
+}
+
+// topTSDBStats returns the n largest stats, breaking ties by name.
+func topTSDBStats(stats []TSDBStat, n int) []TSDBStat {
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Value != stats[j].Value {
+			return stats[i].Value > stats[j].Value
+		}
+		return stats[i].Name < stats[j].Name
+	})
+	if len(stats) > n {
+		stats = stats[:n]
+	}
+	return stats
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package native
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeSuccessResponse(t *testing.T, w *httptest.ResponseRecorder, data interface{}) {
+	resp := w.Result()
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result struct {
+		Status string          `json:"status"`
+		Data   json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.Equal(t, "success", result.Status)
+	require.NoError(t, json.Unmarshal(result.Data, data))
+}
+
+func TestBuildInfoHandler(t *testing.T) {
+	h := NewBuildInfoHandler(options.EmptyHandlerOptions())
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, BuildInfoURL, nil))
+
+	var info BuildInfo
+	decodeSuccessResponse(t, w, &info)
+	assert.Equal(t, BuildInfo{
+		Version:   instrument.Version,
+		Revision:  instrument.Revision,
+		Branch:    instrument.Branch,
+		BuildDate: instrument.BuildDate,
+		GoVersion: runtime.Version(),
+	}, info)
+}
+
+func TestRuntimeInfoHandler(t *testing.T) {
+	opts := options.EmptyHandlerOptions()
+	h := NewRuntimeInfoHandler(opts)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, RuntimeInfoURL, nil))
+
+	var info RuntimeInfo
+	decodeSuccessResponse(t, w, &info)
+	assert.True(t, opts.CreatedAt().Equal(info.StartTime))
+	assert.True(t, info.ReloadConfigSuccess)
+	assert.Equal(t, runtime.GOMAXPROCS(0), info.GOMAXPROCS)
+	assert.True(t, info.GoroutineCount > 0)
+	assert.NotEmpty(t, info.CWD)
+}
+
+func TestFlagsHandler(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.String("f", "", "configuration file")
+	require.NoError(t, flags.Parse([]string{"-f", "config.yml"}))
+
+	h := &flagsHandler{
+		flags:          flags,
+		instrumentOpts: instrument.NewOptions(),
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, FlagsURL, nil))
+
+	var result map[string]string
+	decodeSuccessResponse(t, w, &result)
+	assert.Equal(t, map[string]string{"f": "config.yml"}, result)
+}
+
+func TestTSDBStatusHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now   = time.Unix(1600000000, 0)
+		store = storage.NewMockStorage(ctrl)
+	)
+	store.EXPECT().
+		CompleteTags(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ interface{},
+			q *storage.CompleteTagsQuery,
+			_ interface{},
+		) (*consolidators.CompleteTagsResult, error) {
+			assert.False(t, q.CompleteNameOnly)
+			assert.Equal(t, now.Add(-tsdbStatusRange).UnixNano(), int64(q.Start))
+			assert.Equal(t, now.UnixNano(), int64(q.End))
+			return &consolidators.CompleteTagsResult{
+				CompletedTags: []consolidators.CompletedTag{
+					{Name: b("__name__"), Values: [][]byte{b("up"), b("requests")}},
+					{Name: b("job"), Values: [][]byte{b("api")}},
+					{Name: b("instance"), Values: [][]byte{b("a:1"), b("b:1")}},
+				},
+			}, nil
+		})
+
+	fb, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+
+	h := NewTSDBStatusHandler(options.EmptyHandlerOptions().
+		SetStorage(store).
+		SetFetchOptionsBuilder(fb).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(func() time.Time { return now }))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, TSDBStatusURL, nil))
+
+	var status TSDBStatus
+	decodeSuccessResponse(t, w, &status)
+	assert.Equal(t, TSDBStatus{
+		HeadStats: TSDBHeadStats{
+			NumLabelPairs: 5,
+			MinTime:       now.Add(-tsdbStatusRange).UnixNano() / int64(time.Millisecond),
+			MaxTime:       now.UnixNano() / int64(time.Millisecond),
+		},
+		SeriesCountByMetricName: []TSDBStat{},
+		LabelValueCountByLabelName: []TSDBStat{
+			{Name: "__name__", Value: 2},
+			{Name: "instance", Value: 2},
+			{Name: "job", Value: 1},
+		},
+		MemoryInBytesByLabelName: []TSDBStat{
+			{Name: "__name__", Value: 10},
+			{Name: "instance", Value: 6},
+			{Name: "job", Value: 3},
+		},
+		SeriesCountByLabelValuePair: []TSDBStat{},
+	}, status)
+}
+
+func TestTSDBStatusHandlerIndexStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		now     = time.Unix(1600000000, 0)
+		session = client.NewMockSession(ctrl)
+	)
+	session.EXPECT().
+		IndexStats(ident.NewIDMatcher("metrics"), client.IndexStatsOptions{
+			StartInclusive: xtime.ToUnixNano(now.Add(-tsdbStatusRange)),
+			EndExclusive:   xtime.ToUnixNano(now),
+			Limit:          2,
+			MetricNameTag:  []byte("__name__"),
+		}).
+		Return(index.CardinalityStatsSummary{
+			NumSeries:     3,
+			NumLabelPairs: 4,
+			SeriesCountByMetricName: []index.CardinalityStat{
+				{Name: "up", Value: 2},
+				{Name: "requests", Value: 1},
+			},
+			LabelValueCountByLabelName: []index.CardinalityStat{
+				{Name: "__name__", Value: 2},
+				{Name: "job", Value: 2},
+			},
+			MemoryInBytesByLabelName: []index.CardinalityStat{
+				{Name: "__name__", Value: 10},
+				{Name: "job", Value: 5},
+			},
+			SeriesCountByLabelValuePair: []index.CardinalityStat{
+				{Name: "job=api", Value: 2},
+				{Name: "__name__=up", Value: 2},
+			},
+		}, nil)
+
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	fb, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+
+	h := NewTSDBStatusHandler(options.EmptyHandlerOptions().
+		SetClusters(clusters).
+		SetFetchOptionsBuilder(fb).
+		SetTagOptions(models.NewTagOptions()).
+		SetNowFn(func() time.Time { return now }))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, TSDBStatusURL+"?limit=2", nil))
+
+	var status TSDBStatus
+	decodeSuccessResponse(t, w, &status)
+	assert.Equal(t, TSDBStatus{
+		HeadStats: TSDBHeadStats{
+			NumSeries:     3,
+			NumLabelPairs: 4,
+			MinTime:       now.Add(-tsdbStatusRange).UnixNano() / int64(time.Millisecond),
+			MaxTime:       now.UnixNano() / int64(time.Millisecond),
+		},
+		SeriesCountByMetricName: []TSDBStat{
+			{Name: "up", Value: 2},
+			{Name: "requests", Value: 1},
+		},
+		LabelValueCountByLabelName: []TSDBStat{
+			{Name: "__name__", Value: 2},
+			{Name: "job", Value: 2},
+		},
+		MemoryInBytesByLabelName: []TSDBStat{
+			{Name: "__name__", Value: 10},
+			{Name: "job", Value: 5},
+		},
+		SeriesCountByLabelValuePair: []TSDBStat{
+			{Name: "job=api", Value: 2},
+			{Name: "__name__=up", Value: 2},
+		},
+	}, status)
+}
+
+func TestTSDBStatusHandlerInvalidLimit(t *testing.T) {
+	fb, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{Timeout: 15 * time.Second})
+	require.NoError(t, err)
+
+	h := NewTSDBStatusHandler(options.EmptyHandlerOptions().
+		SetFetchOptionsBuilder(fb))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(StatusHTTPMethod, TSDBStatusURL+"?limit=0", nil))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
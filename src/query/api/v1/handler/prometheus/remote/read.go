@@ -124,6 +124,21 @@ func (h *promReadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	responseType, err := negotiateResponseType(req.GetAcceptedResponseTypes())
+	if err != nil {
+		h.promReadMetrics.incError(err)
+		logger.Error("remote read response type negotiation error",
+			zap.Error(err),
+			zap.Any("req", req))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	if responseType == prompb.ReadRequest_STREAMED_XOR_CHUNKS {
+		h.serveStreamedChunks(ctx, w, req, fetchOpts, logger)
+		return
+	}
+
 	readResult, err := Read(ctx, req, fetchOpts, h.opts)
 	if err != nil {
 		h.promReadMetrics.incError(err)
@@ -438,15 +453,7 @@ func Read(
 		cancelFuncs  = make([]context.CancelFunc, queryCount)
 		queryResults = make([]*prompb.QueryResult, queryCount)
 		meta         = block.NewResultMetadata()
-		queryOpts    = &executor.QueryOptions{
-			QueryContextOptions: models.QueryContextOptions{
-				LimitMaxTimeseries:             fetchOpts.SeriesLimit,
-				LimitMaxDocs:                   fetchOpts.DocsLimit,
-				LimitMaxReturnedSeries:         fetchOpts.ReturnedSeriesLimit,
-				LimitMaxReturnedDatapoints:     fetchOpts.ReturnedDatapointsLimit,
-				LimitMaxReturnedSeriesMetadata: fetchOpts.ReturnedSeriesMetadataLimit,
-			},
-		}
+		queryOpts    = newQueryOptions(fetchOpts)
 
 		engine = opts.Engine()
 
@@ -503,6 +510,18 @@ func Read(
 	return ReadResult{Result: queryResults, Meta: meta}, nil
 }
 
+func newQueryOptions(fetchOpts *storage.FetchOptions) *executor.QueryOptions {
+	return &executor.QueryOptions{
+		QueryContextOptions: models.QueryContextOptions{
+			LimitMaxTimeseries:             fetchOpts.SeriesLimit,
+			LimitMaxDocs:                   fetchOpts.DocsLimit,
+			LimitMaxReturnedSeries:         fetchOpts.ReturnedSeriesLimit,
+			LimitMaxReturnedDatapoints:     fetchOpts.ReturnedDatapointsLimit,
+			LimitMaxReturnedSeriesMetadata: fetchOpts.ReturnedSeriesMetadataLimit,
+		},
+	}
+}
+
 // filterResults removes series tags based on options.
 func filterResults(
 	series []*prompb.TimeSeries,
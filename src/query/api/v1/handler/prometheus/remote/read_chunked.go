@@ -0,0 +1,516 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	queryerrors "github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"go.uber.org/zap"
+)
+
+const (
+	// ContentTypeStreamedChunks is the content type of a STREAMED_XOR_CHUNKS
+	// remote read response.
+	ContentTypeStreamedChunks = "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse"
+
+	// maxSamplesInChunk is the number of samples at which Prometheus cuts
+	// its own XOR chunks.
+	maxSamplesInChunk = 120
+
+	// maxBytesInFrame is the size of encoded chunks after which a series is
+	// continued in a new frame, matching the Prometheus remote read server.
+	maxBytesInFrame = 1024 * 1024
+)
+
+var (
+	castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+	errStreamingNotSupported = errors.New("response writer does not support streaming")
+)
+
+// negotiateResponseType returns the first response type accepted by the
+// client which is supported, defaulting to SAMPLES when none are given.
+func negotiateResponseType(
+	accepted []prompb.ReadRequest_ResponseType,
+) (prompb.ReadRequest_ResponseType, error) {
+	if len(accepted) == 0 {
+		return prompb.ReadRequest_SAMPLES, nil
+	}
+
+	for _, responseType := range accepted {
+		switch responseType {
+		case prompb.ReadRequest_SAMPLES, prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+			return responseType, nil
+		}
+	}
+
+	return 0, xerrors.NewInvalidParamsError(fmt.Errorf(
+		"none of the accepted response types are supported: %v", accepted))
+}
+
+func (h *promReadHandler) serveStreamedChunks(
+	ctx context.Context,
+	w http.ResponseWriter,
+	req *prompb.ReadRequest,
+	fetchOpts *storage.FetchOptions,
+	logger *zap.Logger,
+) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.promReadMetrics.incError(errStreamingNotSupported)
+		logger.Error("remote read streaming error", zap.Error(errStreamingNotSupported))
+		xhttp.WriteError(w, errStreamingNotSupported)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchOpts.Timeout)
+	defer cancel()
+
+	results, meta, err := fetchChunked(ctx, req, fetchOpts, h.opts)
+	if err != nil {
+		h.promReadMetrics.incError(err)
+		logger.Error("remote read query error",
+			zap.Error(err),
+			zap.Any("req", req),
+			zap.Any("fetchOpts", fetchOpts))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	defer closeChunkedResults(results)
+
+	// Write headers before response.
+	err = handleroptions.AddDBResultResponseHeaders(w, meta, fetchOpts)
+	if err != nil {
+		h.promReadMetrics.incError(err)
+		logger.Error("remote read query write response header error",
+			zap.Error(err),
+			zap.Any("req", req),
+			zap.Any("fetchOpts", fetchOpts))
+		xhttp.WriteError(w, err)
+		return
+	}
+
+	w.Header().Set(xhttp.HeaderContentType, ContentTypeStreamedChunks)
+	writer := newChunkedWriter(w, flusher)
+	tagOpts := h.opts.TagOptions()
+	for i, result := range results {
+		if err := result.stream(writer, int64(i), fetchOpts, tagOpts); err != nil {
+			h.promReadMetrics.incError(err)
+			logger.Error("remote read stream error",
+				zap.Error(err),
+				zap.Int("framesWritten", writer.framesWritten),
+				zap.Any("req", req),
+				zap.Any("fetchOpts", fetchOpts))
+			// NB: once a frame has been sent the status code and headers are
+			// already written, and an error body would be read by the client
+			// as a corrupt frame; stop writing so the stream is truncated.
+			if writer.framesWritten == 0 {
+				xhttp.WriteError(w, err)
+			}
+			return
+		}
+	}
+
+	h.promReadMetrics.fetchSuccess.Inc(1)
+}
+
+// compressedFetcher is implemented by storages which can return compressed
+// series iterators, letting chunks be encoded as series are decompressed
+// rather than after decompressing every series of a query.
+type compressedFetcher interface {
+	FetchCompressed(
+		ctx context.Context,
+		query *storage.FetchQuery,
+		options *storage.FetchOptions,
+	) (consolidators.SeriesFetchResult, m3.Cleanup, error)
+}
+
+// chunkedResult is the result of a single query of a streamed read, holding
+// either compressed series iterators or, when the storage can not provide
+// those, already decompressed series.
+type chunkedResult struct {
+	compressed *consolidators.SeriesFetchResult
+	cleanup    m3.Cleanup
+	series     []*prompb.TimeSeries
+}
+
+func fetchChunked(
+	ctx context.Context,
+	req *prompb.ReadRequest,
+	fetchOpts *storage.FetchOptions,
+	opts options.HandlerOptions,
+) ([]chunkedResult, block.ResultMetadata, error) {
+	var (
+		results = make([]chunkedResult, 0, len(req.Queries))
+		meta    = block.NewResultMetadata()
+	)
+
+	for _, promQuery := range req.Queries {
+		query, err := storage.PromReadQueryToM3(promQuery)
+		if err != nil {
+			closeChunkedResults(results)
+			return nil, meta, err
+		}
+
+		result, resultMeta, err := fetchChunkedQuery(ctx, query, fetchOpts, opts)
+		if err != nil {
+			closeChunkedResults(results)
+			return nil, meta, err
+		}
+
+		results = append(results, result)
+		meta = meta.CombineMetadata(resultMeta)
+	}
+
+	return results, meta, nil
+}
+
+func fetchChunkedQuery(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	fetchOpts *storage.FetchOptions,
+	opts options.HandlerOptions,
+) (chunkedResult, block.ResultMetadata, error) {
+	if fetcher, ok := opts.Storage().(compressedFetcher); ok {
+		result, cleanup, err := fetcher.FetchCompressed(ctx, query, fetchOpts)
+		if err == nil {
+			if err := result.Verify(); err != nil {
+				cleanup()
+				return chunkedResult{}, result.Metadata, err
+			}
+
+			return chunkedResult{
+				compressed: &result,
+				cleanup:    cleanup,
+			}, result.Metadata, nil
+		}
+
+		if err != queryerrors.ErrNotImplemented {
+			return chunkedResult{}, result.Metadata, err
+		}
+	}
+
+	result, err := opts.Engine().ExecuteProm(ctx, query,
+		newQueryOptions(fetchOpts), fetchOpts)
+	if err != nil {
+		return chunkedResult{}, result.Metadata, err
+	}
+
+	return chunkedResult{
+		series: filterResults(result.PromResult.GetTimeseries(), fetchOpts),
+	}, result.Metadata, nil
+}
+
+func closeChunkedResults(results []chunkedResult) {
+	for _, result := range results {
+		if result.cleanup != nil {
+			result.cleanup()
+		}
+	}
+}
+
+// stream writes the series of the result in label order, as required by
+// clients merging streamed series.
+func (r chunkedResult) stream(
+	w *chunkedWriter,
+	queryIndex int64,
+	fetchOpts *storage.FetchOptions,
+	tagOpts models.TagOptions,
+) error {
+	if r.compressed == nil {
+		for _, series := range r.series {
+			series.Labels = sortLabels(series.Labels)
+		}
+
+		sort.Slice(r.series, func(i, j int) bool {
+			return labelsLess(r.series[i].Labels, r.series[j].Labels)
+		})
+
+		for _, series := range r.series {
+			iter := &promSamplesIterator{samples: series.Samples, idx: -1}
+			if err := w.writeSeries(queryIndex, series.Labels, iter); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var filterByNames [][]byte
+	if fetchOpts != nil {
+		filterByNames = fetchOpts.RestrictQueryOptions.GetRestrictByTag().GetFilterByNames()
+	}
+
+	// NB: only labels are resolved upfront so that series are written in
+	// order; datapoints are decompressed one series at a time.
+	var (
+		count = r.compressed.Count()
+		refs  = make([]seriesRef, 0, count)
+	)
+	for i := 0; i < count; i++ {
+		_, tags, err := r.compressed.IterTagsAtIndex(i, tagOpts)
+		if err != nil {
+			return err
+		}
+
+		labels := filterLabels(storage.TagsToPromLabels(tags), filterByNames)
+		refs = append(refs, seriesRef{idx: i, labels: sortLabels(labels)})
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		return labelsLess(refs[i].labels, refs[j].labels)
+	})
+
+	for _, ref := range refs {
+		iter, _, err := r.compressed.IterTagsAtIndex(ref.idx, tagOpts)
+		if err != nil {
+			return err
+		}
+
+		samples := &seriesIteratorSamples{iter: iter}
+		if err := w.writeSeries(queryIndex, ref.labels, samples); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type seriesRef struct {
+	idx    int
+	labels []prompb.Label
+}
+
+func sortLabels(labels []prompb.Label) []prompb.Label {
+	sort.Slice(labels, func(i, j int) bool {
+		return bytes.Compare(labels[i].Name, labels[j].Name) < 0
+	})
+
+	return labels
+}
+
+func labelsLess(a, b []prompb.Label) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := bytes.Compare(a[i].Name, b[i].Name); c != 0 {
+			return c < 0
+		}
+
+		if c := bytes.Compare(a[i].Value, b[i].Value); c != 0 {
+			return c < 0
+		}
+	}
+
+	return len(a) < len(b)
+}
+
+// sampleIterator iterates over the samples of a single series in time order.
+type sampleIterator interface {
+	Next() bool
+	At() (int64, float64)
+	Err() error
+}
+
+type seriesIteratorSamples struct {
+	iter encoding.SeriesIterator
+}
+
+func (it *seriesIteratorSamples) Next() bool {
+	return it.iter.Next()
+}
+
+func (it *seriesIteratorSamples) At() (int64, float64) {
+	dp, _, _ := it.iter.Current()
+	return storage.TimeToPromTimestamp(dp.TimestampNanos), dp.Value
+}
+
+func (it *seriesIteratorSamples) Err() error {
+	return it.iter.Err()
+}
+
+type promSamplesIterator struct {
+	samples []prompb.Sample
+	idx     int
+}
+
+func (it *promSamplesIterator) Next() bool {
+	it.idx++
+	return it.idx < len(it.samples)
+}
+
+func (it *promSamplesIterator) At() (int64, float64) {
+	sample := it.samples[it.idx]
+	return sample.Timestamp, sample.Value
+}
+
+func (it *promSamplesIterator) Err() error {
+	return nil
+}
+
+// chunkedWriter writes the frames of a streamed read response; each frame is
+// the uvarint size of the message, its big-endian CRC32 Castagnoli checksum
+// and the message itself, and is flushed once written.
+type chunkedWriter struct {
+	writer        io.Writer
+	flusher       http.Flusher
+	buf           []byte
+	framesWritten int
+}
+
+func newChunkedWriter(w io.Writer, f http.Flusher) *chunkedWriter {
+	return &chunkedWriter{writer: w, flusher: f}
+}
+
+// writeSeries encodes the samples of a series into XOR chunks, writing a
+// frame whenever the chunks exceed maxBytesInFrame and once the samples are
+// exhausted. Series without samples are skipped.
+func (w *chunkedWriter) writeSeries(
+	queryIndex int64,
+	labels []prompb.Label,
+	iter sampleIterator,
+) error {
+	var (
+		chunks     []prompb.Chunk
+		frameBytes int
+		chunk      *chunkenc.XORChunk
+		app        chunkenc.Appender
+		minTime    int64
+		maxTime    int64
+	)
+
+	cutChunk := func() {
+		data := chunk.Bytes()
+		chunks = append(chunks, prompb.Chunk{
+			MinTimeMs: minTime,
+			MaxTimeMs: maxTime,
+			Type:      prompb.Chunk_XOR,
+			Data:      data,
+		})
+		frameBytes += len(data)
+		chunk = nil
+	}
+
+	for iter.Next() {
+		t, v := iter.At()
+		if chunk == nil {
+			chunk = chunkenc.NewXORChunk()
+			var err error
+			if app, err = chunk.Appender(); err != nil {
+				return err
+			}
+
+			minTime = t
+		}
+
+		app.Append(t, v)
+		maxTime = t
+		if chunk.NumSamples() < maxSamplesInChunk {
+			continue
+		}
+
+		cutChunk()
+		if frameBytes < maxBytesInFrame {
+			continue
+		}
+
+		if err := w.writeFrame(queryIndex, labels, chunks); err != nil {
+			return err
+		}
+
+		chunks = chunks[:0]
+		frameBytes = 0
+	}
+
+	if err := iter.Err(); err != nil {
+		return err
+	}
+
+	if chunk != nil {
+		cutChunk()
+	}
+
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	return w.writeFrame(queryIndex, labels, chunks)
+}
+
+func (w *chunkedWriter) writeFrame(
+	queryIndex int64,
+	labels []prompb.Label,
+	chunks []prompb.Chunk,
+) error {
+	resp := &prompb.ChunkedReadResponse{
+		ChunkedSeries: []*prompb.ChunkedSeries{
+			{Labels: labels, Chunks: chunks},
+		},
+		QueryIndex: queryIndex,
+	}
+
+	size := resp.Size()
+	if cap(w.buf) < size {
+		w.buf = make([]byte, size)
+	}
+
+	n, err := resp.MarshalTo(w.buf[:size])
+	if err != nil {
+		return err
+	}
+
+	data := w.buf[:n]
+	var header [binary.MaxVarintLen64 + 4]byte
+	headerLen := binary.PutUvarint(header[:], uint64(len(data)))
+	binary.BigEndian.PutUint32(header[headerLen:], crc32.Checksum(data, castagnoliTable))
+	if _, err := w.writer.Write(header[:headerLen+4]); err != nil {
+		return err
+	}
+
+	if _, err := w.writer.Write(data); err != nil {
+		return err
+	}
+
+	w.flusher.Flush()
+	w.framesWritten++
+	return nil
+}
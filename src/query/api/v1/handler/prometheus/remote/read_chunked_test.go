@@ -0,0 +1,468 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
+	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/block"
+	queryerrors "github.com/m3db/m3/src/query/errors"
+	"github.com/m3db/m3/src/query/executor"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/test"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/headers"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestNegotiateResponseType(t *testing.T) {
+	tests := []struct {
+		accepted []prompb.ReadRequest_ResponseType
+		expected prompb.ReadRequest_ResponseType
+		err      bool
+	}{
+		{
+			expected: prompb.ReadRequest_SAMPLES,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{
+				prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+				prompb.ReadRequest_SAMPLES,
+			},
+			expected: prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{
+				5, prompb.ReadRequest_SAMPLES,
+			},
+			expected: prompb.ReadRequest_SAMPLES,
+		},
+		{
+			accepted: []prompb.ReadRequest_ResponseType{5},
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		actual, err := negotiateResponseType(tt.accepted)
+		if tt.err {
+			require.Error(t, err)
+			assert.True(t, xerrors.IsInvalidParams(err))
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, actual)
+	}
+}
+
+type readChunk struct {
+	minTime, maxTime int64
+	samples          []prompb.Sample
+}
+
+type readSeries struct {
+	queryIndex int64
+	labels     []prompb.Label
+	chunks     []readChunk
+}
+
+func readChunkedResponse(t *testing.T, r io.Reader) []readSeries {
+	var (
+		reader = bufio.NewReader(r)
+		result []readSeries
+	)
+
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			return result
+		}
+		require.NoError(t, err)
+
+		var checksum uint32
+		require.NoError(t, binary.Read(reader, binary.BigEndian, &checksum))
+		data := make([]byte, size)
+		_, err = io.ReadFull(reader, data)
+		require.NoError(t, err)
+		require.Equal(t, checksum, crc32.Checksum(data, castagnoliTable))
+
+		var resp prompb.ChunkedReadResponse
+		require.NoError(t, resp.Unmarshal(data))
+		for _, series := range resp.ChunkedSeries {
+			s := readSeries{queryIndex: resp.QueryIndex, labels: series.Labels}
+			for _, c := range series.Chunks {
+				require.Equal(t, prompb.Chunk_XOR, c.Type)
+				chunk, err := chunkenc.FromData(chunkenc.EncXOR, c.Data)
+				require.NoError(t, err)
+
+				readChunk := readChunk{minTime: c.MinTimeMs, maxTime: c.MaxTimeMs}
+				it := chunk.Iterator(nil)
+				for it.Next() {
+					ts, v := it.At()
+					readChunk.samples = append(readChunk.samples,
+						prompb.Sample{Timestamp: ts, Value: v})
+				}
+				require.NoError(t, it.Err())
+				s.chunks = append(s.chunks, readChunk)
+			}
+
+			result = append(result, s)
+		}
+	}
+}
+
+func newStreamedReadRequest(t *testing.T, queries ...*prompb.Query) *http.Request {
+	req := &prompb.ReadRequest{
+		Queries: queries,
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{
+			prompb.ReadRequest_STREAMED_XOR_CHUNKS,
+		},
+	}
+
+	data, err := req.Marshal()
+	require.NoError(t, err)
+	return httptest.NewRequest(http.MethodPost, PromReadURL,
+		bytes.NewReader(snappy.Encode(nil, data)))
+}
+
+func newStreamedReadHandler(
+	t *testing.T,
+	engine executor.Engine,
+	store storage.Storage,
+) http.Handler {
+	fetchOptsBuilder, err := handleroptions.NewFetchOptionsBuilder(
+		handleroptions.FetchOptionsBuilderOptions{
+			Timeout: 15 * time.Second,
+		})
+	require.NoError(t, err)
+
+	opts := options.EmptyHandlerOptions().
+		SetEngine(engine).
+		SetStorage(store).
+		SetTagOptions(models.NewTagOptions()).
+		SetFetchOptionsBuilder(fetchOptsBuilder)
+	return NewPromReadHandler(opts)
+}
+
+func generateSamples(start int64, n int) []prompb.Sample {
+	samples := make([]prompb.Sample, 0, n)
+	for i := 0; i < n; i++ {
+		samples = append(samples, prompb.Sample{
+			Timestamp: start + int64(i)*1000,
+			Value:     float64(i),
+		})
+	}
+
+	return samples
+}
+
+func TestPromReadStreamedChunks(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	samples := generateSamples(1000, 250)
+	result := storage.PromResult{
+		PromResult: &prompb.QueryResult{
+			Timeseries: []*prompb.TimeSeries{
+				{
+					Labels: []prompb.Label{
+						{Name: []byte("z"), Value: []byte("1")},
+						{Name: []byte("a"), Value: []byte("2")},
+					},
+					Samples: samples[:1],
+				},
+				{
+					Labels:  []prompb.Label{{Name: []byte("a"), Value: []byte("1")}},
+					Samples: samples,
+				},
+			},
+		},
+		Metadata: block.ResultMetadata{
+			Exhaustive: false,
+			LocalOnly:  true,
+		},
+	}
+
+	engine := executor.NewMockEngine(ctrl)
+	engine.EXPECT().
+		ExecuteProm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(result, nil)
+
+	// NB: storages which can not fetch compressed series fall back to the
+	// engine.
+	store := m3.NewMockStorage(ctrl)
+	store.EXPECT().
+		FetchCompressed(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(consolidators.SeriesFetchResult{}, nil, queryerrors.ErrNotImplemented)
+
+	handler := newStreamedReadHandler(t, engine, store)
+	req := newStreamedReadRequest(t, &prompb.Query{
+		StartTimestampMs: 0,
+		EndTimestampMs:   300000,
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.Equal(t, ContentTypeStreamedChunks,
+		recorder.Header().Get(xhttp.HeaderContentType))
+	assert.Equal(t, "", recorder.Header().Get("Content-Encoding"))
+	assert.Equal(t, headers.LimitHeaderSeriesLimitApplied,
+		recorder.Header().Get(headers.LimitHeader))
+
+	series := readChunkedResponse(t, recorder.Body)
+	require.Equal(t, 2, len(series))
+
+	assert.Equal(t, []prompb.Label{{Name: []byte("a"), Value: []byte("1")}},
+		series[0].labels)
+	require.Equal(t, 3, len(series[0].chunks))
+	var actual []prompb.Sample
+	for i, chunk := range series[0].chunks {
+		expected := samples[i*maxSamplesInChunk:]
+		if len(expected) > maxSamplesInChunk {
+			expected = expected[:maxSamplesInChunk]
+		}
+
+		assert.Equal(t, expected[0].Timestamp, chunk.minTime)
+		assert.Equal(t, expected[len(expected)-1].Timestamp, chunk.maxTime)
+		actual = append(actual, chunk.samples...)
+	}
+	assert.Equal(t, samples, actual)
+
+	assert.Equal(t, []prompb.Label{
+		{Name: []byte("a"), Value: []byte("2")},
+		{Name: []byte("z"), Value: []byte("1")},
+	}, series[1].labels)
+	require.Equal(t, 1, len(series[1].chunks))
+	assert.Equal(t, samples[:1], series[1].chunks[0].samples)
+}
+
+func TestPromReadStreamedChunksCompressed(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	start := xtime.Now().Truncate(time.Hour)
+	dps := make([]test.Datapoint, 0, 130)
+	for i := 0; i < 130; i++ {
+		dps = append(dps, test.Datapoint{
+			Value:  float64(i),
+			Offset: time.Duration(i) * time.Second,
+		})
+	}
+
+	iterB, _, err := test.BuildCustomIterator([][]test.Datapoint{dps},
+		map[string]string{"__name__": "foo", "instance": "b"},
+		"b", "ns", start, time.Hour, time.Second)
+	require.NoError(t, err)
+	iterA, _, err := test.BuildCustomIterator([][]test.Datapoint{dps[:2]},
+		map[string]string{"__name__": "foo", "instance": "a"},
+		"a", "ns", start, time.Hour, time.Second)
+	require.NoError(t, err)
+
+	fetchResult, err := consolidators.NewSeriesFetchResult(
+		encoding.NewSeriesIterators([]encoding.SeriesIterator{iterB, iterA}, nil),
+		nil, block.NewResultMetadata())
+	require.NoError(t, err)
+
+	cleaned := false
+	store := m3.NewMockStorage(ctrl)
+	store.EXPECT().
+		FetchCompressed(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(fetchResult, m3.Cleanup(func() error {
+			cleaned = true
+			return nil
+		}), nil)
+
+	// NB: the engine must not be used when compressed series are available.
+	engine := executor.NewMockEngine(ctrl)
+	handler := newStreamedReadHandler(t, engine, store)
+	req := newStreamedReadRequest(t, &prompb.Query{
+		StartTimestampMs: storage.TimeToPromTimestamp(start),
+		EndTimestampMs:   storage.TimeToPromTimestamp(start.Add(time.Hour)),
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	assert.True(t, cleaned)
+
+	series := readChunkedResponse(t, recorder.Body)
+	require.Equal(t, 2, len(series))
+
+	expectedLabels := func(instance string) []prompb.Label {
+		return []prompb.Label{
+			{Name: []byte("__name__"), Value: []byte("foo")},
+			{Name: []byte("instance"), Value: []byte(instance)},
+		}
+	}
+
+	assert.Equal(t, expectedLabels("a"), series[0].labels)
+	require.Equal(t, 1, len(series[0].chunks))
+	assert.Equal(t, 2, len(series[0].chunks[0].samples))
+
+	assert.Equal(t, expectedLabels("b"), series[1].labels)
+	require.Equal(t, 2, len(series[1].chunks))
+	assert.Equal(t, maxSamplesInChunk, len(series[1].chunks[0].samples))
+	assert.Equal(t, 10, len(series[1].chunks[1].samples))
+	last := series[1].chunks[1].samples[9]
+	assert.Equal(t, float64(129), last.Value)
+	assert.Equal(t, storage.TimeToPromTimestamp(start.Add(129*time.Second)),
+		last.Timestamp)
+}
+
+func TestPromReadStreamedChunksStreamError(t *testing.T) {
+	start := xtime.Now().Truncate(time.Hour)
+	streamErr := errors.New("decode error")
+	newFailingIter := func(ctrl *gomock.Controller) encoding.SeriesIterator {
+		iter := encoding.NewMockSeriesIterator(ctrl)
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Next().Return(false)
+		iter.EXPECT().Current().
+			Return(ts.Datapoint{TimestampNanos: start, Value: 1}, xtime.Second, nil)
+		iter.EXPECT().Err().Return(streamErr)
+		return iter
+	}
+
+	tagsFor := func(instance string) *models.Tags {
+		tags := models.NewTags(2, nil).
+			AddTag(models.Tag{Name: []byte("__name__"), Value: []byte("foo")}).
+			AddTag(models.Tag{Name: []byte("instance"), Value: []byte(instance)})
+		return &tags
+	}
+
+	serve := func(
+		t *testing.T,
+		ctrl *gomock.Controller,
+		iters []encoding.SeriesIterator,
+		tags []*models.Tags,
+	) (*httptest.ResponseRecorder, tally.TestScope) {
+		fetchResult, err := consolidators.NewSeriesFetchResult(
+			encoding.NewSeriesIterators(iters, nil), tags, block.NewResultMetadata())
+		require.NoError(t, err)
+
+		store := m3.NewMockStorage(ctrl)
+		store.EXPECT().
+			FetchCompressed(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(fetchResult, m3.Cleanup(func() error { return nil }), nil)
+
+		scope := tally.NewTestScope("", nil)
+		handler := newStreamedReadHandler(t, executor.NewMockEngine(ctrl), store)
+		handler.(*promReadHandler).promReadMetrics = newPromReadMetrics(scope)
+		req := newStreamedReadRequest(t, &prompb.Query{
+			StartTimestampMs: storage.TimeToPromTimestamp(start),
+			EndTimestampMs:   storage.TimeToPromTimestamp(start.Add(time.Hour)),
+		})
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder, scope
+	}
+
+	serverErrors := func(scope tally.TestScope) int64 {
+		counter, ok := scope.Snapshot().Counters()["fetch.errors+code=5XX"]
+		if !ok {
+			return 0
+		}
+		return counter.Value()
+	}
+
+	t.Run("before first frame", func(t *testing.T) {
+		ctrl := xtest.NewController(t)
+		defer ctrl.Finish()
+
+		recorder, scope := serve(t, ctrl,
+			[]encoding.SeriesIterator{newFailingIter(ctrl)},
+			[]*models.Tags{tagsFor("a")})
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), streamErr.Error())
+		assert.Equal(t, int64(1), serverErrors(scope))
+	})
+
+	t.Run("after first frame", func(t *testing.T) {
+		ctrl := xtest.NewController(t)
+		defer ctrl.Finish()
+
+		iterA, _, err := test.BuildCustomIterator(
+			[][]test.Datapoint{{{Value: 1}, {Value: 2, Offset: time.Second}}},
+			map[string]string{"__name__": "foo", "instance": "a"},
+			"a", "ns", start, time.Hour, time.Second)
+		require.NoError(t, err)
+
+		recorder, scope := serve(t, ctrl,
+			[]encoding.SeriesIterator{newFailingIter(ctrl), iterA},
+			[]*models.Tags{tagsFor("b"), tagsFor("a")})
+
+		// NB: the stream is truncated after the frames already sent, with no
+		// error body which would be read as a corrupt frame.
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, ContentTypeStreamedChunks,
+			recorder.Header().Get(xhttp.HeaderContentType))
+		series := readChunkedResponse(t, recorder.Body)
+		require.Equal(t, 1, len(series))
+		assert.Equal(t, []byte("a"), series[0].labels[1].Value)
+		assert.Equal(t, int64(1), serverErrors(scope))
+	})
+}
+
+func TestPromReadUnsupportedResponseType(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	handler := newStreamedReadHandler(t, executor.NewMockEngine(ctrl), nil)
+	data, err := (&prompb.ReadRequest{
+		Queries:               []*prompb.Query{{EndTimestampMs: 1000}},
+		AcceptedResponseTypes: []prompb.ReadRequest_ResponseType{5},
+	}).Marshal()
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, PromReadURL,
+		bytes.NewReader(snappy.Encode(nil, data)))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
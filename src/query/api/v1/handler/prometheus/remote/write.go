@@ -23,6 +23,7 @@ package remote
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,9 +40,12 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/generated/proto/prompb/writev2"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/exemplar"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/util/logging"
 	"github.com/m3db/m3/src/x/clock"
@@ -107,18 +111,21 @@ var (
 
 // PromWriteHandler represents a handler for prometheus write endpoint.
 type PromWriteHandler struct {
-	downsamplerAndWriter   ingest.DownsamplerAndWriter
-	tagOptions             models.TagOptions
-	storeMetricsType       bool
-	forwarding             handleroptions.PromWriteHandlerForwardingOptions
-	forwardTimeout         time.Duration
-	forwardHTTPClient      *http.Client
-	forwardingBoundWorkers xsync.WorkerPool
-	forwardContext         context.Context
-	forwardRetrier         retry.Retrier
-	nowFn                  clock.NowFn
-	instrumentOpts         instrument.Options
-	metrics                promWriteMetrics
+	downsamplerAndWriter    ingest.DownsamplerAndWriter
+	tagOptions              models.TagOptions
+	storeMetricsType        bool
+	forwarding              handleroptions.PromWriteHandlerForwardingOptions
+	forwardTimeout          time.Duration
+	forwardHTTPClient       *http.Client
+	forwardingBoundWorkers  xsync.WorkerPool
+	forwardContext          context.Context
+	forwardRetrier          retry.Retrier
+	metadataStore           metricmetadata.Store
+	exemplarStore           exemplar.Store
+	ingestCreatedTimestamps bool
+	nowFn                   clock.NowFn
+	instrumentOpts          instrument.Options
+	metrics                 promWriteMetrics
 }
 
 // NewPromWriteHandler returns a new instance of handler.
@@ -177,18 +184,21 @@ func NewPromWriteHandler(options options.HandlerOptions) (http.Handler, error) {
 	)
 
 	return &PromWriteHandler{
-		downsamplerAndWriter:   downsamplerAndWriter,
-		tagOptions:             tagOptions,
-		storeMetricsType:       options.StoreMetricsType(),
-		forwarding:             forwarding,
-		forwardTimeout:         forwardTimeout,
-		forwardHTTPClient:      xhttp.NewHTTPClient(forwardHTTPOpts),
-		forwardingBoundWorkers: forwardingBoundWorkers,
-		forwardContext:         context.Background(),
-		forwardRetrier:         retry.NewRetrier(forwardRetryOpts),
-		nowFn:                  nowFn,
-		metrics:                metrics,
-		instrumentOpts:         instrumentOpts,
+		downsamplerAndWriter:    downsamplerAndWriter,
+		tagOptions:              tagOptions,
+		storeMetricsType:        options.StoreMetricsType(),
+		forwarding:              forwarding,
+		forwardTimeout:          forwardTimeout,
+		forwardHTTPClient:       xhttp.NewHTTPClient(forwardHTTPOpts),
+		forwardingBoundWorkers:  forwardingBoundWorkers,
+		forwardContext:          context.Background(),
+		forwardRetrier:          retry.NewRetrier(forwardRetryOpts),
+		metadataStore:           options.MetricMetadataStore(),
+		exemplarStore:           options.ExemplarStore(),
+		ingestCreatedTimestamps: options.Config().PrometheusRemoteWrite.IngestCreatedTimestamps,
+		nowFn:                   nowFn,
+		metrics:                 metrics,
+		instrumentOpts:          instrumentOpts,
 	}, nil
 }
 
@@ -204,6 +214,7 @@ type promWriteMetrics struct {
 	forwardErrors            tally.Counter
 	forwardDropped           tally.Counter
 	forwardLatency           tally.Histogram
+	exemplarErrors           tally.Counter
 }
 
 func (m *promWriteMetrics) incError(err error) {
@@ -231,6 +242,7 @@ func newPromWriteMetrics(scope tally.Scope) (promWriteMetrics, error) {
 		forwardErrors:            scope.SubScope("forward").Counter("errors"),
 		forwardDropped:           scope.SubScope("forward").Counter("dropped"),
 		forwardLatency:           scope.SubScope("forward").Histogram("latency", buckets.WriteLatencyBuckets),
+		exemplarErrors:           scope.SubScope("exemplars").Counter("errors"),
 	}, nil
 }
 
@@ -274,6 +286,10 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						age := now.Sub(storage.PromTimestampToTime(sample.Timestamp))
 						h.metrics.forwardLatency.RecordDuration(age)
 					}
+					for _, histogram := range series.Histograms {
+						age := now.Sub(storage.PromTimestampToTime(histogram.Timestamp))
+						h.metrics.forwardLatency.RecordDuration(age)
+					}
 				}
 
 				if err != nil {
@@ -299,6 +315,25 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Metadata is sent by Prometheus either alongside series or in
+	// metadata-only requests, both of which are recorded here.
+	if h.metadataStore != nil {
+		h.metadataStore.Update(req.Metadata)
+	}
+
+	// Exemplars failing to be written do not fail the request, since the
+	// samples they belong to are written independently.
+	exemplarsWritten := false
+	if h.exemplarStore != nil {
+		if err := h.appendExemplars(r.Context(), req); err != nil {
+			h.metrics.exemplarErrors.Inc(1)
+			logger := logging.WithContext(r.Context(), h.instrumentOpts)
+			logger.Error("exemplar write error", zap.Error(err))
+		} else {
+			exemplarsWritten = true
+		}
+	}
+
 	batchErr := h.write(r.Context(), req, opts)
 
 	// Record ingestion delay latency
@@ -308,6 +343,10 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			age := now.Sub(storage.PromTimestampToTime(sample.Timestamp))
 			h.metrics.ingestLatency.RecordDuration(age)
 		}
+		for _, histogram := range series.Histograms {
+			age := now.Sub(storage.PromTimestampToTime(histogram.Timestamp))
+			h.metrics.ingestLatency.RecordDuration(age)
+		}
 	}
 
 	if batchErr != nil {
@@ -369,6 +408,15 @@ func (h *PromWriteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if checkedReq.ProtoMsg == remoteWriteV2ProtoMsg {
+		stats := checkedReq.Stats
+		if !exemplarsWritten {
+			// Exemplars are dropped unless they are stored.
+			stats.exemplars = 0
+		}
+		stats.setHeaders(w.Header())
+	}
+
 	// NB(schallert): this is frustrating but if we don't explicitly write an HTTP
 	// status code (or via Write()), OpenTracing middleware reports code=0 and
 	// shows up as error.
@@ -380,12 +428,21 @@ type parseRequestResult struct {
 	Request        *prompb.WriteRequest
 	Options        ingest.WriteOptions
 	CompressResult prometheus.ParsePromCompressedRequestResult
+	// ProtoMsg is the protobuf message the request was encoded with.
+	ProtoMsg string
+	// Stats are the counts reported back to remote write 2.0 requests.
+	Stats writeStats
 }
 
 func (h *PromWriteHandler) checkedParseRequest(
 	r *http.Request,
 ) (parseRequestResult, error) {
-	result, err := h.parseRequest(r)
+	protoMsg, err := parseRemoteWriteProtoMsg(r.Header.Get(xhttp.HeaderContentType))
+	if err != nil {
+		return parseRequestResult{}, xhttp.NewError(err, http.StatusUnsupportedMediaType)
+	}
+
+	result, err := h.parseRequest(r, protoMsg)
 	if err != nil {
 		// Always invalid request if parsing fails params.
 		return parseRequestResult{}, xerrors.NewInvalidParamsError(err)
@@ -402,6 +459,7 @@ func (h *PromWriteHandler) checkedParseRequest(
 // uphold the same guarantees.
 func (h *PromWriteHandler) parseRequest(
 	r *http.Request,
+	protoMsg string,
 ) (parseRequestResult, error) {
 	var opts ingest.WriteOptions
 	if v := strings.TrimSpace(r.Header.Get(headers.MetricsTypeHeader)); v != "" {
@@ -455,9 +513,26 @@ func (h *PromWriteHandler) parseRequest(
 		return parseRequestResult{}, err
 	}
 
-	var req prompb.WriteRequest
-	if err := proto.Unmarshal(result.UncompressedBody, &req); err != nil {
-		return parseRequestResult{}, err
+	var (
+		req   *prompb.WriteRequest
+		stats writeStats
+	)
+	switch protoMsg {
+	case remoteWriteV2ProtoMsg:
+		var reqV2 writev2.Request
+		if err := proto.Unmarshal(result.UncompressedBody, &reqV2); err != nil {
+			return parseRequestResult{}, err
+		}
+		req, stats, err = writeV2RequestToWriteRequest(&reqV2, h.ingestCreatedTimestamps)
+		if err != nil {
+			return parseRequestResult{}, err
+		}
+	default:
+		req = &prompb.WriteRequest{}
+		body := normalizeV1Exemplars(result.UncompressedBody)
+		if err := proto.Unmarshal(body, req); err != nil {
+			return parseRequestResult{}, err
+		}
 	}
 
 	if mapStr := r.Header.Get(headers.MapTagsByJSONHeader); mapStr != "" {
@@ -466,7 +541,7 @@ func (h *PromWriteHandler) parseRequest(
 			return parseRequestResult{}, err
 		}
 
-		if err := mapTags(&req, opts); err != nil {
+		if err := mapTags(req, opts); err != nil {
 			return parseRequestResult{}, err
 		}
 	}
@@ -482,9 +557,11 @@ func (h *PromWriteHandler) parseRequest(
 	}
 
 	return parseRequestResult{
-		Request:        &req,
+		Request:        req,
 		Options:        opts,
 		CompressResult: result,
+		ProtoMsg:       protoMsg,
+		Stats:          stats,
 	}, nil
 }
 
@@ -501,6 +578,215 @@ func (h *PromWriteHandler) write(
 	return h.downsamplerAndWriter.WriteBatch(ctx, iter, opts)
 }
 
+// appendExemplars writes the exemplars of the series of the request.
+func (h *PromWriteHandler) appendExemplars(
+	ctx context.Context,
+	req *prompb.WriteRequest,
+) error {
+	var series []exemplar.SeriesExemplars
+	for _, promTS := range req.Timeseries {
+		if len(promTS.Exemplars) == 0 {
+			continue
+		}
+
+		exemplars := make([]exemplar.Exemplar, 0, len(promTS.Exemplars))
+		for _, e := range promTS.Exemplars {
+			labels := make([]models.Tag, 0, len(e.Labels))
+			for _, l := range e.Labels {
+				labels = append(labels, models.Tag{Name: l.Name, Value: l.Value})
+			}
+
+			exemplars = append(exemplars, exemplar.Exemplar{
+				Labels:    labels,
+				Value:     e.Value,
+				Timestamp: xtime.ToUnixNano(storage.PromTimestampToTime(e.Timestamp)),
+			})
+		}
+
+		series = append(series, exemplar.SeriesExemplars{
+			Tags:      storage.PromLabelsToM3Tags(promTS.Labels, h.tagOptions),
+			Exemplars: exemplars,
+		})
+	}
+
+	if len(series) == 0 {
+		return nil
+	}
+
+	return h.exemplarStore.Append(ctx, series)
+}
+
+const (
+	// protoWireTypeLengthDelimited is the wire type of protobuf bytes, strings
+	// and embedded messages.
+	protoWireTypeLengthDelimited = 2
+
+	// writeRequestTimeseriesField is the field number of the series of a
+	// remote write request.
+	writeRequestTimeseriesField = 1
+
+	// promExemplarsField is the field number of the exemplars of a series in
+	// the Prometheus remote write 1.0 protocol, which M3 uses for the metric
+	// type of the series.
+	promExemplarsField = 3
+
+	// m3ExemplarsField is the field number of the exemplars of a series in
+	// M3's remote write protocol.
+	m3ExemplarsField = 105
+)
+
+// normalizeV1Exemplars moves the exemplars that Prometheus encodes as field 3
+// of each series of a remote write 1.0 request to the field M3 decodes them
+// from, since field 3 holds the metric type in M3's remote write protocol.
+// The two are told apart by their wire type: exemplars are embedded messages
+// while the metric type is a varint. Requests without such exemplars, and
+// malformed requests which fail to decode regardless, are returned as is.
+func normalizeV1Exemplars(data []byte) []byte {
+	if !hasV1Exemplars(data) {
+		return data
+	}
+
+	normalized := make([]byte, 0, len(data)+len(data)/8)
+	for len(data) > 0 {
+		field, fieldLen, payloadStart, ok := nextProtoField(data)
+		if !ok {
+			return data
+		}
+
+		if field.num != writeRequestTimeseriesField ||
+			field.wireType != protoWireTypeLengthDelimited {
+			normalized = append(normalized, data[:fieldLen]...)
+			data = data[fieldLen:]
+			continue
+		}
+
+		series, ok := normalizeV1SeriesExemplars(data[payloadStart:fieldLen])
+		if !ok {
+			return data
+		}
+
+		normalized = appendProtoTag(normalized, writeRequestTimeseriesField,
+			protoWireTypeLengthDelimited)
+		normalized = appendProtoVarint(normalized, uint64(len(series)))
+		normalized = append(normalized, series...)
+		data = data[fieldLen:]
+	}
+
+	return normalized
+}
+
+func hasV1Exemplars(data []byte) bool {
+	for len(data) > 0 {
+		field, fieldLen, payloadStart, ok := nextProtoField(data)
+		if !ok {
+			return false
+		}
+
+		if field.num == writeRequestTimeseriesField &&
+			field.wireType == protoWireTypeLengthDelimited {
+			series := data[payloadStart:fieldLen]
+			for len(series) > 0 {
+				seriesField, seriesFieldLen, _, ok := nextProtoField(series)
+				if !ok {
+					return false
+				}
+				if seriesField.num == promExemplarsField &&
+					seriesField.wireType == protoWireTypeLengthDelimited {
+					return true
+				}
+				series = series[seriesFieldLen:]
+			}
+		}
+
+		data = data[fieldLen:]
+	}
+
+	return false
+}
+
+func normalizeV1SeriesExemplars(data []byte) ([]byte, bool) {
+	normalized := make([]byte, 0, len(data)+len(data)/8)
+	for len(data) > 0 {
+		field, fieldLen, _, ok := nextProtoField(data)
+		if !ok {
+			return nil, false
+		}
+
+		if field.num != promExemplarsField ||
+			field.wireType != protoWireTypeLengthDelimited {
+			normalized = append(normalized, data[:fieldLen]...)
+			data = data[fieldLen:]
+			continue
+		}
+
+		normalized = appendProtoTag(normalized, m3ExemplarsField,
+			protoWireTypeLengthDelimited)
+		normalized = append(normalized, data[field.tagLen:fieldLen]...)
+		data = data[fieldLen:]
+	}
+
+	return normalized, true
+}
+
+type protoField struct {
+	num      uint64
+	wireType uint64
+	tagLen   int
+}
+
+// nextProtoField decodes the field at the start of data, returning the field,
+// its encoded length and, for length delimited fields, the offset of its
+// payload.
+func nextProtoField(data []byte) (protoField, int, int, bool) {
+	tag, tagLen := binary.Uvarint(data)
+	if tagLen <= 0 {
+		return protoField{}, 0, 0, false
+	}
+
+	field := protoField{num: tag >> 3, wireType: tag & 0x7, tagLen: tagLen}
+	switch field.wireType {
+	case 0:
+		_, n := binary.Uvarint(data[tagLen:])
+		if n <= 0 {
+			return protoField{}, 0, 0, false
+		}
+		return field, tagLen + n, 0, true
+	case 1:
+		if len(data) < tagLen+8 {
+			return protoField{}, 0, 0, false
+		}
+		return field, tagLen + 8, 0, true
+	case protoWireTypeLengthDelimited:
+		length, n := binary.Uvarint(data[tagLen:])
+		if n <= 0 {
+			return protoField{}, 0, 0, false
+		}
+		payloadStart := tagLen + n
+		if length > uint64(len(data)-payloadStart) {
+			return protoField{}, 0, 0, false
+		}
+		return field, payloadStart + int(length), payloadStart, true
+	case 5:
+		if len(data) < tagLen+4 {
+			return protoField{}, 0, 0, false
+		}
+		return field, tagLen + 4, 0, true
+	default:
+		// NB: groups are deprecated and never used by remote write.
+		return protoField{}, 0, 0, false
+	}
+}
+
+func appendProtoTag(b []byte, num, wireType uint64) []byte {
+	return appendProtoVarint(b, num<<3|wireType)
+}
+
+func appendProtoVarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(b, buf[:n]...)
+}
+
 func (h *PromWriteHandler) forward(
 	ctx context.Context,
 	request prometheus.ParsePromCompressedRequestResult,
@@ -527,6 +813,17 @@ func (h *PromWriteHandler) forward(
 				req.Header.Add(h, header.Get(h))
 			}
 		}
+
+		// The content type and protocol version determine the message the
+		// target decodes the request with.
+		for _, h := range []string{
+			xhttp.HeaderContentType,
+			headers.PrometheusRemoteWriteVersionHeader,
+		} {
+			if v := header.Get(h); v != "" {
+				req.Header.Set(h, v)
+			}
+		}
 	}
 
 	if targetHeaders := target.Headers; targetHeaders != nil {
@@ -566,6 +863,7 @@ func newPromTSIter(
 		tags             = make([]models.Tags, 0, len(timeseries))
 		datapoints       = make([]ts.Datapoints, 0, len(timeseries))
 		seriesAttributes = make([]ts.SeriesAttributes, 0, len(timeseries))
+		annotations      = make([][]byte, 0, len(timeseries))
 	)
 
 	graphiteTagOpts := tagOpts.SetIDSchemeType(models.TypeGraphite)
@@ -581,9 +879,36 @@ func newPromTSIter(
 			opts = graphiteTagOpts
 		}
 
-		seriesAttributes = append(seriesAttributes, attributes)
-		tags = append(tags, storage.PromLabelsToM3Tags(promTS.Labels, opts))
-		datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(promTS.Samples))
+		seriesTags := storage.PromLabelsToM3Tags(promTS.Labels, opts)
+		if len(promTS.Samples) > 0 || len(promTS.Histograms) == 0 {
+			seriesAttributes = append(seriesAttributes, attributes)
+			tags = append(tags, seriesTags)
+			datapoints = append(datapoints, storage.PromSamplesToM3Datapoints(promTS.Samples))
+			annotations = append(annotations, nil)
+		}
+
+		// NB: each native histogram sample is written as a datapoint holding
+		// its count, annotated with the encoded histogram. Annotations apply
+		// to all datapoints of a write, so each sample is written on its own.
+		for _, h := range promTS.Histograms {
+			dp, annotation, err := storage.PromHistogramToM3Datapoint(h)
+			if err != nil {
+				return nil, xerrors.NewInvalidParamsError(err)
+			}
+
+			histogramAttributes := attributes
+			histogramAttributes.PromType = ts.PromMetricTypeHistogram
+			histogramAttributes.HandleValueResets = true
+			if h.ResetHint == prompb.Histogram_GAUGE {
+				histogramAttributes.PromType = ts.PromMetricTypeGaugeHistogram
+				histogramAttributes.HandleValueResets = false
+			}
+
+			seriesAttributes = append(seriesAttributes, histogramAttributes)
+			tags = append(tags, seriesTags)
+			datapoints = append(datapoints, ts.Datapoints{dp})
+			annotations = append(annotations, annotation)
+		}
 	}
 
 	return &promTSIter{
@@ -591,18 +916,20 @@ func newPromTSIter(
 		idx:              -1,
 		tags:             tags,
 		datapoints:       datapoints,
+		annotations:      annotations,
 		storeMetricsType: storeMetricsType,
 	}, nil
 }
 
 type promTSIter struct {
-	idx        int
-	err        error
-	attributes []ts.SeriesAttributes
-	tags       []models.Tags
-	datapoints []ts.Datapoints
-	metadatas  []ts.Metadata
-	annotation []byte
+	idx         int
+	err         error
+	attributes  []ts.SeriesAttributes
+	tags        []models.Tags
+	datapoints  []ts.Datapoints
+	annotations [][]byte
+	metadatas   []ts.Metadata
+	annotation  []byte
 
 	storeMetricsType bool
 }
@@ -617,7 +944,14 @@ func (i *promTSIter) Next() bool {
 		return false
 	}
 
+	if i.idx < len(i.annotations) && i.annotations[i.idx] != nil {
+		// Native histogram samples always carry their annotation.
+		i.annotation = i.annotations[i.idx]
+		return true
+	}
+
 	if !i.storeMetricsType {
+		i.annotation = nil
 		return true
 	}
 
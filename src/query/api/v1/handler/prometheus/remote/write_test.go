@@ -34,6 +34,7 @@ import (
 
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
+	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/metrics/policy"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
@@ -41,14 +42,21 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/options"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/exemplar"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	xclock "github.com/m3db/m3/src/x/clock"
 	xerrors "github.com/m3db/m3/src/x/errors"
 	"github.com/m3db/m3/src/x/headers"
+	"github.com/m3db/m3/src/x/ident"
 	"github.com/m3db/m3/src/x/instrument"
 	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/uber-go/tally"
@@ -80,7 +88,7 @@ func TestPromWriteParsing(t *testing.T) {
 	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
 	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
 
-	r, err := handler.(*PromWriteHandler).parseRequest(req)
+	r, err := handler.(*PromWriteHandler).parseRequest(req, remoteWriteV1ProtoMsg)
 	require.Nil(t, err, "unable to parse request")
 	require.Equal(t, len(r.Request.Timeseries), 2)
 	require.Equal(t, ingest.WriteOptions{}, r.Options)
@@ -109,6 +117,283 @@ func TestPromWrite(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPromWriteMetadata(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	store := metricmetadata.NewStore(metricmetadata.StoreOptions{})
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetMetricMetadataStore(store)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReq := &prompb.WriteRequest{
+		Metadata: []prompb.MetricMetadata{{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "first",
+			Help:             "The first metric.",
+		}},
+	}
+	promReqBody := test.GeneratePromWriteRequestBody(t, promReq)
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assert.Equal(t, map[string][]metricmetadata.Metadata{
+		"first": {{Type: "counter", Help: "The first metric."}},
+	}, store.Metadata("", 0))
+}
+
+func newTestExemplarStore(t *testing.T, session client.Session) exemplar.Store {
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	return exemplar.NewStore(exemplar.StoreOptions{Clusters: clusters})
+}
+
+func newTestExemplarWriteRequest(now xtime.UnixNano) *prompb.WriteRequest {
+	return &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels: []prompb.Label{
+				{Name: []byte("__name__"), Value: []byte("latency")},
+			},
+			Samples: []prompb.Sample{
+				{Value: 1, Timestamp: storage.TimeToPromTimestamp(now)},
+			},
+			Exemplars: []prompb.Exemplar{{
+				Labels: []prompb.Label{
+					{Name: []byte("trace_id"), Value: []byte("abc")},
+				},
+				Value:     1,
+				Timestamp: storage.TimeToPromTimestamp(now),
+			}},
+		}},
+	}
+}
+
+func expectTestExemplarWrite(
+	t *testing.T,
+	session *client.MockSession,
+	now xtime.UnixNano,
+) {
+	session.EXPECT().
+		WriteTagged(ident.NewIDMatcher(exemplar.DefaultNamespace),
+			gomock.Any(), gomock.Any(), now, float64(1), xtime.Millisecond,
+			gomock.Any()).
+		DoAndReturn(func(
+			_, _ ident.ID,
+			_ ident.TagIterator,
+			_ xtime.UnixNano,
+			_ float64,
+			_ xtime.Unit,
+			annotation []byte,
+		) error {
+			var e prompb.Exemplar
+			require.NoError(t, e.Unmarshal(annotation))
+			assert.Equal(t, []prompb.Label{
+				{Name: []byte("trace_id"), Value: []byte("abc")},
+			}, e.Labels)
+			return nil
+		})
+}
+
+// newTestV1ExemplarWriteRequestBytes encodes the exemplar write request the way
+// Prometheus remote write 1.0 does, with the exemplars of a series as field 3.
+func newTestV1ExemplarWriteRequestBytes(
+	t *testing.T,
+	now xtime.UnixNano,
+) []byte {
+	req := newTestExemplarWriteRequest(now)
+	require.Equal(t, 1, len(req.Timeseries))
+
+	var series []byte
+	for _, l := range req.Timeseries[0].Labels {
+		series = appendTestProtoMessage(t, series, 0x0a, &l)
+	}
+	for _, s := range req.Timeseries[0].Samples {
+		series = appendTestProtoMessage(t, series, 0x12, &s)
+	}
+	for _, e := range req.Timeseries[0].Exemplars {
+		series = appendTestProtoMessage(t, series, 0x1a, &e)
+	}
+
+	data := appendProtoVarint([]byte{0x0a}, uint64(len(series)))
+	return append(data, series...)
+}
+
+func appendTestProtoMessage(
+	t *testing.T,
+	b []byte,
+	tag byte,
+	m interface{ Marshal() ([]byte, error) },
+) []byte {
+	data, err := m.Marshal()
+	require.NoError(t, err)
+
+	b = appendProtoVarint(append(b, tag), uint64(len(data)))
+	return append(b, data...)
+}
+
+func TestPromWriteExemplars(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	now := xtime.Now().Truncate(time.Millisecond)
+	session := client.NewMockSession(ctrl)
+	expectTestExemplarWrite(t, session, now)
+
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetExemplarStore(newTestExemplarStore(t, session))
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReqBody := test.GeneratePromWriteRequestBody(t,
+		newTestExemplarWriteRequest(now))
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPromWriteV1Exemplars(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	now := xtime.Now().Truncate(time.Millisecond)
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			require.True(t, iter.Next())
+			value := iter.Current()
+			require.Equal(t, 1, len(value.Datapoints))
+			assert.Equal(t, now, value.Datapoints[0].Timestamp)
+			assert.Equal(t, float64(1), value.Datapoints[0].Value)
+			require.False(t, iter.Next())
+			return nil
+		})
+
+	session := client.NewMockSession(ctrl)
+	expectTestExemplarWrite(t, session, now)
+
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetExemplarStore(newTestExemplarStore(t, session))
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	data := newTestV1ExemplarWriteRequestBytes(t, now)
+	promReqBody := bytes.NewReader(snappy.Encode(nil, data))
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNormalizeV1Exemplars(t *testing.T) {
+	now := xtime.Now().Truncate(time.Millisecond)
+
+	var req prompb.WriteRequest
+	require.NoError(t, req.Unmarshal(
+		normalizeV1Exemplars(newTestV1ExemplarWriteRequestBytes(t, now))))
+	assert.Equal(t, *newTestExemplarWriteRequest(now), req)
+
+	// The metric type is left untouched.
+	data, err := (&prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{{
+			Labels: []prompb.Label{
+				{Name: []byte("__name__"), Value: []byte("requests")},
+			},
+			Type: prompb.MetricType_COUNTER,
+		}},
+	}).Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, data, normalizeV1Exemplars(data))
+
+	// Malformed requests are left for the decoder to reject.
+	malformed := []byte{0x0a, 0x05, 0x1a, 0x10}
+	assert.Equal(t, malformed, normalizeV1Exemplars(malformed))
+}
+
+func TestPromWriteExemplarsError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	session := client.NewMockSession(ctrl)
+	session.EXPECT().
+		WriteTagged(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("write error"))
+
+	opts := makeOptions(mockDownsamplerAndWriter).
+		SetExemplarStore(newTestExemplarStore(t, session))
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	promReqBody := test.GeneratePromWriteRequestBody(t,
+		newTestExemplarWriteRequest(xtime.Now().Truncate(time.Millisecond)))
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL, promReqBody)
+
+	// Exemplars failing to be written do not fail the samples.
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPromWriteMetricTypeField(t *testing.T) {
+	// NB: the metric type is encoded as field 3, exemplars are encoded as the
+	// M3 custom field 105.
+	var series prompb.TimeSeries
+	require.NoError(t, series.Unmarshal([]byte{0x18, 0x1}))
+	assert.Equal(t, prompb.MetricType_COUNTER, series.Type)
+	assert.Empty(t, series.Exemplars)
+
+	data, err := (&prompb.TimeSeries{
+		Exemplars: []prompb.Exemplar{{Value: 1}},
+	}).Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xca, 0x6}, data[:2])
+
+	var decoded prompb.TimeSeries
+	require.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, prompb.MetricType_UNKNOWN, decoded.Type)
+	assert.Equal(t, 1, len(decoded.Exemplars))
+}
+
 func TestPromWriteError(t *testing.T) {
 	ctrl := xtest.NewController(t)
 	defer ctrl.Finish()
@@ -418,6 +703,82 @@ func TestPromWriteDisabledMetricsTypes(t *testing.T) {
 	require.NoError(t, capturedIter.Error())
 }
 
+func TestPromWriteNativeHistograms(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var capturedIter ingest.DownsampleAndWriteIter
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, iter ingest.DownsampleAndWriteIter, _ ingest.WriteOptions) ingest.BatchError {
+			capturedIter = iter
+			return nil
+		})
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetStoreMetricsType(false)
+
+	promReq := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels: []prompb.Label{{Name: []byte("__name__"), Value: []byte("foo")}},
+				Histograms: []prompb.Histogram{
+					{
+						CountInt:       3,
+						Sum:            4.5,
+						ZeroCountInt:   1,
+						PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 2}},
+						PositiveDeltas: []int64{1, 0},
+						Timestamp:      1000,
+					},
+					{
+						CountInt:       5,
+						Sum:            9,
+						ZeroCountInt:   1,
+						PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 2}},
+						PositiveDeltas: []int64{1, 2},
+						Timestamp:      2000,
+					},
+				},
+			},
+			{
+				Labels:  []prompb.Label{{Name: []byte("__name__"), Value: []byte("bar")}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}
+
+	executeWriteRequest(t, opts, promReq)
+
+	for _, expected := range []struct {
+		count   float64
+		sum     float64
+		buckets []float64
+	}{
+		{count: 3, sum: 4.5, buckets: []float64{1, 1}},
+		{count: 5, sum: 9, buckets: []float64{1, 3}},
+	} {
+		require.True(t, capturedIter.Next())
+		value := capturedIter.Current()
+		require.Equal(t, 1, len(value.Datapoints))
+		assert.Equal(t, expected.count, value.Datapoints[0].Value)
+
+		h, ok, err := storage.AnnotationToNativeHistogram(value.Annotation)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, expected.count, h.Count)
+		assert.Equal(t, expected.sum, h.Sum)
+		assert.Equal(t, expected.buckets, h.PositiveBuckets)
+	}
+
+	// NB: float samples that follow histograms must not carry an annotation.
+	verifyIterValueNoAnnotation(t, capturedIter)
+
+	require.False(t, capturedIter.Next())
+	require.NoError(t, capturedIter.Error())
+}
+
 func BenchmarkWriteDatapoints(b *testing.B) {
 	ctrl := xtest.NewController(b)
 	defer ctrl.Finish()
@@ -0,0 +1,302 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/generated/proto/prompb/writev2"
+	"github.com/m3db/m3/src/x/headers"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+)
+
+const (
+	// remoteWriteV1ProtoMsg is the protobuf message of remote write 1.0
+	// requests.
+	remoteWriteV1ProtoMsg = "prometheus.WriteRequest"
+
+	// remoteWriteV2ProtoMsg is the protobuf message of remote write 2.0
+	// requests.
+	remoteWriteV2ProtoMsg = "io.prometheus.write.v2.Request"
+
+	// customBucketsSchema is the native histogram schema of histograms with
+	// custom bucket boundaries.
+	customBucketsSchema = -53
+
+	quantileLabel = "quantile"
+)
+
+var (
+	errOddLabelsRefs          = errors.New("odd number of label references")
+	errCustomBucketsHistogram = errors.New("native histograms with custom buckets are not supported")
+
+	writeV2MetricTypes = map[writev2.Metadata_MetricType]prompb.MetricType{
+		writev2.Metadata_METRIC_TYPE_UNSPECIFIED:    prompb.MetricType_UNKNOWN,
+		writev2.Metadata_METRIC_TYPE_COUNTER:        prompb.MetricType_COUNTER,
+		writev2.Metadata_METRIC_TYPE_GAUGE:          prompb.MetricType_GAUGE,
+		writev2.Metadata_METRIC_TYPE_HISTOGRAM:      prompb.MetricType_HISTOGRAM,
+		writev2.Metadata_METRIC_TYPE_GAUGEHISTOGRAM: prompb.MetricType_GAUGE_HISTOGRAM,
+		writev2.Metadata_METRIC_TYPE_SUMMARY:        prompb.MetricType_SUMMARY,
+		writev2.Metadata_METRIC_TYPE_INFO:           prompb.MetricType_INFO,
+		writev2.Metadata_METRIC_TYPE_STATESET:       prompb.MetricType_STATESET,
+	}
+)
+
+// parseRemoteWriteProtoMsg returns the protobuf message of a remote write
+// request from its content type. Requests without a protobuf content type
+// predate content negotiation and are always remote write 1.0 requests.
+func parseRemoteWriteProtoMsg(contentType string) (string, error) {
+	if contentType == "" {
+		return remoteWriteV1ProtoMsg, nil
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != xhttp.ContentTypeProtobuf {
+		return remoteWriteV1ProtoMsg, nil
+	}
+
+	switch msg := params["proto"]; msg {
+	case "", remoteWriteV1ProtoMsg:
+		return remoteWriteV1ProtoMsg, nil
+	case remoteWriteV2ProtoMsg:
+		return remoteWriteV2ProtoMsg, nil
+	default:
+		return "", fmt.Errorf("unsupported remote write protobuf message: %s", msg)
+	}
+}
+
+// writeStats are the number of samples, native histogram samples and
+// exemplars of a remote write 2.0 request, reported back once written.
+type writeStats struct {
+	samples    int
+	histograms int
+	exemplars  int
+}
+
+func (s writeStats) setHeaders(header http.Header) {
+	header.Set(headers.PrometheusRemoteWriteSamplesWrittenHeader, strconv.Itoa(s.samples))
+	header.Set(headers.PrometheusRemoteWriteHistogramsWrittenHeader, strconv.Itoa(s.histograms))
+	header.Set(headers.PrometheusRemoteWriteExemplarsWrittenHeader, strconv.Itoa(s.exemplars))
+}
+
+// writeV2RequestToWriteRequest converts a remote write 2.0 request to the
+// remote write 1.0 request that is written, resolving the labels and
+// metadata of each series from the symbols of the request.
+func writeV2RequestToWriteRequest(
+	req *writev2.Request,
+	ingestCreatedTimestamps bool,
+) (*prompb.WriteRequest, writeStats, error) {
+	var (
+		stats  writeStats
+		result = &prompb.WriteRequest{
+			Timeseries: make([]prompb.TimeSeries, 0, len(req.Timeseries)),
+		}
+		metadataNames = make(map[string]struct{})
+	)
+	for _, series := range req.Timeseries {
+		labels, err := labelsFromRefs(req.Symbols, series.LabelsRefs)
+		if err != nil {
+			return nil, writeStats{}, err
+		}
+		help, err := symbol(req.Symbols, series.Metadata.HelpRef)
+		if err != nil {
+			return nil, writeStats{}, err
+		}
+		unit, err := symbol(req.Symbols, series.Metadata.UnitRef)
+		if err != nil {
+			return nil, writeStats{}, err
+		}
+
+		promTS := prompb.TimeSeries{
+			Labels:     labels,
+			Samples:    make([]prompb.Sample, 0, len(series.Samples)),
+			Histograms: make([]prompb.Histogram, 0, len(series.Histograms)),
+			Exemplars:  make([]prompb.Exemplar, 0, len(series.Exemplars)),
+			Type:       writeV2MetricTypes[series.Metadata.Type],
+			Unit:       unit,
+			Help:       help,
+		}
+		for _, s := range series.Samples {
+			promTS.Samples = append(promTS.Samples, prompb.Sample{
+				Value:     s.Value,
+				Timestamp: s.Timestamp,
+			})
+		}
+		for _, h := range series.Histograms {
+			if h.Schema == customBucketsSchema {
+				return nil, writeStats{}, errCustomBucketsHistogram
+			}
+			promTS.Histograms = append(promTS.Histograms, writeV2HistogramToHistogram(h))
+		}
+		for _, e := range series.Exemplars {
+			exemplarLabels, err := labelsFromRefs(req.Symbols, e.LabelsRefs)
+			if err != nil {
+				return nil, writeStats{}, err
+			}
+			promTS.Exemplars = append(promTS.Exemplars, prompb.Exemplar{
+				Labels:    exemplarLabels,
+				Value:     e.Value,
+				Timestamp: e.Timestamp,
+			})
+		}
+
+		stats.samples += len(promTS.Samples)
+		stats.histograms += len(promTS.Histograms)
+		stats.exemplars += len(promTS.Exemplars)
+
+		if ingestCreatedTimestamps && series.CreatedTimestamp != 0 {
+			addCreatedTimestampSample(&promTS, series.CreatedTimestamp)
+		}
+
+		// Metadata is recorded once for each metric name of the request, the
+		// same as remote write 1.0 requests send it.
+		name := metricName(labels)
+		if _, ok := metadataNames[name]; !ok && name != "" &&
+			(promTS.Type != prompb.MetricType_UNKNOWN || help != "" || unit != "") {
+			metadataNames[name] = struct{}{}
+			result.Metadata = append(result.Metadata, prompb.MetricMetadata{
+				Type:             promTS.Type,
+				MetricFamilyName: name,
+				Help:             help,
+				Unit:             unit,
+			})
+		}
+
+		result.Timeseries = append(result.Timeseries, promTS)
+	}
+	return result, stats, nil
+}
+
+func symbol(symbols []string, ref uint32) (string, error) {
+	if int(ref) >= len(symbols) {
+		return "", fmt.Errorf("symbol reference %d out of range of %d symbols",
+			ref, len(symbols))
+	}
+	return symbols[ref], nil
+}
+
+func labelsFromRefs(symbols []string, refs []uint32) ([]prompb.Label, error) {
+	if len(refs)%2 != 0 {
+		return nil, errOddLabelsRefs
+	}
+
+	labels := make([]prompb.Label, 0, len(refs)/2)
+	for i := 0; i < len(refs); i += 2 {
+		name, err := symbol(symbols, refs[i])
+		if err != nil {
+			return nil, err
+		}
+		value, err := symbol(symbols, refs[i+1])
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, prompb.Label{
+			Name:  []byte(name),
+			Value: []byte(value),
+		})
+	}
+	return labels, nil
+}
+
+func metricName(labels []prompb.Label) string {
+	for _, l := range labels {
+		if string(l.Name) == "__name__" {
+			return string(l.Value)
+		}
+	}
+	return ""
+}
+
+func writeV2HistogramToHistogram(h writev2.Histogram) prompb.Histogram {
+	result := prompb.Histogram{
+		CountInt:       h.CountInt,
+		CountFloat:     h.CountFloat,
+		Sum:            h.Sum,
+		Schema:         h.Schema,
+		ZeroThreshold:  h.ZeroThreshold,
+		ZeroCountInt:   h.ZeroCountInt,
+		ZeroCountFloat: h.ZeroCountFloat,
+		NegativeSpans:  make([]prompb.BucketSpan, 0, len(h.NegativeSpans)),
+		NegativeDeltas: h.NegativeDeltas,
+		NegativeCounts: h.NegativeCounts,
+		PositiveSpans:  make([]prompb.BucketSpan, 0, len(h.PositiveSpans)),
+		PositiveDeltas: h.PositiveDeltas,
+		PositiveCounts: h.PositiveCounts,
+		Timestamp:      h.Timestamp,
+	}
+	for _, s := range h.NegativeSpans {
+		result.NegativeSpans = append(result.NegativeSpans,
+			prompb.BucketSpan{Offset: s.Offset, Length: s.Length})
+	}
+	for _, s := range h.PositiveSpans {
+		result.PositiveSpans = append(result.PositiveSpans,
+			prompb.BucketSpan{Offset: s.Offset, Length: s.Length})
+	}
+	switch h.ResetHint {
+	case writev2.Histogram_RESET_HINT_YES:
+		result.ResetHint = prompb.Histogram_YES
+	case writev2.Histogram_RESET_HINT_NO:
+		result.ResetHint = prompb.Histogram_NO
+	case writev2.Histogram_RESET_HINT_GAUGE:
+		result.ResetHint = prompb.Histogram_GAUGE
+	default:
+		result.ResetHint = prompb.Histogram_UNKNOWN
+	}
+	return result
+}
+
+// addCreatedTimestampSample prepends a zero sample at the created timestamp
+// of a counter-like series, marking the start of the counter, if it precedes
+// the first sample of the series.
+func addCreatedTimestampSample(series *prompb.TimeSeries, createdTimestamp int64) {
+	switch series.Type {
+	case prompb.MetricType_COUNTER, prompb.MetricType_HISTOGRAM:
+	case prompb.MetricType_SUMMARY:
+		// Quantiles of summaries are gauges.
+		for _, l := range series.Labels {
+			if string(l.Name) == quantileLabel {
+				return
+			}
+		}
+	default:
+		return
+	}
+
+	if len(series.Samples) > 0 && createdTimestamp < series.Samples[0].Timestamp {
+		series.Samples = append([]prompb.Sample{{Timestamp: createdTimestamp}},
+			series.Samples...)
+	}
+	if len(series.Histograms) > 0 && createdTimestamp < series.Histograms[0].Timestamp &&
+		series.Histograms[0].ResetHint != prompb.Histogram_GAUGE {
+		first := series.Histograms[0]
+		series.Histograms = append([]prompb.Histogram{{
+			Schema:        first.Schema,
+			ZeroThreshold: first.ZeroThreshold,
+			ResetHint:     prompb.Histogram_YES,
+			Timestamp:     createdTimestamp,
+		}}, series.Histograms...)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package remote
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
+	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/remote/test"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/generated/proto/prompb/writev2"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
+	"github.com/m3db/m3/src/x/headers"
+	xhttp "github.com/m3db/m3/src/x/net/http"
+	xtest "github.com/m3db/m3/src/x/test"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const contentTypeWriteV2 = "application/x-protobuf;proto=io.prometheus.write.v2.Request"
+
+func newTestWriteV2Request() *writev2.Request {
+	return &writev2.Request{
+		Symbols: []string{
+			"", "__name__", "requests_total", "job", "api",
+			"Total requests.", "latency", "trace_id", "abc",
+		},
+		Timeseries: []writev2.TimeSeries{
+			{
+				LabelsRefs: []uint32{1, 2, 3, 4},
+				Samples: []writev2.Sample{
+					{Value: 1, Timestamp: 1000},
+					{Value: 2, Timestamp: 2000},
+				},
+				Exemplars: []writev2.Exemplar{
+					{LabelsRefs: []uint32{7, 8}, Value: 2, Timestamp: 2000},
+				},
+				Metadata: writev2.Metadata{
+					Type:    writev2.Metadata_METRIC_TYPE_COUNTER,
+					HelpRef: 5,
+				},
+				CreatedTimestamp: 500,
+			},
+			{
+				LabelsRefs: []uint32{1, 6},
+				Histograms: []writev2.Histogram{{
+					CountInt:       3,
+					Sum:            4.5,
+					ZeroCountInt:   1,
+					PositiveSpans:  []writev2.BucketSpan{{Offset: 0, Length: 2}},
+					PositiveDeltas: []int64{1, 0},
+					Timestamp:      1000,
+				}},
+				Metadata: writev2.Metadata{
+					Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+				},
+			},
+		},
+	}
+}
+
+func TestParseRemoteWriteProtoMsg(t *testing.T) {
+	tests := []struct {
+		contentType string
+		expected    string
+		err         bool
+	}{
+		{contentType: "", expected: remoteWriteV1ProtoMsg},
+		{contentType: "application/x-protobuf", expected: remoteWriteV1ProtoMsg},
+		{contentType: "application/octet-stream", expected: remoteWriteV1ProtoMsg},
+		{
+			contentType: "application/x-protobuf;proto=prometheus.WriteRequest",
+			expected:    remoteWriteV1ProtoMsg,
+		},
+		{contentType: contentTypeWriteV2, expected: remoteWriteV2ProtoMsg},
+		{
+			contentType: "application/x-protobuf; proto=io.prometheus.write.v2.Request",
+			expected:    remoteWriteV2ProtoMsg,
+		},
+		{contentType: "application/x-protobuf;proto=unknown.Request", err: true},
+	}
+	for _, tt := range tests {
+		protoMsg, err := parseRemoteWriteProtoMsg(tt.contentType)
+		if tt.err {
+			require.Error(t, err, tt.contentType)
+			continue
+		}
+		require.NoError(t, err, tt.contentType)
+		assert.Equal(t, tt.expected, protoMsg, tt.contentType)
+	}
+}
+
+func TestPromWriteV2(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var written []ingest.IterValue
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			iter ingest.DownsampleAndWriteIter,
+			_ ingest.WriteOptions,
+		) ingest.BatchError {
+			for iter.Next() {
+				written = append(written, iter.Current())
+			}
+			require.NoError(t, iter.Error())
+			return nil
+		})
+
+	store := metricmetadata.NewStore(metricmetadata.StoreOptions{})
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	opts := makeOptions(mockDownsamplerAndWriter).SetMetricMetadataStore(store)
+	handler, err := NewPromWriteHandler(opts)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL,
+		test.GeneratePromWriteV2RequestBody(t, newTestWriteV2Request()))
+	req.Header.Set(xhttp.HeaderContentType, contentTypeWriteV2)
+	req.Header.Set(headers.PrometheusRemoteWriteVersionHeader, "2.0.0")
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "2", resp.Header.Get(headers.PrometheusRemoteWriteSamplesWrittenHeader))
+	assert.Equal(t, "1", resp.Header.Get(headers.PrometheusRemoteWriteHistogramsWrittenHeader))
+	// Exemplars are dropped without an exemplar store.
+	assert.Equal(t, "0", resp.Header.Get(headers.PrometheusRemoteWriteExemplarsWrittenHeader))
+
+	require.Equal(t, 2, len(written))
+	assert.Equal(t, "__name__: requests_total, job: api", written[0].Tags.String())
+	require.Equal(t, 2, len(written[0].Datapoints))
+	assert.Equal(t, 1.0, written[0].Datapoints[0].Value)
+	assert.Equal(t, 2.0, written[0].Datapoints[1].Value)
+
+	assert.Equal(t, "__name__: latency", written[1].Tags.String())
+	h, ok, err := storage.AnnotationToNativeHistogram(written[1].Annotation)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, 3.0, h.Count)
+	assert.Equal(t, []float64{1, 1}, h.PositiveBuckets)
+
+	assert.Equal(t, map[string][]metricmetadata.Metadata{
+		"requests_total": {{Type: "counter", Help: "Total requests."}},
+		"latency":        {{Type: "histogram"}},
+	}, store.Metadata("", 0))
+}
+
+func TestPromWriteV1NoWrittenHeaders(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	mockDownsamplerAndWriter := ingest.NewMockDownsamplerAndWriter(ctrl)
+	mockDownsamplerAndWriter.
+		EXPECT().
+		WriteBatch(gomock.Any(), gomock.Any(), gomock.Any())
+
+	handler, err := NewPromWriteHandler(makeOptions(mockDownsamplerAndWriter))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL,
+		test.GeneratePromWriteRequestBody(t, test.GeneratePromWriteRequest()))
+	req.Header.Set(xhttp.HeaderContentType,
+		"application/x-protobuf;proto=prometheus.WriteRequest")
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	resp := writer.Result()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(headers.PrometheusRemoteWriteSamplesWrittenHeader))
+}
+
+func TestPromWriteUnsupportedProtoMsg(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	handler, err := NewPromWriteHandler(
+		makeOptions(ingest.NewMockDownsamplerAndWriter(ctrl)))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL,
+		test.GeneratePromWriteRequestBody(t, test.GeneratePromWriteRequest()))
+	req.Header.Set(xhttp.HeaderContentType,
+		"application/x-protobuf;proto=io.prometheus.write.v3.Request")
+
+	writer := httptest.NewRecorder()
+	handler.ServeHTTP(writer, req)
+	require.Equal(t, http.StatusUnsupportedMediaType, writer.Result().StatusCode)
+}
+
+func TestPromWriteV2InvalidSymbolReference(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	handler, err := NewPromWriteHandler(
+		makeOptions(ingest.NewMockDownsamplerAndWriter(ctrl)))
+	require.NoError(t, err)
+
+	for _, refs := range [][]uint32{{1, 2, 3}, {1, 100}} {
+		v2Req := newTestWriteV2Request()
+		v2Req.Timeseries[0].LabelsRefs = refs
+
+		req := httptest.NewRequest(PromWriteHTTPMethod, PromWriteURL,
+			test.GeneratePromWriteV2RequestBody(t, v2Req))
+		req.Header.Set(xhttp.HeaderContentType, contentTypeWriteV2)
+
+		writer := httptest.NewRecorder()
+		handler.ServeHTTP(writer, req)
+		require.Equal(t, http.StatusBadRequest, writer.Result().StatusCode)
+	}
+}
+
+func TestWriteV2RequestToWriteRequest(t *testing.T) {
+	req, stats, err := writeV2RequestToWriteRequest(newTestWriteV2Request(), false)
+	require.NoError(t, err)
+	assert.Equal(t, writeStats{samples: 2, histograms: 1, exemplars: 1}, stats)
+
+	require.Equal(t, 2, len(req.Timeseries))
+	series := req.Timeseries[0]
+	assert.Equal(t, []prompb.Label{
+		{Name: []byte("__name__"), Value: []byte("requests_total")},
+		{Name: []byte("job"), Value: []byte("api")},
+	}, series.Labels)
+	assert.Equal(t, prompb.MetricType_COUNTER, series.Type)
+	assert.Equal(t, "Total requests.", series.Help)
+	assert.Equal(t, []prompb.Sample{
+		{Value: 1, Timestamp: 1000},
+		{Value: 2, Timestamp: 2000},
+	}, series.Samples)
+	assert.Equal(t, []prompb.Exemplar{{
+		Labels:    []prompb.Label{{Name: []byte("trace_id"), Value: []byte("abc")}},
+		Value:     2,
+		Timestamp: 2000,
+	}}, series.Exemplars)
+
+	series = req.Timeseries[1]
+	assert.Equal(t, prompb.MetricType_HISTOGRAM, series.Type)
+	require.Equal(t, 1, len(series.Histograms))
+	assert.Equal(t, uint64(3), series.Histograms[0].CountInt)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 0, Length: 2}},
+		series.Histograms[0].PositiveSpans)
+
+	assert.Equal(t, []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "requests_total",
+			Help:             "Total requests.",
+		},
+		{
+			Type:             prompb.MetricType_HISTOGRAM,
+			MetricFamilyName: "latency",
+		},
+	}, req.Metadata)
+}
+
+func TestWriteV2RequestToWriteRequestCreatedTimestamps(t *testing.T) {
+	v2Req := newTestWriteV2Request()
+	v2Req.Symbols = append(v2Req.Symbols, "quantile", "0.5")
+	v2Req.Timeseries[1].CreatedTimestamp = 500
+	v2Req.Timeseries = append(v2Req.Timeseries,
+		writev2.TimeSeries{
+			// Quantiles of summaries are not counters.
+			LabelsRefs:       []uint32{1, 6, 9, 10},
+			Samples:          []writev2.Sample{{Value: 1, Timestamp: 1000}},
+			Metadata:         writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_SUMMARY},
+			CreatedTimestamp: 500,
+		},
+		writev2.TimeSeries{
+			// Created timestamps after the first sample are ignored.
+			LabelsRefs:       []uint32{1, 2},
+			Samples:          []writev2.Sample{{Value: 1, Timestamp: 1000}},
+			Metadata:         writev2.Metadata{Type: writev2.Metadata_METRIC_TYPE_COUNTER},
+			CreatedTimestamp: 1500,
+		})
+
+	req, stats, err := writeV2RequestToWriteRequest(v2Req, true)
+	require.NoError(t, err)
+	// Samples at created timestamps are not reported as written.
+	assert.Equal(t, writeStats{samples: 4, histograms: 1, exemplars: 1}, stats)
+
+	require.Equal(t, 4, len(req.Timeseries))
+	assert.Equal(t, []prompb.Sample{
+		{Value: 0, Timestamp: 500},
+		{Value: 1, Timestamp: 1000},
+		{Value: 2, Timestamp: 2000},
+	}, req.Timeseries[0].Samples)
+
+	histograms := req.Timeseries[1].Histograms
+	require.Equal(t, 2, len(histograms))
+	assert.Equal(t, prompb.Histogram{ResetHint: prompb.Histogram_YES, Timestamp: 500},
+		histograms[0])
+
+	assert.Equal(t, []prompb.Sample{{Value: 1, Timestamp: 1000}},
+		req.Timeseries[2].Samples)
+	assert.Equal(t, []prompb.Sample{{Value: 1, Timestamp: 1000}},
+		req.Timeseries[3].Samples)
+
+	// Created timestamps are only written when enabled.
+	req, _, err = writeV2RequestToWriteRequest(newTestWriteV2Request(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(req.Timeseries[0].Samples))
+}
+
+func TestWriteV2RequestToWriteRequestCustomBuckets(t *testing.T) {
+	v2Req := newTestWriteV2Request()
+	v2Req.Timeseries[1].Histograms[0].Schema = customBucketsSchema
+	v2Req.Timeseries[1].Histograms[0].CustomValues = []float64{1, 2}
+
+	_, _, err := writeV2RequestToWriteRequest(v2Req, false)
+	require.Equal(t, errCustomBucketsHistogram, err)
+}
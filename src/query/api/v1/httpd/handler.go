@@ -36,6 +36,8 @@ import (
 	m3json "github.com/m3db/m3/src/query/api/v1/handler/json"
 	"github.com/m3db/m3/src/query/api/v1/handler/namespace"
 	"github.com/m3db/m3/src/query/api/v1/handler/openapi"
+	"github.com/m3db/m3/src/query/api/v1/handler/opentsdb"
+	"github.com/m3db/m3/src/query/api/v1/handler/otlp"
 	"github.com/m3db/m3/src/query/api/v1/handler/placement"
 	"github.com/m3db/m3/src/query/api/v1/handler/prom"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
@@ -252,6 +254,44 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// InfluxDB query and ping endpoints used by clients before writing.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    influxdb.InfluxQueryURL,
+		Handler: influxdb.NewInfluxQueryHandler(h.options),
+		Methods: methods(http.MethodGet, http.MethodPost),
+	}); err != nil {
+		return err
+	}
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    influxdb.InfluxPingURL,
+		Handler: influxdb.NewInfluxPingHandler(),
+		Methods: methods(http.MethodGet, http.MethodHead),
+	}); err != nil {
+		return err
+	}
+
+	// OpenTSDB write endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    opentsdb.PutURL,
+		Handler: opentsdb.NewPutHandler(h.options),
+		Methods: methods(opentsdb.PutHTTPMethod),
+		// Register with no response logging for write calls since so frequent.
+		MiddlewareOverride: middleware.WithNoResponseLogging,
+	}); err != nil {
+		return err
+	}
+
+	// OpenTelemetry OTLP metrics write endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    otlp.WriteURL,
+		Handler: otlp.NewWriteHandler(h.options),
+		Methods: methods(otlp.WriteHTTPMethod),
+		// Register with no response logging for write calls since so frequent.
+		MiddlewareOverride: middleware.WithNoResponseLogging,
+	}); err != nil {
+		return err
+	}
+
 	// Native M3 search and write endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    handler.SearchURL,
@@ -331,6 +371,53 @@ func (h *Handler) RegisterRoutes() error {
 		return err
 	}
 
+	// Metric metadata endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.MetadataURL,
+		Handler: native.NewMetadataHandler(h.options),
+		Methods: methods(native.MetadataHTTPMethod),
+	}); err != nil {
+		return err
+	}
+
+	// Exemplars endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.QueryExemplarsURL,
+		Handler: native.NewQueryExemplarsHandler(h.options),
+		Methods: native.QueryExemplarsHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Series deletion endpoint.
+	if err := h.registry.Register(queryhttp.RegisterOptions{
+		Path:    native.DeleteSeriesURL,
+		Handler: native.NewDeleteSeriesHandler(h.options),
+		Methods: native.DeleteSeriesHTTPMethods,
+	}); err != nil {
+		return err
+	}
+
+	// Status endpoints.
+	statusHandlers := []struct {
+		path    string
+		handler http.Handler
+	}{
+		{path: native.BuildInfoURL, handler: native.NewBuildInfoHandler(h.options)},
+		{path: native.RuntimeInfoURL, handler: native.NewRuntimeInfoHandler(h.options)},
+		{path: native.FlagsURL, handler: native.NewFlagsHandler(h.options)},
+		{path: native.TSDBStatusURL, handler: native.NewTSDBStatusHandler(h.options)},
+	}
+	for _, s := range statusHandlers {
+		if err := h.registry.Register(queryhttp.RegisterOptions{
+			Path:    s.path,
+			Handler: s.handler,
+			Methods: methods(native.StatusHTTPMethod),
+		}); err != nil {
+			return err
+		}
+	}
+
 	// Graphite endpoints.
 	if err := h.registry.Register(queryhttp.RegisterOptions{
 		Path:    graphite.ReadURL,
@@ -346,6 +433,24 @@ func (h *Handler) RegisterRoutes() error {
 	}); err != nil {
 		return err
 	}
+	graphiteTagsHandlers := []struct {
+		path    string
+		handler http.Handler
+	}{
+		{path: graphite.TagsURL, handler: graphite.NewTagsHandler(h.options)},
+		{path: graphite.TagsAutoCompleteTagsURL, handler: graphite.NewTagsAutoCompleteTagsHandler(h.options)},
+		{path: graphite.TagsAutoCompleteValuesURL, handler: graphite.NewTagsAutoCompleteValuesHandler(h.options)},
+		{path: graphite.TagValuesURL, handler: graphite.NewTagValuesHandler(h.options)},
+	}
+	for _, t := range graphiteTagsHandlers {
+		if err := h.registry.Register(queryhttp.RegisterOptions{
+			Path:    t.path,
+			Handler: t.handler,
+			Methods: graphite.TagsHTTPMethods,
+		}); err != nil {
+			return err
+		}
+	}
 
 	placementOpts, err := h.placementOpts()
 	if err != nil {
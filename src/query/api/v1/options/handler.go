@@ -38,11 +38,14 @@ import (
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/middleware"
 	"github.com/m3db/m3/src/query/api/v1/validators"
+	"github.com/m3db/m3/src/query/cache"
 	"github.com/m3db/m3/src/query/executor"
 	graphite "github.com/m3db/m3/src/query/graphite/storage"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/exemplar"
 	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/ts"
 	"github.com/m3db/m3/src/query/ts/m3db"
 	"github.com/m3db/m3/src/x/clock"
@@ -242,6 +245,21 @@ type HandlerOptions interface {
 	SetRegisterMiddleware(value middleware.Register) HandlerOptions
 	// RegisterMiddleware returns the function to construct the set of Middleware functions to run.
 	RegisterMiddleware() middleware.Register
+
+	// SetResultsCache sets the range query results cache.
+	SetResultsCache(value cache.ResultsCache) HandlerOptions
+	// ResultsCache returns the range query results cache, if any.
+	ResultsCache() cache.ResultsCache
+
+	// SetMetricMetadataStore sets the Prometheus metric metadata store.
+	SetMetricMetadataStore(value metricmetadata.Store) HandlerOptions
+	// MetricMetadataStore returns the Prometheus metric metadata store, if any.
+	MetricMetadataStore() metricmetadata.Store
+
+	// SetExemplarStore sets the Prometheus exemplar store.
+	SetExemplarStore(value exemplar.Store) HandlerOptions
+	// ExemplarStore returns the Prometheus exemplar store, if any.
+	ExemplarStore() exemplar.Store
 }
 
 // HandlerOptions represents handler options.
@@ -274,6 +292,9 @@ type handlerOptions struct {
 	storeMetricsType                  bool
 	kvStoreProtoParser                KVStoreProtoParser
 	registerMiddleware                middleware.Register
+	resultsCache                      cache.ResultsCache
+	metricMetadataStore               metricmetadata.Store
+	exemplarStore                     exemplar.Store
 }
 
 // EmptyHandlerOptions returns  default handler options.
@@ -648,5 +669,35 @@ func (o *handlerOptions) SetRegisterMiddleware(value middleware.Register) Handle
 	return &opts
 }
 
+func (o *handlerOptions) SetResultsCache(value cache.ResultsCache) HandlerOptions {
+	opts := *o
+	opts.resultsCache = value
+	return &opts
+}
+
+func (o *handlerOptions) ResultsCache() cache.ResultsCache {
+	return o.resultsCache
+}
+
+func (o *handlerOptions) SetMetricMetadataStore(value metricmetadata.Store) HandlerOptions {
+	opts := *o
+	opts.metricMetadataStore = value
+	return &opts
+}
+
+func (o *handlerOptions) MetricMetadataStore() metricmetadata.Store {
+	return o.metricMetadataStore
+}
+
+func (o *handlerOptions) SetExemplarStore(value exemplar.Store) HandlerOptions {
+	opts := *o
+	opts.exemplarStore = value
+	return &opts
+}
+
+func (o *handlerOptions) ExemplarStore() exemplar.Store {
+	return o.exemplarStore
+}
+
 // KVStoreProtoParser parses protobuf messages based off specific keys.
 type KVStoreProtoParser func(key string) (protoiface.MessageV1, error)
@@ -0,0 +1,73 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/ts"
+)
+
+func TestUnconsolidatedBlock(t *testing.T) {
+	meta := Metadata{
+		Tags:   models.MustMakeTags("a", "b"),
+		Bounds: testBound,
+	}
+
+	series := make([]UnconsolidatedSeries, 0, 5)
+	for i := 0; i < 5; i++ {
+		dps := ts.Datapoints{{Timestamp: start, Value: float64(i)}}
+		series = append(series, NewUnconsolidatedSeries(dps, SeriesMeta{
+			Name: []byte{byte('a' + i)},
+		}, UnconsolidatedSeriesStats{}))
+	}
+
+	bl := NewUnconsolidatedBlock(meta, series)
+	assert.True(t, meta.Equals(bl.Meta()))
+	assert.Equal(t, BlockDecompressed, bl.Info().Type())
+
+	_, err := bl.StepIter()
+	assert.Error(t, err)
+
+	iter, err := bl.SeriesIter()
+	require.NoError(t, err)
+	assert.Equal(t, 5, iter.SeriesCount())
+	for i := 0; iter.Next(); i++ {
+		assert.Equal(t, float64(i), iter.Current().Datapoints()[0].Value)
+	}
+
+	assert.NoError(t, iter.Err())
+
+	_, err = bl.MultiSeriesIter(0)
+	assert.Error(t, err)
+
+	batches, err := bl.MultiSeriesIter(2)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+	assert.Equal(t, 3, batches[0].Size)
+	assert.Equal(t, 2, batches[1].Size)
+
+	assert.NoError(t, bl.Close())
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	xcache "github.com/m3db/m3/src/x/cache"
+
+	"github.com/uber-go/tally"
+)
+
+var errUnexpectedCachedValue = errors.New("unexpected value in results cache")
+
+// LRUResultsCacheOptions are the options for an in-process results cache.
+type LRUResultsCacheOptions struct {
+	// MaxEntries is the maximum number of queries to cache results for.
+	MaxEntries int
+	// TTL is the duration results are cached for.
+	TTL time.Duration
+	// Metrics is the scope to emit cache metrics to.
+	Metrics tally.Scope
+}
+
+type lruResultsCache struct {
+	lru *xcache.LRU
+}
+
+// NewLRUResultsCache returns a results cache backed by an in-process LRU.
+func NewLRUResultsCache(opts LRUResultsCacheOptions) ResultsCache {
+	return &lruResultsCache{
+		lru: xcache.NewLRU(&xcache.LRUOptions{
+			MaxEntries: opts.MaxEntries,
+			TTL:        opts.TTL,
+			Metrics:    opts.Metrics,
+		}),
+	}
+}
+
+func (c *lruResultsCache) Get(_ context.Context, key string) (Extent, bool, error) {
+	value, ok := c.lru.TryGet(key)
+	if !ok {
+		return Extent{}, false, nil
+	}
+
+	extent, ok := value.(Extent)
+	if !ok {
+		return Extent{}, false, errUnexpectedCachedValue
+	}
+
+	return extent, true, nil
+}
+
+func (c *lruResultsCache) Put(ctx context.Context, key string, extent Extent) error {
+	if _, ok := c.lru.TryGet(key); ok {
+		c.lru.Put(key, extent)
+		return nil
+	}
+
+	// NB: the LRU only evicts entries to make room for loaded values, so new
+	// results are loaded to keep the cache within its max entries.
+	_, err := c.lru.Get(ctx, key, func(context.Context, string) (interface{}, error) {
+		return extent, nil
+	})
+	return err
+}
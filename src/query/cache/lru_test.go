@@ -0,0 +1,69 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/models"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRUResultsCache(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		cache = NewLRUResultsCache(LRUResultsCacheOptions{MaxEntries: 1})
+		start = xtime.Now().Truncate(time.Minute)
+	)
+
+	_, ok, err := cache.Get(ctx, "foo")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	extent := Extent{
+		Start: start,
+		End:   start.Add(2 * time.Minute),
+		Step:  time.Minute,
+		Series: []Series{{
+			Name:   []byte("foo"),
+			Tags:   models.EmptyTags(),
+			Values: []float64{1, 2, 3},
+		}},
+	}
+
+	require.NoError(t, cache.Put(ctx, "foo", extent))
+	actual, ok, err := cache.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, extent, actual)
+	assert.Equal(t, 3, actual.Steps())
+
+	// NB: the cache only holds a single entry, so this evicts foo.
+	require.NoError(t, cache.Put(ctx, "bar", extent))
+	_, ok, err = cache.Get(ctx, "foo")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
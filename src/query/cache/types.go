@@ -0,0 +1,75 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package cache provides caching of query results.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// ResultsCache stores the results of range queries as step aligned extents,
+// keyed by a normalized representation of the query. Implementations may be
+// in-process or backed by an external store.
+type ResultsCache interface {
+	// Get returns the extent cached for the key, if any.
+	Get(ctx context.Context, key string) (Extent, bool, error)
+	// Put stores the extent for the key, replacing any existing extent.
+	Put(ctx context.Context, key string, extent Extent) error
+}
+
+// Extent is a contiguous range of query results, with values at every step
+// between Start and End inclusive.
+type Extent struct {
+	// Start is the timestamp of the first step in the extent.
+	Start xtime.UnixNano
+	// End is the timestamp of the last step in the extent.
+	End xtime.UnixNano
+	// Step is the step size of the extent.
+	Step time.Duration
+	// BlockType is the type of the block the results were rendered from.
+	BlockType block.BlockType
+	// Series are the series in the extent.
+	Series []Series
+}
+
+// Series is a single series within an extent.
+type Series struct {
+	// Name is the name of the series.
+	Name []byte
+	// Tags are the tags of the series.
+	Tags models.Tags
+	// Values are the values of the series at every step of the extent.
+	Values []float64
+}
+
+// Steps returns the number of steps in the extent.
+func (e Extent) Steps() int {
+	if e.Step <= 0 || e.End.Before(e.Start) {
+		return 0
+	}
+
+	return int(e.End.Sub(e.Start)/e.Step) + 1
+}
@@ -121,11 +121,33 @@ func (e *engine) ExecuteExpr(
 		return nil, err
 	}
 
+	if splits := splitParams(params, opts, e.opts.SplitInterval()); len(splits) > 1 {
+		return e.executeSplit(ctx, nodes, edges, opts, fetchOpts, params, splits)
+	}
+
+	return e.execute(ctx, req, nodes, edges, opts, params)
+}
+
+// execute plans and executes a compiled request, where query is the request
+// as issued by the caller and may cover a wider range than the request itself
+// if the query has been split.
+func (e *engine) execute(
+	ctx context.Context,
+	req *Request,
+	nodes parser.Nodes,
+	edges parser.Edges,
+	opts *QueryOptions,
+	query models.RequestParams,
+) (block.Block, error) {
 	pp, err := req.plan(ctx, nodes, edges)
 	if err != nil {
 		return nil, err
 	}
 
+	// NB: start() and end() always refer to the range of the whole query.
+	pp.TimeSpec.QueryStart = query.Start
+	pp.TimeSpec.QueryEnd = query.End
+
 	state, err := req.generateExecutionState(ctx, pp)
 	if err != nil {
 		return nil, err
@@ -33,12 +33,17 @@ type engineOptions struct {
 	store            storage.Storage
 	parseOptions     promql.ParseOptions
 	lookbackDuration time.Duration
+	splitInterval    time.Duration
+	splitConcurrency int
 }
 
+const defaultSplitConcurrency = 4
+
 // NewEngineOptions returns a new instance of options used to create an engine.
 func NewEngineOptions() EngineOptions {
 	return &engineOptions{
-		parseOptions: promql.NewParseOptions(),
+		parseOptions:     promql.NewParseOptions(),
+		splitConcurrency: defaultSplitConcurrency,
 	}
 }
 
@@ -81,3 +86,23 @@ func (o *engineOptions) SetParseOptions(p promql.ParseOptions) EngineOptions {
 	opts.parseOptions = p
 	return &opts
 }
+
+func (o *engineOptions) SplitInterval() time.Duration {
+	return o.splitInterval
+}
+
+func (o *engineOptions) SetSplitInterval(v time.Duration) EngineOptions {
+	opts := *o
+	opts.splitInterval = v
+	return &opts
+}
+
+func (o *engineOptions) SplitConcurrency() int {
+	return o.splitConcurrency
+}
+
+func (o *engineOptions) SetSplitConcurrency(v int) EngineOptions {
+	opts := *o
+	opts.splitConcurrency = v
+	return &opts
+}
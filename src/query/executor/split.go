@@ -0,0 +1,279 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/storage"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/opentracing"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// splitParams splits a range query into sub-intervals aligned to the given
+// interval, such that every step of the query is evaluated by exactly one of
+// the sub-intervals. Each sub-interval is planned independently, so fetches
+// are extended backwards to account for the ranges and lookback required at
+// its start. Returns nil if the query should not be split.
+func splitParams(
+	params models.RequestParams,
+	opts *QueryOptions,
+	interval time.Duration,
+) []models.RequestParams {
+	if interval <= 0 || params.Step <= 0 || opts.QueryContextOptions.Instantaneous {
+		return nil
+	}
+
+	var (
+		step  = params.Step
+		last  = params.LastStep()
+		start = params.Start
+	)
+
+	if !last.After(start) {
+		return nil
+	}
+
+	var splits []models.RequestParams
+	for !start.After(last) {
+		// NB: sub-intervals end at the last step before the next interval
+		// boundary, keeping steps aligned to the start of the whole query.
+		boundary := start.Truncate(interval).Add(interval)
+		next := params.Start.Add(ceilDuration(boundary.Sub(params.Start), step))
+		end := xtime.MinUnixNano(next.Add(-step), last)
+
+		split := params
+		split.Start = start
+		split.End = end
+		split.IncludeEnd = true
+		splits = append(splits, split)
+		start = end.Add(step)
+	}
+
+	return splits
+}
+
+func ceilDuration(d, step time.Duration) time.Duration {
+	if rem := d % step; rem != 0 {
+		return d + step - rem
+	}
+
+	return d
+}
+
+// executeSplit executes each sub-interval of a split query, with bounded
+// concurrency, and stitches the results into a single block.
+func (e *engine) executeSplit(
+	ctx context.Context,
+	nodes parser.Nodes,
+	edges parser.Edges,
+	opts *QueryOptions,
+	fetchOpts *storage.FetchOptions,
+	params models.RequestParams,
+	splits []models.RequestParams,
+) (block.Block, error) {
+	sp, ctx := opentracing.StartSpanFromContext(ctx, "execute_split")
+	defer sp.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := e.opts.SplitConcurrency()
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		multiErr xerrors.MultiError
+		blocks   = make([]block.Block, len(splits))
+		leases   = make(chan struct{}, concurrency)
+	)
+
+	for i, split := range splits {
+		leases <- struct{}{}
+		if ctx.Err() != nil {
+			// NB: a sub-interval has already failed, so skip the remainder.
+			<-leases
+			break
+		}
+
+		i, split := i, split
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-leases
+				wg.Done()
+			}()
+
+			req := newRequest(e, split, fetchOpts, e.opts.InstrumentOptions())
+			bl, err := e.execute(ctx, req, nodes, edges, opts, params)
+			if err != nil {
+				mu.Lock()
+				multiErr = multiErr.Add(err)
+				mu.Unlock()
+				cancel()
+				return
+			}
+
+			blocks[i] = bl
+		}()
+	}
+
+	wg.Wait()
+
+	defer func() {
+		for _, bl := range blocks {
+			if bl != nil {
+				bl.Close()
+			}
+		}
+	}()
+
+	if err := multiErr.FinalError(); err != nil {
+		return nil, err
+	}
+
+	queryCtx := models.NewQueryContext(ctx,
+		e.opts.InstrumentOptions().MetricsScope(), opts.QueryContextOptions)
+	return stitchBlocks(queryCtx, splits, blocks)
+}
+
+// stitchBlocks combines the results of each sub-interval of a split query
+// into a single block spanning every step of the query. Series are matched
+// across sub-intervals by their tags, and steps where a series is absent from
+// a sub-interval are filled with NaNs.
+func stitchBlocks(
+	queryCtx *models.QueryContext,
+	splits []models.RequestParams,
+	blocks []block.Block,
+) (block.Block, error) {
+	var (
+		first = splits[0]
+		step  = first.Step
+		last  = splits[len(splits)-1].End
+		steps = int(last.Sub(first.Start)/step) + 1
+
+		seriesMetas []block.SeriesMeta
+		values      [][]float64
+		byID        = make(map[string]int)
+	)
+
+	resultMeta := block.NewResultMetadata()
+	for i, bl := range blocks {
+		meta := bl.Meta()
+		resultMeta = resultMeta.CombineMetadata(meta.ResultMetadata)
+
+		iter, err := bl.StepIter()
+		if err != nil {
+			return nil, err
+		}
+
+		// NB: common tags differ between sub-intervals, so are pushed down
+		// onto each series of the stitched block.
+		indices := make([]int, 0, len(iter.SeriesMeta()))
+		for _, seriesMeta := range iter.SeriesMeta() {
+			tags := seriesMeta.Tags.AddTags(meta.Tags.Tags)
+			id := string(tags.ID())
+			idx, ok := byID[id]
+			if !ok {
+				idx = len(seriesMetas)
+				byID[id] = idx
+				seriesMetas = append(seriesMetas, block.SeriesMeta{
+					Name: seriesMeta.Name,
+					Tags: tags,
+				})
+
+				series := make([]float64, steps)
+				for j := range series {
+					series[j] = math.NaN()
+				}
+
+				values = append(values, series)
+			}
+
+			indices = append(indices, idx)
+		}
+
+		split := splits[i]
+		for iter.Next() {
+			current := iter.Current()
+			t := current.Time()
+			if t.Before(split.Start) || t.After(split.End) {
+				continue
+			}
+
+			stepIdx := int(t.Sub(first.Start) / step)
+			for j, v := range current.Values() {
+				values[indices[j]][stepIdx] = v
+			}
+		}
+
+		err = iter.Err()
+		iter.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	meta := block.Metadata{
+		Bounds: models.Bounds{
+			Start:    first.Start,
+			Duration: time.Duration(steps) * step,
+			StepSize: step,
+		},
+		Tags:           models.NewTags(0, blocks[0].Meta().Tags.Opts),
+		ResultMetadata: resultMeta,
+	}
+
+	builder := block.NewColumnBlockBuilder(queryCtx, meta, seriesMetas)
+	if err := builder.AddCols(steps); err != nil {
+		return nil, err
+	}
+
+	column := make([]float64, len(values))
+	for stepIdx := 0; stepIdx < steps; stepIdx++ {
+		for i, series := range values {
+			column[i] = series[stepIdx]
+		}
+
+		if err := builder.AppendValues(stepIdx, column); err != nil {
+			return nil, err
+		}
+	}
+
+	// NB: preserve scalar and time block types, since these are rendered
+	// differently from vectors.
+	switch blockType := blocks[0].Info().Type(); blockType {
+	case block.BlockScalar, block.BlockTime:
+		return builder.BuildAsType(blockType), nil
+	default:
+		return builder.Build(), nil
+	}
+}
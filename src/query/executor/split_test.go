@@ -0,0 +1,225 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser/promql"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/x/instrument"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitParams(t *testing.T) {
+	var (
+		day   = 24 * time.Hour
+		start = xtime.Now().Truncate(day).Add(-3 * day).Add(-time.Hour)
+		step  = 7 * time.Minute
+	)
+
+	params := models.RequestParams{
+		Start:      start,
+		End:        start.Add(2 * day),
+		Step:       step,
+		IncludeEnd: true,
+	}
+
+	splits := splitParams(params, &QueryOptions{}, day)
+	require.Len(t, splits, 3)
+	assert.Equal(t, start, splits[0].Start)
+	assert.Equal(t, params.LastStep(), splits[2].End)
+
+	for i, split := range splits {
+		assert.True(t, split.IncludeEnd)
+		assert.Equal(t, time.Duration(0), split.Start.Sub(start)%step)
+		assert.Equal(t, time.Duration(0), split.End.Sub(start)%step)
+		if i == 0 {
+			continue
+		}
+
+		// NB: every sub-interval starts at the first step on or after a day
+		// boundary, directly following the last step of the previous one.
+		assert.Equal(t, splits[i-1].End.Add(step), split.Start)
+		boundary := split.Start.Truncate(day)
+		assert.True(t, split.Start.Sub(boundary) < step)
+	}
+
+	assert.Nil(t, splitParams(params, &QueryOptions{}, 0))
+	assert.Nil(t, splitParams(params, &QueryOptions{
+		QueryContextOptions: models.QueryContextOptions{Instantaneous: true},
+	}, day))
+
+	// NB: queries within a single interval are not split.
+	params.Start = start.Add(2 * time.Hour)
+	params.End = params.Start.Add(time.Hour)
+	assert.Len(t, splitParams(params, &QueryOptions{}, day), 1)
+}
+
+func TestStitchBlocks(t *testing.T) {
+	var (
+		start = xtime.Now().Truncate(time.Hour)
+		step  = time.Minute
+		metas = test.NewSeriesMeta("foo", 2)
+	)
+
+	splits := []models.RequestParams{
+		{Start: start, End: start.Add(step), Step: step},
+		{Start: start.Add(2 * step), End: start.Add(3 * step), Step: step},
+	}
+
+	// NB: the first block includes a step preceding the first sub-interval,
+	// which must be dropped when stitching.
+	first := test.NewBlockFromValuesWithSeriesMeta(models.Bounds{
+		Start:    start.Add(-step),
+		Duration: 3 * step,
+		StepSize: step,
+	}, metas[:1], [][]float64{{-1, 0, 1}})
+	second := test.NewBlockFromValuesWithSeriesMeta(models.Bounds{
+		Start:    start.Add(2 * step),
+		Duration: 2 * step,
+		StepSize: step,
+	}, metas, [][]float64{{2, 3}, {20, 30}})
+
+	bl, err := stitchBlocks(models.NoopQueryContext(), splits,
+		[]block.Block{first, second})
+	require.NoError(t, err)
+
+	meta := bl.Meta()
+	assert.Equal(t, start, meta.Bounds.Start)
+	assert.Equal(t, 4, meta.Bounds.Steps())
+
+	iter, err := bl.StepIter()
+	require.NoError(t, err)
+	require.Len(t, iter.SeriesMeta(), 2)
+	assert.Equal(t, metas[0].Tags, iter.SeriesMeta()[0].Tags)
+	assert.Equal(t, metas[1].Tags, iter.SeriesMeta()[1].Tags)
+
+	var actual [][]float64
+	for iter.Next() {
+		actual = append(actual, append([]float64{}, iter.Current().Values()...))
+	}
+
+	require.NoError(t, iter.Err())
+	test.EqualsWithNans(t, [][]float64{
+		{0, math.NaN()},
+		{1, math.NaN()},
+		{2, 20},
+		{3, 30},
+	}, actual)
+}
+
+func TestExecuteExprSplitWithAtModifier(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		start    = xtime.Now().Truncate(time.Hour).Add(-3 * time.Hour)
+		step     = time.Minute
+		lookback = 5 * time.Minute
+		at       = start.Add(30 * time.Minute)
+	)
+
+	parser, err := promql.Parse(fmt.Sprintf("foo @ %d", at.Seconds()), step,
+		models.NewTagOptions(), promql.NewParseOptions())
+	require.NoError(t, err)
+
+	var (
+		mu      sync.Mutex
+		queries []*storage.FetchQuery
+	)
+
+	store := storage.NewMockStorage(ctrl)
+	store.EXPECT().FetchBlocks(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			query *storage.FetchQuery,
+			_ *storage.FetchOptions,
+		) (block.Result, error) {
+			mu.Lock()
+			queries = append(queries, query)
+			mu.Unlock()
+
+			bounds := models.Bounds{
+				Start:    xtime.ToUnixNano(query.Start),
+				Duration: query.End.Sub(query.Start),
+				StepSize: query.Interval,
+			}
+
+			values := make([]float64, bounds.Steps())
+			for i := range values {
+				values[i] = float64(i)
+			}
+
+			return block.Result{
+				Blocks: []block.Block{test.NewBlockFromValues(bounds, [][]float64{values})},
+			}, nil
+		}).Times(3)
+
+	engine := NewEngine(NewEngineOptions().
+		SetStore(store).
+		SetSplitInterval(time.Hour).
+		SetInstrumentOptions(instrument.NewOptions()))
+
+	bl, err := engine.ExecuteExpr(context.TODO(), parser,
+		&QueryOptions{}, storage.NewFetchOptions(), models.RequestParams{
+			Start:            start,
+			End:              start.Add(3 * time.Hour).Add(-step),
+			Step:             step,
+			IncludeEnd:       true,
+			LookbackDuration: lookback,
+		})
+	require.NoError(t, err)
+	defer bl.Close()
+
+	// NB: every sub-interval fetches the same range, ending at the step the
+	// @ modifier pins the query to.
+	require.Len(t, queries, 3)
+	for _, query := range queries {
+		assert.Equal(t, at.Add(-lookback).ToTime(), query.Start)
+		assert.Equal(t, at.Add(step).ToTime(), query.End)
+	}
+
+	iter, err := bl.StepIter()
+	require.NoError(t, err)
+
+	steps := 0
+	for iter.Next() {
+		assert.Equal(t, []float64{lookback.Minutes()}, iter.Current().Values())
+		steps++
+	}
+
+	require.NoError(t, iter.Err())
+	assert.Equal(t, 180, steps)
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 
 	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/plan"
@@ -139,6 +140,14 @@ func (s *ExecutionState) createNode(
 	options transform.Options,
 ) (*transform.Controller, error) {
 	// TODO: consider using a registry instead of casting to an interface.
+	subqueryOp, ok := step.Transform.Op.(functions.SubqueryOp)
+	if ok {
+		source, controller := createSubquerySource(step.ID(), subqueryOp,
+			s.storage, options, s.plan.LookbackDuration)
+		s.sources = append(s.sources, source)
+		return controller, nil
+	}
+
 	sourceParams, ok := step.Transform.Op.(SourceParams)
 	if ok {
 		source, controller := CreateSource(step.ID(), sourceParams,
@@ -0,0 +1,224 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"math"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/plan"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/ts"
+	"github.com/m3db/m3/src/x/opentracing"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// subquerySource evaluates the inner expression of a subquery and emits the
+// results as raw datapoints, so that downstream temporal functions can range
+// over them as if they were fetched from storage.
+type subquerySource struct {
+	op         functions.SubqueryOp
+	controller *transform.Controller
+	storage    storage.Storage
+	opts       transform.Options
+	lookback   time.Duration
+}
+
+func createSubquerySource(
+	ID parser.NodeID,
+	op functions.SubqueryOp,
+	storage storage.Storage,
+	options transform.Options,
+	lookback time.Duration,
+) (parser.Source, *transform.Controller) {
+	controller := &transform.Controller{ID: ID}
+	return &subquerySource{
+		op:         op,
+		controller: controller,
+		storage:    storage,
+		opts:       options,
+		lookback:   lookback,
+	}, controller
+}
+
+// params returns the request params for the inner expression. As with
+// Prometheus, the inner expression is evaluated at absolute multiples of the
+// subquery step, covering the range preceding every step of the outer query.
+func (s *subquerySource) params() models.RequestParams {
+	var (
+		timeSpec = s.opts.TimeSpec().WithAtModifier(s.op.At)
+		step     = s.op.Step
+		start    = timeSpec.Start.Add(-s.op.Offset - s.op.Range)
+		end      = timeSpec.End.Add(-timeSpec.Step - s.op.Offset)
+	)
+
+	alignedStart := start.Truncate(step)
+	if alignedStart.Before(start) {
+		alignedStart = alignedStart.Add(step)
+	}
+
+	return models.RequestParams{
+		Start:            alignedStart,
+		End:              end.Truncate(step),
+		Now:              timeSpec.Now,
+		Step:             step,
+		IncludeEnd:       true,
+		Debug:            s.opts.Debug(),
+		BlockType:        s.opts.BlockType(),
+		LookbackDuration: s.lookback,
+	}
+}
+
+func (s *subquerySource) evaluate(
+	queryCtx *models.QueryContext,
+	params models.RequestParams,
+) (block.Block, error) {
+	lp, err := plan.NewLogicalPlan(s.op.Nodes, s.op.Edges)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := plan.NewPhysicalPlan(lp, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// NB: start() and end() within the subquery refer to the outer query.
+	outer := s.opts.TimeSpec()
+	pp.TimeSpec.QueryStart = outer.QueryStart
+	pp.TimeSpec.QueryEnd = outer.QueryEnd
+
+	state, err := GenerateExecutionState(pp, s.storage,
+		s.opts.FetchOptions(), s.opts.InstrumentOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := state.Execute(queryCtx); err != nil {
+		state.sink.closeWithError(err)
+		return nil, err
+	}
+
+	return state.sink.getValue()
+}
+
+// Execute runs the subquery and forwards its results downstream.
+func (s *subquerySource) Execute(queryCtx *models.QueryContext) error {
+	sp, ctx := opentracing.StartSpanFromContext(queryCtx.Ctx, "subquery")
+	defer sp.Finish()
+
+	var (
+		bounds = s.opts.TimeSpec().WithAtModifier(s.op.At).Bounds()
+		params = s.params()
+	)
+
+	queryCtx = queryCtx.WithContext(ctx)
+	if params.End.Before(params.Start) {
+		// NB: the subquery range is shorter than its step, so there are no
+		// timestamps to evaluate the inner expression at.
+		meta := block.Metadata{
+			Bounds:         bounds,
+			Tags:           models.EmptyTags(),
+			ResultMetadata: block.NewResultMetadata(),
+		}
+
+		return s.controller.Process(queryCtx, block.NewUnconsolidatedBlock(meta, nil))
+	}
+
+	inner, err := s.evaluate(queryCtx, params)
+	if err != nil {
+		return err
+	}
+
+	series, err := subquerySeries(inner, params.Start, s.op.Offset)
+	if err != nil {
+		inner.Close()
+		return err
+	}
+
+	meta := inner.Meta()
+	meta.Bounds = bounds
+	if err := inner.Close(); err != nil {
+		return err
+	}
+
+	bl := block.NewUnconsolidatedBlock(meta, series)
+	defer bl.Close()
+	return s.controller.Process(queryCtx, bl)
+}
+
+// subquerySeries converts the consolidated result of the inner expression into
+// raw series, dropping empty steps and any steps preceding the subquery range.
+// Timestamps are shifted forward by the subquery offset so that they line up
+// with the steps of the outer query.
+func subquerySeries(
+	bl block.Block,
+	start xtime.UnixNano,
+	offset time.Duration,
+) ([]block.UnconsolidatedSeries, error) {
+	iter, err := bl.StepIter()
+	if err != nil {
+		return nil, err
+	}
+
+	defer iter.Close()
+	metas := iter.SeriesMeta()
+	datapoints := make([]ts.Datapoints, len(metas))
+	for i := range datapoints {
+		datapoints[i] = make(ts.Datapoints, 0, iter.StepCount())
+	}
+
+	for iter.Next() {
+		step := iter.Current()
+		t := step.Time()
+		if t.Before(start) {
+			continue
+		}
+
+		for i, v := range step.Values() {
+			if math.IsNaN(v) {
+				continue
+			}
+
+			datapoints[i] = append(datapoints[i], ts.Datapoint{
+				Timestamp: t.Add(offset),
+				Value:     v,
+			})
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	series := make([]block.UnconsolidatedSeries, 0, len(metas))
+	for i, meta := range metas {
+		series = append(series, block.NewUnconsolidatedSeries(datapoints[i],
+			meta, block.UnconsolidatedSeriesStats{}))
+	}
+
+	return series, nil
+}
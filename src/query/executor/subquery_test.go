@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executor
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubqueryParams(t *testing.T) {
+	start := xtime.FromSeconds(3600)
+	opts, err := transform.NewOptions(transform.OptionsParams{
+		FetchOptions: storage.NewFetchOptions(),
+		TimeSpec: transform.TimeSpec{
+			Start: start,
+			End:   start.Add(10 * time.Minute),
+			Step:  time.Minute,
+		},
+		InstrumentOptions: instrument.NewOptions(),
+	})
+	require.NoError(t, err)
+
+	source := &subquerySource{
+		op: functions.SubqueryOp{
+			Range:  5*time.Minute + 10*time.Second,
+			Step:   time.Minute,
+			Offset: time.Minute,
+		},
+		opts:     opts,
+		lookback: defaultLookbackDuration,
+	}
+
+	params := source.params()
+	assert.Equal(t, start.Add(-6*time.Minute), params.Start)
+	assert.Equal(t, start.Add(8*time.Minute), params.End)
+	assert.Equal(t, time.Minute, params.Step)
+	assert.True(t, params.IncludeEnd)
+	assert.Equal(t, defaultLookbackDuration, params.LookbackDuration)
+}
+
+func TestSubquerySeries(t *testing.T) {
+	start := xtime.FromSeconds(3600)
+	bounds := models.Bounds{
+		Start:    start,
+		Duration: 4 * time.Minute,
+		StepSize: time.Minute,
+	}
+
+	nan := math.NaN()
+	bl := test.NewBlockFromValues(bounds, [][]float64{
+		{1, 2, nan, 4},
+		{nan, nan, nan, nan},
+	})
+
+	series, err := subquerySeries(bl, start.Add(time.Minute), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, series, 2)
+
+	dps := series[0].Datapoints()
+	require.Len(t, dps, 2)
+	assert.Equal(t, start.Add(2*time.Minute), dps[0].Timestamp)
+	assert.Equal(t, 2.0, dps[0].Value)
+	assert.Equal(t, start.Add(4*time.Minute), dps[1].Timestamp)
+	assert.Equal(t, 4.0, dps[1].Value)
+
+	assert.Len(t, series[1].Datapoints(), 0)
+}
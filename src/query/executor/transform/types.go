@@ -117,6 +117,14 @@ type TimeSpec struct {
 	Now time.Time
 	// Step is the step size for the query.
 	Step time.Duration
+	// Shift is how far Start has been moved back from the start of the
+	// request to account for ranges or lookback.
+	Shift time.Duration
+	// QueryStart is the start of the query as requested, which may cover a
+	// wider range than Start if the query was split; used to resolve @ start().
+	QueryStart xtime.UnixNano
+	// QueryEnd is the end of the query as requested; used to resolve @ end().
+	QueryEnd xtime.UnixNano
 }
 
 // Bounds transforms the timespec to bounds.
@@ -128,6 +136,20 @@ func (ts TimeSpec) Bounds() models.Bounds {
 	}
 }
 
+// WithAtModifier returns a timespec whose final step is pinned to the time the
+// @ modifier resolves to, keeping any shift applied to the start of the query.
+// The timespec is returned unchanged if the modifier is not set.
+func (ts TimeSpec) WithAtModifier(at models.AtModifier) TimeSpec {
+	t, ok := at.Resolve(ts.QueryStart, ts.QueryEnd)
+	if !ok {
+		return ts
+	}
+
+	ts.Start = t.Add(-ts.Shift)
+	ts.End = t.Add(ts.Step)
+	return ts
+}
+
 // Params are defined by transforms.
 type Params interface {
 	parser.Params
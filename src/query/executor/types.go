@@ -82,4 +82,18 @@ type EngineOptions interface {
 	ParseOptions() promql.ParseOptions
 	// SetParseOptions sets the parse options.
 	SetParseOptions(p promql.ParseOptions) EngineOptions
+
+	// SplitInterval returns the interval range queries are split by, where
+	// zero disables splitting.
+	SplitInterval() time.Duration
+	// SetSplitInterval sets the interval range queries are split by, where
+	// zero disables splitting.
+	SetSplitInterval(time.Duration) EngineOptions
+
+	// SplitConcurrency returns the maximum number of split intervals of a
+	// single query to execute concurrently.
+	SplitConcurrency() int
+	// SetSplitConcurrency sets the maximum number of split intervals of a
+	// single query to execute concurrently.
+	SetSplitConcurrency(int) EngineOptions
 }
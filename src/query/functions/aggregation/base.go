@@ -41,6 +41,7 @@ var aggregationFunctions = map[string]aggregationFn{
 	StandardDeviationType: stddevFn,
 	StandardVarianceType:  varianceFn,
 	CountType:             countFn,
+	GroupType:             groupFn,
 }
 
 // NodeParams contains additional parameters required for aggregation ops.
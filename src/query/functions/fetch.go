@@ -45,6 +45,7 @@ type FetchOp struct {
 	Name     string
 	Range    time.Duration
 	Offset   time.Duration
+	At       models.AtModifier
 	Matchers models.Matchers
 }
 
@@ -76,8 +77,8 @@ func (o FetchOp) Bounds() transform.BoundSpec {
 
 // String is the string representation for this operation.
 func (o FetchOp) String() string {
-	return fmt.Sprintf("type: %s. name: %s, range: %v, offset: %v, matchers: %v",
-		o.OpType(), o.Name, o.Range, o.Offset, o.Matchers)
+	return fmt.Sprintf("type: %s. name: %s, range: %v, offset: %v, at: %v, matchers: %v",
+		o.OpType(), o.Name, o.Range, o.Offset, o.At, o.Matchers)
 }
 
 // Node creates the fetch execution node for this operation.
@@ -103,9 +104,9 @@ func (n *FetchNode) fetch(queryCtx *models.QueryContext) (block.Result, error) {
 	sp, ctx := opentracing.StartSpanFromContext(ctx, "fetch")
 	defer sp.Finish()
 
-	timeSpec := n.timespec
 	// No need to adjust start and ends since physical plan
-	// already considers the offset, range
+	// already considers the offset, range; only pin them for @ modifiers.
+	timeSpec := n.timespec.WithAtModifier(n.op.At)
 	startTime := timeSpec.Start
 	endTime := timeSpec.End
 
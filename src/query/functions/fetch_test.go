@@ -123,6 +123,56 @@ func TestOffsetFetch(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAtModifierFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := storage.NewMockStorage(ctrl)
+	now := time.Now().Truncate(time.Minute)
+	at := now.Add(time.Hour * -2)
+	op := &FetchOp{
+		Offset: time.Minute,
+		At: models.AtModifier{
+			Type:      models.AtTimestamp,
+			Timestamp: xtime.ToUnixNano(at),
+		},
+	}
+
+	start := now.Add(time.Hour * -1)
+	opts := transformtest.Options(t, transform.OptionsParams{
+		TimeSpec: transform.TimeSpec{
+			Start:      xtime.ToUnixNano(start.Add(time.Minute * -5)),
+			End:        xtime.ToUnixNano(now),
+			Now:        now,
+			Step:       time.Minute,
+			Shift:      time.Minute * 5,
+			QueryStart: xtime.ToUnixNano(start),
+			QueryEnd:   xtime.ToUnixNano(now),
+		},
+	})
+
+	qMatcher := &predicateMatcher{
+		name: "query",
+		fn: func(i interface{}) bool {
+			q, ok := i.(*storage.FetchQuery)
+			if !ok {
+				return false
+			}
+
+			return q.Start.Equal(at.Add(time.Minute*-6)) &&
+				q.End.Equal(at)
+		},
+	}
+
+	store.EXPECT().FetchBlocks(gomock.Any(), qMatcher, gomock.Any())
+
+	c, _ := executor.NewControllerWithSink(parser.NodeID(rune(1)))
+	node := op.Node(c, store, opts)
+
+	err := node.Execute(models.NoopQueryContext())
+	require.NoError(t, err)
+}
+
 func TestFetchWithRestrictFetch(t *testing.T) {
 	values, bounds := test.GenerateValuesAndBounds(nil, nil)
 	b := test.NewBlockFromValues(bounds, values)
@@ -0,0 +1,184 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package linear
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/functions/utils"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+const (
+	// HistogramCountType returns the count of observations of native
+	// histograms.
+	//
+	// NB: native histograms are only queryable once expanded into their
+	// classic histogram series at fetch time; the count is the value of the
+	// +Inf bucket series.
+	HistogramCountType = "histogram_count"
+
+	// HistogramSumType returns the sum of observations of native histograms.
+	//
+	// NB: the sum is the value of the series tagged with the native histogram
+	// sum tag by the fetch time expansion.
+	HistogramSumType = "histogram_sum"
+)
+
+// NewHistogramFunctionOp creates a new native histogram count or sum
+// operation.
+func NewHistogramFunctionOp(opType string) (parser.Params, error) {
+	if opType != HistogramCountType && opType != HistogramSumType {
+		return nil, fmt.Errorf("operator not supported: %s", opType)
+	}
+
+	return histogramFunctionOp{opType: opType}, nil
+}
+
+// histogramFunctionOp stores required properties for native histogram ops.
+type histogramFunctionOp struct {
+	opType string
+}
+
+// OpType for the operator.
+func (o histogramFunctionOp) OpType() string {
+	return o.opType
+}
+
+// String representation.
+func (o histogramFunctionOp) String() string {
+	return fmt.Sprintf("type: %s", o.OpType())
+}
+
+// Node creates an execution node.
+func (o histogramFunctionOp) Node(
+	controller *transform.Controller,
+	_ transform.Options,
+) transform.OpNode {
+	return &histogramFunctionNode{
+		op:         o,
+		controller: controller,
+	}
+}
+
+type histogramFunctionNode struct {
+	op         histogramFunctionOp
+	controller *transform.Controller
+}
+
+// matches returns true if the series with the given tags holds the value
+// of the operation.
+func (o histogramFunctionOp) matches(tags models.Tags) bool {
+	if o.opType == HistogramSumType {
+		_, found := tags.Get(models.NativeHistogramSumTagName)
+		return found
+	}
+
+	value, found := tags.Bucket()
+	if !found {
+		return false
+	}
+
+	bound, err := strconv.ParseFloat(string(value), 64)
+	return err == nil && math.IsInf(bound, 1)
+}
+
+func (n *histogramFunctionNode) Params() parser.Params {
+	return n.op
+}
+
+// Process the block
+func (n *histogramFunctionNode) Process(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) error {
+	return transform.ProcessSimpleBlock(n, n.controller, queryCtx, ID, b)
+}
+
+func (n *histogramFunctionNode) ProcessBlock(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) (block.Block, error) {
+	stepIter, err := b.StepIter()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := b.Meta()
+	seriesMetas := utils.FlattenMetadata(meta, stepIter.SeriesMeta())
+
+	var (
+		indices = make([]int, 0, len(seriesMetas))
+		metas   = make([]block.SeriesMeta, 0, len(seriesMetas))
+	)
+
+	for i, seriesMeta := range seriesMetas {
+		tags := seriesMeta.Tags
+		if !n.op.matches(tags) {
+			continue
+		}
+
+		excludeTags := [][]byte{
+			tags.Opts.MetricName(),
+			tags.Opts.BucketName(),
+			models.NativeHistogramSumTagName,
+		}
+
+		indices = append(indices, i)
+		metas = append(metas, block.SeriesMeta{
+			Tags: tags.TagsWithoutKeys(excludeTags),
+		})
+	}
+
+	builder, err := n.controller.BlockBuilder(queryCtx, meta, metas)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = builder.AddCols(stepIter.StepCount()); err != nil {
+		return nil, err
+	}
+
+	for index := 0; stepIter.Next(); index++ {
+		values := stepIter.Current().Values()
+		selected := make([]float64, 0, len(indices))
+		for _, idx := range indices {
+			selected = append(selected, values[idx])
+		}
+
+		if err := builder.AppendValues(index, selected); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = stepIter.Err(); err != nil {
+		return nil, err
+	}
+
+	return builder.Build(), nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package linear
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/test/executor"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistogramFunctionOp(t *testing.T) {
+	_, err := NewHistogramFunctionOp(HistogramQuantileType)
+	assert.Error(t, err)
+
+	op, err := NewHistogramFunctionOp(HistogramCountType)
+	require.NoError(t, err)
+	assert.Equal(t, HistogramCountType, op.OpType())
+	assert.Equal(t, "type: histogram_count", op.String())
+
+	op, err = NewHistogramFunctionOp(HistogramSumType)
+	require.NoError(t, err)
+	assert.Equal(t, HistogramSumType, op.OpType())
+	assert.Equal(t, "type: histogram_sum", op.String())
+}
+
+func testHistogramFunction(
+	t *testing.T,
+	opType string,
+) ([]block.SeriesMeta, [][]float64) {
+	op, err := NewHistogramFunctionOp(opType)
+	require.NoError(t, err)
+
+	tagOpts := models.NewTagOptions().
+		SetIDSchemeType(models.TypeQuoted).
+		SetMetricName([]byte("name")).
+		SetBucketName([]byte("bucket"))
+
+	tags := models.NewTags(3, tagOpts).SetName([]byte("foo")).AddTag(models.Tag{
+		Name:  []byte("bar"),
+		Value: []byte("baz"),
+	})
+
+	seriesMetas := []block.SeriesMeta{
+		{Tags: tags.Clone().SetBucket([]byte("0.5"))},
+		{Tags: tags.Clone().SetBucket([]byte("1"))},
+		{Tags: tags.Clone().SetBucket([]byte("+Inf"))},
+		{Tags: tags.Clone().AddTag(models.Tag{
+			Name:  models.NativeHistogramSumTagName,
+			Value: []byte("true"),
+		})},
+		// this series is neither a count nor a sum series.
+		{Tags: tags.Clone()},
+	}
+
+	v := [][]float64{
+		{1, 2, 3},
+		{2, 3, 4},
+		{3, 5, math.NaN()},
+		{1.5, 4, 6.5},
+		{7, 8, 9},
+	}
+
+	bounds := models.Bounds{
+		Start:    xtime.Now(),
+		Duration: time.Minute * 3,
+		StepSize: time.Minute,
+	}
+
+	bl := test.NewBlockFromValuesWithSeriesMeta(bounds, seriesMetas, v)
+	c, sink := executor.NewControllerWithSink(parser.NodeID(rune(1)))
+	node := op.(histogramFunctionOp).Node(c, transform.Options{})
+	err = node.Process(models.NoopQueryContext(), parser.NodeID(rune(0)), bl)
+	require.NoError(t, err)
+
+	return sink.Metas, sink.Values
+}
+
+func TestHistogramCount(t *testing.T) {
+	metas, values := testHistogramFunction(t, HistogramCountType)
+	require.Equal(t, 1, len(metas))
+	assert.Equal(t, `{bar="baz"}`, string(metas[0].Tags.ID()))
+	test.EqualsWithNans(t, [][]float64{{3, 5, math.NaN()}}, values)
+}
+
+func TestHistogramSum(t *testing.T) {
+	metas, values := testHistogramFunction(t, HistogramSumType)
+	require.Equal(t, 1, len(metas))
+	assert.Equal(t, `{bar="baz"}`, string(metas[0].Tags.ID()))
+	assert.Equal(t, [][]float64{{1.5, 4, 6.5}}, values)
+}
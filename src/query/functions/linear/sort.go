@@ -21,6 +21,7 @@
 package linear
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
@@ -37,6 +38,14 @@ const (
 
 	// SortDescType is the same as sort, but sorts in descending order.
 	SortDescType = "sort_desc"
+
+	// SortByLabelType returns timeseries elements sorted by the values of the
+	// given labels, in ascending order.
+	SortByLabelType = "sort_by_label"
+
+	// SortByLabelDescType is the same as sort_by_label, but sorts in descending
+	// order.
+	SortByLabelDescType = "sort_by_label_desc"
 )
 
 type sortOp struct {
@@ -64,7 +73,7 @@ type valueAndMeta struct {
 	seriesMeta block.SeriesMeta
 }
 
-type lessFn func (i, j float64) bool
+type lessFn func(i, j valueAndMeta) bool
 
 // Node creates an execution node
 func (o sortOp) Node(
@@ -116,8 +125,8 @@ func (n *sortNode) processInstantBlock(queryCtx *models.QueryContext, stepIter b
 		}
 	}
 
-	sort.Slice(valuesToSort, func(i, j int) bool {
-		return n.op.lessFn(valuesToSort[i].val, valuesToSort[j].val)
+	sort.SliceStable(valuesToSort, func(i, j int) bool {
+		return n.op.lessFn(valuesToSort[i], valuesToSort[j])
 	})
 
 	for i, sorted := range valuesToSort {
@@ -158,9 +167,45 @@ func NewSortOp(opType string) (parser.Params, error) {
 		return nil, fmt.Errorf("operator not supported: %s", opType)
 	}
 
-	lessFn := utils.GreaterWithNaNs
+	valueLessFn := utils.GreaterWithNaNs
 	if ascending {
-		lessFn = utils.LesserWithNaNs
+		valueLessFn = utils.LesserWithNaNs
+	}
+
+	lessFn := func(i, j valueAndMeta) bool {
+		return valueLessFn(i.val, j.val)
+	}
+
+	return sortOp{opType, lessFn}, nil
+}
+
+// NewSortByLabelOp creates a new op which sorts series by the values of the
+// given labels, in order; series missing a label sort before those that have it.
+func NewSortByLabelOp(opType string, labels []string) (parser.Params, error) {
+	ascending := opType == SortByLabelType
+	if !ascending && opType != SortByLabelDescType {
+		return nil, fmt.Errorf("operator not supported: %s", opType)
+	}
+
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("%s requires at least one label", opType)
+	}
+
+	names := make([][]byte, 0, len(labels))
+	for _, l := range labels {
+		names = append(names, []byte(l))
+	}
+
+	lessFn := func(i, j valueAndMeta) bool {
+		for _, name := range names {
+			a, _ := i.seriesMeta.Tags.Get(name)
+			b, _ := j.seriesMeta.Tags.Get(name)
+			if cmp := bytes.Compare(a, b); cmp != 0 {
+				return (cmp < 0) == ascending
+			}
+		}
+
+		return false
 	}
 
 	return sortOp{opType, lessFn}, nil
@@ -98,19 +98,48 @@ func TestSortNop(t *testing.T) {
 	test.EqualsWithNansWithDelta(t, expected, sink.Values, math.Pow10(-5))
 }
 
-var (
-	seriesMetas = []block.SeriesMeta{
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "0"}, {N: "group", V: "production"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "1"}, {N: "group", V: "production"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "2"}, {N: "group", V: "production"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "0"}, {N: "group", V: "canary"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "1"}, {N: "group", V: "canary"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "0"}, {N: "group", V: "production"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "1"}, {N: "group", V: "production"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "0"}, {N: "group", V: "canary"}})},
-		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "1"}, {N: "group", V: "canary"}})},
+func TestSortByLabelInstant(t *testing.T) {
+	_, err := NewSortByLabelOp(SortByLabelType, nil)
+	require.Error(t, err)
+
+	op, err := NewSortByLabelOp(SortByLabelType, []string{"group", "instance"})
+	require.NoError(t, err)
+
+	sink := processSortOpWithSeriesMetas(t, op, true, newSeriesMetas())
+	expectedValues := [][]float64{{400}, {700}, {math.NaN()}, {800},
+		{100}, {500}, {200}, {600}, {300}}
+
+	require.Len(t, sink.Metas, len(seriesMetas))
+	expectedOrder := []string{"canary0", "canary0", "canary1", "canary1",
+		"production0", "production0", "production1", "production1", "production2"}
+	for i, m := range sink.Metas {
+		group, _ := m.Tags.Get([]byte("group"))
+		instance, _ := m.Tags.Get([]byte("instance"))
+		assert.Equal(t, expectedOrder[i], string(group)+string(instance))
 	}
 
+	test.EqualsWithNansWithDelta(t, expectedValues, sink.Values, math.Pow10(-5))
+}
+
+func TestSortByLabelDescInstant(t *testing.T) {
+	op, err := NewSortByLabelOp(SortByLabelDescType, []string{"job"})
+	require.NoError(t, err)
+
+	sink := processSortOpWithSeriesMetas(t, op, true, newSeriesMetas())
+	require.Len(t, sink.Metas, len(seriesMetas))
+	for i, m := range sink.Metas {
+		job, _ := m.Tags.Get([]byte("job"))
+		if i < 4 {
+			assert.Equal(t, "app-server", string(job))
+		} else {
+			assert.Equal(t, "api-server", string(job))
+		}
+	}
+}
+
+var (
+	seriesMetas = newSeriesMetas()
+
 	v = [][]float64{
 		{60, 70, 80, 90, 100},
 		{150, 160, 170, 180, 200},
@@ -130,8 +159,31 @@ var (
 	}
 )
 
+func newSeriesMetas() []block.SeriesMeta {
+	return []block.SeriesMeta{
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "0"}, {N: "group", V: "production"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "1"}, {N: "group", V: "production"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "2"}, {N: "group", V: "production"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "0"}, {N: "group", V: "canary"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "api-server"}, {N: "instance", V: "1"}, {N: "group", V: "canary"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "0"}, {N: "group", V: "production"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "1"}, {N: "group", V: "production"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "0"}, {N: "group", V: "canary"}})},
+		{Tags: test.StringTagsToTags(test.StringTags{{N: "job", V: "app-server"}, {N: "instance", V: "1"}, {N: "group", V: "canary"}})},
+	}
+}
+
 func processSortOp(t *testing.T, op parser.Params, instant bool) *executor.SinkNode {
-	bl := test.NewBlockFromValuesWithSeriesMeta(bounds, seriesMetas, v)
+	return processSortOpWithSeriesMetas(t, op, instant, seriesMetas)
+}
+
+func processSortOpWithSeriesMetas(
+	t *testing.T,
+	op parser.Params,
+	instant bool,
+	metas []block.SeriesMeta,
+) *executor.SinkNode {
+	bl := test.NewBlockFromValuesWithSeriesMeta(bounds, metas, v)
 	c, sink := executor.NewControllerWithSink(parser.NodeID(rune(1)))
 	node := op.(sortOp).Node(c, transform.Options{})
 	queryContext := models.NoopQueryContext()
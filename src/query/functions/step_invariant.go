@@ -0,0 +1,131 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+// StepInvariantType broadcasts the result of an expression pinned by the @
+// modifier to every step of the query.
+const StepInvariantType = "step_invariant"
+
+// StepInvariantOp stores required properties for step invariant expressions.
+type StepInvariantOp struct{}
+
+// NewStepInvariantOp creates a new step invariant operation.
+func NewStepInvariantOp() StepInvariantOp {
+	return StepInvariantOp{}
+}
+
+// OpType for the operator.
+func (o StepInvariantOp) OpType() string {
+	return StepInvariantType
+}
+
+// String is the string representation for this operation.
+func (o StepInvariantOp) String() string {
+	return fmt.Sprintf("type: %s", o.OpType())
+}
+
+// Node creates an execution node.
+func (o StepInvariantOp) Node(
+	controller *transform.Controller,
+	opts transform.Options,
+) transform.OpNode {
+	return &stepInvariantNode{
+		op:         o,
+		controller: controller,
+		bounds:     opts.TimeSpec().Bounds(),
+	}
+}
+
+type stepInvariantNode struct {
+	op         StepInvariantOp
+	controller *transform.Controller
+	bounds     models.Bounds
+}
+
+func (n *stepInvariantNode) Params() parser.Params {
+	return n.op
+}
+
+// Process the block.
+func (n *stepInvariantNode) Process(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) error {
+	return transform.ProcessSimpleBlock(n, n.controller, queryCtx, ID, b)
+}
+
+// ProcessBlock takes the values at the final step of the block, which is the
+// step pinned by the @ modifier, and repeats them across the query bounds.
+func (n *stepInvariantNode) ProcessBlock(
+	queryCtx *models.QueryContext,
+	ID parser.NodeID,
+	b block.Block,
+) (block.Block, error) {
+	stepIter, err := b.StepIter()
+	if err != nil {
+		return nil, err
+	}
+
+	seriesMetas := stepIter.SeriesMeta()
+	values := make([]float64, len(seriesMetas))
+	for i := range values {
+		values[i] = math.NaN()
+	}
+
+	for stepIter.Next() {
+		copy(values, stepIter.Current().Values())
+	}
+
+	if err := stepIter.Err(); err != nil {
+		return nil, err
+	}
+
+	meta := b.Meta()
+	meta.Bounds = n.bounds
+	builder, err := n.controller.BlockBuilder(queryCtx, meta, seriesMetas)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := n.bounds.Steps()
+	if err := builder.AddCols(steps); err != nil {
+		return nil, err
+	}
+
+	for index := 0; index < steps; index++ {
+		if err := builder.AppendValues(index, values); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.Build(), nil
+}
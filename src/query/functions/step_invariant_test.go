@@ -0,0 +1,73 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package functions
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+	"github.com/m3db/m3/src/query/test"
+	"github.com/m3db/m3/src/query/test/executor"
+	"github.com/m3db/m3/src/query/test/transformtest"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepInvariant(t *testing.T) {
+	pinned := models.Bounds{
+		Start:    xtime.Now().Truncate(time.Minute),
+		Duration: time.Minute * 2,
+		StepSize: time.Minute,
+	}
+
+	values, bounds := test.GenerateValuesAndBounds([][]float64{
+		{0, 1},
+		{2, math.NaN()},
+	}, &pinned)
+	b := test.NewBlockFromValues(bounds, values)
+
+	start := pinned.Start.Add(time.Hour)
+	c, sink := executor.NewControllerWithSink(parser.NodeID(rune(1)))
+	node := NewStepInvariantOp().Node(c, transformtest.Options(t,
+		transform.OptionsParams{
+			TimeSpec: transform.TimeSpec{
+				Start: start,
+				End:   start.Add(time.Minute * 3),
+				Step:  time.Minute,
+			},
+		}))
+
+	err := node.Process(models.NoopQueryContext(), parser.NodeID(rune(0)), b)
+	require.NoError(t, err)
+
+	test.EqualsWithNans(t, [][]float64{
+		{1, 1, 1},
+		{math.NaN(), math.NaN(), math.NaN()},
+	}, sink.Values)
+	assert.Equal(t, start, sink.Meta.Bounds.Start)
+	assert.Equal(t, 3, sink.Meta.Bounds.Steps())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package functions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m3db/m3/src/query/executor/transform"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/parser"
+)
+
+// SubqueryType evaluates an inner expression over a range at a given step.
+const SubqueryType = "subquery"
+
+// SubqueryOp stores required properties for a subquery. The inner expression
+// is described by its own DAG, which the executor evaluates with a time spec
+// derived from the outer query, the subquery range, step and offset.
+type SubqueryOp struct {
+	Nodes  parser.Nodes
+	Edges  parser.Edges
+	Range  time.Duration
+	Step   time.Duration
+	Offset time.Duration
+	At     models.AtModifier
+}
+
+// OpType for the operator.
+func (o SubqueryOp) OpType() string {
+	return SubqueryType
+}
+
+// Bounds returns the bounds for this operation.
+func (o SubqueryOp) Bounds() transform.BoundSpec {
+	return transform.BoundSpec{
+		Range:  o.Range,
+		Offset: o.Offset,
+	}
+}
+
+// String is the string representation for this operation.
+func (o SubqueryOp) String() string {
+	return fmt.Sprintf("type: %s. range: %v, step: %v, offset: %v, at: %v, nodes: %v",
+		o.OpType(), o.Range, o.Step, o.Offset, o.At, o.Nodes)
+}
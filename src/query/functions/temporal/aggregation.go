@@ -54,19 +54,31 @@ const (
 
 	// QuantileType calculates the φ-quantile (0 ≤ φ ≤ 1) of the values in the specified interval.
 	QuantileType = "quantile_over_time"
+
+	// LastType returns the most recent value in the specified interval.
+	LastType = "last_over_time"
+
+	// PresentType returns 1 for any series with values in the specified interval.
+	PresentType = "present_over_time"
+
+	// AbsentType returns 1 if there are no values for any series in the
+	// specified interval. It is evaluated as absent(present_over_time(...)).
+	AbsentType = "absent_over_time"
 )
 
 type aggFunc func([]float64) float64
 
 var (
 	aggFuncs = map[string]aggFunc{
-		AvgType:    avgOverTime,
-		CountType:  countOverTime,
-		MinType:    minOverTime,
-		MaxType:    maxOverTime,
-		SumType:    sumOverTime,
-		StdDevType: stddevOverTime,
-		StdVarType: stdvarOverTime,
+		AvgType:     avgOverTime,
+		CountType:   countOverTime,
+		MinType:     minOverTime,
+		MaxType:     maxOverTime,
+		SumType:     sumOverTime,
+		StdDevType:  stddevOverTime,
+		StdVarType:  stdvarOverTime,
+		LastType:    lastOverTime,
+		PresentType: presentOverTime,
 	}
 )
 
@@ -220,6 +232,26 @@ func stdvarOverTime(values []float64) float64 {
 	return aux / count
 }
 
+func lastOverTime(values []float64) float64 {
+	for i := len(values) - 1; i >= 0; i-- {
+		if !math.IsNaN(values[i]) {
+			return values[i]
+		}
+	}
+
+	return math.NaN()
+}
+
+func presentOverTime(values []float64) float64 {
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			return 1
+		}
+	}
+
+	return math.NaN()
+}
+
 func sumAndCount(values []float64) (float64, float64) {
 	sum := 0.0
 	count := 0.0
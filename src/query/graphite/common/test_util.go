@@ -232,3 +232,12 @@ func (s *MovingFunctionStorage) CompleteTags(
 ) (*consolidators.CompleteTagsResult, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+
+// SearchSeries implements the storage interface.
+func (s *MovingFunctionStorage) SearchSeries(
+	ctx stdcontext.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	return nil, fmt.Errorf("not implemented")
+}
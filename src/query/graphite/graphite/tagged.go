@@ -0,0 +1,292 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// NameTag is the tag that holds the dot separated path of a tagged
+	// graphite series, i.e. "a.b.c" for the series "a.b.c;dc=east".
+	NameTag = "name"
+
+	tagSeparator      = ';'
+	tagValueSeparator = '='
+
+	seriesByTagPrefix = "seriesByTag("
+	seriesByTagSuffix = ")"
+)
+
+var (
+	errEmptyTagExpression     = errors.New("tag expression empty")
+	errNoTagExpressions       = errors.New("seriesByTag requires at least one tag expression")
+	errInvalidSeriesByTagArgs = errors.New("seriesByTag arguments must be quoted strings")
+)
+
+// TagOperator is an operator in a graphite tag expression.
+type TagOperator string
+
+const (
+	// TagOperatorEqual matches series with a tag equal to the value.
+	TagOperatorEqual TagOperator = "="
+	// TagOperatorNotEqual matches series with a tag not equal to the value.
+	TagOperatorNotEqual TagOperator = "!="
+	// TagOperatorRegexp matches series with a tag matching the regexp.
+	TagOperatorRegexp TagOperator = "=~"
+	// TagOperatorNotRegexp matches series with a tag not matching the regexp.
+	TagOperatorNotRegexp TagOperator = "!=~"
+)
+
+// TagExpression is a single seriesByTag expression such as "dc=~east.*".
+type TagExpression struct {
+	Tag      string
+	Operator TagOperator
+	Value    string
+
+	re *regexp.Regexp
+}
+
+// ParseTagExpression parses a graphite tag expression.
+func ParseTagExpression(expr string) (TagExpression, error) {
+	idx := strings.IndexByte(expr, tagValueSeparator)
+	if idx < 0 {
+		return TagExpression{}, fmt.Errorf("invalid tag expression, no operator: %s", expr)
+	}
+
+	var (
+		tag    = expr[:idx]
+		value  = expr[idx+1:]
+		negate = strings.HasSuffix(tag, "!")
+		regex  = strings.HasPrefix(value, "~")
+	)
+	if negate {
+		tag = tag[:len(tag)-1]
+	}
+	if regex {
+		value = value[1:]
+	}
+	if tag == "" {
+		return TagExpression{}, fmt.Errorf("invalid tag expression, no tag: %s", expr)
+	}
+
+	result := TagExpression{Tag: tag, Value: value}
+	switch {
+	case negate && regex:
+		result.Operator = TagOperatorNotRegexp
+	case regex:
+		result.Operator = TagOperatorRegexp
+	case negate:
+		result.Operator = TagOperatorNotEqual
+	default:
+		result.Operator = TagOperatorEqual
+	}
+
+	if regex {
+		// NB: graphite matches tag expressions from the start of the value only.
+		re, err := regexp.Compile("^(?:" + value + ")")
+		if err != nil {
+			return TagExpression{}, fmt.Errorf("invalid tag expression regexp %s: %v", expr, err)
+		}
+		result.re = re
+	}
+
+	return result, nil
+}
+
+// ParseTagExpressions parses a list of graphite tag expressions.
+func ParseTagExpressions(exprs []string) ([]TagExpression, error) {
+	if len(exprs) == 0 {
+		return nil, errNoTagExpressions
+	}
+
+	result := make([]TagExpression, 0, len(exprs))
+	for _, expr := range exprs {
+		if expr == "" {
+			return nil, errEmptyTagExpression
+		}
+		parsed, err := ParseTagExpression(expr)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
+	}
+
+	return result, nil
+}
+
+// IsRegexp returns true if the expression value is a regexp.
+func (e TagExpression) IsRegexp() bool {
+	return e.Operator == TagOperatorRegexp || e.Operator == TagOperatorNotRegexp
+}
+
+// MatchesValue returns true if the expression matches the given tag value,
+// with a missing tag being treated as an empty value.
+func (e TagExpression) MatchesValue(value string) bool {
+	switch e.Operator {
+	case TagOperatorEqual:
+		return value == e.Value
+	case TagOperatorNotEqual:
+		return value != e.Value
+	case TagOperatorRegexp:
+		return e.re.MatchString(value)
+	case TagOperatorNotRegexp:
+		return !e.re.MatchString(value)
+	}
+	return false
+}
+
+// MatchesEmpty returns true if the expression matches series without the tag.
+func (e TagExpression) MatchesEmpty() bool {
+	return e.MatchesValue("")
+}
+
+// Matches returns true if the expression matches the given set of tags.
+func (e TagExpression) Matches(tags map[string]string) bool {
+	return e.MatchesValue(tags[e.Tag])
+}
+
+// String returns the expression in graphite form.
+func (e TagExpression) String() string {
+	return e.Tag + string(e.Operator) + e.Value
+}
+
+// SeriesByTagQuery returns the storage query for a set of tag expressions.
+func SeriesByTagQuery(exprs []TagExpression) string {
+	var b strings.Builder
+	b.WriteString(seriesByTagPrefix)
+	for i, expr := range exprs {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.Quote(expr.String()))
+	}
+	b.WriteString(seriesByTagSuffix)
+	return b.String()
+}
+
+// ParseSeriesByTagQuery parses the tag expressions from a seriesByTag query,
+// returning false if the query is not a seriesByTag query.
+func ParseSeriesByTagQuery(query string) ([]TagExpression, bool, error) {
+	query = strings.TrimSpace(query)
+	if !strings.HasPrefix(query, seriesByTagPrefix) ||
+		!strings.HasSuffix(query, seriesByTagSuffix) {
+		return nil, false, nil
+	}
+
+	args := query[len(seriesByTagPrefix) : len(query)-len(seriesByTagSuffix)]
+	var exprs []string
+	for {
+		args = strings.TrimSpace(args)
+		if args == "" {
+			break
+		}
+
+		expr, rest, err := unquoteArg(args)
+		if err != nil {
+			return nil, true, err
+		}
+		exprs = append(exprs, expr)
+
+		rest = strings.TrimSpace(rest)
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return nil, true, errInvalidSeriesByTagArgs
+		}
+		args = rest[1:]
+	}
+
+	result, err := ParseTagExpressions(exprs)
+	return result, true, err
+}
+
+func unquoteArg(s string) (string, string, error) {
+	quote := s[0]
+	if quote != '"' && quote != '\'' {
+		return "", "", errInvalidSeriesByTagArgs
+	}
+
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			if quote == '\'' {
+				return s[1:i], s[i+1:], nil
+			}
+			value, err := strconv.Unquote(s[:i+1])
+			if err != nil {
+				return "", "", err
+			}
+			return value, s[i+1:], nil
+		}
+	}
+
+	return "", "", errInvalidSeriesByTagArgs
+}
+
+// ParseSeriesTags parses the tags of a graphite series name of the form
+// "a.b.c;tag1=value1;tag2=value2", with the path stored as the name tag.
+func ParseSeriesTags(name string) map[string]string {
+	parts := strings.Split(name, string(tagSeparator))
+	tags := make(map[string]string, len(parts))
+	tags[NameTag] = parts[0]
+	for _, part := range parts[1:] {
+		idx := strings.IndexByte(part, tagValueSeparator)
+		if idx <= 0 {
+			continue
+		}
+		tags[part[:idx]] = part[idx+1:]
+	}
+	return tags
+}
+
+// TaggedName returns the graphite series name for a path and a set of tags,
+// the tags are appended to the path sorted by name.
+func TaggedName(path string, tags map[string]string) string {
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		if name == NameTag {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(path)
+	for _, name := range names {
+		b.WriteByte(tagSeparator)
+		b.WriteString(name)
+		b.WriteByte(tagValueSeparator)
+		b.WriteString(tags[name])
+	}
+	return b.String()
+}
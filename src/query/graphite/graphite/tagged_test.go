@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graphite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTagExpression(t *testing.T) {
+	tests := []struct {
+		expr     string
+		tag      string
+		operator TagOperator
+		value    string
+	}{
+		{"dc=east", "dc", TagOperatorEqual, "east"},
+		{"dc!=east", "dc", TagOperatorNotEqual, "east"},
+		{"dc=~ea.*", "dc", TagOperatorRegexp, "ea.*"},
+		{"dc!=~ea.*", "dc", TagOperatorNotRegexp, "ea.*"},
+		{"dc=", "dc", TagOperatorEqual, ""},
+		{"name=a.b=c", "name", TagOperatorEqual, "a.b=c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseTagExpression(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.tag, expr.Tag)
+			assert.Equal(t, tt.operator, expr.Operator)
+			assert.Equal(t, tt.value, expr.Value)
+			assert.Equal(t, tt.expr, expr.String())
+		})
+	}
+
+	for _, expr := range []string{"dc", "=east", "!=east", "dc=~(", ""} {
+		_, err := ParseTagExpression(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestTagExpressionMatches(t *testing.T) {
+	tags := map[string]string{"name": "a.b.c", "dc": "east"}
+	tests := []struct {
+		expr    string
+		matches bool
+		empty   bool
+	}{
+		{"dc=east", true, false},
+		{"dc=west", false, false},
+		{"dc!=west", true, true},
+		{"dc=~ea", true, false},
+		{"dc=~st", false, false},
+		{"dc!=~we", true, true},
+		{"dc=~.*", true, true},
+		{"env=", true, true},
+		{"env!=", false, false},
+		{"name=~a\\.b", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseTagExpression(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matches, expr.Matches(tags))
+			assert.Equal(t, tt.empty, expr.MatchesEmpty())
+		})
+	}
+}
+
+func TestSeriesByTagQueryRoundTrip(t *testing.T) {
+	exprs, err := ParseTagExpressions([]string{"name=~a.*", `dc!="x'y"`, "env=prod"})
+	require.NoError(t, err)
+
+	query := SeriesByTagQuery(exprs)
+	assert.Equal(t, `seriesByTag("name=~a.*","dc!=\"x'y\"","env=prod")`, query)
+
+	parsed, ok, err := ParseSeriesByTagQuery(query)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, parsed, len(exprs))
+	for i := range exprs {
+		assert.Equal(t, exprs[i].String(), parsed[i].String())
+		assert.Equal(t, exprs[i].Operator, parsed[i].Operator)
+	}
+
+	parsed, ok, err = ParseSeriesByTagQuery("seriesByTag('dc=east', \"env=prod\")")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, parsed, 2)
+	assert.Equal(t, "dc=east", parsed[0].String())
+	assert.Equal(t, "env=prod", parsed[1].String())
+
+	_, ok, err = ParseSeriesByTagQuery("a.b.c")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	for _, query := range []string{
+		"seriesByTag()",
+		"seriesByTag(dc=east)",
+		"seriesByTag('dc=east' 'env=prod')",
+		"seriesByTag('dc=east)",
+	} {
+		_, ok, err := ParseSeriesByTagQuery(query)
+		assert.True(t, ok, query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestParseSeriesTags(t *testing.T) {
+	assert.Equal(t, map[string]string{"name": "a.b.c"}, ParseSeriesTags("a.b.c"))
+	assert.Equal(t, map[string]string{
+		"name": "a.b.c",
+		"dc":   "east",
+		"env":  "prod",
+	}, ParseSeriesTags("a.b.c;dc=east;env=prod;invalid"))
+}
+
+func TestTaggedName(t *testing.T) {
+	assert.Equal(t, "a.b", TaggedName("a.b", nil))
+	assert.Equal(t, "a.b;dc=east;env=prod", TaggedName("a.b", map[string]string{
+		"name": "ignored",
+		"env":  "prod",
+		"dc":   "east",
+	}))
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 	xerrors "github.com/m3db/m3/src/x/errors"
 )
@@ -277,6 +278,79 @@ func divideSeriesLists(ctx *common.Context, dividendSeriesList, divisorSeriesLis
 	return r, nil
 }
 
+// aggregateSeriesLists iterates over a two lists and aggregates using specified function
+// list1[0] to list2[0], list1[1] to list2[1] and so on.
+// The lists will need to be the same length.
+func aggregateSeriesLists(
+	ctx *common.Context,
+	seriesListFirstPos, seriesListSecondPos singlePathSpec,
+	fname string,
+) (ts.SeriesList, error) {
+	if len(seriesListFirstPos.Values) != len(seriesListSecondPos.Values) {
+		err := xerrors.NewInvalidParamsError(fmt.Errorf(
+			"aggregateSeriesLists both SeriesLists must have exactly the same length"))
+		return ts.NewSeriesList(), err
+	}
+
+	metadata := seriesListFirstPos.Metadata.CombineMetadata(seriesListSecondPos.Metadata)
+	results := make([]*ts.Series, 0, len(seriesListFirstPos.Values))
+	for idx, first := range seriesListFirstPos.Values {
+		pair := ts.SeriesList{
+			Values:   []*ts.Series{first, seriesListSecondPos.Values[idx]},
+			Metadata: metadata,
+		}
+		aggregated, err := aggregate(ctx, singlePathSpec(pair), fname)
+		if err != nil {
+			return ts.NewSeriesList(), err
+		}
+		results = append(results, aggregated.Values...)
+	}
+
+	r := ts.SeriesList(seriesListFirstPos)
+	r.Values = results
+	r.Metadata = metadata
+	return r, nil
+}
+
+// sumSeriesLists sums list1[0] with list2[0], list1[1] with list2[1] and so on.
+// The lists will need to be the same length.
+func sumSeriesLists(ctx *common.Context, seriesListFirstPos, seriesListSecondPos singlePathSpec) (ts.SeriesList, error) {
+	return aggregateSeriesLists(ctx, seriesListFirstPos, seriesListSecondPos, sumFnName)
+}
+
+// diffSeriesLists subtracts list2[0] from list1[0], list2[1] from list1[1] and so on.
+// The lists will need to be the same length.
+func diffSeriesLists(ctx *common.Context, seriesListFirstPos, seriesListSecondPos singlePathSpec) (ts.SeriesList, error) {
+	return aggregateSeriesLists(ctx, seriesListFirstPos, seriesListSecondPos, diffFnName)
+}
+
+// multiplySeriesLists multiplies list1[0] with list2[0], list1[1] with list2[1] and so on.
+// The lists will need to be the same length.
+func multiplySeriesLists(ctx *common.Context, seriesListFirstPos, seriesListSecondPos singlePathSpec) (ts.SeriesList, error) {
+	return aggregateSeriesLists(ctx, seriesListFirstPos, seriesListSecondPos, multiplyFnName)
+}
+
+// validateAggregateFnName checks at compile time that fname is a function
+// supported by aggregate.
+func validateAggregateFnName(arg interface{}) error {
+	switch fname, _ := arg.(string); fname {
+	case emptyFnName, sumFnName, sumSeriesFnName, totalFnName,
+		minFnName, minSeriesFnName,
+		maxFnName, maxSeriesFnName,
+		medianFnName, medianSeriesFnName,
+		avgFnName, averageFnName, averageSeriesFnName,
+		multiplyFnName, multiplySeriesFnName,
+		diffFnName, diffSeriesFnName,
+		countFnName, countSeriesFnName,
+		rangeFnName, rangeOfFnName, rangeOfSeriesFnName,
+		lastFnName, currentFnName,
+		stddevFnName, stdevFnName, stddevSeriesFnName:
+		return nil
+	default:
+		return fmt.Errorf("invalid func %v", arg)
+	}
+}
+
 // aggregate takes a list of series and returns a new series containing the
 // value aggregated across the series at each datapoint using the specified function.
 // This function can be used with aggregation functions average (or avg), avg_zero,
@@ -679,6 +753,44 @@ func groupByNodes(ctx *common.Context, seriesList singlePathSpec, fname string,
 	return applyFnToMetaSeries(ctx, seriesList, metaSeries, fname)
 }
 
+// groupByTags takes a serieslist of tagged series and maps a callback to
+// subgroups within as defined by the given tags
+//
+//      &target=groupByTags(seriesByTag("name=cpu","dc=dc1"),"sum","dc")
+//
+// Would return a series for each distinct value of the tags, named with the
+// tag values such as "sum;dc=dc1". If the "name" tag is one of the tags the
+// series are named after the name tag value instead of the function.
+func groupByTags(ctx *common.Context, seriesList singlePathSpec, fname string, tags ...string) (ts.SeriesList, error) {
+	if len(tags) == 0 {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(
+			fmt.Errorf("groupByTags requires at least one tag"))
+	}
+
+	if fname == "" {
+		fname = sumFnName
+	}
+
+	metaSeries := make(map[string][]*ts.Series)
+	for _, s := range seriesList.Values {
+		seriesTags := graphite.ParseSeriesTags(s.Name())
+		name := fname
+		groupTags := make(map[string]string, len(tags))
+		for _, tag := range tags {
+			if tag == graphite.NameTag {
+				name = seriesTags[graphite.NameTag]
+				continue
+			}
+			groupTags[tag] = seriesTags[tag]
+		}
+
+		key := graphite.TaggedName(name, groupTags)
+		metaSeries[key] = append(metaSeries[key], s)
+	}
+
+	return applyFnToMetaSeries(ctx, seriesList, metaSeries, fname)
+}
+
 func applyFnToMetaSeries(ctx *common.Context, series singlePathSpec, metaSeries map[string][]*ts.Series, fname string) (ts.SeriesList, error) {
 	if fname == "" {
 		fname = sumFnName
@@ -242,6 +242,75 @@ func TestAggregateSeriesMedian(t *testing.T) {
 	common.CompareOutputsAndExpected(t, 60000, start, expectedResults, result.Values)
 }
 
+func TestAggregateSeriesLists(t *testing.T) {
+	var (
+		ctx           = common.NewTestContext()
+		start         = ctx.StartTime
+		millisPerStep = 60000
+		newSeries     = func(name string, values []float64) *ts.Series {
+			return ts.NewSeries(ctx, name, start,
+				common.NewTestSeriesValues(ctx, millisPerStep, values))
+		}
+		firstList = singlePathSpec{Values: []*ts.Series{
+			newSeries("a", []float64{1, 2, 3}),
+			newSeries("b", []float64{4, 5, 6}),
+		}}
+		secondList = singlePathSpec{Values: []*ts.Series{
+			newSeries("c", []float64{10, 20, 30}),
+			newSeries("d", []float64{2, 2, 2}),
+		}}
+	)
+	defer func() { _ = ctx.Close() }()
+
+	tests := []struct {
+		fn       func(*common.Context, singlePathSpec, singlePathSpec) (ts.SeriesList, error)
+		expected []common.TestSeries
+	}{
+		{
+			fn: func(ctx *common.Context, first, second singlePathSpec) (ts.SeriesList, error) {
+				return aggregateSeriesLists(ctx, first, second, "max")
+			},
+			expected: []common.TestSeries{
+				{Name: "maxSeries(a,c)", Data: []float64{10, 20, 30}},
+				{Name: "maxSeries(b,d)", Data: []float64{4, 5, 6}},
+			},
+		},
+		{
+			fn: sumSeriesLists,
+			expected: []common.TestSeries{
+				{Name: "sumSeries(a,c)", Data: []float64{11, 22, 33}},
+				{Name: "sumSeries(b,d)", Data: []float64{6, 7, 8}},
+			},
+		},
+		{
+			fn: diffSeriesLists,
+			expected: []common.TestSeries{
+				{Name: "diffSeries(a,c)", Data: []float64{-9, -18, -27}},
+				{Name: "diffSeries(b,d)", Data: []float64{2, 3, 4}},
+			},
+		},
+		{
+			fn: multiplySeriesLists,
+			expected: []common.TestSeries{
+				{Name: "multiplySeries(a,c)", Data: []float64{10, 40, 90}},
+				{Name: "multiplySeries(b,d)", Data: []float64{8, 10, 12}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		result, err := test.fn(ctx, firstList, secondList)
+		require.NoError(t, err)
+		common.CompareOutputsAndExpected(t, millisPerStep, start, test.expected, result.Values)
+	}
+
+	_, err := sumSeriesLists(ctx, firstList, singlePathSpec{Values: secondList.Values[:1]})
+	require.Error(t, err)
+
+	_, err = aggregateSeriesLists(ctx, firstList, secondList, "unknown")
+	require.Error(t, err)
+}
+
 type mockEngine struct {
 	fn func(
 		ctx context.Context,
@@ -856,6 +925,70 @@ func TestGroupByNodes(t *testing.T) {
 	}
 }
 
+func TestGroupByTags(t *testing.T) {
+	var (
+		start, _ = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:41:19 GMT")
+		end, _   = time.Parse(time.RFC1123, "Mon, 27 Jul 2015 19:43:19 GMT")
+		ctx      = common.NewContext(common.ContextOptions{Start: start, End: end})
+		inputs   = []*ts.Series{
+			ts.NewSeries(ctx, "cpu.load;dc=east;host=a", start,
+				ts.NewConstantValues(ctx, 2, 12, 10000)),
+			ts.NewSeries(ctx, "cpu.load;dc=east;host=b", start,
+				ts.NewConstantValues(ctx, 4, 12, 10000)),
+			ts.NewSeries(ctx, "cpu.load;dc=west;host=c", start,
+				ts.NewConstantValues(ctx, 6, 12, 10000)),
+			ts.NewSeries(ctx, "cpu.idle;dc=west;host=c", start,
+				ts.NewConstantValues(ctx, 8, 12, 10000)),
+		}
+	)
+	defer ctx.Close()
+
+	type result struct {
+		name      string
+		sumOfVals float64
+	}
+
+	tests := []struct {
+		fname           string
+		tags            []string
+		expectedResults []result
+	}{
+		{"sum", []string{"dc"}, []result{
+			{"sum;dc=east", (2 + 4) * 12},
+			{"sum;dc=west", (6 + 8) * 12},
+		}},
+		{"max", []string{"name", "dc"}, []result{
+			{"cpu.idle;dc=west", 8 * 12},
+			{"cpu.load;dc=east", 4 * 12},
+			{"cpu.load;dc=west", 6 * 12},
+		}},
+		{"avg", []string{"env"}, []result{ // test a tag that is not set
+			{"avg;env=", ((2 + 4 + 6 + 8) / 4) * 12},
+		}},
+	}
+
+	for _, test := range tests {
+		outSeries, err := groupByTags(ctx, singlePathSpec{
+			Values: inputs,
+		}, test.fname, test.tags...)
+		require.NoError(t, err)
+		require.Equal(t, len(test.expectedResults), len(outSeries.Values))
+
+		outSeries, _ = sortByName(ctx, singlePathSpec(outSeries), false, false)
+
+		for i, expected := range test.expectedResults {
+			series := outSeries.Values[i]
+			assert.Equal(t, expected.name, series.Name(),
+				"wrong name for %v %s (%d)", test.tags, test.fname, i)
+			assert.Equal(t, expected.sumOfVals, series.SafeSum(),
+				"wrong result for %v %s (%d)", test.tags, test.fname, i)
+		}
+	}
+
+	_, err := groupByTags(ctx, singlePathSpec{Values: inputs}, "sum")
+	require.Error(t, err)
+}
+
 func TestWeightedAverage(t *testing.T) {
 	ctx, _ := newConsolidationTestSeries()
 	defer ctx.Close()
@@ -21,9 +21,11 @@
 package native
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/m3db/m3/src/query/graphite/common"
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/graphite/ts"
 )
 
@@ -66,6 +68,40 @@ func aliasByNode(ctx *common.Context, seriesList singlePathSpec, nodes ...int) (
 	return ts.SeriesList(seriesList), nil
 }
 
+// aliasByTags renames a time series result according to tags of a tagged
+// series and nodes of its path, joined with dots. Numeric arguments are
+// treated as path nodes, all others as tag names.
+func aliasByTags(ctx *common.Context, seriesList singlePathSpec, tags ...string) (ts.SeriesList, error) {
+	renamed := make([]*ts.Series, 0, ts.SeriesList(seriesList).Len())
+	for _, series := range seriesList.Values {
+		seriesTags := graphite.ParseSeriesTags(series.Name())
+		nameParts := strings.Split(getFirstPathExpression(seriesTags[graphite.NameTag]), ".")
+		newNameParts := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			node, err := strconv.Atoi(tag)
+			if err != nil {
+				if value, ok := seriesTags[tag]; ok {
+					newNameParts = append(newNameParts, value)
+				}
+				continue
+			}
+
+			if node < 0 {
+				node += len(nameParts)
+			}
+			if node < 0 || node >= len(nameParts) {
+				continue
+			}
+			newNameParts = append(newNameParts, nameParts[node])
+		}
+		newName := strings.Join(newNameParts, ".")
+		newSeries := series.RenamedTo(newName)
+		renamed = append(renamed, newSeries)
+	}
+	seriesList.Values = renamed
+	return ts.SeriesList(seriesList), nil
+}
+
 func getFirstPathExpression(name string) string {
 	expr, err := Compile(name, CompileOptions{})
 	if err != nil {
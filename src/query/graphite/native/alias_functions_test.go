@@ -194,6 +194,40 @@ func TestAliasByNode(t *testing.T) {
 	assert.Equal(t, "P75", results.Values[2].Name())
 }
 
+func TestAliasByTags(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer ctx.Close()
+
+	now := time.Now()
+	values := ts.NewConstantValues(ctx, 10.0, 1000, 10)
+
+	series := []*ts.Series{
+		ts.NewSeries(ctx, "foo.bar.baz;dc=east;env=prod", now, values),
+		ts.NewSeries(ctx, "foo.qux.baz;dc=west", now, values),
+		ts.NewSeries(ctx, "foo.bar", now, values),
+	}
+
+	results, err := aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "1", "dc", "env")
+	require.Nil(t, err)
+	require.NotNil(t, results)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "bar.east.prod", results.Values[0].Name())
+	assert.Equal(t, "qux.west", results.Values[1].Name())
+	assert.Equal(t, "bar", results.Values[2].Name())
+
+	results, err = aliasByTags(ctx, singlePathSpec{
+		Values: series,
+	}, "name", "-1")
+	require.Nil(t, err)
+	require.NotNil(t, results)
+	require.Equal(t, len(series), results.Len())
+	assert.Equal(t, "foo.bar.baz.baz", results.Values[0].Name())
+	assert.Equal(t, "foo.qux.baz.baz", results.Values[1].Name())
+	assert.Equal(t, "foo.bar.bar", results.Values[2].Name())
+}
+
 func TestAliasByNodeWithComposition(t *testing.T) {
 	ctx := common.NewTestContext()
 	defer ctx.Close()
@@ -301,6 +301,17 @@ func identity(ctx *common.Context, name string) (ts.SeriesList, error) {
 	return common.Identity(ctx, name)
 }
 
+// seriesByTag fetches the tagged series matching all of the given tag
+// expressions, e.g. seriesByTag('name=cpu.load', 'dc=~us-.*', 'env!=test').
+func seriesByTag(ctx *common.Context, tagExpressions ...string) (ts.SeriesList, error) {
+	exprs, err := graphite.ParseTagExpressions(tagExpressions)
+	if err != nil {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(err)
+	}
+
+	return newFetchExpression(graphite.SeriesByTagQuery(exprs)).Execute(ctx)
+}
+
 // limit takes one metric or a wildcard seriesList followed by an integer N, and draws
 // the first N metrics.
 func limit(_ *common.Context, series singlePathSpec, n int) (ts.SeriesList, error) {
@@ -462,6 +473,108 @@ func timeSlice(ctx *common.Context, inputPath singlePathSpec, start string, end
 	return input, nil
 }
 
+// linearRegression graphs the linear regression function by least squares
+// method. The regression is computed from the series between startSourceAt
+// and endSourceAt, which default to the range of the graph, and is projected
+// over the range of the graph.
+func linearRegression(
+	ctx *common.Context,
+	_ singlePathSpec,
+	startSourceAt string,
+	endSourceAt string,
+) (*unaryContextShifter, error) {
+	var (
+		now                     = time.Now()
+		tzOffsetForAbsoluteTime time.Duration
+		sourceStart             = ctx.StartTime
+		sourceEnd               = ctx.EndTime
+		err                     error
+	)
+	if startSourceAt != "" {
+		sourceStart, err = graphite.ParseTime(startSourceAt, now, tzOffsetForAbsoluteTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if endSourceAt != "" {
+		sourceEnd, err = graphite.ParseTime(endSourceAt, now, tzOffsetForAbsoluteTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !sourceStart.Before(sourceEnd) {
+		return nil, xerrors.NewInvalidParamsError(fmt.Errorf(
+			"linearRegression source start %v must be before source end %v", sourceStart, sourceEnd))
+	}
+
+	contextShiftingFn := func(c *common.Context) *common.Context {
+		opts := common.NewChildContextOptions()
+		opts.AdjustTimeRange(sourceStart.Sub(c.StartTime), sourceEnd.Sub(c.EndTime), 0, 0)
+		childCtx := c.NewChildContext(opts)
+		return childCtx
+	}
+
+	transformerFn := func(input ts.SeriesList) (ts.SeriesList, error) {
+		output := make([]*ts.Series, 0, input.Len())
+		for _, source := range input.Values {
+			factor, offset, ok := linearRegressionAnalysis(source)
+			if !ok {
+				continue
+			}
+
+			var (
+				millisPerStep = source.MillisPerStep()
+				numSteps      = ts.NumSteps(ctx.StartTime, ctx.EndTime, millisPerStep)
+				vals          = ts.NewValues(ctx, millisPerStep, numSteps)
+				startSeconds  = float64(ctx.StartTime.Unix())
+				stepSeconds   = float64(millisPerStep) / millisPerSecond
+			)
+			for i := 0; i < numSteps; i++ {
+				vals.SetValueAt(i, offset+(startSeconds+float64(i)*stepSeconds)*factor)
+			}
+
+			name := fmt.Sprintf("linearRegression(%s, %d, %d)",
+				source.Name(), sourceStart.Unix(), sourceEnd.Unix())
+			output = append(output, ts.NewSeries(ctx, name, ctx.StartTime, vals))
+		}
+		input.Values = output
+		return input, nil
+	}
+
+	return &unaryContextShifter{
+		ContextShiftFunc: contextShiftingFn,
+		UnaryTransformer: transformerFn,
+	}, nil
+}
+
+// linearRegressionAnalysis returns the factor and offset, in seconds since the
+// epoch, of the least squares fit of the series, or false if the fit is undefined.
+func linearRegressionAnalysis(series *ts.Series) (float64, float64, bool) {
+	var n, sumI, sumV, sumII, sumIV float64
+	for i := 0; i < series.Len(); i++ {
+		v := series.ValueAt(i)
+		if math.IsNaN(v) {
+			continue
+		}
+		idx := float64(i)
+		n++
+		sumI += idx
+		sumV += v
+		sumII += idx * idx
+		sumIV += idx * v
+	}
+
+	denominator := n*sumII - sumI*sumI
+	if denominator == 0 {
+		return 0, 0, false
+	}
+
+	stepSeconds := float64(series.MillisPerStep()) / millisPerSecond
+	factor := (n*sumIV - sumI*sumV) / denominator / stepSeconds
+	offset := (sumII*sumV-sumIV*sumI)/denominator - factor*float64(series.StartTime().Unix())
+	return factor, offset, true
+}
+
 // absolute returns the absolute value of each element in the series.
 func absolute(ctx *common.Context, input singlePathSpec) (ts.SeriesList, error) {
 	return transform(ctx, input,
@@ -1366,6 +1479,26 @@ func group(_ *common.Context, input multiplePathSpecs) (ts.SeriesList, error) {
 	return ts.SeriesList(input), nil
 }
 
+// unique takes an arbitrary number of pathspecs and returns the series with
+// unique names, keeping the first occurrence of each name.
+func unique(_ *common.Context, input multiplePathSpecs) (ts.SeriesList, error) {
+	var (
+		r       = ts.SeriesList(input)
+		seen    = make(map[string]struct{}, len(input.Values))
+		results = make([]*ts.Series, 0, len(input.Values))
+	)
+	for _, series := range input.Values {
+		if _, ok := seen[series.Name()]; ok {
+			continue
+		}
+		seen[series.Name()] = struct{}{}
+		results = append(results, series)
+	}
+
+	r.Values = results
+	return r, nil
+}
+
 func derivativeTemplate(ctx *common.Context, input singlePathSpec, nameTemplate string,
 	fn func(float64, float64) float64) (ts.SeriesList, error) {
 	output := make([]*ts.Series, len(input.Values))
@@ -2107,6 +2240,52 @@ func removeBelowPercentile(ctx *common.Context, seriesList singlePathSpec, perce
 		common.LessThan)
 }
 
+// removeBetweenPercentile removes series that have no values outside of the
+// n-th and (100-n)-th percentiles of all series at the same timestamp.
+func removeBetweenPercentile(ctx *common.Context, seriesList singlePathSpec, percentile float64) (ts.SeriesList, error) {
+	if percentile < 50 {
+		percentile = 100 - percentile
+	}
+
+	maxLen := 0
+	for _, series := range seriesList.Values {
+		if series.Len() > maxLen {
+			maxLen = series.Len()
+		}
+	}
+
+	var (
+		lowPercentiles  = make([]float64, maxLen)
+		highPercentiles = make([]float64, maxLen)
+		column          = make([]float64, 0, len(seriesList.Values))
+	)
+	for i := 0; i < maxLen; i++ {
+		column = column[:0]
+		for _, series := range seriesList.Values {
+			if i < series.Len() {
+				column = append(column, series.ValueAt(i))
+			}
+		}
+		lowPercentiles[i] = common.GetPercentile(column, 100-percentile, false)
+		highPercentiles[i] = common.GetPercentile(column, percentile, false)
+	}
+
+	results := make([]*ts.Series, 0, len(seriesList.Values))
+	for _, series := range seriesList.Values {
+		for i := 0; i < series.Len(); i++ {
+			v := series.ValueAt(i)
+			if !math.IsNaN(v) && !(lowPercentiles[i] < v && v < highPercentiles[i]) {
+				results = append(results, series)
+				break
+			}
+		}
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = results
+	return r, nil
+}
+
 // randomWalkFunction returns a random walk starting at 0.
 // Note: step has a unit of seconds.
 func randomWalkFunction(ctx *common.Context, name string, step int) (ts.SeriesList, error) {
@@ -2587,6 +2766,36 @@ func offsetToZero(ctx *common.Context, seriesList singlePathSpec) (ts.SeriesList
 	return r, nil
 }
 
+// minMax applies min-max normalization to each series, scaling its values
+// to the range [0, 1].
+func minMax(ctx *common.Context, seriesList singlePathSpec) (ts.SeriesList, error) {
+	results := make([]*ts.Series, 0, len(seriesList.Values))
+	for _, series := range seriesList.Values {
+		var (
+			minVal = series.SafeMin()
+			maxVal = series.SafeMax()
+			vals   = ts.NewValues(ctx, series.MillisPerStep(), series.Len())
+		)
+		for i := 0; i < series.Len(); i++ {
+			v := series.ValueAt(i)
+			switch {
+			case math.IsNaN(v):
+				continue
+			case maxVal == minVal:
+				vals.SetValueAt(i, 0)
+			default:
+				vals.SetValueAt(i, (v-minVal)/(maxVal-minVal))
+			}
+		}
+		name := fmt.Sprintf("minMax(%s)", series.Name())
+		results = append(results, ts.NewSeries(ctx, name, series.StartTime(), vals))
+	}
+
+	r := ts.SeriesList(seriesList)
+	r.Values = results
+	return r, nil
+}
+
 // timeFunction returns the timestamp for each X value.
 // Note: step is measured in seconds.
 func timeFunction(ctx *common.Context, name string, step int) (ts.SeriesList, error) {
@@ -2608,6 +2817,28 @@ func timeFunction(ctx *common.Context, name string, step int) (ts.SeriesList, er
 	return ts.NewSeriesListWithSeries(series), nil
 }
 
+// sinFunction returns the sine of the timestamp for each X value multiplied
+// by amplitude.
+// Note: step is measured in seconds.
+func sinFunction(ctx *common.Context, name string, amplitude float64, step int) (ts.SeriesList, error) {
+	if step <= 0 {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(
+			fmt.Errorf("step must be a positive int but instead is %d", step))
+	}
+
+	stepSizeInMilli := step * millisPerSecond
+	numSteps := ts.NumSteps(ctx.StartTime, ctx.EndTime, stepSizeInMilli)
+	vals := ts.NewValues(ctx, stepSizeInMilli, numSteps)
+	start := ctx.StartTime.Truncate(time.Second)
+	for current, index := start.Unix(), 0; index < numSteps; index++ {
+		vals.SetValueAt(index, math.Sin(float64(current))*amplitude)
+		current += int64(step)
+	}
+
+	series := ts.NewSeries(ctx, name, start, vals)
+	return ts.NewSeriesListWithSeries(series), nil
+}
+
 // dashed draws the selected metrics with a dotted line with segments of length f.
 func dashed(_ *common.Context, seriesList singlePathSpec, dashLength float64) (ts.SeriesList, error) {
 	if dashLength <= 0 {
@@ -2644,19 +2875,89 @@ func threshold(ctx *common.Context, value float64, label string, color string) (
 	return ts.NewSeriesListWithSeries(series), nil
 }
 
+// verticalLine draws a vertical line at the designated timestamp with optional
+// label and color.
+func verticalLine(ctx *common.Context, timestamp string, label string, color string) (ts.SeriesList, error) {
+	var (
+		now                     = time.Now()
+		tzOffsetForAbsoluteTime time.Duration
+	)
+	at, err := graphite.ParseTime(timestamp, now, tzOffsetForAbsoluteTime)
+	if err != nil {
+		return ts.NewSeriesList(), err
+	}
+	at = at.Truncate(time.Second)
+
+	if at.Before(ctx.StartTime.Truncate(time.Second)) {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(fmt.Errorf(
+			"verticalLine timestamp %d exists before start of range", at.Unix()))
+	}
+	if at.After(ctx.EndTime) {
+		return ts.NewSeriesList(), xerrors.NewInvalidParamsError(fmt.Errorf(
+			"verticalLine timestamp %d exists after end of range", at.Unix()))
+	}
+
+	vals := ts.NewValues(ctx, millisPerSecond, 2)
+	vals.SetValueAt(0, 1.0)
+	vals.SetValueAt(1, 1.0)
+
+	name := label
+	if name == "" {
+		name = fmt.Sprintf("verticalLine(%s)", timestamp)
+	}
+	series := ts.NewSeries(ctx, name, at, vals)
+	return ts.NewSeriesListWithSeries(series), nil
+}
+
+// validateSeriesReducer checks at compile time that f names a series reducer.
+func validateSeriesReducer(arg interface{}) error {
+	f, _ := arg.(string)
+	_, err := getReducer(f)
+	return err
+}
+
+// validatePositive checks at compile time that a numeric argument is positive.
+func validatePositive(arg interface{}) error {
+	switch v := arg.(type) {
+	case int:
+		if v > 0 {
+			return nil
+		}
+	case float64:
+		if v > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("expected a positive value, received %v", arg)
+}
+
+// validatePercentile checks at compile time that a percentile is within [0, 100].
+func validatePercentile(arg interface{}) error {
+	if v, ok := arg.(float64); ok && v >= 0 && v <= 100 {
+		return nil
+	}
+	return fmt.Errorf("expected a percentile between 0 and 100, received %v", arg)
+}
+
 func init() {
 	// functions - in alpha ordering
 	MustRegisterFunction(absolute)
-	MustRegisterFunction(aggregate)
+	MustRegisterFunction(aggregate).WithArgValidators(map[uint8]argValidator{
+		2: validateAggregateFnName, // fname
+	})
 	MustRegisterFunction(aggregateLine).WithDefaultParams(map[uint8]interface{}{
 		2: "avg", // f
 	})
+	MustRegisterFunction(aggregateSeriesLists).WithArgValidators(map[uint8]argValidator{
+		3: validateAggregateFnName, // fname
+	})
 	MustRegisterFunction(aggregateWithWildcards).WithDefaultParams(map[uint8]interface{}{
 		3: -1, // positions
 	})
 	MustRegisterFunction(alias)
 	MustRegisterFunction(aliasByMetric)
 	MustRegisterFunction(aliasByNode)
+	MustRegisterFunction(aliasByTags)
 	MustRegisterFunction(aliasSub)
 	MustRegisterFunction(applyByNode).WithDefaultParams(map[uint8]interface{}{
 		4: "", // newName
@@ -2683,6 +2984,7 @@ func init() {
 	MustRegisterFunction(delay)
 	MustRegisterFunction(derivative)
 	MustRegisterFunction(diffSeries)
+	MustRegisterFunction(diffSeriesLists)
 	MustRegisterFunction(divideSeries)
 	MustRegisterFunction(divideSeriesLists)
 	MustRegisterFunction(exclude)
@@ -2696,9 +2998,12 @@ func init() {
 		3: "average", // fname
 	})
 	MustRegisterFunction(groupByNodes)
+	MustRegisterFunction(groupByTags)
 	MustRegisterFunction(highest).WithDefaultParams(map[uint8]interface{}{
 		2: 1,         // n,
 		3: "average", // f
+	}).WithArgValidators(map[uint8]argValidator{
+		3: validateSeriesReducer, // f
 	})
 	MustRegisterFunction(highestAverage)
 	MustRegisterFunction(highestCurrent)
@@ -2719,17 +3024,24 @@ func init() {
 	})
 	MustRegisterFunction(legendValue)
 	MustRegisterFunction(limit)
+	MustRegisterFunction(linearRegression).WithDefaultParams(map[uint8]interface{}{
+		2: "", // startSourceAt
+		3: "", // endSourceAt
+	})
 	MustRegisterFunction(logarithm).WithDefaultParams(map[uint8]interface{}{
 		2: 10.0, // base
 	})
 	MustRegisterFunction(lowest).WithDefaultParams(map[uint8]interface{}{
 		2: 1,         // n,
 		3: "average", // f
+	}).WithArgValidators(map[uint8]argValidator{
+		3: validateSeriesReducer, // f
 	})
 	MustRegisterFunction(lowestAverage)
 	MustRegisterFunction(lowestCurrent)
 	MustRegisterFunction(maxSeries)
 	MustRegisterFunction(maximumAbove)
+	MustRegisterFunction(minMax)
 	MustRegisterFunction(minSeries)
 	MustRegisterFunction(minimumAbove)
 	MustRegisterFunction(mostDeviant)
@@ -2765,6 +3077,7 @@ func init() {
 		}).
 		WithoutUnaryContextShifterSkipFetchOptimization()
 	MustRegisterFunction(multiplySeries)
+	MustRegisterFunction(multiplySeriesLists)
 	MustRegisterFunction(nonNegativeDerivative).WithDefaultParams(map[uint8]interface{}{
 		2: math.NaN(), // maxValue
 	})
@@ -2782,19 +3095,33 @@ func init() {
 	MustRegisterFunction(rangeOfSeries)
 	MustRegisterFunction(randomWalkFunction).WithDefaultParams(map[uint8]interface{}{
 		2: 60, // step
+	}).WithArgValidators(map[uint8]argValidator{
+		2: validatePositive, // step
 	})
 	MustRegisterFunction(removeAbovePercentile)
 	MustRegisterFunction(removeAboveValue)
 	MustRegisterFunction(removeBelowPercentile)
 	MustRegisterFunction(removeBelowValue)
+	MustRegisterFunction(removeBetweenPercentile).WithArgValidators(map[uint8]argValidator{
+		2: validatePercentile, // percentile
+	})
 	MustRegisterFunction(removeEmptySeries).WithDefaultParams(map[uint8]interface{}{
 		2: 0.0, // xFilesFactor
 	})
 	MustRegisterFunction(scale)
 	MustRegisterFunction(scaleToSeconds)
+	MustRegisterFunction(seriesByTag)
+	MustRegisterFunction(sinFunction).WithDefaultParams(map[uint8]interface{}{
+		2: 1.0, // amplitude
+		3: 60,  // step
+	}).WithArgValidators(map[uint8]argValidator{
+		3: validatePositive, // step
+	})
 	MustRegisterFunction(sortBy).WithDefaultParams(map[uint8]interface{}{
 		2: "average", // fn
 		3: false,     // reverse
+	}).WithArgValidators(map[uint8]argValidator{
+		2: validateSeriesReducer, // fn
 	})
 	MustRegisterFunction(sortByMaxima)
 	MustRegisterFunction(sortByMinima)
@@ -2820,6 +3147,7 @@ func init() {
 		3: "", // fname
 	})
 	MustRegisterFunction(sumSeries)
+	MustRegisterFunction(sumSeriesLists)
 	MustRegisterFunction(sumSeriesWithWildcards).WithDefaultParams(map[uint8]interface{}{
 		2: -1, // positions
 	})
@@ -2831,6 +3159,8 @@ func init() {
 	})
 	MustRegisterFunction(timeFunction).WithDefaultParams(map[uint8]interface{}{
 		2: 60, // step
+	}).WithArgValidators(map[uint8]argValidator{
+		2: validatePositive, // step
 	})
 	MustRegisterFunction(timeShift).WithDefaultParams(map[uint8]interface{}{
 		3: true,  // resetEnd
@@ -2842,17 +3172,23 @@ func init() {
 	MustRegisterFunction(transformNull).WithDefaultParams(map[uint8]interface{}{
 		2: 0.0, // defaultValue
 	})
+	MustRegisterFunction(unique)
 	MustRegisterFunction(useSeriesAbove)
+	MustRegisterFunction(verticalLine).WithDefaultParams(map[uint8]interface{}{
+		2: "", // label
+		3: "", // color
+	})
 	MustRegisterFunction(weightedAverage)
 
 	// alias functions - in alpha ordering
 	MustRegisterAliasedFunction("abs", absolute)
-	MustRegisterAliasedFunction("aliasByTags", aliasByNode)
 	MustRegisterAliasedFunction("avg", averageSeries)
 	MustRegisterAliasedFunction("log", logarithm)
 	MustRegisterAliasedFunction("max", maxSeries)
 	MustRegisterAliasedFunction("min", minSeries)
+	MustRegisterAliasedFunction("pct", asPercent)
 	MustRegisterAliasedFunction("randomWalk", randomWalkFunction)
+	MustRegisterAliasedFunction("sin", sinFunction)
 	MustRegisterAliasedFunction("sum", sumSeries)
 	MustRegisterAliasedFunction("time", timeFunction)
 }
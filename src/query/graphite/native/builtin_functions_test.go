@@ -453,6 +453,39 @@ func TestUseSeriesAbove(t *testing.T) {
 	}
 }
 
+func TestSeriesByTag(t *testing.T) {
+	var (
+		ctrl      = xgomock.NewController(t)
+		store     = storage.NewMockStorage(ctrl)
+		now       = time.Now().Truncate(time.Hour)
+		engine    = NewEngine(store, CompileOptions{})
+		startTime = now.Add(-3 * time.Minute)
+		endTime   = now.Add(-time.Minute)
+		ctx       = common.NewContext(common.ContextOptions{Start: startTime, End: endTime, Engine: engine})
+		stepSize  = 60000
+	)
+
+	defer ctrl.Finish()
+	defer func() { _ = ctx.Close() }()
+
+	query := `seriesByTag("name=foo.bar","dc=~east.*")`
+	store.EXPECT().FetchByQuery(gomock.Any(), query, gomock.Any()).DoAndReturn(
+		buildTestSeriesFn(stepSize, "foo.bar;dc=east1"))
+
+	expr, err := engine.Compile("seriesByTag('name=foo.bar', 'dc=~east.*')")
+	require.NoError(t, err)
+	res, err := expr.Execute(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Values))
+	assert.Equal(t, "foo.bar;dc=east1", res.Values[0].Name())
+	assert.Equal(t, query, res.Values[0].Specification)
+
+	_, err = seriesByTag(ctx)
+	require.Error(t, err)
+	_, err = seriesByTag(ctx, "dc")
+	require.Error(t, err)
+}
+
 func TestPercentileOfSeriesErrors(t *testing.T) {
 	ctx := common.NewTestContext()
 
@@ -1623,6 +1656,14 @@ func TestHighest(t *testing.T) {
 			},
 			"last",
 		},
+		{
+			nIntParamGoldenData{
+				testInput,
+				2,
+				[]common.TestSeries{testInput[2], testInput[4]},
+			},
+			"median",
+		},
 	}
 	testOrderedAggregationFunc(t, ctx, tests, false)
 }
@@ -3296,6 +3337,13 @@ func (*mockStorage) CompleteTags(
 ) (*consolidators.CompleteTagsResult, error) {
 	return nil, fmt.Errorf("not implemented")
 }
+func (*mockStorage) SearchSeries(
+	ctx context.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	return nil, fmt.Errorf("not implemented")
+}
 
 func TestHoltWintersForecast(t *testing.T) {
 	ctx := common.NewTestContext()
@@ -4315,12 +4363,199 @@ func TestThreshold(t *testing.T) {
 	require.Equal(t, "1.000", results[0].Name())
 }
 
+func TestLinearRegression(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	start := time.Unix(1500000000, 0)
+	ctx.StartTime = start
+	ctx.EndTime = start.Add(5 * time.Minute)
+
+	shifter, err := linearRegression(ctx, singlePathSpec{}, "1499999400", "1499999700")
+	require.NoError(t, err)
+
+	shifted := shifter.ContextShiftFunc(ctx)
+	require.Equal(t, start.Add(-10*time.Minute), shifted.StartTime)
+	require.Equal(t, start.Add(-5*time.Minute), shifted.EndTime)
+
+	nan := math.NaN()
+	stepSize := 60000
+	input := ts.NewSeriesListWithSeries(
+		ts.NewSeries(ctx, "foo", shifted.StartTime,
+			common.NewTestSeriesValues(ctx, stepSize, []float64{1, nan, 3, 4, 5})),
+		ts.NewSeries(ctx, "bar", shifted.StartTime,
+			common.NewTestSeriesValues(ctx, stepSize, []float64{nan, 2, nan, nan, nan})),
+	)
+	results, err := shifter.UnaryTransformer(input)
+	require.NoError(t, err)
+
+	// Series without a defined fit are dropped.
+	expected := common.TestSeries{
+		Name: "linearRegression(foo, 1499999400, 1499999700)",
+		Data: []float64{11, 12, 13, 14, 15},
+	}
+	common.CompareOutputsAndExpected(t, stepSize, start,
+		[]common.TestSeries{expected}, results.Values)
+
+	_, err = linearRegression(ctx, singlePathSpec{}, "1499999700", "1499999400")
+	require.Error(t, err)
+}
+
+func TestMinMax(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	nan := math.NaN()
+	startTime := ctx.StartTime
+	stepSize := 10000
+	inputs := []struct {
+		name     string
+		values   []float64
+		expected []float64
+	}{
+		{
+			"foo",
+			[]float64{nan, nan, nan},
+			[]float64{nan, nan, nan},
+		},
+		{
+			"bar",
+			[]float64{1.0, 2.0, nan, 5.0, 3.0},
+			[]float64{0.0, 0.25, nan, 1.0, 0.5},
+		},
+		{
+			"baz",
+			[]float64{4.0, 4.0},
+			[]float64{0.0, 0.0},
+		},
+	}
+
+	for _, input := range inputs {
+		series := ts.NewSeries(
+			ctx,
+			input.name,
+			startTime,
+			common.NewTestSeriesValues(ctx, stepSize, input.values),
+		)
+		results, err := minMax(ctx, singlePathSpec{
+			Values: []*ts.Series{series},
+		})
+		require.NoError(t, err)
+		expected := common.TestSeries{
+			Name: fmt.Sprintf("minMax(%s)", input.name),
+			Data: input.expected,
+		}
+		common.CompareOutputsAndExpected(t, stepSize, startTime,
+			[]common.TestSeries{expected}, results.Values)
+	}
+}
+
+func TestRemoveBetweenPercentile(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	var (
+		nan    = math.NaN()
+		inputs = []common.TestSeries{
+			{Name: "a", Data: []float64{1, 1, nan}},
+			{Name: "b", Data: []float64{2, 2, nan}},
+			{Name: "c", Data: []float64{3, 3, nan}},
+			{Name: "d", Data: []float64{4, 4}},
+			{Name: "e", Data: []float64{5, 5, 5}},
+		}
+		series = make([]*ts.Series, 0, len(inputs))
+	)
+	for _, input := range inputs {
+		series = append(series, ts.NewSeries(ctx, input.Name, ctx.StartTime,
+			common.NewTestSeriesValues(ctx, 10000, input.Data)))
+	}
+
+	for _, percentile := range []float64{30, 70} {
+		results, err := removeBetweenPercentile(ctx, singlePathSpec{Values: series}, percentile)
+		require.NoError(t, err)
+		common.CompareOutputsAndExpected(t, 10000, ctx.StartTime,
+			[]common.TestSeries{inputs[0], inputs[1], inputs[4]}, results.Values)
+	}
+}
+
+func TestSinFunction(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	start := time.Unix(1500000000, 0)
+	ctx.StartTime = start
+	ctx.EndTime = start.Add(3 * time.Minute)
+
+	results, err := sinFunction(ctx, "foo", 2.0, 60)
+	require.NoError(t, err)
+	expected := common.TestSeries{
+		Name: "foo",
+		Data: []float64{
+			2 * math.Sin(1500000000),
+			2 * math.Sin(1500000060),
+			2 * math.Sin(1500000120),
+		},
+	}
+	common.CompareOutputsAndExpected(t, 60000, start,
+		[]common.TestSeries{expected}, results.Values)
+
+	_, err = sinFunction(ctx, "foo", 1.0, 0)
+	require.Error(t, err)
+}
+
+func TestVerticalLine(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	start := time.Unix(1500000000, 0)
+	ctx.StartTime = start
+	ctx.EndTime = start.Add(5 * time.Minute)
+
+	results, err := verticalLine(ctx, "1500000060", "deploy", "red")
+	require.NoError(t, err)
+	expected := common.TestSeries{Name: "deploy", Data: []float64{1, 1}}
+	common.CompareOutputsAndExpected(t, 1000, start.Add(time.Minute),
+		[]common.TestSeries{expected}, results.Values)
+
+	results, err = verticalLine(ctx, "1500000060", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "verticalLine(1500000060)", results.Values[0].Name())
+
+	_, err = verticalLine(ctx, "1499999940", "", "")
+	require.Error(t, err)
+
+	_, err = verticalLine(ctx, "1500000360", "", "")
+	require.Error(t, err)
+}
+
+func TestUnique(t *testing.T) {
+	ctx := common.NewTestContext()
+	defer func() { _ = ctx.Close() }()
+
+	inputs := []common.TestSeries{
+		{Name: "foo", Data: []float64{1, 2}},
+		{Name: "bar", Data: []float64{3, 4}},
+		{Name: "foo", Data: []float64{5, 6}},
+	}
+	series := make([]*ts.Series, 0, len(inputs))
+	for _, input := range inputs {
+		series = append(series, ts.NewSeries(ctx, input.Name, ctx.StartTime,
+			common.NewTestSeriesValues(ctx, 10000, input.Data)))
+	}
+
+	results, err := unique(ctx, multiplePathSpecs{Values: series})
+	require.NoError(t, err)
+	common.CompareOutputsAndExpected(t, 10000, ctx.StartTime,
+		inputs[:2], results.Values)
+}
+
 func TestFunctionsRegistered(t *testing.T) {
 	fnames := []string{
 		"abs",
 		"absolute",
 		"aggregate",
 		"aggregateLine",
+		"aggregateSeriesLists",
 		"alias",
 		"aliasByMetric",
 		"aliasByNode",
@@ -4343,6 +4578,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"delay",
 		"derivative",
 		"diffSeries",
+		"diffSeriesLists",
 		"divideSeries",
 		"divideSeriesLists",
 		"exclude",
@@ -4352,6 +4588,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"group",
 		"groupByNode",
 		"groupByNodes",
+		"groupByTags",
 		"highest",
 		"highestAverage",
 		"highestCurrent",
@@ -4368,6 +4605,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"keepLastValue",
 		"legendValue",
 		"limit",
+		"linearRegression",
 		"log",
 		"logarithm",
 		"lowest",
@@ -4377,6 +4615,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"maxSeries",
 		"maximumAbove",
 		"min",
+		"minMax",
 		"minSeries",
 		"minimumAbove",
 		"mostDeviant",
@@ -4386,10 +4625,12 @@ func TestFunctionsRegistered(t *testing.T) {
 		"movingMax",
 		"movingMin",
 		"multiplySeries",
+		"multiplySeriesLists",
 		"nonNegativeDerivative",
 		"nPercentile",
 		"offset",
 		"offsetToZero",
+		"pct",
 		"perSecond",
 		"pow",
 		"powSeries",
@@ -4400,9 +4641,13 @@ func TestFunctionsRegistered(t *testing.T) {
 		"removeAboveValue",
 		"removeBelowPercentile",
 		"removeBelowValue",
+		"removeBetweenPercentile",
 		"removeEmptySeries",
 		"scale",
 		"scaleToSeconds",
+		"seriesByTag",
+		"sin",
+		"sinFunction",
 		"smartSummarize",
 		"sortByMaxima",
 		"sortByMinima",
@@ -4414,6 +4659,7 @@ func TestFunctionsRegistered(t *testing.T) {
 		"substr",
 		"sum",
 		"sumSeries",
+		"sumSeriesLists",
 		"summarize",
 		"threshold",
 		"time",
@@ -4421,7 +4667,9 @@ func TestFunctionsRegistered(t *testing.T) {
 		"timeShift",
 		"timeSlice",
 		"transformNull",
+		"unique",
 		"useSeriesAbove",
+		"verticalLine",
 		"weightedAverage",
 	}
 
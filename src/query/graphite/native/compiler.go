@@ -227,6 +227,10 @@ func (c *compiler) compileFunctionCall(fname string, nextToken *lexer.Token) (*f
 			fn.name, variadicComment, len(argTypes), len(args))
 	}
 
+	if err := fn.validateArgs(args); err != nil {
+		return nil, c.errorf("invalid function call %s, %v", fn.name, err)
+	}
+
 	return &functionCall{f: fn, in: args}, nil
 }
 
@@ -279,6 +283,12 @@ func (c *compiler) convertTokenToArg(token *lexer.Token, reflectType reflect.Typ
 			return newIntConst(int(n)), nil
 		}
 
+		if reflectType.Kind() == reflect.String {
+			// NB: graphite allows numbers where either a node or a name may
+			// be given, such as the nodes and tags of aliasByTags.
+			return newStringConst(token.Value()), nil
+		}
+
 		return newFloat64Const(n), nil
 	case lexer.String:
 		return newStringConst(token.Value()), nil
@@ -53,6 +53,7 @@ func TestCompile1(t *testing.T) {
 		sortByName        = findFunction("sortByName")
 		noArgs            = findFunction("noArgs")
 		aliasByNode       = findFunction("aliasByNode")
+		aliasByTags       = findFunction("aliasByTags")
 		summarize         = findFunction("summarize")
 		defaultArgs       = findFunction("defaultArgs")
 		sumSeries         = findFunction("sumSeries")
@@ -89,6 +90,16 @@ func TestCompile1(t *testing.T) {
 				},
 			},
 		}},
+		{"aliasByTags(foo.bar4.*.metrics.written, 2, \"dc\")", &funcExpression{
+			&functionCall{
+				f: aliasByTags,
+				in: []funcArg{
+					newFetchExpression("foo.bar4.*.metrics.written"),
+					newStringConst("2"),
+					newStringConst("dc"),
+				},
+			},
+		}},
 		{"summarize(foo.bar.baz.quux, \"1h\", \"max\", TRUE)", &funcExpression{
 			&functionCall{
 				f: summarize,
@@ -462,6 +473,27 @@ func TestCompileErrors(t *testing.T) {
 				"arg 1: invalid expression 'scale(servers.foobar*-qaz.quail.qux-qaz-qab.cpu.*, 1.2ee)': " +
 				"expected one of 0123456789, found e not valid",
 		},
+		{
+			"highest(foo.bar.*, 2, 'unknown')",
+			"invalid expression 'highest(foo.bar.*, 2, 'unknown')': " +
+				"invalid function call highest, arg 3: invalid function unknown",
+		},
+		{
+			"aggregateSeriesLists(foo.bar.*, foo.baz.*, 'unknown')",
+			"invalid expression 'aggregateSeriesLists(foo.bar.*, foo.baz.*, 'unknown')': " +
+				"invalid function call aggregateSeriesLists, arg 3: invalid func unknown",
+		},
+		{
+			"sin('foo', 1, 0)",
+			"invalid expression 'sin('foo', 1, 0)': " +
+				"invalid function call sinFunction, arg 3: expected a positive value, received 0",
+		},
+		{
+			"removeBetweenPercentile(foo.bar.*, 110)",
+			"invalid expression 'removeBetweenPercentile(foo.bar.*, 110)': " +
+				"invalid function call removeBetweenPercentile, arg 2: " +
+				"expected a percentile between 0 and 100, received 110",
+		},
 	}
 
 	for _, test := range tests {
@@ -231,6 +231,7 @@ type Function struct {
 	f        reflect.Value
 	in       []reflect.Type
 	defaults map[uint8]interface{}
+	validate map[uint8]argValidator
 	out      reflect.Type
 	variadic bool
 
@@ -248,6 +249,41 @@ func (f *Function) WithDefaultParams(defaultParams map[uint8]interface{}) *Funct
 	return f
 }
 
+// argValidator validates the value of a constant function argument.
+type argValidator func(arg interface{}) error
+
+// WithArgValidators provides validators for constant parameters that are run
+// when a call to the function is compiled, indexed in the same way as
+// WithDefaultParams.
+func (f *Function) WithArgValidators(validators map[uint8]argValidator) *Function {
+	for index := range validators {
+		if int(index) <= 0 || int(index) > len(f.in) {
+			panic(fmt.Sprintf("Validated parameter #%d is out-of-range", index))
+		}
+	}
+	f.validate = validators
+	return f
+}
+
+// validateArgs runs the registered validators against the constant arguments
+// of a call, arguments resolved at runtime are left to the function itself.
+func (f *Function) validateArgs(args []funcArg) error {
+	for index, validator := range f.validate {
+		i := int(index) - 1
+		if i >= len(args) {
+			continue
+		}
+		arg, ok := args[i].(constFuncArg)
+		if !ok || !arg.value.IsValid() {
+			continue
+		}
+		if err := validator(arg.value.Interface()); err != nil {
+			return fmt.Errorf("arg %d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
 // WithoutUnaryContextShifterSkipFetchOptimization allows a function to skip
 // the optimization that avoids fetching data for the first execution phase
 // of a unary context shifted function (where it is called the first time
@@ -46,7 +46,11 @@ import (
 	"go.uber.org/zap"
 )
 
-var errSeriesNoResolution = errors.New("series has no resolution set")
+var (
+	errSeriesNoResolution = errors.New("series has no resolution set")
+	errNoNonEmptyTagExpr  = errors.New("at least one tag expression must " +
+		"require a non-empty value")
+)
 
 type m3WrappedStore struct {
 	m3             storage.Storage
@@ -153,12 +157,142 @@ func GetQueryTerminatorTagName(query string) []byte {
 	return graphite.TagName(metricLength)
 }
 
+// TranslateTagExpressionsToMatchers converts graphite seriesByTag
+// expressions to tag matchers. Expressions that cannot be expressed exactly
+// as matchers are either widened or skipped, so results must be filtered
+// against the expressions once fetched.
+func TranslateTagExpressionsToMatchers(
+	exprs []graphite.TagExpression,
+) (models.Matchers, error) {
+	var (
+		matchers    models.Matchers
+		hasNonEmpty bool
+	)
+	for _, expr := range exprs {
+		if !expr.MatchesEmpty() {
+			hasNonEmpty = true
+		}
+
+		if expr.Tag == graphite.NameTag {
+			switch expr.Operator {
+			case graphite.TagOperatorEqual:
+				pathMatchers, err := TranslateQueryToMatchersWithTerminator(expr.Value)
+				if err != nil {
+					return nil, err
+				}
+				matchers = append(matchers, pathMatchers...)
+			case graphite.TagOperatorRegexp:
+				// NB: the name is the prefix of the ID of a tagged series.
+				matchers = append(matchers, models.Matcher{
+					Type:  models.MatchRegexp,
+					Name:  doc.IDReservedFieldName,
+					Value: tagExpressionRegexp(expr),
+				})
+			}
+			continue
+		}
+
+		name := []byte(expr.Tag)
+		switch expr.Operator {
+		case graphite.TagOperatorEqual:
+			if expr.Value == "" {
+				matchers = append(matchers, models.Matcher{
+					Type: models.MatchNotField,
+					Name: name,
+				})
+				continue
+			}
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchEqual,
+				Name:  name,
+				Value: []byte(expr.Value),
+			})
+		case graphite.TagOperatorNotEqual:
+			if expr.Value == "" {
+				matchers = append(matchers, models.Matcher{
+					Type: models.MatchField,
+					Name: name,
+				})
+				continue
+			}
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchNotEqual,
+				Name:  name,
+				Value: []byte(expr.Value),
+			})
+		case graphite.TagOperatorRegexp:
+			if expr.MatchesEmpty() {
+				// Series without the tag match too, leave to filtering.
+				continue
+			}
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchRegexp,
+				Name:  name,
+				Value: tagExpressionRegexp(expr),
+			})
+		case graphite.TagOperatorNotRegexp:
+			matchers = append(matchers, models.Matcher{
+				Type:  models.MatchNotRegexp,
+				Name:  name,
+				Value: tagExpressionRegexp(expr),
+			})
+		}
+	}
+
+	if !hasNonEmpty || len(matchers) == 0 {
+		return nil, errNoNonEmptyTagExpr
+	}
+
+	return matchers, nil
+}
+
+// tagExpressionRegexp returns the matcher regexp for a tag expression, which
+// unlike matcher regexps is only anchored at the start of the value.
+func tagExpressionRegexp(expr graphite.TagExpression) []byte {
+	return []byte("(?:" + expr.Value + ").*")
+}
+
+func translateQueryToMatchers(query string) (models.Matchers, error) {
+	exprs, ok, err := graphite.ParseSeriesByTagQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return TranslateTagExpressionsToMatchers(exprs)
+	}
+
+	return TranslateQueryToMatchersWithTerminator(query)
+}
+
+// filterTaggedSeries filters fetched series down to those matching every
+// seriesByTag expression.
+func filterTaggedSeries(
+	series []*ts.Series,
+	exprs []graphite.TagExpression,
+) []*ts.Series {
+	filtered := series[:0]
+	for _, s := range series {
+		tags := graphite.ParseSeriesTags(s.Name())
+		matches := true
+		for _, expr := range exprs {
+			if !expr.Matches(tags) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
 func translateQuery(
 	query string,
 	fetchOpts FetchOptions,
 	opts M3WrappedStorageOptions,
 ) (*storage.FetchQuery, error) {
-	matchers, err := TranslateQueryToMatchersWithTerminator(query)
+	matchers, err := translateQueryToMatchers(query)
 	if err != nil {
 		return nil, err
 	}
@@ -471,6 +605,10 @@ func (s *m3WrappedStore) FetchByQuery(
 		return nil, err
 	}
 
+	if exprs, ok, _ := graphite.ParseSeriesByTagQuery(query); ok {
+		series = filterTaggedSeries(series, exprs)
+	}
+
 	return NewFetchResult(ctx, series, res.Metadata), nil
 }
 
@@ -485,3 +623,13 @@ func (s *m3WrappedStore) CompleteTags(
 	opts.FanoutOptions = s.fanoutOptions()
 	return s.m3.CompleteTags(ctx, query, opts)
 }
+
+func (s *m3WrappedStore) SearchSeries(
+	ctx context.Context,
+	query *querystorage.FetchQuery,
+	opts *querystorage.FetchOptions,
+) (*querystorage.SearchResults, error) {
+	opts = opts.Clone() // Clone to avoid mutating input and cause data races.
+	opts.FanoutOptions = s.fanoutOptions()
+	return s.m3.SearchSeries(ctx, query, opts)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/m3db/m3/src/query/block"
 	xctx "github.com/m3db/m3/src/query/graphite/context"
 	"github.com/m3db/m3/src/query/graphite/graphite"
+	"github.com/m3db/m3/src/query/graphite/ts"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/storage"
 	"github.com/m3db/m3/src/query/storage/mock"
@@ -131,6 +132,75 @@ func TestTranslateQueryTrailingDot(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTranslateSeriesByTagQuery(t *testing.T) {
+	exprs, err := graphite.ParseTagExpressions([]string{
+		"name=foo.b*",
+		"dc=east",
+		"env!=prod",
+		"host=~web",
+		"az=~.*",
+		"role!=~db.*",
+		"rack=",
+		"team!=",
+	})
+	require.NoError(t, err)
+
+	query := graphite.SeriesByTagQuery(exprs)
+	translated, err := translateQuery(query, FetchOptions{}, M3WrappedStorageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, query, translated.Raw)
+
+	expected := models.Matchers{
+		{Type: models.MatchEqual, Name: graphite.TagName(0), Value: []byte("foo")},
+		{Type: models.MatchRegexp, Name: graphite.TagName(1), Value: []byte(`b[^\.]*`)},
+		{Type: models.MatchNotField, Name: graphite.TagName(2)},
+		{Type: models.MatchEqual, Name: []byte("dc"), Value: []byte("east")},
+		{Type: models.MatchNotEqual, Name: []byte("env"), Value: []byte("prod")},
+		{Type: models.MatchRegexp, Name: []byte("host"), Value: []byte("(?:web).*")},
+		{Type: models.MatchNotRegexp, Name: []byte("role"), Value: []byte("(?:db.*).*")},
+		{Type: models.MatchNotField, Name: []byte("rack")},
+		{Type: models.MatchField, Name: []byte("team")},
+	}
+	assert.Equal(t, expected, translated.TagMatchers)
+
+	exprs, err = graphite.ParseTagExpressions([]string{"name=~foo\\..*", "dc!=east"})
+	require.NoError(t, err)
+	matchers, err := TranslateTagExpressionsToMatchers(exprs)
+	require.NoError(t, err)
+	assert.Equal(t, models.Matchers{
+		{Type: models.MatchRegexp, Name: doc.IDReservedFieldName, Value: []byte("(?:foo\\..*).*")},
+		{Type: models.MatchNotEqual, Name: []byte("dc"), Value: []byte("east")},
+	}, matchers)
+
+	// Every expression matches series without the tag.
+	exprs, err = graphite.ParseTagExpressions([]string{"dc!=east", "env=~.*"})
+	require.NoError(t, err)
+	_, err = TranslateTagExpressionsToMatchers(exprs)
+	require.Error(t, err)
+}
+
+func TestFilterTaggedSeries(t *testing.T) {
+	ctx := xctx.New()
+	var series []*ts.Series
+	for _, name := range []string{
+		"foo.bar;dc=east;env=prod",
+		"foo.bar;dc=eastern",
+		"foo.baz;dc=east",
+		"foo.bar",
+	} {
+		series = append(series, ts.NewSeries(ctx, name, time.Now(),
+			ts.NewConstantValues(ctx, 1, 1, 1000)))
+	}
+
+	exprs, err := graphite.ParseTagExpressions([]string{"name=~foo.ba", "dc=east"})
+	require.NoError(t, err)
+
+	filtered := filterTaggedSeries(series, exprs)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "foo.bar;dc=east;env=prod", filtered[0].Name())
+	assert.Equal(t, "foo.baz;dc=east", filtered[1].Name())
+}
+
 func buildResult(
 	ctrl *gomock.Controller,
 	resolution time.Duration,
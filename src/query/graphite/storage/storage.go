@@ -69,6 +69,13 @@ type Storage interface {
 		query *querystorage.CompleteTagsQuery,
 		opts *querystorage.FetchOptions,
 	) (*consolidators.CompleteTagsResult, error)
+
+	// SearchSeries fetches series IDs and tags based on a request.
+	SearchSeries(
+		ctx stdcontext.Context,
+		query *querystorage.FetchQuery,
+		opts *querystorage.FetchOptions,
+	) (*querystorage.SearchResults, error)
 }
 
 // FetchResult provides a fetch result and meta information.
@@ -87,3 +87,18 @@ func (mr *MockStorageMockRecorder) FetchByQuery(arg0, arg1, arg2 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchByQuery", reflect.TypeOf((*MockStorage)(nil).FetchByQuery), arg0, arg1, arg2)
 }
+
+// SearchSeries mocks base method.
+func (m *MockStorage) SearchSeries(arg0 context.Context, arg1 *storage0.FetchQuery, arg2 *storage0.FetchOptions) (*storage0.SearchResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSeries", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*storage0.SearchResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSeries indicates an expected call of SearchSeries.
+func (mr *MockStorageMockRecorder) SearchSeries(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSeries", reflect.TypeOf((*MockStorage)(nil).SearchSeries), arg0, arg1, arg2)
+}
@@ -310,6 +310,35 @@ func (b *Series) SetConsolidationFunc(cf ConsolidationFunc) {
 	b.consolidationFunc = cf
 }
 
+// ConsolidateToMaxDataPoints returns a series consolidated with the series'
+// consolidation function such that it has at most maxDataPoints values, the
+// same way graphite-web honors maxDataPoints. Consolidated steps start at the
+// start of the series so that no values are dropped.
+func (b *Series) ConsolidateToMaxDataPoints(maxDataPoints int) *Series {
+	if maxDataPoints <= 0 || b.Len() <= maxDataPoints {
+		return b
+	}
+
+	var (
+		valuesPerPoint = int(math.Ceil(float64(b.Len()) / float64(maxDataPoints)))
+		numSteps       = int(math.Ceil(float64(b.Len()) / float64(valuesPerPoint)))
+		vals           = NewValues(b.ctx, b.MillisPerStep()*valuesPerPoint, numSteps)
+		fn             = b.ConsolidationFunc()
+	)
+	for i := 0; i < numSteps; i++ {
+		var (
+			value = math.NaN()
+			count = 0
+		)
+		for j := i * valuesPerPoint; j < (i+1)*valuesPerPoint && j < b.Len(); j++ {
+			value, count = consolidateValues(value, b.ValueAt(j), count, fn)
+		}
+		vals.SetValueAt(i, value)
+	}
+
+	return b.DerivedSeries(b.StartTime(), vals)
+}
+
 // PostConsolidationFunc is a function that takes a tuple of time and value after consolidation.
 type PostConsolidationFunc func(timestamp time.Time, value float64)
 
@@ -20,7 +20,11 @@
 
 package ts
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"sort"
+)
 
 // SeriesReducerApproach defines an approach to reduce a series to a single value.
 type SeriesReducerApproach string
@@ -34,9 +38,17 @@ const (
 	SeriesReducerStdDev SeriesReducerApproach = "stddev"
 	SeriesReducerLast   SeriesReducerApproach = "last"
 
+	SeriesReducerMedian   SeriesReducerApproach = "median"
+	SeriesReducerDiff     SeriesReducerApproach = "diff"
+	SeriesReducerCount    SeriesReducerApproach = "count"
+	SeriesReducerRange    SeriesReducerApproach = "range"
+	SeriesReducerMultiply SeriesReducerApproach = "multiply"
+	SeriesReducerAvgZero  SeriesReducerApproach = "avg_zero"
+
 	SeriesReducerAverage SeriesReducerApproach = "average" // alias for "avg"
 	SeriesReducerTotal   SeriesReducerApproach = "total"   // alias for "sum"
 	SeriesReducerCurrent SeriesReducerApproach = "current" // alias for "last"
+	SeriesReducerRangeOf SeriesReducerApproach = "rangeOf" // alias for "range"
 )
 
 // SeriesReducer reduces a series to a single value.
@@ -59,13 +71,80 @@ func (sa SeriesReducerApproach) Reducer() SeriesReducer {
 }
 
 var seriesReducers = map[SeriesReducerApproach]SeriesReducer{
-	SeriesReducerAvg:     func(b *Series) float64 { return b.SafeAvg() },
-	SeriesReducerAverage: func(b *Series) float64 { return b.SafeAvg() },
-	SeriesReducerTotal:   func(b *Series) float64 { return b.SafeSum() },
-	SeriesReducerSum:     func(b *Series) float64 { return b.SafeSum() },
-	SeriesReducerMin:     func(b *Series) float64 { return b.SafeMin() },
-	SeriesReducerMax:     func(b *Series) float64 { return b.SafeMax() },
-	SeriesReducerStdDev:  func(b *Series) float64 { return b.SafeStdDev() },
-	SeriesReducerLast:    func(b *Series) float64 { return b.SafeLastValue() },
-	SeriesReducerCurrent: func(b *Series) float64 { return b.SafeLastValue() },
+	SeriesReducerAvg:      func(b *Series) float64 { return b.SafeAvg() },
+	SeriesReducerAverage:  func(b *Series) float64 { return b.SafeAvg() },
+	SeriesReducerTotal:    func(b *Series) float64 { return b.SafeSum() },
+	SeriesReducerSum:      func(b *Series) float64 { return b.SafeSum() },
+	SeriesReducerMin:      func(b *Series) float64 { return b.SafeMin() },
+	SeriesReducerMax:      func(b *Series) float64 { return b.SafeMax() },
+	SeriesReducerStdDev:   func(b *Series) float64 { return b.SafeStdDev() },
+	SeriesReducerLast:     func(b *Series) float64 { return b.SafeLastValue() },
+	SeriesReducerCurrent:  func(b *Series) float64 { return b.SafeLastValue() },
+	SeriesReducerMedian:   reduceMedian,
+	SeriesReducerDiff:     reduceDiff,
+	SeriesReducerCount:    func(b *Series) float64 { return float64(len(b.SafeValues())) },
+	SeriesReducerRange:    reduceRange,
+	SeriesReducerRangeOf:  reduceRange,
+	SeriesReducerMultiply: reduceMultiply,
+	SeriesReducerAvgZero:  reduceAvgZero,
+}
+
+// reduceMedian returns the median of the non-NaN values of a series.
+func reduceMedian(b *Series) float64 {
+	vals := b.SafeValues()
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+
+	sort.Float64s(vals)
+	mid := len(vals) / 2
+	if len(vals)%2 == 0 {
+		return (vals[mid-1] + vals[mid]) / 2
+	}
+	return vals[mid]
+}
+
+// reduceDiff subtracts the remaining non-NaN values of a series from the first.
+func reduceDiff(b *Series) float64 {
+	vals := b.SafeValues()
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+
+	result := vals[0]
+	for _, v := range vals[1:] {
+		result -= v
+	}
+	return result
+}
+
+// reduceRange returns the difference between the max and min of a series.
+func reduceRange(b *Series) float64 {
+	stats := b.CalcStatistics()
+	if stats.Count == 0 {
+		return math.NaN()
+	}
+	return stats.Max - stats.Min
+}
+
+// reduceMultiply returns the product of the non-NaN values of a series.
+func reduceMultiply(b *Series) float64 {
+	vals := b.SafeValues()
+	if len(vals) == 0 {
+		return math.NaN()
+	}
+
+	result := 1.0
+	for _, v := range vals {
+		result *= v
+	}
+	return result
+}
+
+// reduceAvgZero returns the average of a series treating NaNs as zeroes.
+func reduceAvgZero(b *Series) float64 {
+	if b.Len() == 0 {
+		return math.NaN()
+	}
+	return b.SafeSum() / float64(b.Len())
 }
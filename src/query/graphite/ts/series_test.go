@@ -374,6 +374,43 @@ func TestIntersectAndResize(t *testing.T) {
 	}
 }
 
+func TestConsolidateToMaxDataPoints(t *testing.T) {
+	ctx := context.New()
+	defer ctx.Close()
+
+	newSeries := func(start time.Time) *Series {
+		values := NewValues(ctx, 10000, 10)
+		for i := 0; i < values.Len(); i++ {
+			values.SetValueAt(i, float64(i+1))
+		}
+		values.SetValueAt(4, math.NaN())
+		return NewSeries(ctx, "foo", start, values)
+	}
+
+	// Within the limit the series is returned as is.
+	series := newSeries(time.Unix(990, 0))
+	require.True(t, series == series.ConsolidateToMaxDataPoints(10))
+
+	// Averaged by default and ignoring NaNs.
+	consolidated := series.ConsolidateToMaxDataPoints(4)
+	require.Equal(t, 30000, consolidated.MillisPerStep())
+	require.Equal(t, time.Unix(990, 0), consolidated.StartTime())
+	require.Equal(t, []float64{2, 5, 8, 10}, consolidated.SafeValues())
+	require.Equal(t, "foo", consolidated.Name())
+
+	// Honors the consolidation function.
+	series.SetConsolidationFunc(Max)
+	consolidated = series.ConsolidateToMaxDataPoints(4)
+	require.Equal(t, []float64{3, 6, 9, 10}, consolidated.SafeValues())
+
+	// Steps start at the start of the series.
+	series = newSeries(time.Unix(1000, 0))
+	series.SetConsolidationFunc(Sum)
+	consolidated = series.ConsolidateToMaxDataPoints(4)
+	require.Equal(t, time.Unix(1000, 0), consolidated.StartTime())
+	require.Equal(t, []float64{1 + 2 + 3, 4 + 6, 7 + 8 + 9, 10}, consolidated.SafeValues())
+}
+
 var (
 	benchmarkRange     = 24 * time.Hour
 	benchmarkEndTime   = time.Now()
@@ -132,6 +132,13 @@ func TestSortSeries(t *testing.T) {
 		{testInput, []testSeries{testInput[1], testInput[3], testInput[0], testInput[2], testInput[4]}},
 	}, SeriesReducerAvg.Reducer(), Ascending)
 
+	testSortImpl(ctx, t, []testSortData{
+		{testInput, []testSeries{testInput[2], testInput[4], testInput[0], testInput[3], testInput[1]}},
+	}, SeriesReducerMedian.Reducer(), Descending)
+
+	testSortImpl(ctx, t, []testSortData{
+		{testInput, []testSeries{testInput[4], testInput[3], testInput[0], testInput[2], testInput[1]}},
+	}, SeriesReducerRange.Reducer(), Descending)
 }
 
 func TestSortSeriesStable(t *testing.T) {
@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package models
+
+import (
+	"fmt"
+
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// AtModifierType describes how the @ modifier pins the evaluation time of a
+// selector or subquery.
+type AtModifierType uint8
+
+const (
+	// AtNone evaluates the selector at every step of the query.
+	AtNone AtModifierType = iota
+	// AtTimestamp evaluates the selector at a fixed timestamp.
+	AtTimestamp
+	// AtStart evaluates the selector at the start of the query.
+	AtStart
+	// AtEnd evaluates the selector at the end of the query.
+	AtEnd
+)
+
+// AtModifier pins the evaluation time of a selector or subquery, regardless
+// of the step being evaluated.
+type AtModifier struct {
+	// Type is the type of the modifier.
+	Type AtModifierType
+	// Timestamp is the evaluation time for AtTimestamp modifiers.
+	Timestamp xtime.UnixNano
+}
+
+// IsSet returns true if the modifier pins the evaluation time.
+func (m AtModifier) IsSet() bool {
+	return m.Type != AtNone
+}
+
+// Resolve returns the evaluation time given the start and end of the query,
+// and a boolean indicating if the modifier pins the evaluation time.
+func (m AtModifier) Resolve(start, end xtime.UnixNano) (xtime.UnixNano, bool) {
+	switch m.Type {
+	case AtTimestamp:
+		return m.Timestamp, true
+	case AtStart:
+		return start, true
+	case AtEnd:
+		return end, true
+	default:
+		return 0, false
+	}
+}
+
+// String is the string representation of the modifier.
+func (m AtModifier) String() string {
+	switch m.Type {
+	case AtTimestamp:
+		return fmt.Sprintf("@ %v", m.Timestamp)
+	case AtStart:
+		return "@ start()"
+	case AtEnd:
+		return "@ end()"
+	default:
+		return "none"
+	}
+}
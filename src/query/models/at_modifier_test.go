@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+func TestAtModifierResolve(t *testing.T) {
+	var (
+		start = xtime.Now()
+		end   = start.Add(time.Hour)
+		at    = start.Add(time.Minute)
+	)
+
+	tests := []struct {
+		modifier AtModifier
+		expected xtime.UnixNano
+		set      bool
+	}{
+		{AtModifier{}, 0, false},
+		{AtModifier{Type: AtTimestamp, Timestamp: at}, at, true},
+		{AtModifier{Type: AtStart}, start, true},
+		{AtModifier{Type: AtEnd}, end, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.modifier.String(), func(t *testing.T) {
+			assert.Equal(t, tt.set, tt.modifier.IsSet())
+			actual, ok := tt.modifier.Resolve(start, end)
+			assert.Equal(t, tt.set, ok)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -45,6 +45,7 @@ type tagOptions struct {
 	filters                Filters
 	allowTagNameDuplicates bool
 	allowTagValueEmpty     bool
+	graphiteTaggedIDs      bool
 }
 
 // NewTagOptions builds a new tag options with default values.
@@ -131,10 +132,21 @@ func (o *tagOptions) AllowTagValueEmpty() bool {
 	return o.allowTagValueEmpty
 }
 
+func (o *tagOptions) SetGraphiteTaggedIDs(value bool) TagOptions {
+	opts := *o
+	opts.graphiteTaggedIDs = value
+	return &opts
+}
+
+func (o *tagOptions) GraphiteTaggedIDs() bool {
+	return o.graphiteTaggedIDs
+}
+
 func (o *tagOptions) Equals(other TagOptions) bool {
 	return o.idScheme == other.IDSchemeType() &&
 		bytes.Equal(o.metricName, other.MetricName()) &&
 		bytes.Equal(o.bucketName, other.BucketName()) &&
 		o.allowTagNameDuplicates == other.AllowTagNameDuplicates() &&
-		o.allowTagValueEmpty == other.AllowTagValueEmpty()
+		o.allowTagValueEmpty == other.AllowTagValueEmpty() &&
+		o.graphiteTaggedIDs == other.GraphiteTaggedIDs()
 }
@@ -90,6 +90,10 @@ func TestOptionsEquals(t *testing.T) {
 	assert.False(t, opts.Equals(other))
 
 	opts = opts.SetMetricName(n)
+	opts = opts.SetGraphiteTaggedIDs(true)
+	assert.False(t, opts.Equals(other))
+
+	opts = opts.SetGraphiteTaggedIDs(false)
 	opts = opts.SetIDSchemeType(IDSchemeType(10))
 	assert.False(t, opts.Equals(other))
 }
@@ -85,3 +85,9 @@ func (r RequestParams) ExclusiveEnd() xtime.UnixNano {
 
 	return r.End
 }
+
+// LastStep returns the timestamp of the final step of a range query.
+func (r RequestParams) LastStep() xtime.UnixNano {
+	steps := (r.ExclusiveEnd().Sub(r.Start) - 1) / r.Step
+	return r.Start.Add(steps * r.Step)
+}
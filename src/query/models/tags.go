@@ -37,6 +37,11 @@ var (
 	errNoTags = errors.New("no tags")
 )
 
+// NativeHistogramSumTagName is the name of the tag that marks the series
+// holding the sum of observations of a native histogram, once it has been
+// expanded into classic histogram series.
+var NativeHistogramSumTagName = []byte("__m3_native_histogram_sum__")
+
 // NewTags builds a tags with the given size and tag options.
 func NewTags(size int, opts TagOptions) Tags {
 	if opts == nil {
@@ -21,6 +21,10 @@
 package models
 
 import (
+	"bytes"
+	"sort"
+
+	"github.com/m3db/m3/src/query/graphite/graphite"
 	"github.com/m3db/m3/src/query/models/strconv"
 	"github.com/m3db/m3/src/query/util/writer"
 )
@@ -218,6 +222,14 @@ func idLenGraphite(t Tags) int {
 }
 
 func graphiteID(t Tags) []byte {
+	if t.Opts.GraphiteTaggedIDs() {
+		for _, tag := range t.Tags {
+			if _, ok := graphite.TagIndex(tag.Name); !ok {
+				return taggedGraphiteID(t)
+			}
+		}
+	}
+
 	// TODO: pool these bytes.
 	id := make([]byte, idLenGraphite(t))
 	idx := 0
@@ -231,3 +243,41 @@ func graphiteID(t Tags) []byte {
 	copy(id[idx:], t.Tags[lastIndex].Value)
 	return id
 }
+
+// taggedGraphiteID generates the ID of a graphite series that has tags other
+// than its path, in the graphite tagged series form of "a.b.c;k1=v1;k2=v2"
+// with the non-path tags sorted by name.
+func taggedGraphiteID(t Tags) []byte {
+	var (
+		path  []Tag
+		other []Tag
+	)
+	for _, tag := range t.Tags {
+		if _, ok := graphite.TagIndex(tag.Name); ok {
+			path = append(path, tag)
+		} else {
+			other = append(other, tag)
+		}
+	}
+
+	sort.Slice(other, func(i, j int) bool {
+		return bytes.Compare(other[i].Name, other[j].Name) < 0
+	})
+
+	var id []byte
+	for i, tag := range path {
+		if i > 0 {
+			id = append(id, graphiteSep)
+		}
+		id = append(id, tag.Value...)
+	}
+
+	for _, tag := range other {
+		id = append(id, graphiteTagSep)
+		id = append(id, tag.Name...)
+		id = append(id, eq)
+		id = append(id, tag.Value...)
+	}
+
+	return id
+}
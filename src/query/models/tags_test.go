@@ -76,6 +76,26 @@ func TestLongTagNewIDOutOfOrderGraphite(t *testing.T) {
 	assert.Equal(t, []byte("v0.v1.v2.v3.v4.v5.v6.v7.v8.v9.v10.v11.v12"), actual)
 }
 
+func TestTaggedNewIDGraphite(t *testing.T) {
+	newTags := func(opts TagOptions) Tags {
+		return NewTags(5, opts).AddTags([]Tag{
+			{Name: []byte("env"), Value: []byte("prod")},
+			{Name: []byte("__g1__"), Value: []byte("v1")},
+			{Name: []byte("dc"), Value: []byte("east")},
+			{Name: []byte("__g0__"), Value: []byte("v0")},
+			{Name: []byte("__g10__"), Value: []byte("v10")},
+		})
+	}
+
+	// NB: tagged IDs are opt in so that the IDs of existing series with tags
+	// other than their path are unchanged.
+	opts := NewTagOptions().SetIDSchemeType(TypeGraphite)
+	assert.Equal(t, []byte("east.prod.v0.v1.v10"), newTags(opts).ID())
+
+	opts = opts.SetGraphiteTaggedIDs(true)
+	assert.Equal(t, []byte("v0.v1.v10;dc=east;env=prod"), newTags(opts).ID())
+}
+
 func TestLongTagNewIDOutOfOrderQuotedWithEscape(t *testing.T) {
 	tags := testLongTagIDOutOfOrder(t, TypeQuoted)
 	tags = tags.AddTag(Tag{Name: []byte(`t5""`), Value: []byte(`v"5`)})
@@ -27,12 +27,13 @@ import (
 
 // Separators for tags.
 const (
-	graphiteSep  = byte('.')
-	sep          = byte(',')
-	finish       = byte('!')
-	eq           = byte('=')
-	leftBracket  = byte('{')
-	rightBracket = byte('}')
+	graphiteSep    = byte('.')
+	graphiteTagSep = byte(';')
+	sep            = byte(',')
+	finish         = byte('!')
+	eq             = byte('=')
+	leftBracket    = byte('{')
+	rightBracket   = byte('}')
 )
 
 // IDSchemeType determines the scheme for generating
@@ -62,6 +63,11 @@ const (
 	// ingestion path, as it ignores tag names and is very prone to collisions if
 	// used on non-graphite data.
 	// {__g0__:v1},{__g1__:v2} -> v1.v2
+	// {__g0__:v1},{__g1__:v2},{dc:east} -> east.v1.v2
+	//
+	// When graphite tagged IDs are enabled, tags other than the graphite path
+	// tags are appended in the graphite tagged series form instead:
+	// {__g0__:v1},{__g1__:v2},{dc:east} -> v1.v2;dc=east
 	//
 	// NB: when TypeGraphite is specified, tags are ordered numerically rather
 	// than lexically.
@@ -113,6 +119,16 @@ type TagOptions interface {
 	// AllowTagValueEmpty returns the value to allow empty tag values to appear.
 	AllowTagValueEmpty() bool
 
+	// SetGraphiteTaggedIDs sets whether graphite scheme IDs of series with tags
+	// other than their path are generated in the graphite tagged series form.
+	// NB: enabling this changes the IDs of such series, which were previously
+	// generated by joining every tag value.
+	SetGraphiteTaggedIDs(value bool) TagOptions
+
+	// GraphiteTaggedIDs returns whether graphite scheme IDs of series with tags
+	// other than their path are generated in the graphite tagged series form.
+	GraphiteTaggedIDs() bool
+
 	// Equals determines if two tag options are equivalent.
 	Equals(other TagOptions) bool
 }
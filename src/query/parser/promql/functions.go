@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package promql
+
+import (
+	"github.com/m3db/m3/src/query/functions/linear"
+	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
+
+	pql "github.com/prometheus/prometheus/promql/parser"
+)
+
+// additionalFunctions are functions supported by M3 which may not be known
+// by the version of the Prometheus parser in use; they are registered with
+// the parser unless it already defines them.
+var additionalFunctions = []*pql.Function{
+	{
+		Name:       temporal.LastType,
+		ArgTypes:   []pql.ValueType{pql.ValueTypeMatrix},
+		ReturnType: pql.ValueTypeVector,
+	},
+	{
+		Name:       temporal.PresentType,
+		ArgTypes:   []pql.ValueType{pql.ValueTypeMatrix},
+		ReturnType: pql.ValueTypeVector,
+	},
+	{
+		Name:       scalar.PiType,
+		ArgTypes:   []pql.ValueType{},
+		ReturnType: pql.ValueTypeScalar,
+	},
+	{
+		Name:       linear.SortByLabelType,
+		ArgTypes:   []pql.ValueType{pql.ValueTypeVector, pql.ValueTypeString},
+		Variadic:   -1,
+		ReturnType: pql.ValueTypeVector,
+	},
+	{
+		Name:       linear.SortByLabelDescType,
+		ArgTypes:   []pql.ValueType{pql.ValueTypeVector, pql.ValueTypeString},
+		Variadic:   -1,
+		ReturnType: pql.ValueTypeVector,
+	},
+}
+
+func init() {
+	for _, name := range []string{
+		linear.SgnType, linear.AcosType, linear.AcoshType, linear.AsinType,
+		linear.AsinhType, linear.AtanType, linear.AtanhType, linear.CosType,
+		linear.CoshType, linear.SinType, linear.SinhType, linear.TanType,
+		linear.TanhType, linear.DegType, linear.RadType,
+		linear.HistogramCountType, linear.HistogramSumType,
+	} {
+		additionalFunctions = append(additionalFunctions, &pql.Function{
+			Name:       name,
+			ArgTypes:   []pql.ValueType{pql.ValueTypeVector},
+			ReturnType: pql.ValueTypeVector,
+		})
+	}
+
+	for _, fn := range additionalFunctions {
+		if _, ok := pql.Functions[fn.Name]; !ok {
+			pql.Functions[fn.Name] = fn
+		}
+	}
+}
@@ -22,6 +22,8 @@ package promql
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/m3db/m3/src/query/block"
 	"github.com/m3db/m3/src/query/functions"
@@ -35,6 +37,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	"github.com/m3db/m3/src/query/parser/common"
+	xtime "github.com/m3db/m3/src/x/time"
 
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/pkg/labels"
@@ -54,6 +57,7 @@ func NewSelectorFromVector(
 	return functions.FetchOp{
 		Name:     n.Name,
 		Offset:   n.Offset,
+		At:       NewAtModifier(n.Timestamp, n.StartOrEnd),
 		Matchers: matchers,
 	}, nil
 }
@@ -72,11 +76,30 @@ func NewSelectorFromMatrix(
 	return functions.FetchOp{
 		Name:     vectorSelector.Name,
 		Offset:   vectorSelector.Offset,
+		At:       NewAtModifier(vectorSelector.Timestamp, vectorSelector.StartOrEnd),
 		Matchers: matchers,
 		Range:    n.Range,
 	}, nil
 }
 
+// NewAtModifier creates a new @ modifier from a millisecond timestamp or a
+// start() or end() preprocessor.
+func NewAtModifier(timestamp *int64, startOrEnd promql.ItemType) models.AtModifier {
+	switch {
+	case timestamp != nil:
+		return models.AtModifier{
+			Type:      models.AtTimestamp,
+			Timestamp: xtime.FromNormalizedTime(*timestamp, time.Millisecond),
+		}
+	case startOrEnd == promql.START:
+		return models.AtModifier{Type: models.AtStart}
+	case startOrEnd == promql.END:
+		return models.AtModifier{Type: models.AtEnd}
+	default:
+		return models.AtModifier{}
+	}
+}
+
 // NewAggregationOperator creates a new aggregation operator based on the type.
 func NewAggregationOperator(expr *promql.AggregateExpr) (parser.Params, error) {
 	opType := expr.Op
@@ -162,6 +185,8 @@ func getAggOpType(opType promql.ItemType) string {
 		return aggregation.StandardVarianceType
 	case promql.COUNT:
 		return aggregation.CountType
+	case promql.GROUP:
+		return aggregation.GroupType
 
 	case promql.TOPK:
 		return aggregation.TopKType
@@ -235,7 +260,12 @@ func NewFunctionExpr(
 	switch name {
 	case linear.AbsType, linear.CeilType, linear.ExpType,
 		linear.FloorType, linear.LnType, linear.Log10Type,
-		linear.Log2Type, linear.SqrtType:
+		linear.Log2Type, linear.SqrtType, linear.SgnType,
+		linear.AcosType, linear.AcoshType, linear.AsinType,
+		linear.AsinhType, linear.AtanType, linear.AtanhType,
+		linear.CosType, linear.CoshType, linear.SinType,
+		linear.SinhType, linear.TanType, linear.TanhType,
+		linear.DegType, linear.RadType:
 		p, err = linear.NewMathOp(name)
 		return p, true, err
 
@@ -251,6 +281,10 @@ func NewFunctionExpr(
 		p, err = linear.NewHistogramQuantileOp(argValues, name)
 		return p, true, err
 
+	case linear.HistogramCountType, linear.HistogramSumType:
+		p, err = linear.NewHistogramFunctionOp(name)
+		return p, true, err
+
 	case linear.RoundType:
 		p, err = linear.NewRoundOp(argValues)
 		return p, true, err
@@ -261,7 +295,7 @@ func NewFunctionExpr(
 
 	case temporal.AvgType, temporal.CountType, temporal.MinType,
 		temporal.MaxType, temporal.SumType, temporal.StdDevType,
-		temporal.StdVarType:
+		temporal.StdVarType, temporal.LastType, temporal.PresentType:
 		p, err = temporal.NewAggOp(argValues, name)
 		return p, true, err
 
@@ -295,10 +329,18 @@ func NewFunctionExpr(
 		p, err = scalar.NewTimeOp(tagOptions)
 		return p, true, err
 
+	case scalar.PiType:
+		p, err = scalar.NewScalarOp(math.Pi, tagOptions)
+		return p, true, err
+
 	case linear.SortType, linear.SortDescType:
 		p, err = linear.NewSortOp(name)
 		return p, true, err
 
+	case linear.SortByLabelType, linear.SortByLabelDescType:
+		p, err = linear.NewSortByLabelOp(name, stringValues)
+		return p, true, err
+
 	// NB: no-ops.
 	case scalar.ScalarType:
 		return nil, false, err
@@ -27,9 +27,12 @@ import (
 	pql "github.com/prometheus/prometheus/promql/parser"
 
 	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/functions"
+	"github.com/m3db/m3/src/query/functions/aggregation"
 	"github.com/m3db/m3/src/query/functions/binary"
 	"github.com/m3db/m3/src/query/functions/lazy"
 	"github.com/m3db/m3/src/query/functions/scalar"
+	"github.com/m3db/m3/src/query/functions/temporal"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
 	xtime "github.com/m3db/m3/src/x/time"
@@ -127,11 +130,10 @@ func (p *parseState) addLazyUnaryTransform(unaryOp string) error {
 }
 
 func (p *parseState) addLazyOffsetTransform(offset time.Duration) error {
-	// NB: if offset is <= 0, we do not apply any offsets.
+	// NB: if offset is 0, we do not apply any offsets. Negative offsets shift
+	// values backwards, i.e. look ahead of the evaluation time.
 	if offset == 0 {
 		return nil
-	} else if offset < 0 {
-		return fmt.Errorf("offset must be positive, received: %v", offset)
 	}
 
 	var (
@@ -161,7 +163,59 @@ func (p *parseState) addLazyOffsetTransform(offset time.Duration) error {
 	return nil
 }
 
+// addStepInvariantTransform broadcasts the result of an expression pinned by
+// the @ modifier to every step of the query.
+func (p *parseState) addStepInvariantTransform() {
+	opTransform := parser.NewTransformFromOperation(
+		functions.NewStepInvariantOp(), p.transformLen())
+	p.edges = append(p.edges, parser.Edge{
+		ParentID: p.lastTransformID(),
+		ChildID:  opTransform.ID,
+	})
+	p.transforms = append(p.transforms, opTransform)
+}
+
+func (p *parseState) addSubquery(n *pql.SubqueryExpr) error {
+	// NB: subqueries without an explicit step are evaluated at the step of the
+	// enclosing query.
+	step := n.Step
+	if step == 0 {
+		step = p.stepSize
+	}
+
+	inner := &parseState{
+		stepSize:          step,
+		tagOpts:           p.tagOpts,
+		parseFunctionExpr: p.parseFunctionExpr,
+	}
+
+	if err := inner.walk(n.Expr); err != nil {
+		return err
+	}
+
+	op := functions.SubqueryOp{
+		Nodes:  inner.transforms,
+		Edges:  inner.edges,
+		Range:  n.Range,
+		Step:   step,
+		Offset: n.OriginalOffset,
+		At:     NewAtModifier(n.Timestamp, n.StartOrEnd),
+	}
+
+	p.transforms = append(
+		p.transforms,
+		parser.NewTransformFromOperation(op, p.transformLen()),
+	)
+
+	return nil
+}
+
 func adjustOffset(offset time.Duration, step time.Duration) time.Duration {
+	// NB: negative offsets are rounded away from zero, mirroring positive ones.
+	if offset < 0 {
+		return -adjustOffset(-offset, step)
+	}
+
 	// handles case where offset is 0 too.
 	align := offset % step
 	if align == 0 {
@@ -173,6 +227,27 @@ func adjustOffset(offset time.Duration, step time.Duration) time.Duration {
 	return offset + step - align
 }
 
+// hasPinnedRangeArg returns true if any of the range arguments to a function
+// are pinned by the @ modifier; the function is only evaluated at the pinned
+// time so its result must be broadcast to every step.
+func hasPinnedRangeArg(args pql.Expressions) bool {
+	for _, arg := range args {
+		switch e := arg.(type) {
+		case *pql.MatrixSelector:
+			vectorSelector := e.VectorSelector.(*pql.VectorSelector)
+			if vectorSelector.Timestamp != nil || vectorSelector.StartOrEnd != 0 {
+				return true
+			}
+		case *pql.SubqueryExpr:
+			if e.Timestamp != nil || e.StartOrEnd != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (p *parseState) walk(node pql.Node) error {
 	if node == nil {
 		return nil
@@ -227,9 +302,33 @@ func (p *parseState) walk(node pql.Node) error {
 			parser.NewTransformFromOperation(operation, p.transformLen()),
 		)
 
-		return p.addLazyOffsetTransform(n.OriginalOffset)
+		if err := p.addLazyOffsetTransform(n.OriginalOffset); err != nil {
+			return err
+		}
+
+		if n.Timestamp != nil || n.StartOrEnd != 0 {
+			p.addStepInvariantTransform()
+		}
+
+		return nil
 
 	case *pql.Call:
+		if n.Func.Name == temporal.AbsentType {
+			// NB: absent_over_time is evaluated as the absent of the
+			// present_over_time of its argument.
+			present := &pql.Call{
+				Func:     pql.Functions[temporal.PresentType],
+				Args:     n.Args,
+				PosRange: n.PosRange,
+			}
+
+			return p.walk(&pql.Call{
+				Func:     pql.Functions[aggregation.AbsentType],
+				Args:     pql.Expressions{present},
+				PosRange: n.PosRange,
+			})
+		}
+
 		if n.Func.Name == scalar.VectorType {
 			if len(n.Args) != 1 {
 				return fmt.Errorf(
@@ -301,7 +400,10 @@ func (p *parseState) walk(node pql.Node) error {
 			} else if argType == pql.ValueTypeString {
 				stringValues = append(stringValues, expr.(*pql.StringLiteral).Val)
 			} else {
-				if e, ok := expr.(*pql.MatrixSelector); ok {
+				switch e := expr.(type) {
+				case *pql.MatrixSelector:
+					argValues = append(argValues, e.Range)
+				case *pql.SubqueryExpr:
 					argValues = append(argValues, e.Range)
 				}
 
@@ -339,7 +441,8 @@ func (p *parseState) walk(node pql.Node) error {
 		}
 
 		opTransform := parser.NewTransformFromOperation(op, p.transformLen())
-		if op.OpType() != scalar.TimeType {
+		// NB: time() and pi() are sources and have no parent.
+		if op.OpType() != scalar.TimeType && op.OpType() != scalar.ScalarType {
 			p.edges = append(p.edges, parser.Edge{
 				ParentID: p.lastTransformID(),
 				ChildID:  opTransform.ID,
@@ -347,6 +450,10 @@ func (p *parseState) walk(node pql.Node) error {
 		}
 
 		p.transforms = append(p.transforms, opTransform)
+		if hasPinnedRangeArg(n.Args) {
+			p.addStepInvariantTransform()
+		}
+
 		return nil
 
 	case *pql.BinaryExpr:
@@ -393,6 +500,9 @@ func (p *parseState) walk(node pql.Node) error {
 		// Evaluate inside of paren expressions
 		return p.walk(n.Expr)
 
+	case *pql.SubqueryExpr:
+		return p.addSubquery(n)
+
 	case *pql.UnaryExpr:
 		err := p.walk(n.Expr)
 		if err != nil {
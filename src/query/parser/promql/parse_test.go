@@ -22,6 +22,7 @@ package promql
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -35,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/query/functions/temporal"
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/parser"
+	xtime "github.com/m3db/m3/src/x/time"
 
 	pql "github.com/prometheus/prometheus/promql/parser"
 	"github.com/stretchr/testify/assert"
@@ -77,10 +79,66 @@ func TestDAGWithOffset(t *testing.T) {
 		"offset should be the child")
 }
 
-func TestInvalidOffset(t *testing.T) {
-	q := "up offset -2m"
-	_, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
+func TestNegativeOffset(t *testing.T) {
+	q := "up offset -61s"
+	p, err := Parse(q, time.Minute, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
 	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+	assert.Equal(t, transforms[1].Op.OpType(), lazy.OffsetType)
+	assert.Len(t, edges, 1)
+
+	fetch, ok := transforms[0].Op.(functions.FetchOp)
+	require.True(t, ok)
+	assert.Equal(t, -2*time.Minute, fetch.Offset)
+}
+
+func TestAtModifierParses(t *testing.T) {
+	tests := []struct {
+		q        string
+		at       models.AtModifier
+		expected []string
+	}{
+		{
+			q: "up @ 100",
+			at: models.AtModifier{
+				Type:      models.AtTimestamp,
+				Timestamp: xtime.FromSeconds(100),
+			},
+			expected: []string{functions.FetchType, functions.StepInvariantType},
+		},
+		{
+			q:  "up @ start() offset 1m",
+			at: models.AtModifier{Type: models.AtStart},
+			expected: []string{functions.FetchType, lazy.OffsetType,
+				functions.StepInvariantType},
+		},
+		{
+			q:  "rate(up[5m] @ end())",
+			at: models.AtModifier{Type: models.AtEnd},
+			expected: []string{functions.FetchType, temporal.RateType,
+				functions.StepInvariantType},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.q, func(t *testing.T) {
+			p, err := Parse(tt.q, time.Second, models.NewTagOptions(), NewParseOptions())
+			require.NoError(t, err)
+			transforms, edges, err := p.DAG()
+			require.NoError(t, err)
+			require.Len(t, transforms, len(tt.expected))
+			for i, transform := range transforms {
+				assert.Equal(t, tt.expected[i], transform.Op.OpType())
+			}
+
+			assert.Len(t, edges, len(tt.expected)-1)
+			fetch, ok := transforms[0].Op.(functions.FetchOp)
+			require.True(t, ok)
+			assert.Equal(t, tt.at, fetch.At)
+		})
+	}
 }
 
 func TestNegativeUnary(t *testing.T) {
@@ -149,6 +207,7 @@ var aggregateParseTests = []struct {
 	{"stddev(up)", aggregation.StandardDeviationType},
 	{"stdvar(up)", aggregation.StandardVarianceType},
 	{"count(up)", aggregation.CountType},
+	{"group(up)", aggregation.GroupType},
 
 	{"topk(3, up)", aggregation.TopKType},
 	{"bottomk(3, up)", aggregation.BottomKType},
@@ -221,6 +280,21 @@ var linearParseTests = []struct {
 	{"log2(up)", linear.Log2Type},
 	{"log10(up)", linear.Log10Type},
 	{"sqrt(up)", linear.SqrtType},
+	{"sgn(up)", linear.SgnType},
+	{"acos(up)", linear.AcosType},
+	{"acosh(up)", linear.AcoshType},
+	{"asin(up)", linear.AsinType},
+	{"asinh(up)", linear.AsinhType},
+	{"atan(up)", linear.AtanType},
+	{"atanh(up)", linear.AtanhType},
+	{"cos(up)", linear.CosType},
+	{"cosh(up)", linear.CoshType},
+	{"sin(up)", linear.SinType},
+	{"sinh(up)", linear.SinhType},
+	{"tan(up)", linear.TanType},
+	{"tanh(up)", linear.TanhType},
+	{"deg(up)", linear.DegType},
+	{"rad(up)", linear.RadType},
 	{"round(up)", linear.RoundType},
 	{"round(up, 10)", linear.RoundType},
 
@@ -235,6 +309,8 @@ var linearParseTests = []struct {
 	{"year(up)", linear.YearType},
 
 	{"histogram_quantile(1,up)", linear.HistogramQuantileType},
+	{"histogram_count(up)", linear.HistogramCountType},
+	{"histogram_sum(up)", linear.HistogramSumType},
 }
 
 func TestLinearParses(t *testing.T) {
@@ -293,6 +369,8 @@ var sortTests = []struct {
 }{
 	{"sort(up)", linear.SortType},
 	{"sort_desc(up)", linear.SortDescType},
+	{`sort_by_label(up, "job")`, linear.SortByLabelType},
+	{`sort_by_label_desc(up, "job", "instance")`, linear.SortByLabelDescType},
 }
 
 func TestSort(t *testing.T) {
@@ -347,6 +425,35 @@ func TestVector(t *testing.T) {
 	}
 }
 
+func TestPiParse(t *testing.T) {
+	p, err := Parse("pi()", time.Second, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 1)
+	op, ok := transforms[0].Op.(*scalar.ScalarOp)
+	require.True(t, ok)
+	assert.Equal(t, math.Pi, op.Value())
+	assert.Len(t, edges, 0)
+}
+
+func TestAbsentOverTimeParse(t *testing.T) {
+	q := "absent_over_time(up[5m])"
+	p, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+	assert.Equal(t, transforms[0].Op.OpType(), functions.FetchType)
+	assert.Equal(t, transforms[1].Op.OpType(), temporal.PresentType)
+	assert.Equal(t, transforms[2].Op.OpType(), aggregation.AbsentType)
+	require.Len(t, edges, 2)
+	assert.Equal(t, edges[0].ParentID, parser.NodeID("0"))
+	assert.Equal(t, edges[0].ChildID, parser.NodeID("1"))
+	assert.Equal(t, edges[1].ParentID, parser.NodeID("1"))
+	assert.Equal(t, edges[1].ChildID, parser.NodeID("2"))
+}
+
 func TestTimeTypeParse(t *testing.T) {
 	q := "time()"
 	p, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
@@ -480,6 +587,8 @@ var temporalParseTests = []struct {
 	{"holt_winters(up[5m], 0.2, 0.3)", temporal.HoltWintersType},
 	{"predict_linear(up[5m], 100)", temporal.PredictLinearType},
 	{"deriv(up[5m])", temporal.DerivType},
+	{"last_over_time(up[5m])", temporal.LastType},
+	{"present_over_time(up[5m])", temporal.PresentType},
 }
 
 func TestTemporalParses(t *testing.T) {
@@ -530,6 +639,68 @@ func TestTagParses(t *testing.T) {
 	}
 }
 
+func TestSubqueryParses(t *testing.T) {
+	q := "max_over_time(rate(http_requests_total[5m])[1h:1m] offset 2m)"
+	p, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+	assert.Equal(t, transforms[0].Op.OpType(), functions.SubqueryType)
+	assert.Equal(t, transforms[0].ID, parser.NodeID("0"))
+	assert.Equal(t, transforms[1].Op.OpType(), temporal.MaxType)
+	assert.Equal(t, transforms[1].ID, parser.NodeID("1"))
+	require.Len(t, edges, 1)
+	assert.Equal(t, edges[0].ParentID, parser.NodeID("0"))
+	assert.Equal(t, edges[0].ChildID, parser.NodeID("1"))
+
+	subquery, ok := transforms[0].Op.(functions.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, time.Hour, subquery.Range)
+	assert.Equal(t, time.Minute, subquery.Step)
+	assert.Equal(t, 2*time.Minute, subquery.Offset)
+
+	// NB: the inner expression is parsed into its own DAG.
+	require.Len(t, subquery.Nodes, 2)
+	assert.Equal(t, subquery.Nodes[0].Op.OpType(), functions.FetchType)
+	assert.Equal(t, subquery.Nodes[1].Op.OpType(), temporal.RateType)
+	require.Len(t, subquery.Edges, 1)
+}
+
+func TestSubqueryWithAtModifier(t *testing.T) {
+	q := "max_over_time(rate(up[5m])[1h:1m] @ 100)"
+	p, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, edges, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 3)
+	assert.Equal(t, transforms[0].Op.OpType(), functions.SubqueryType)
+	assert.Equal(t, transforms[1].Op.OpType(), temporal.MaxType)
+	assert.Equal(t, transforms[2].Op.OpType(), functions.StepInvariantType)
+	require.Len(t, edges, 2)
+
+	subquery, ok := transforms[0].Op.(functions.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, models.AtModifier{
+		Type:      models.AtTimestamp,
+		Timestamp: xtime.FromSeconds(100),
+	}, subquery.At)
+}
+
+func TestSubqueryDefaultsToQueryStep(t *testing.T) {
+	q := "sum_over_time(up[10m:])"
+	p, err := Parse(q, 15*time.Second, models.NewTagOptions(), NewParseOptions())
+	require.NoError(t, err)
+	transforms, _, err := p.DAG()
+	require.NoError(t, err)
+	require.Len(t, transforms, 2)
+
+	subquery, ok := transforms[0].Op.(functions.SubqueryOp)
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Minute, subquery.Range)
+	assert.Equal(t, 15*time.Second, subquery.Step)
+}
+
 func TestFailedTemporalParse(t *testing.T) {
 	q := "unknown_over_time(http_requests_total[5m])"
 	_, err := Parse(q, time.Second, models.NewTagOptions(), NewParseOptions())
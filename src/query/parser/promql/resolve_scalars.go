@@ -25,6 +25,7 @@ import (
 	"math"
 
 	"github.com/m3db/m3/src/query/functions/binary"
+	"github.com/m3db/m3/src/query/functions/scalar"
 
 	pql "github.com/prometheus/prometheus/promql/parser"
 )
@@ -106,6 +107,8 @@ func resolveScalarArgumentWithNesting(
 			}
 
 			return resolveScalarArgumentWithNesting(n.Args[0], nesting-1)
+		} else if n.Func.Name == scalar.PiType {
+			return math.Pi, nesting, nil
 		}
 
 		return 0, 0, nil
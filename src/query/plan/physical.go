@@ -69,6 +69,9 @@ func NewPhysicalPlan(
 			End:   params.ExclusiveEnd(),
 			Now:   params.Now,
 			Step:  params.Step,
+
+			QueryStart: params.Start,
+			QueryEnd:   params.End,
 		},
 		Debug:            params.Debug,
 		BlockType:        params.BlockType,
@@ -117,6 +120,7 @@ func (p PhysicalPlan) shiftTime() PhysicalPlan {
 	alignedShift := startShift + extraShift
 
 	p.TimeSpec.Start = p.TimeSpec.Start.Add(-1 * alignedShift)
+	p.TimeSpec.Shift = alignedShift
 
 	return p
 }
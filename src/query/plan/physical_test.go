@@ -136,6 +136,7 @@ func TestShiftTime(t *testing.T) {
 			p, err := NewPhysicalPlan(lp, params)
 			require.NoError(t, err)
 			assert.Equal(t, tt.wantShiftBy.String(), params.Start.Sub(p.TimeSpec.Start).String(), "start time shifted by")
+			assert.Equal(t, tt.wantShiftBy, p.TimeSpec.Shift)
 		})
 	}
 }
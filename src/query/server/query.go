@@ -38,12 +38,15 @@ import (
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/downsample"
 	"github.com/m3db/m3/src/cmd/services/m3coordinator/ingest"
 	ingestcarbon "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/carbon"
+	ingestopentsdb "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/opentsdb"
+	ingestotlp "github.com/m3db/m3/src/cmd/services/m3coordinator/ingest/otlp"
 	dbconfig "github.com/m3db/m3/src/cmd/services/m3dbnode/config"
 	"github.com/m3db/m3/src/cmd/services/m3query/config"
 	"github.com/m3db/m3/src/dbnode/client"
 	"github.com/m3db/m3/src/query/api/v1/handler/prometheus/handleroptions"
 	"github.com/m3db/m3/src/query/api/v1/httpd"
 	"github.com/m3db/m3/src/query/api/v1/options"
+	"github.com/m3db/m3/src/query/cache"
 	m3dbcluster "github.com/m3db/m3/src/query/cluster/m3db"
 	"github.com/m3db/m3/src/query/executor"
 	graphite "github.com/m3db/m3/src/query/graphite/storage"
@@ -53,9 +56,11 @@ import (
 	"github.com/m3db/m3/src/query/pools"
 	tsdbremote "github.com/m3db/m3/src/query/remote"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/exemplar"
 	"github.com/m3db/m3/src/query/storage/fanout"
 	"github.com/m3db/m3/src/query/storage/m3"
 	queryconsolidators "github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/query/storage/metricmetadata"
 	"github.com/m3db/m3/src/query/storage/remote"
 	"github.com/m3db/m3/src/query/stores/m3db"
 	tsdb "github.com/m3db/m3/src/query/ts/m3db"
@@ -358,7 +363,8 @@ func Run(runOpts RunOptions) {
 		SetConsolidationFunc(consolidators.TakeLast).
 		SetReadWorkerPool(readWorkerPool).
 		SetWriteWorkerPool(writeWorkerPool).
-		SetSeriesConsolidationMatchOptions(matchOptions)
+		SetSeriesConsolidationMatchOptions(matchOptions).
+		SetExpandNativeHistograms(cfg.Query.NativeHistograms.Enabled)
 
 	if runOpts.ApplyCustomTSDBOptions != nil {
 		tsdbOpts = runOpts.ApplyCustomTSDBOptions(tsdbOpts)
@@ -455,6 +461,13 @@ func Run(runOpts RunOptions) {
 			SetParseOptions(engineOpts.ParseOptions().SetParseFn(fn))
 	}
 
+	if splitCfg := cfg.Query.Split; splitCfg.Interval > 0 {
+		engineOpts = engineOpts.SetSplitInterval(splitCfg.Interval)
+		if splitCfg.Concurrency > 0 {
+			engineOpts = engineOpts.SetSplitConcurrency(splitCfg.Concurrency)
+		}
+	}
+
 	engine := executor.NewEngine(engineOpts)
 	downsamplerAndWriter, err := newDownsamplerAndWriter(
 		backendStorage,
@@ -557,6 +570,44 @@ func Run(runOpts RunOptions) {
 		logger.Fatal("unable to set up handler options", zap.Error(err))
 	}
 
+	if resultsCacheCfg := cfg.Query.ResultsCache; resultsCacheCfg.Enabled {
+		handlerOptions = handlerOptions.SetResultsCache(
+			cache.NewLRUResultsCache(cache.LRUResultsCacheOptions{
+				MaxEntries: resultsCacheCfg.MaxEntriesOrDefault(),
+				TTL:        resultsCacheCfg.TTLOrDefault(),
+				Metrics:    instrumentOptions.MetricsScope().SubScope("results-cache"),
+			}))
+	}
+
+	metricMetadataStoreOpts := metricmetadata.StoreOptions{
+		InstrumentOptions: instrumentOptions.SetMetricsScope(
+			instrumentOptions.MetricsScope().SubScope("metric-metadata")),
+	}
+	if clusterClient != nil {
+		// The cluster client may be initialized asynchronously, in which case
+		// the store retries acquiring the KV store until it is available.
+		metricMetadataStoreOpts.KVStoreFn = clusterClient.KV
+	}
+	metricMetadataStore := metricmetadata.NewStore(metricMetadataStoreOpts)
+	defer func() {
+		if err := metricMetadataStore.Close(); err != nil {
+			logger.Error("error closing metric metadata store", zap.Error(err))
+		}
+	}()
+	handlerOptions = handlerOptions.SetMetricMetadataStore(metricMetadataStore)
+
+	if exemplarsCfg := cfg.Exemplars; exemplarsCfg.Enabled {
+		handlerOptions = handlerOptions.SetExemplarStore(
+			exemplar.NewStore(exemplar.StoreOptions{
+				Clusters:    m3dbClusters,
+				Namespace:   exemplarsCfg.Namespace,
+				SeriesLimit: exemplarsCfg.SeriesLimit,
+				TagOptions:  tagOptions,
+				InstrumentOptions: instrumentOptions.SetMetricsScope(
+					instrumentOptions.MetricsScope().SubScope("exemplars")),
+			}))
+	}
+
 	if fn := runOpts.CustomHandlerOptions.OptionTransformFn; fn != nil {
 		handlerOptions = fn(handlerOptions)
 	}
@@ -634,10 +685,23 @@ func Run(runOpts RunOptions) {
 	}
 
 	if cfg.Carbon != nil && cfg.Carbon.Ingester != nil {
-		server := startCarbonIngestion(*cfg.Carbon.Ingester, listenerOpts,
+		cleanup := startCarbonIngestion(*cfg.Carbon.Ingester, listenerOpts,
 			instrumentOptions, logger, m3dbClusters, clusterNamespacesWatcher,
 			downsamplerAndWriter)
-		defer server.Close()
+		defer cleanup()
+	}
+
+	if cfg.OpenTSDB != nil && cfg.OpenTSDB.ListenAddress != "" {
+		cleanup := startOpenTSDBIngestion(*cfg.OpenTSDB, listenerOpts,
+			instrumentOptions, tagOptions, logger, downsamplerAndWriter)
+		defer cleanup()
+	}
+
+	if cfg.OTLP != nil && cfg.OTLP.GRPCListenAddress != "" {
+		storeMetricsType := cfg.StoreMetricsType != nil && *cfg.StoreMetricsType
+		cleanup := startOTLPIngestion(*cfg.OTLP, instrumentOptions, tagOptions,
+			storeMetricsType, logger, downsamplerAndWriter)
+		defer cleanup()
 	}
 
 	// Wait for process interrupt.
@@ -1145,7 +1209,7 @@ func startCarbonIngestion(
 	m3dbClusters m3.Clusters,
 	clusterNamespacesWatcher m3.ClusterNamespacesWatcher,
 	downsamplerAndWriter ingest.DownsamplerAndWriter,
-) xserver.Server {
+) cleanupFn {
 	logger.Info("carbon ingestion enabled, configuring ingester")
 
 	// Setup worker pool.
@@ -1212,7 +1276,128 @@ func startCarbonIngestion(
 
 	logger.Info("started carbon ingestion server", zap.String("listenAddress", carbonListenAddress))
 
-	return carbonServer
+	closers := []func(){carbonServer.Close}
+	if pickleListenAddress := ingesterCfg.PickleListenAddress; pickleListenAddress != "" {
+		pickleServer := xserver.NewServer(pickleListenAddress, ingester.PickleHandler(), serverOpts)
+
+		logger.Info("starting carbon pickle ingestion server", zap.String("listenAddress", pickleListenAddress))
+		if err := pickleServer.ListenAndServe(); err != nil {
+			logger.Fatal("unable to start carbon pickle ingestion server at listen address",
+				zap.String("listenAddress", pickleListenAddress), zap.Error(err))
+		}
+
+		logger.Info("started carbon pickle ingestion server", zap.String("listenAddress", pickleListenAddress))
+		closers = append(closers, pickleServer.Close)
+	}
+
+	if udpListenAddress := ingesterCfg.UDPListenAddress; udpListenAddress != "" {
+		conn, err := net.ListenPacket("udp", udpListenAddress)
+		if err != nil {
+			logger.Fatal("unable to start carbon UDP ingestion server at listen address",
+				zap.String("listenAddress", udpListenAddress), zap.Error(err))
+		}
+
+		go func() {
+			// Reading only fails once the connection is closed on shutdown.
+			err := ingester.ServePacketConn(conn)
+			logger.Info("stopped carbon UDP ingestion server",
+				zap.String("listenAddress", udpListenAddress), zap.Error(err))
+		}()
+
+		logger.Info("started carbon UDP ingestion server", zap.String("listenAddress", udpListenAddress))
+		closers = append(closers, func() { conn.Close() })
+	}
+
+	return func() error {
+		for _, closer := range closers {
+			closer()
+		}
+		return nil
+	}
+}
+
+func startOpenTSDBIngestion(
+	openTSDBCfg config.OpenTSDBConfiguration,
+	listenerOpts xnet.ListenerOptions,
+	iOpts instrument.Options,
+	tagOptions models.TagOptions,
+	logger *zap.Logger,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+) cleanupFn {
+	logger.Info("opentsdb telnet ingestion enabled, configuring ingester")
+
+	openTSDBIOpts := iOpts.SetMetricsScope(
+		iOpts.MetricsScope().SubScope("ingest-opentsdb"))
+	ingester, err := ingestopentsdb.NewIngester(downsamplerAndWriter,
+		ingestopentsdb.Options{
+			InstrumentOptions: openTSDBIOpts,
+			TagOptions:        tagOptions,
+		})
+	if err != nil {
+		logger.Fatal("unable to create opentsdb ingester", zap.Error(err))
+	}
+
+	var (
+		serverOpts = xserver.NewOptions().
+				SetInstrumentOptions(openTSDBIOpts).
+				SetListenerOptions(listenerOpts)
+		listenAddress = openTSDBCfg.ListenAddress
+		server        = xserver.NewServer(listenAddress, ingester, serverOpts)
+	)
+
+	logger.Info("starting opentsdb ingestion server", zap.String("listenAddress", listenAddress))
+	if err := server.ListenAndServe(); err != nil {
+		logger.Fatal("unable to start opentsdb ingestion server at listen address",
+			zap.String("listenAddress", listenAddress), zap.Error(err))
+	}
+
+	logger.Info("started opentsdb ingestion server", zap.String("listenAddress", listenAddress))
+
+	return func() error {
+		server.Close()
+		return nil
+	}
+}
+
+func startOTLPIngestion(
+	otlpCfg config.OTLPConfiguration,
+	iOpts instrument.Options,
+	tagOptions models.TagOptions,
+	storeMetricsType bool,
+	logger *zap.Logger,
+	downsamplerAndWriter ingest.DownsamplerAndWriter,
+) cleanupFn {
+	logger.Info("otlp grpc ingestion enabled, configuring server")
+
+	server, err := ingestotlp.NewGRPCServer(downsamplerAndWriter, ingestotlp.Options{
+		InstrumentOptions: iOpts.SetMetricsScope(
+			iOpts.MetricsScope().SubScope("ingest-otlp")),
+		TagOptions:       tagOptions,
+		StoreMetricsType: storeMetricsType,
+	})
+	if err != nil {
+		logger.Fatal("unable to create otlp grpc server", zap.Error(err))
+	}
+
+	listenAddress := otlpCfg.GRPCListenAddress
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		logger.Fatal("unable to start otlp grpc server at listen address",
+			zap.String("listenAddress", listenAddress), zap.Error(err))
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			logger.Error("error from serving otlp grpc server", zap.Error(err))
+		}
+	}()
+
+	logger.Info("started otlp grpc server", zap.String("listenAddress", listenAddress))
+
+	return func() error {
+		server.GracefulStop()
+		return nil
+	}
 }
 
 func newDownsamplerAndWriter(
@@ -174,6 +174,7 @@ func TestMultiProcessSetsProcessLabel(t *testing.T) {
 	require.NoError(t, err)
 	kvClient := kv.NewMockStore(ctrl)
 	kvClient.EXPECT().Watch(gomock.Any()).Return(rulesNamespacesWatch, nil).AnyTimes()
+	kvClient.EXPECT().Get(gomock.Any()).Return(nil, kv.ErrNotFound).AnyTimes()
 	clusterClient := clusterclient.NewMockClient(ctrl)
 	clusterClient.EXPECT().KV().Return(kvClient, nil).AnyTimes()
 	clusterClientCh := make(chan clusterclient.Client, 1)
@@ -329,6 +330,7 @@ func testWrite(t *testing.T, cfg config.Configuration, ctrl *gomock.Controller)
 	require.NoError(t, err)
 	kvClient := kv.NewMockStore(ctrl)
 	kvClient.EXPECT().Watch(gomock.Any()).Return(rulesNamespacesWatch, nil).AnyTimes()
+	kvClient.EXPECT().Get(gomock.Any()).Return(nil, kv.ErrNotFound).AnyTimes()
 	clusterClient := clusterclient.NewMockClient(ctrl)
 	clusterClient.EXPECT().KV().Return(kvClient, nil).AnyTimes()
 	clusterClientCh := make(chan clusterclient.Client, 1)
@@ -426,6 +428,7 @@ func testIngest(t *testing.T, cfg config.Configuration, ctrl *gomock.Controller)
 	require.NoError(t, err)
 	kvClient := kv.NewMockStore(ctrl)
 	kvClient.EXPECT().Watch(gomock.Any()).Return(rulesNamespacesWatch, nil).AnyTimes()
+	kvClient.EXPECT().Get(gomock.Any()).Return(nil, kv.ErrNotFound).AnyTimes()
 	clusterClient := clusterclient.NewMockClient(ctrl)
 	clusterClient.EXPECT().KV().Return(kvClient, nil).AnyTimes()
 	clusterClientCh := make(chan clusterclient.Client, 1)
@@ -26,6 +26,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
 	"github.com/m3db/m3/src/dbnode/generated/proto/annotation"
 	"github.com/m3db/m3/src/query/generated/proto/prompb"
 	"github.com/m3db/m3/src/query/models"
@@ -200,6 +201,125 @@ func PromSamplesToM3Datapoints(samples []prompb.Sample) ts.Datapoints {
 	return datapoints
 }
 
+// PromHistogramToM3Datapoint converts a Prometheus native histogram sample into
+// a datapoint holding its count of observations, along with an annotation
+// payload that carries the encoded histogram.
+func PromHistogramToM3Datapoint(h prompb.Histogram) (ts.Datapoint, []byte, error) {
+	nativeHistogram := PromHistogramToNativeHistogram(h)
+	encoded, err := histogram.Encode(nativeHistogram)
+	if err != nil {
+		return ts.Datapoint{}, nil, err
+	}
+
+	payload := annotation.Payload{
+		MetricType:        annotation.MetricType_HISTOGRAM,
+		HandleValueResets: true,
+		NativeHistogram:   encoded,
+	}
+	if h.ResetHint == prompb.Histogram_GAUGE {
+		payload.MetricType = annotation.MetricType_GAUGE_HISTOGRAM
+		payload.HandleValueResets = false
+	}
+
+	ant, err := payload.Marshal()
+	if err != nil {
+		return ts.Datapoint{}, nil, err
+	}
+
+	return ts.Datapoint{
+		Timestamp: promTimestampToUnixNanos(h.Timestamp),
+		Value:     nativeHistogram.Count,
+	}, ant, nil
+}
+
+// PromHistogramToNativeHistogram converts a Prometheus native histogram into
+// its stored representation, which holds absolute bucket counts whether the
+// histogram has integer or float counts.
+func PromHistogramToNativeHistogram(h prompb.Histogram) histogram.Histogram {
+	result := histogram.Histogram{
+		Schema:        h.Schema,
+		ZeroThreshold: h.ZeroThreshold,
+		Sum:           h.Sum,
+		PositiveSpans: promBucketSpansToSpans(h.PositiveSpans),
+		NegativeSpans: promBucketSpansToSpans(h.NegativeSpans),
+	}
+
+	isFloat := h.CountFloat != 0 || h.ZeroCountFloat != 0 ||
+		len(h.PositiveCounts) > 0 || len(h.NegativeCounts) > 0
+	if isFloat {
+		result.Count = h.CountFloat
+		result.ZeroCount = h.ZeroCountFloat
+		result.PositiveBuckets = h.PositiveCounts
+		result.NegativeBuckets = h.NegativeCounts
+		return result
+	}
+
+	result.Count = float64(h.CountInt)
+	result.ZeroCount = float64(h.ZeroCountInt)
+	result.PositiveBuckets = promBucketDeltasToCounts(h.PositiveDeltas)
+	result.NegativeBuckets = promBucketDeltasToCounts(h.NegativeDeltas)
+	return result
+}
+
+func promBucketSpansToSpans(spans []prompb.BucketSpan) []histogram.Span {
+	if len(spans) == 0 {
+		return nil
+	}
+
+	result := make([]histogram.Span, 0, len(spans))
+	for _, span := range spans {
+		result = append(result, histogram.Span{
+			Offset: span.Offset,
+			Length: span.Length,
+		})
+	}
+
+	return result
+}
+
+func promBucketDeltasToCounts(deltas []int64) []float64 {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	var (
+		counts = make([]float64, 0, len(deltas))
+		count  int64
+	)
+	for _, delta := range deltas {
+		count += delta
+		counts = append(counts, float64(count))
+	}
+
+	return counts
+}
+
+// AnnotationToNativeHistogram decodes the native histogram carried by a
+// datapoint annotation, returning false if the annotation carries none.
+func AnnotationToNativeHistogram(ant []byte) (histogram.Histogram, bool, error) {
+	if len(ant) == 0 {
+		return histogram.Histogram{}, false, nil
+	}
+
+	var payload annotation.Payload
+	if err := payload.Unmarshal(ant); err != nil {
+		// NB: annotations are not required to be payloads, so one that cannot
+		// be unmarshalled does not carry a native histogram.
+		return histogram.Histogram{}, false, nil
+	}
+
+	if len(payload.NativeHistogram) == 0 {
+		return histogram.Histogram{}, false, nil
+	}
+
+	h, err := histogram.Decode(payload.NativeHistogram)
+	if err != nil {
+		return histogram.Histogram{}, false, err
+	}
+
+	return h, true, nil
+}
+
 // PromReadQueryToM3 converts a prometheus read query to m3 read query
 func PromReadQueryToM3(query *prompb.Query) (*FetchQuery, error) {
 	tagMatchers, err := PromMatchersToM3(query.Matchers)
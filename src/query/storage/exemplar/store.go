@@ -0,0 +1,323 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exemplar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/ident"
+	"github.com/m3db/m3/src/x/instrument"
+	xsync "github.com/m3db/m3/src/x/sync"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/uber-go/tally"
+)
+
+const (
+	// DefaultNamespace is the default namespace exemplars are written to.
+	DefaultNamespace = "exemplars"
+	// DefaultSeriesLimit is the default maximum number of series exemplars
+	// are returned for by a query.
+	DefaultSeriesLimit = 10000
+	// DefaultWriteConcurrency is the default number of exemplars written to
+	// the database nodes concurrently.
+	DefaultWriteConcurrency = 64
+)
+
+var errClustersNotInitialized = errors.New(
+	"exemplar store clusters not yet initialized")
+
+type storeMetrics struct {
+	appended    tally.Counter
+	writeErrors tally.Counter
+	queried     tally.Counter
+}
+
+func newStoreMetrics(scope tally.Scope) storeMetrics {
+	return storeMetrics{
+		appended:    scope.Counter("appended"),
+		writeErrors: scope.Counter("write-errors"),
+		queried:     scope.Counter("queried"),
+	}
+}
+
+type store struct {
+	clusters    m3.Clusters
+	namespace   ident.ID
+	seriesLimit int
+	tagOptions  models.TagOptions
+	workerPool  xsync.WorkerPool
+	metrics     storeMetrics
+}
+
+// NewStore returns a new exemplar store which writes exemplars to a
+// dedicated namespace of the database nodes of the unaggregated namespace.
+// Each exemplar is written as a datapoint of its series with the exemplar
+// labels encoded in the datapoint annotation, so exemplars are kept for the
+// retention of the namespace.
+func NewStore(opts StoreOptions) Store {
+	if opts.Namespace == "" {
+		opts.Namespace = DefaultNamespace
+	}
+	if opts.SeriesLimit <= 0 {
+		opts.SeriesLimit = DefaultSeriesLimit
+	}
+	if opts.WriteConcurrency <= 0 {
+		opts.WriteConcurrency = DefaultWriteConcurrency
+	}
+	if opts.TagOptions == nil {
+		opts.TagOptions = models.NewTagOptions()
+	}
+	if opts.InstrumentOptions == nil {
+		opts.InstrumentOptions = instrument.NewOptions()
+	}
+
+	workerPool := xsync.NewWorkerPool(opts.WriteConcurrency)
+	workerPool.Init()
+
+	return &store{
+		clusters:    opts.Clusters,
+		namespace:   ident.StringID(opts.Namespace),
+		seriesLimit: opts.SeriesLimit,
+		tagOptions:  opts.TagOptions,
+		workerPool:  workerPool,
+		metrics:     newStoreMetrics(opts.InstrumentOptions.MetricsScope()),
+	}
+}
+
+func (s *store) clusterNamespace() (m3.ClusterNamespace, error) {
+	if s.clusters == nil {
+		return nil, errClustersNotInitialized
+	}
+
+	ns, ok := s.clusters.UnaggregatedClusterNamespace()
+	if !ok {
+		return nil, errClustersNotInitialized
+	}
+
+	return ns, nil
+}
+
+func (s *store) Append(ctx context.Context, series []SeriesExemplars) error {
+	ns, err := s.clusterNamespace()
+	if err != nil {
+		return err
+	}
+
+	var (
+		session  = ns.Session()
+		wg       sync.WaitGroup
+		errLock  sync.Mutex
+		multiErr xerrors.MultiError
+		appended int64
+	)
+	for _, se := range series {
+		id := ident.BytesID(se.Tags.ID())
+		for _, e := range se.Exemplars {
+			annotation, err := exemplarAnnotation(e)
+			if err != nil {
+				errLock.Lock()
+				multiErr = multiErr.Add(err)
+				errLock.Unlock()
+				continue
+			}
+
+			var (
+				tags = storage.TagsToIdentTagIterator(se.Tags)
+				e    = e
+			)
+			wg.Add(1)
+			s.workerPool.Go(func() {
+				defer wg.Done()
+
+				err := session.WriteTagged(s.namespace, id, tags, e.Timestamp,
+					e.Value, xtime.Millisecond, annotation)
+
+				errLock.Lock()
+				if err != nil {
+					multiErr = multiErr.Add(err)
+				} else {
+					appended++
+				}
+				errLock.Unlock()
+			})
+		}
+	}
+	wg.Wait()
+
+	s.metrics.appended.Inc(appended)
+	if err := multiErr.FinalError(); err != nil {
+		s.metrics.writeErrors.Inc(int64(multiErr.NumErrors()))
+		return err
+	}
+
+	return nil
+}
+
+// exemplarAnnotation encodes the exemplar as the annotation of its
+// datapoint. The whole exemplar is encoded rather than just its labels so
+// that every annotation differs from the previous one, since unchanged
+// annotations are not repeated in the encoded series.
+func exemplarAnnotation(e Exemplar) ([]byte, error) {
+	labels := make([]prompb.Label, 0, len(e.Labels))
+	for _, l := range e.Labels {
+		labels = append(labels, prompb.Label{Name: l.Name, Value: l.Value})
+	}
+
+	pe := prompb.Exemplar{
+		Labels:    labels,
+		Value:     e.Value,
+		Timestamp: storage.TimeToPromTimestamp(e.Timestamp),
+	}
+	return pe.Marshal()
+}
+
+func (s *store) Query(
+	ctx context.Context,
+	matchers []models.Matchers,
+	start, end xtime.UnixNano,
+) ([]SeriesExemplars, error) {
+	ns, err := s.clusterNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		session = ns.Session()
+		seen    = make(map[string]struct{})
+		result  []SeriesExemplars
+	)
+	for _, m := range matchers {
+		query, err := storage.FetchQueryToM3Query(&storage.FetchQuery{
+			TagMatchers: m,
+			Start:       start.ToTime(),
+			End:         end.ToTime(),
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		iters, _, err := session.FetchTagged(ctx, s.namespace, query,
+			index.QueryOptions{
+				StartInclusive: start,
+				// NB: the end of the range is inclusive.
+				EndExclusive: end + 1,
+				SeriesLimit:  s.seriesLimit,
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		series, err := s.seriesExemplars(iters.Iters(), seen)
+		iters.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, series...)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return string(result[i].Tags.ID()) < string(result[j].Tags.ID())
+	})
+
+	var numExemplars int
+	for _, series := range result {
+		numExemplars += len(series.Exemplars)
+	}
+	s.metrics.queried.Inc(int64(numExemplars))
+
+	return result, nil
+}
+
+// seriesExemplars decodes the exemplars of the series not yet seen, which
+// may already have matched a previous matcher set.
+func (s *store) seriesExemplars(
+	iters []encoding.SeriesIterator,
+	seen map[string]struct{},
+) ([]SeriesExemplars, error) {
+	result := make([]SeriesExemplars, 0, len(iters))
+	for _, iter := range iters {
+		id := iter.ID().String()
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		tags, err := consolidators.FromIdentTagIteratorToTags(iter.Tags(),
+			s.tagOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		var exemplars []Exemplar
+		for iter.Next() {
+			dp, _, annotation := iter.Current()
+			e, err := exemplarFromAnnotation(annotation)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode exemplar of %s: %w", id, err)
+			}
+
+			e.Value = dp.Value
+			e.Timestamp = dp.TimestampNanos
+			exemplars = append(exemplars, e)
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+		if len(exemplars) == 0 {
+			continue
+		}
+
+		result = append(result, SeriesExemplars{
+			Tags:      tags.Clone(),
+			Exemplars: exemplars,
+		})
+	}
+
+	return result, nil
+}
+
+func exemplarFromAnnotation(annotation []byte) (Exemplar, error) {
+	var pe prompb.Exemplar
+	if err := pe.Unmarshal(annotation); err != nil {
+		return Exemplar{}, err
+	}
+
+	labels := make([]models.Tag, 0, len(pe.Labels))
+	for _, l := range pe.Labels {
+		labels = append(labels, models.Tag{Name: l.Name, Value: l.Value})
+	}
+
+	return Exemplar{Labels: labels}, nil
+}
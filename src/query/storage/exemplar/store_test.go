@@ -0,0 +1,233 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package exemplar
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/client"
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/storage/index"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/ident"
+	xtest "github.com/m3db/m3/src/x/test"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTagOpts = models.NewTagOptions().SetIDSchemeType(models.TypeQuoted)
+
+func testTags(name, job string) models.Tags {
+	return models.NewTags(2, testTagOpts).SetName([]byte(name)).
+		AddTag(models.Tag{Name: []byte("job"), Value: []byte(job)})
+}
+
+func testExemplar(traceID string, value float64, ts xtime.UnixNano) Exemplar {
+	return Exemplar{
+		Labels:    []models.Tag{{Name: []byte("trace_id"), Value: []byte(traceID)}},
+		Value:     value,
+		Timestamp: ts,
+	}
+}
+
+func testMatchers(t *testing.T, name string) []models.Matchers {
+	m, err := models.NewMatcher(models.MatchEqual, testTagOpts.MetricName(),
+		[]byte(name))
+	require.NoError(t, err)
+	return []models.Matchers{{m}}
+}
+
+func newTestStore(t *testing.T, session client.Session) Store {
+	clusters, err := m3.NewClusters(m3.UnaggregatedClusterNamespaceDefinition{
+		NamespaceID: ident.StringID("metrics"),
+		Session:     session,
+		Retention:   24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	return NewStore(StoreOptions{
+		Clusters:   clusters,
+		TagOptions: testTagOpts,
+	})
+}
+
+func testAnnotation(t *testing.T, e Exemplar) []byte {
+	annotation, err := exemplarAnnotation(e)
+	require.NoError(t, err)
+	return annotation
+}
+
+func TestStoreAppend(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		session = client.NewMockSession(ctrl)
+		store   = newTestStore(t, session)
+		tags    = testTags("latency", "api")
+		now     = xtime.Now().Truncate(time.Millisecond)
+		first   = testExemplar("a", 1, now)
+		second  = testExemplar("b", 2, now.Add(time.Second))
+	)
+	for _, e := range []Exemplar{first, second} {
+		session.EXPECT().WriteTagged(ident.NewIDMatcher(DefaultNamespace),
+			ident.NewIDMatcher(string(tags.ID())), gomock.Any(), e.Timestamp,
+			e.Value, xtime.Millisecond, testAnnotation(t, e))
+	}
+
+	require.NoError(t, store.Append(context.Background(), []SeriesExemplars{
+		{Tags: tags, Exemplars: []Exemplar{first, second}},
+	}))
+}
+
+func TestStoreAppendError(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		session = client.NewMockSession(ctrl)
+		store   = newTestStore(t, session)
+		now     = xtime.Now().Truncate(time.Millisecond)
+	)
+	session.EXPECT().
+		WriteTagged(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(errors.New("write error"))
+
+	err := store.Append(context.Background(), []SeriesExemplars{{
+		Tags:      testTags("latency", "api"),
+		Exemplars: []Exemplar{testExemplar("a", 1, now)},
+	}})
+	require.Error(t, err)
+}
+
+func TestStoreClustersNotInitialized(t *testing.T) {
+	store := NewStore(StoreOptions{})
+
+	err := store.Append(context.Background(), []SeriesExemplars{{
+		Tags: testTags("latency", "api"),
+	}})
+	assert.Equal(t, errClustersNotInitialized, err)
+
+	_, err = store.Query(context.Background(), nil, 0, 0)
+	assert.Equal(t, errClustersNotInitialized, err)
+}
+
+func newTestSeriesIterator(
+	t *testing.T,
+	ctrl *gomock.Controller,
+	tags models.Tags,
+	exemplars []Exemplar,
+) encoding.SeriesIterator {
+	identTags := make([]ident.Tag, 0, tags.Len())
+	for _, tag := range tags.Tags {
+		identTags = append(identTags,
+			ident.StringTag(string(tag.Name), string(tag.Value)))
+	}
+
+	iter := encoding.NewMockSeriesIterator(ctrl)
+	iter.EXPECT().ID().Return(ident.BytesID(tags.ID())).AnyTimes()
+	iter.EXPECT().Tags().
+		Return(ident.NewTagsIterator(ident.NewTags(identTags...))).AnyTimes()
+	for _, e := range exemplars {
+		iter.EXPECT().Next().Return(true)
+		iter.EXPECT().Current().Return(
+			ts.Datapoint{TimestampNanos: e.Timestamp, Value: e.Value},
+			xtime.Millisecond, ts.Annotation(testAnnotation(t, e)))
+	}
+	iter.EXPECT().Next().Return(false).MaxTimes(1)
+	iter.EXPECT().Err().Return(nil).MaxTimes(1)
+	iter.EXPECT().Close()
+	return iter
+}
+
+func TestStoreQuery(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		session = client.NewMockSession(ctrl)
+		store   = newTestStore(t, session)
+		start   = xtime.Now().Truncate(time.Hour)
+		end     = start.Add(time.Hour)
+		api     = testTags("latency", "api")
+		web     = testTags("latency", "web")
+		apiEx   = []Exemplar{
+			testExemplar("a", 1, start),
+			testExemplar("b", 2, start.Add(time.Minute)),
+		}
+		webEx = []Exemplar{testExemplar("c", 3, start.Add(time.Second))}
+	)
+	iters := encoding.NewSeriesIterators([]encoding.SeriesIterator{
+		newTestSeriesIterator(t, ctrl, web, webEx),
+		newTestSeriesIterator(t, ctrl, api, apiEx),
+	}, nil)
+	session.EXPECT().
+		FetchTagged(gomock.Any(), ident.NewIDMatcher(DefaultNamespace),
+			gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ ident.ID,
+			_ index.Query,
+			opts index.QueryOptions,
+		) (encoding.SeriesIterators, client.FetchResponseMetadata, error) {
+			assert.Equal(t, start, opts.StartInclusive)
+			assert.Equal(t, end+1, opts.EndExclusive)
+			assert.Equal(t, DefaultSeriesLimit, opts.SeriesLimit)
+			return iters, client.FetchResponseMetadata{}, nil
+		})
+
+	result, err := store.Query(context.Background(),
+		testMatchers(t, "latency"), start, end)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result))
+
+	assert.Equal(t, api.ID(), result[0].Tags.ID())
+	assert.Equal(t, apiEx, result[0].Exemplars)
+	assert.Equal(t, web.ID(), result[1].Tags.ID())
+	assert.Equal(t, webEx, result[1].Exemplars)
+}
+
+func TestExemplarAnnotationRoundTrip(t *testing.T) {
+	var (
+		now = xtime.Now().Truncate(time.Millisecond)
+		e   = testExemplar("a", 1, now)
+	)
+	annotation := testAnnotation(t, e)
+
+	var pe prompb.Exemplar
+	require.NoError(t, pe.Unmarshal(annotation))
+	assert.Equal(t, float64(1), pe.Value)
+	assert.Equal(t, now, xtime.UnixNano(pe.Timestamp)*xtime.UnixNano(time.Millisecond))
+
+	decoded, err := exemplarFromAnnotation(annotation)
+	require.NoError(t, err)
+	assert.Equal(t, e.Labels, decoded.Labels)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package exemplar provides storage of Prometheus exemplars.
+package exemplar
+
+import (
+	"context"
+
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage/m3"
+	"github.com/m3db/m3/src/x/instrument"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+// Store stores the exemplars of series as received from Prometheus remote
+// write requests. Exemplars are written to a dedicated namespace of the
+// database nodes, which bounds how long they are kept by its retention.
+type Store interface {
+	// Append writes the exemplars of the given series.
+	Append(ctx context.Context, series []SeriesExemplars) error
+	// Query returns the exemplars within the given time range of the series
+	// that match all matchers of any of the given matcher sets.
+	Query(
+		ctx context.Context,
+		matchers []models.Matchers,
+		start, end xtime.UnixNano,
+	) ([]SeriesExemplars, error)
+}
+
+// Exemplar is an exemplar of a series.
+type Exemplar struct {
+	// Labels are the labels of the exemplar, such as a trace ID.
+	Labels []models.Tag
+	// Value is the value of the exemplar.
+	Value float64
+	// Timestamp is the timestamp of the exemplar.
+	Timestamp xtime.UnixNano
+}
+
+// SeriesExemplars are the exemplars of a series in ascending time order.
+type SeriesExemplars struct {
+	// Tags are the tags of the series.
+	Tags models.Tags
+	// Exemplars are the exemplars of the series.
+	Exemplars []Exemplar
+}
+
+// StoreOptions are the options for an exemplar store.
+type StoreOptions struct {
+	// Clusters are the clusters whose unaggregated namespace session is used
+	// to write and read exemplars.
+	Clusters m3.Clusters
+	// Namespace is the database node namespace exemplars are written to.
+	Namespace string
+	// SeriesLimit is the maximum number of series exemplars are returned for
+	// by each matcher set of a query.
+	SeriesLimit int
+	// WriteConcurrency is the number of exemplars written concurrently.
+	WriteConcurrency int
+	// TagOptions are the tag options used to decode series tags.
+	TagOptions models.TagOptions
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
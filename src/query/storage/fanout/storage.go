@@ -32,6 +32,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	"github.com/m3db/m3/src/query/util/execution"
 	xerrors "github.com/m3db/m3/src/x/errors"
@@ -153,6 +154,29 @@ func (s *fanoutStorage) FetchProm(
 	}, nil
 }
 
+// FetchCompressed fetches compressed series iterators when the query resolves
+// to a single store which itself supports compressed fetches; results from
+// several stores can not be merged without decompressing them, so any other
+// case returns errors.ErrNotImplemented and callers should use FetchProm.
+func (s *fanoutStorage) FetchCompressed(
+	ctx context.Context,
+	query *storage.FetchQuery,
+	options *storage.FetchOptions,
+) (consolidators.SeriesFetchResult, m3.Cleanup, error) {
+	stores := filterStores(s.stores, s.fetchFilter, query)
+	if len(stores) == 1 {
+		if querier, ok := stores[0].(m3.Querier); ok {
+			return querier.FetchCompressed(ctx, query, options)
+		}
+	}
+
+	return consolidators.SeriesFetchResult{
+		Metadata: block.NewResultMetadata(),
+	}, noop, errors.ErrNotImplemented
+}
+
+func noop() error { return nil }
+
 func (s *fanoutStorage) FetchBlocks(
 	ctx context.Context,
 	query *storage.FetchQuery,
@@ -36,6 +36,7 @@ import (
 	"github.com/m3db/m3/src/query/models"
 	"github.com/m3db/m3/src/query/policy/filter"
 	"github.com/m3db/m3/src/query/storage"
+	m3storage "github.com/m3db/m3/src/query/storage/m3"
 	"github.com/m3db/m3/src/query/storage/m3/consolidators"
 	"github.com/m3db/m3/src/query/storage/m3/storagemetadata"
 	"github.com/m3db/m3/src/query/test/m3"
@@ -476,3 +477,42 @@ func TestFanoutFetchErrorContinues(t *testing.T) {
 	require.Equal(t, 1, len(labels))
 	assert.Equal(t, "ok", string(labels[0].GetName()))
 }
+
+func TestFanoutFetchCompressed(t *testing.T) {
+	ctrl := xtest.NewController(t)
+	defer ctrl.Finish()
+
+	filter := func(_ storage.Query, _ storage.Storage) bool { return true }
+	tFilter := func(_ storage.CompleteTagsQuery, _ storage.Storage) bool { return true }
+	newFanout := func(stores ...storage.Storage) *fanoutStorage {
+		return NewStorage(stores, filter, filter, tFilter,
+			models.NewTagOptions(), instrument.NewOptions()).(*fanoutStorage)
+	}
+
+	query := &storage.FetchQuery{}
+	opts := storage.NewFetchOptions()
+	meta := block.NewResultMetadata()
+	meta.Exhaustive = false
+	expected, err := consolidators.NewSeriesFetchResult(fakeIterator(t), nil, meta)
+	require.NoError(t, err)
+
+	m3Store := m3storage.NewMockStorage(ctrl)
+	m3Store.EXPECT().FetchCompressed(gomock.Any(), query, opts).
+		Return(expected, m3storage.Cleanup(func() error { return nil }), nil)
+
+	result, cleanup, err := newFanout(m3Store).FetchCompressed(context.TODO(), query, opts)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Count())
+	assert.False(t, result.Metadata.Exhaustive)
+	assert.NoError(t, cleanup())
+
+	// Stores which do not support compressed fetches, or several stores whose
+	// results would need merging, are not supported.
+	_, _, err = newFanout(storage.NewMockStorage(ctrl)).
+		FetchCompressed(context.TODO(), query, opts)
+	assert.Equal(t, errs.ErrNotImplemented, err)
+
+	_, _, err = newFanout(m3storage.NewMockStorage(ctrl), m3storage.NewMockStorage(ctrl)).
+		FetchCompressed(context.TODO(), query, opts)
+	assert.Equal(t, errs.ErrNotImplemented, err)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/histogram"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+)
+
+var nativeHistogramSumTagValue = []byte("true")
+
+type nativeHistogramSample struct {
+	timestamp xtime.UnixNano
+	unit      xtime.Unit
+	histogram histogram.Histogram
+}
+
+// expandNativeHistograms replaces every series of the result that holds
+// native histogram samples with the equivalent classic histogram series: one
+// series per bucket upper bound, tagged with the bucket tag and holding the
+// cumulative count of observations up to that bound, and one series tagged
+// with models.NativeHistogramSumTagName holding the sum of observations.
+// A series is treated as a native histogram series when its first datapoint
+// in range is a native histogram sample; every other series is passed through
+// without being decoded past that first datapoint.
+func expandNativeHistograms(
+	result consolidators.SeriesFetchResult,
+	tagOpts models.TagOptions,
+) (consolidators.SeriesFetchResult, error) {
+	var (
+		count = result.Count()
+		iters = make([]encoding.SeriesIterator, 0, count)
+		tags  = make([]*models.Tags, 0, count)
+	)
+
+	closeIters := func() {
+		for _, iter := range iters {
+			iter.Close()
+		}
+	}
+
+	for i := 0; i < count; i++ {
+		iter, seriesTags, err := result.IterTagsAtIndex(i, tagOpts)
+		if err != nil {
+			closeIters()
+			return consolidators.SeriesFetchResult{}, err
+		}
+
+		peeked := &peekedSeriesIterator{SeriesIterator: iter}
+		isHistogram, err := peeked.peek()
+		if err != nil {
+			closeIters()
+			return consolidators.SeriesFetchResult{}, err
+		}
+
+		if !isHistogram {
+			iters = append(iters, peeked)
+			tags = append(tags, &seriesTags)
+			continue
+		}
+
+		// NB: float samples interleaved with native histogram samples can not
+		// be represented by the classic histogram series and are dropped.
+		var samples []nativeHistogramSample
+		for ok := true; ok; ok = iter.Next() {
+			dp, unit, ant := iter.Current()
+			h, isHistogram, err := storage.AnnotationToNativeHistogram(ant)
+			if err != nil {
+				closeIters()
+				return consolidators.SeriesFetchResult{}, err
+			}
+
+			if isHistogram {
+				samples = append(samples, nativeHistogramSample{
+					timestamp: dp.TimestampNanos,
+					unit:      unit,
+					histogram: h,
+				})
+			}
+		}
+
+		if err := iter.Err(); err != nil {
+			closeIters()
+			return consolidators.SeriesFetchResult{}, err
+		}
+
+		namespace := iter.Namespace().Bytes()
+		bounds := make([][]float64, 0, len(samples))
+		for _, sample := range samples {
+			bounds = append(bounds, sample.histogram.UpperBounds())
+		}
+
+		upperBounds := append(histogram.MergeUpperBounds(bounds...), math.Inf(1))
+		for _, upperBound := range upperBounds {
+			bucketTags := seriesTags.Clone().SetBucket(
+				[]byte(strconv.FormatFloat(upperBound, 'f', -1, 64)))
+			seriesIter, err := newNativeHistogramSeriesIterator(bucketTags,
+				namespace, iter, samples, func(h histogram.Histogram) float64 {
+					return h.CumulativeCount(upperBound)
+				})
+			if err != nil {
+				closeIters()
+				return consolidators.SeriesFetchResult{}, err
+			}
+
+			iters = append(iters, seriesIter)
+			tags = append(tags, &bucketTags)
+		}
+
+		sumTags := seriesTags.Clone().AddTag(models.Tag{
+			Name:  models.NativeHistogramSumTagName,
+			Value: nativeHistogramSumTagValue,
+		})
+		seriesIter, err := newNativeHistogramSeriesIterator(sumTags,
+			namespace, iter, samples, func(h histogram.Histogram) float64 {
+				return h.Sum
+			})
+		if err != nil {
+			closeIters()
+			return consolidators.SeriesFetchResult{}, err
+		}
+
+		iters = append(iters, seriesIter)
+		tags = append(tags, &sumTags)
+	}
+
+	return consolidators.NewSeriesFetchResult(
+		encoding.NewSeriesIterators(iters, nil), tags, result.Metadata)
+}
+
+// peekedSeriesIterator passes through a fetched series iterator after its
+// first datapoint has been inspected. The fetch result accumulator owns the
+// underlying iterator, so closing it is left to the accumulator.
+type peekedSeriesIterator struct {
+	encoding.SeriesIterator
+
+	pending bool
+}
+
+// peek advances the underlying iterator to its first datapoint and returns
+// whether that datapoint is a native histogram sample.
+func (it *peekedSeriesIterator) peek() (bool, error) {
+	if !it.SeriesIterator.Next() {
+		return false, nil
+	}
+
+	it.pending = true
+	_, _, ant := it.SeriesIterator.Current()
+	_, ok, err := storage.AnnotationToNativeHistogram(ant)
+	return ok, err
+}
+
+func (it *peekedSeriesIterator) Next() bool {
+	if it.pending {
+		it.pending = false
+		return true
+	}
+
+	return it.SeriesIterator.Next()
+}
+
+func (it *peekedSeriesIterator) Close() {}
+
+func newNativeHistogramSeriesIterator(
+	tags models.Tags,
+	namespace []byte,
+	iter encoding.SeriesIterator,
+	samples []nativeHistogramSample,
+	valueFn func(h histogram.Histogram) float64,
+) (encoding.SeriesIterator, error) {
+	var (
+		datapoints = make([]ts.Datapoint, 0, len(samples))
+		units      = make([]xtime.Unit, 0, len(samples))
+	)
+
+	for _, sample := range samples {
+		datapoints = append(datapoints, ts.Datapoint{
+			TimestampNanos: sample.timestamp,
+			Value:          valueFn(sample.histogram),
+		})
+		units = append(units, sample.unit)
+	}
+
+	return newDatapointsSeriesIterator(tags.ID(), namespace, tags,
+		iter.Start(), iter.End(), datapoints, units)
+}
+
+func newDatapointsSeriesIterator(
+	id []byte,
+	namespace []byte,
+	tags models.Tags,
+	start xtime.UnixNano,
+	end xtime.UnixNano,
+	datapoints []ts.Datapoint,
+	units []xtime.Unit,
+) (encoding.SeriesIterator, error) {
+	opts := encoding.NewOptions()
+	encoder := m3tsz.NewEncoder(start, checked.NewBytes(nil, nil),
+		m3tsz.DefaultIntOptimizationEnabled, opts)
+	for i, dp := range datapoints {
+		if err := encoder.Encode(dp, units[i], nil); err != nil {
+			return nil, err
+		}
+	}
+
+	reader := xio.BlockReader{
+		SegmentReader: xio.NewSegmentReader(encoder.Discard()),
+		Start:         start,
+		BlockSize:     end.Sub(start),
+	}
+
+	replica := encoding.NewMultiReaderIterator(
+		m3tsz.DefaultReaderIteratorAllocFn(opts), nil)
+	replica.ResetSliceOfSlices(xio.NewReaderSliceOfSlicesFromBlockReadersIterator(
+		[][]xio.BlockReader{{reader}}), nil)
+
+	return encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.BytesID(id),
+		Namespace:      ident.BytesID(append([]byte(nil), namespace...)),
+		Tags:           storage.TagsToIdentTagIterator(tags),
+		Replicas:       []encoding.MultiReaderIterator{replica},
+		StartInclusive: start,
+		EndExclusive:   end,
+	}, nil), nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package m3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/dbnode/encoding"
+	"github.com/m3db/m3/src/dbnode/encoding/m3tsz"
+	"github.com/m3db/m3/src/dbnode/ts"
+	"github.com/m3db/m3/src/dbnode/x/xio"
+	"github.com/m3db/m3/src/query/block"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/query/models"
+	"github.com/m3db/m3/src/query/storage"
+	"github.com/m3db/m3/src/query/storage/m3/consolidators"
+	"github.com/m3db/m3/src/x/checked"
+	"github.com/m3db/m3/src/x/ident"
+	xtime "github.com/m3db/m3/src/x/time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAnnotatedSeriesIterator(
+	t *testing.T,
+	tags models.Tags,
+	start xtime.UnixNano,
+	end xtime.UnixNano,
+	datapoints []ts.Datapoint,
+	annotations []ts.Annotation,
+) encoding.SeriesIterator {
+	opts := encoding.NewOptions()
+	encoder := m3tsz.NewEncoder(start, checked.NewBytes(nil, nil), true, opts)
+	for i, dp := range datapoints {
+		require.NoError(t, encoder.Encode(dp, xtime.Second, annotations[i]))
+	}
+
+	reader := xio.BlockReader{
+		SegmentReader: xio.NewSegmentReader(encoder.Discard()),
+		Start:         start,
+		BlockSize:     end.Sub(start),
+	}
+
+	replica := encoding.NewMultiReaderIterator(
+		m3tsz.DefaultReaderIteratorAllocFn(opts), nil)
+	replica.ResetSliceOfSlices(xio.NewReaderSliceOfSlicesFromBlockReadersIterator(
+		[][]xio.BlockReader{{reader}}), nil)
+
+	return encoding.NewSeriesIterator(encoding.SeriesIteratorOptions{
+		ID:             ident.BytesID(tags.ID()),
+		Namespace:      ident.StringID("metrics"),
+		Tags:           storage.TagsToIdentTagIterator(tags),
+		Replicas:       []encoding.MultiReaderIterator{replica},
+		StartInclusive: start,
+		EndExclusive:   end,
+	}, nil)
+}
+
+func TestExpandNativeHistograms(t *testing.T) {
+	var (
+		tagOpts = models.NewTagOptions().SetIDSchemeType(models.TypeQuoted)
+		start   = xtime.Now().Truncate(time.Hour)
+		end     = start.Add(time.Hour)
+		first   = start.Add(time.Minute)
+		second  = start.Add(2 * time.Minute)
+	)
+
+	floatTags := models.NewTags(2, tagOpts).SetName([]byte("up")).
+		AddTag(models.Tag{Name: []byte("job"), Value: []byte("test")})
+	floatIter := newTestAnnotatedSeriesIterator(t, floatTags, start, end,
+		[]ts.Datapoint{
+			{TimestampNanos: first, Value: 1},
+			{TimestampNanos: second, Value: 0},
+		}, []ts.Annotation{nil, nil})
+
+	var (
+		datapoints  []ts.Datapoint
+		annotations []ts.Annotation
+	)
+	for _, h := range []prompb.Histogram{
+		{
+			CountInt:       4,
+			Sum:            3.5,
+			PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 2}},
+			PositiveDeltas: []int64{1, 2},
+			Timestamp:      first.ToNormalizedTime(time.Millisecond),
+		},
+		{
+			CountInt:       5,
+			Sum:            6,
+			PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 2}},
+			PositiveDeltas: []int64{2, 1},
+			Timestamp:      second.ToNormalizedTime(time.Millisecond),
+		},
+	} {
+		dp, ant, err := storage.PromHistogramToM3Datapoint(h)
+		require.NoError(t, err)
+		datapoints = append(datapoints, ts.Datapoint{
+			TimestampNanos: dp.Timestamp,
+			Value:          dp.Value,
+		})
+		annotations = append(annotations, ant)
+	}
+
+	histogramTags := models.NewTags(1, tagOpts).SetName([]byte("latency"))
+	histogramIter := newTestAnnotatedSeriesIterator(t, histogramTags, start,
+		end, datapoints, annotations)
+
+	result, err := consolidators.NewSeriesFetchResult(
+		encoding.NewSeriesIterators(
+			[]encoding.SeriesIterator{floatIter, histogramIter}, nil),
+		nil, block.NewResultMetadata())
+	require.NoError(t, err)
+
+	expanded, err := expandNativeHistograms(result, tagOpts)
+	require.NoError(t, err)
+	defer func() {
+		for _, iter := range expanded.SeriesIterators() {
+			iter.Close()
+		}
+
+		floatIter.Close()
+		histogramIter.Close()
+	}()
+
+	var (
+		actual      = make(map[string][]float64, expanded.Count())
+		passthrough int
+	)
+	for i := 0; i < expanded.Count(); i++ {
+		iter, tags, err := expanded.IterTagsAtIndex(i, tagOpts)
+		require.NoError(t, err)
+		assert.Equal(t, string(tags.ID()), iter.ID().String())
+		if peeked, ok := iter.(*peekedSeriesIterator); ok {
+			assert.Equal(t, floatIter, peeked.SeriesIterator)
+			passthrough++
+		}
+
+		var values []float64
+		for iter.Next() {
+			dp, _, ant := iter.Current()
+			assert.Nil(t, ant)
+			values = append(values, dp.Value)
+		}
+
+		require.NoError(t, iter.Err())
+		actual[string(tags.ID())] = values
+	}
+
+	expected := map[string][]float64{
+		`{__name__="up",job="test"}`:                              {1, 0},
+		`{__name__="latency",le="1"}`:                             {1, 2},
+		`{__name__="latency",le="2"}`:                             {4, 5},
+		`{__name__="latency",le="+Inf"}`:                          {4, 5},
+		`{__m3_native_histogram_sum__="true",__name__="latency"}`: {3.5, 6},
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, 1, passthrough)
+}
@@ -235,13 +235,33 @@ func (s *m3storage) FetchCompressed(
 		span.Finish()
 	}
 
+	cleanup := accumulator.Close
+	if s.opts.ExpandNativeHistograms() {
+		expanded, err := expandNativeHistograms(result, s.opts.TagOptions())
+		if err != nil {
+			accumulator.Close()
+			return consolidators.SeriesFetchResult{
+				Metadata: block.NewResultMetadata(),
+			}, noop, err
+		}
+
+		result = expanded
+		cleanup = func() error {
+			for _, iter := range result.SeriesIterators() {
+				iter.Close()
+			}
+
+			return accumulator.Close()
+		}
+	}
+
 	resolutions := make([]time.Duration, 0, len(attrs))
 	for _, attr := range attrs {
 		resolutions = append(resolutions, attr.Resolution)
 	}
 
 	result.Metadata.Resolutions = resolutions
-	return result, accumulator.Close, nil
+	return result, cleanup, nil
 }
 
 // fetches compressed series, returning a MultiFetchResult accumulator
@@ -0,0 +1,403 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metricmetadata
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	xerrors "github.com/m3db/m3/src/x/errors"
+	"github.com/m3db/m3/src/x/instrument"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultKeyPrefix is the default prefix of the KV keys metadata is
+	// persisted under.
+	DefaultKeyPrefix = "_prometheus.metric_metadata"
+	// DefaultNumShards is the default number of KV keys metadata is spread
+	// across by metric family name.
+	DefaultNumShards = 32
+	// DefaultMaxMetrics is the default maximum number of metric families
+	// metadata is kept for.
+	DefaultMaxMetrics = 50000
+	// DefaultFlushInterval is the default interval metadata is synced with
+	// the KV store.
+	DefaultFlushInterval = time.Minute
+
+	// maxMetadataPerMetric bounds the distinct metadata kept for a single
+	// metric family, which can differ between scrape targets.
+	maxMetadataPerMetric = 10
+)
+
+var errStoreClosed = errors.New("metric metadata store closed")
+
+type storeMetrics struct {
+	updates       tally.Counter
+	dropped       tally.Counter
+	persistErrors tally.Counter
+	loadErrors    tally.Counter
+	metrics       tally.Gauge
+}
+
+func newStoreMetrics(scope tally.Scope) storeMetrics {
+	return storeMetrics{
+		updates:       scope.Counter("updates"),
+		dropped:       scope.Counter("dropped"),
+		persistErrors: scope.Counter("persist-errors"),
+		loadErrors:    scope.Counter("load-errors"),
+		metrics:       scope.Gauge("metrics"),
+	}
+}
+
+// kvShard is the metadata of the metric families persisted under one KV key.
+type kvShard struct {
+	key      string
+	metadata map[string][]Metadata
+	dirty    bool
+}
+
+type store struct {
+	sync.RWMutex
+
+	kvStoreFn     KVStoreFn
+	maxMetrics    int
+	flushInterval time.Duration
+	logger        *zap.Logger
+	metrics       storeMetrics
+
+	kvStore    kv.Store
+	shards     []*kvShard
+	numMetrics int
+	closed     bool
+	closeCh    chan struct{}
+	doneCh     chan struct{}
+}
+
+// NewStore returns a new metadata store which, if a KV store is configured,
+// loads previously persisted metadata and periodically persists updates.
+// Metadata is spread across several KV keys by metric family name so that
+// each sync only rewrites the keys of updated metric families.
+func NewStore(opts StoreOptions) Store {
+	if opts.KeyPrefix == "" {
+		opts.KeyPrefix = DefaultKeyPrefix
+	}
+	if opts.NumShards <= 0 {
+		opts.NumShards = DefaultNumShards
+	}
+	if opts.MaxMetrics <= 0 {
+		opts.MaxMetrics = DefaultMaxMetrics
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultFlushInterval
+	}
+	if opts.InstrumentOptions == nil {
+		opts.InstrumentOptions = instrument.NewOptions()
+	}
+
+	shards := make([]*kvShard, 0, opts.NumShards)
+	for i := 0; i < opts.NumShards; i++ {
+		shards = append(shards, &kvShard{
+			key:      fmt.Sprintf("%s/%d", opts.KeyPrefix, i),
+			metadata: make(map[string][]Metadata),
+		})
+	}
+
+	s := &store{
+		kvStoreFn:     opts.KVStoreFn,
+		maxMetrics:    opts.MaxMetrics,
+		flushInterval: opts.FlushInterval,
+		logger:        opts.InstrumentOptions.Logger(),
+		metrics:       newStoreMetrics(opts.InstrumentOptions.MetricsScope()),
+		shards:        shards,
+		closeCh:       make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+
+	if s.kvStoreFn == nil {
+		close(s.doneCh)
+		return s
+	}
+
+	go s.run()
+	return s
+}
+
+func (s *store) Update(metadata []prompb.MetricMetadata) {
+	if len(metadata) == 0 {
+		return
+	}
+
+	s.Lock()
+	s.updateWithLock(metadata, true)
+	s.metrics.metrics.Update(float64(s.numMetrics))
+	s.Unlock()
+
+	s.metrics.updates.Inc(int64(len(metadata)))
+}
+
+func (s *store) shard(name string) *kvShard {
+	return s.shards[xxhash.Sum64String(name)%uint64(len(s.shards))]
+}
+
+// updateWithLock merges the metadata into the store, marking the shards
+// anything new was added to as dirty if markDirty is set. Metadata of new
+// metric families is dropped once the store holds the maximum number of
+// metric families.
+func (s *store) updateWithLock(metadata []prompb.MetricMetadata, markDirty bool) {
+	for _, m := range metadata {
+		if m.MetricFamilyName == "" {
+			continue
+		}
+
+		var (
+			name     = m.MetricFamilyName
+			shard    = s.shard(name)
+			existing = shard.metadata[name]
+			entry    = Metadata{
+				Type: metricTypeName(m.Type),
+				Help: m.Help,
+				Unit: m.Unit,
+			}
+			found bool
+		)
+		for _, e := range existing {
+			if e == entry {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		if len(existing) == 0 {
+			if s.numMetrics >= s.maxMetrics {
+				s.metrics.dropped.Inc(1)
+				continue
+			}
+			s.numMetrics++
+		}
+		if len(existing) >= maxMetadataPerMetric {
+			// Evict the oldest metadata of the metric family.
+			existing = append(existing[:0], existing[1:]...)
+		}
+		shard.metadata[name] = append(existing, entry)
+		if markDirty {
+			shard.dirty = true
+		}
+	}
+}
+
+func (s *store) Metadata(metric string, limit int) map[string][]Metadata {
+	s.RLock()
+	defer s.RUnlock()
+
+	if metric != "" {
+		result := make(map[string][]Metadata, 1)
+		if entries, ok := s.shard(metric).metadata[metric]; ok {
+			result[metric] = append([]Metadata(nil), entries...)
+		}
+		return result
+	}
+
+	names := make([]string, 0, s.numMetrics)
+	for _, shard := range s.shards {
+		for name := range shard.metadata {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if limit > 0 && len(names) > limit {
+		names = names[:limit]
+	}
+
+	result := make(map[string][]Metadata, len(names))
+	for _, name := range names {
+		result[name] = append([]Metadata(nil), s.shard(name).metadata[name]...)
+	}
+	return result
+}
+
+func (s *store) Close() error {
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return errStoreClosed
+	}
+	s.closed = true
+	close(s.closeCh)
+	s.Unlock()
+
+	<-s.doneCh
+	return nil
+}
+
+func (s *store) run() {
+	defer close(s.doneCh)
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.sync(); err != nil {
+			s.logger.Warn("unable to sync metric metadata", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-s.closeCh:
+			if err := s.sync(); err != nil {
+				s.logger.Error("unable to persist metric metadata on close",
+					zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// sync merges the persisted metadata into the store, picking up metadata
+// persisted before a restart or by other coordinators, then persists the
+// shards that contain anything not yet persisted.
+func (s *store) sync() error {
+	if s.kvStore == nil {
+		kvStore, err := s.kvStoreFn()
+		if err != nil {
+			return err
+		}
+		s.kvStore = kvStore
+	}
+
+	multiErr := xerrors.NewMultiError()
+	for _, shard := range s.shards {
+		if err := s.syncShard(shard); err != nil {
+			multiErr = multiErr.Add(err)
+		}
+	}
+	return multiErr.FinalError()
+}
+
+func (s *store) syncShard(shard *kvShard) error {
+	var (
+		persisted prompb.WriteRequest
+		version   int
+	)
+	value, err := s.kvStore.Get(shard.key)
+	switch {
+	case err == kv.ErrNotFound:
+	case err != nil:
+		s.metrics.loadErrors.Inc(1)
+		return err
+	default:
+		if err := value.Unmarshal(&persisted); err != nil {
+			s.metrics.loadErrors.Inc(1)
+			return err
+		}
+		version = value.Version()
+	}
+
+	s.Lock()
+	s.updateWithLock(persisted.Metadata, false)
+	s.metrics.metrics.Update(float64(s.numMetrics))
+	if !shard.dirty {
+		s.Unlock()
+		return nil
+	}
+	req := writeRequest(shard.metadata)
+	shard.dirty = false
+	s.Unlock()
+
+	if version == 0 {
+		_, err = s.kvStore.SetIfNotExists(shard.key, req)
+	} else {
+		_, err = s.kvStore.CheckAndSet(shard.key, version, req)
+	}
+	if err != nil {
+		// Concurrent writers are reconciled on the next sync.
+		s.Lock()
+		shard.dirty = true
+		s.Unlock()
+		s.metrics.persistErrors.Inc(1)
+		return err
+	}
+
+	return nil
+}
+
+// writeRequest returns the metadata as a metadata-only remote write request,
+// the same form Prometheus sends metadata in.
+func writeRequest(metadata map[string][]Metadata) *prompb.WriteRequest {
+	names := make([]string, 0, len(metadata))
+	for name := range metadata {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	req := &prompb.WriteRequest{
+		Metadata: make([]prompb.MetricMetadata, 0, len(names)),
+	}
+	for _, name := range names {
+		for _, m := range metadata[name] {
+			req.Metadata = append(req.Metadata, prompb.MetricMetadata{
+				Type:             metricType(m.Type),
+				MetricFamilyName: name,
+				Help:             m.Help,
+				Unit:             m.Unit,
+			})
+		}
+	}
+	return req
+}
+
+var metricTypeNames = map[prompb.MetricType]string{
+	prompb.MetricType_UNKNOWN:         "unknown",
+	prompb.MetricType_COUNTER:         "counter",
+	prompb.MetricType_GAUGE:           "gauge",
+	prompb.MetricType_HISTOGRAM:       "histogram",
+	prompb.MetricType_GAUGE_HISTOGRAM: "gaugehistogram",
+	prompb.MetricType_SUMMARY:         "summary",
+	prompb.MetricType_INFO:            "info",
+	prompb.MetricType_STATESET:        "stateset",
+}
+
+func metricTypeName(t prompb.MetricType) string {
+	if name, ok := metricTypeNames[t]; ok {
+		return name
+	}
+	return metricTypeNames[prompb.MetricType_UNKNOWN]
+}
+
+func metricType(name string) prompb.MetricType {
+	for t, n := range metricTypeNames {
+		if n == name {
+			return t
+		}
+	}
+	return prompb.MetricType_UNKNOWN
+}
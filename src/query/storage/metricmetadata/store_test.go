@@ -0,0 +1,215 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metricmetadata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/cluster/kv/mem"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(kvStore kv.Store) Store {
+	opts := StoreOptions{FlushInterval: time.Hour}
+	if kvStore != nil {
+		opts.KVStoreFn = func() (kv.Store, error) {
+			return kvStore, nil
+		}
+	}
+	return NewStore(opts)
+}
+
+func TestStoreUpdateAndMetadata(t *testing.T) {
+	store := newTestStore(nil)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	store.Update([]prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "http_requests_total",
+			Help:             "Total HTTP requests.",
+		},
+		{
+			Type:             prompb.MetricType_GAUGE_HISTOGRAM,
+			MetricFamilyName: "request_size",
+			Unit:             "bytes",
+		},
+		{
+			Type: prompb.MetricType_GAUGE,
+			Help: "Dropped since it has no metric family name.",
+		},
+	})
+
+	assert.Equal(t, map[string][]Metadata{
+		"http_requests_total": {{Type: "counter", Help: "Total HTTP requests."}},
+		"request_size":        {{Type: "gaugehistogram", Unit: "bytes"}},
+	}, store.Metadata("", 0))
+
+	assert.Equal(t, map[string][]Metadata{
+		"request_size": {{Type: "gaugehistogram", Unit: "bytes"}},
+	}, store.Metadata("request_size", 0))
+
+	assert.Equal(t, map[string][]Metadata{}, store.Metadata("unknown", 0))
+
+	assert.Equal(t, map[string][]Metadata{
+		"http_requests_total": {{Type: "counter", Help: "Total HTTP requests."}},
+	}, store.Metadata("", 1))
+
+	// Differing metadata for the same metric family is kept.
+	store.Update([]prompb.MetricMetadata{{
+		Type:             prompb.MetricType_GAUGE,
+		MetricFamilyName: "http_requests_total",
+	}})
+	assert.Equal(t, []Metadata{
+		{Type: "counter", Help: "Total HTTP requests."},
+		{Type: "gauge"},
+	}, store.Metadata("http_requests_total", 0)["http_requests_total"])
+}
+
+func TestStorePersistsAcrossRestarts(t *testing.T) {
+	kvStore := mem.NewStore()
+
+	first := newTestStore(kvStore)
+	first.Update([]prompb.MetricMetadata{{
+		Type:             prompb.MetricType_SUMMARY,
+		MetricFamilyName: "rpc_duration_seconds",
+		Help:             "RPC latency.",
+		Unit:             "seconds",
+	}})
+	require.NoError(t, first.Close())
+
+	second := newTestStore(kvStore)
+	second.Update([]prompb.MetricMetadata{{
+		Type:             prompb.MetricType_COUNTER,
+		MetricFamilyName: "rpc_total",
+	}})
+	require.NoError(t, second.Close())
+
+	expected := map[string][]Metadata{
+		"rpc_duration_seconds": {{Type: "summary", Help: "RPC latency.", Unit: "seconds"}},
+		"rpc_total":            {{Type: "counter"}},
+	}
+	assert.Equal(t, expected, second.Metadata("", 0))
+
+	third := newTestStore(kvStore)
+	require.NoError(t, third.Close())
+	assert.Equal(t, expected, third.Metadata("", 0))
+
+	for _, m := range []prompb.MetricMetadata{
+		{
+			Type:             prompb.MetricType_SUMMARY,
+			MetricFamilyName: "rpc_duration_seconds",
+			Help:             "RPC latency.",
+			Unit:             "seconds",
+		},
+		{
+			Type:             prompb.MetricType_COUNTER,
+			MetricFamilyName: "rpc_total",
+		},
+	} {
+		value, err := kvStore.Get(third.(*store).shard(m.MetricFamilyName).key)
+		require.NoError(t, err)
+		var persisted prompb.WriteRequest
+		require.NoError(t, value.Unmarshal(&persisted))
+		assert.Contains(t, persisted.Metadata, m)
+	}
+}
+
+func TestStoreOnlyPersistsUpdatedShards(t *testing.T) {
+	kvStore := mem.NewStore()
+
+	metadataStore := newTestStore(kvStore)
+	metadataStore.Update([]prompb.MetricMetadata{{
+		Type:             prompb.MetricType_GAUGE,
+		MetricFamilyName: "queue_size",
+	}})
+	require.NoError(t, metadataStore.Close())
+
+	s := metadataStore.(*store)
+	updated := s.shard("queue_size")
+	for _, shard := range s.shards {
+		_, err := kvStore.Get(shard.key)
+		if shard == updated {
+			require.NoError(t, err)
+			continue
+		}
+		require.Equal(t, kv.ErrNotFound, err)
+	}
+}
+
+func TestStoreDropsMetricsOverMax(t *testing.T) {
+	store := NewStore(StoreOptions{MaxMetrics: 2})
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	store.Update([]prompb.MetricMetadata{
+		{MetricFamilyName: "a", Type: prompb.MetricType_GAUGE},
+		{MetricFamilyName: "b", Type: prompb.MetricType_GAUGE},
+		{MetricFamilyName: "c", Type: prompb.MetricType_GAUGE},
+	})
+	// Metric families already in the store are still updated.
+	store.Update([]prompb.MetricMetadata{
+		{MetricFamilyName: "a", Type: prompb.MetricType_COUNTER},
+	})
+
+	assert.Equal(t, map[string][]Metadata{
+		"a": {{Type: "gauge"}, {Type: "counter"}},
+		"b": {{Type: "gauge"}},
+	}, store.Metadata("", 0))
+}
+
+func TestStoreEvictsOldestMetadataOfMetric(t *testing.T) {
+	store := newTestStore(nil)
+	defer func() {
+		require.NoError(t, store.Close())
+	}()
+
+	for i := 0; i < maxMetadataPerMetric+1; i++ {
+		store.Update([]prompb.MetricMetadata{{
+			MetricFamilyName: "foo",
+			Help:             string(rune('a' + i)),
+		}})
+	}
+
+	entries := store.Metadata("foo", 0)["foo"]
+	require.Len(t, entries, maxMetadataPerMetric)
+	assert.Equal(t, "b", entries[0].Help)
+	assert.Equal(t, string(rune('a'+maxMetadataPerMetric)), entries[len(entries)-1].Help)
+}
+
+func TestStoreCloseTwice(t *testing.T) {
+	store := newTestStore(nil)
+	require.NoError(t, store.Close())
+	require.Error(t, store.Close())
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metricmetadata provides storage of Prometheus metric metadata.
+package metricmetadata
+
+import (
+	"time"
+
+	"github.com/m3db/m3/src/cluster/kv"
+	"github.com/m3db/m3/src/query/generated/proto/prompb"
+	"github.com/m3db/m3/src/x/instrument"
+)
+
+// Store stores the type, help and unit metadata of metric families as
+// received from Prometheus remote write requests.
+type Store interface {
+	// Update records the metadata of the given metric families.
+	Update(metadata []prompb.MetricMetadata)
+	// Metadata returns the metadata of metric families keyed by metric family
+	// name. If metric is non-empty only that metric family is returned and if
+	// limit is positive at most limit metric families are returned.
+	Metadata(metric string, limit int) map[string][]Metadata
+	// Close stops the store, persisting any pending updates.
+	Close() error
+}
+
+// Metadata is the metadata of a metric family.
+type Metadata struct {
+	// Type is the metric type, as named by the Prometheus API.
+	Type string `json:"type"`
+	// Help is the help text of the metric family.
+	Help string `json:"help"`
+	// Unit is the unit of the metric family.
+	Unit string `json:"unit"`
+}
+
+// KVStoreFn returns the KV store used to persist metadata.
+type KVStoreFn func() (kv.Store, error)
+
+// StoreOptions are the options for a metadata store.
+type StoreOptions struct {
+	// KVStoreFn returns the KV store metadata is persisted to, it is retried
+	// until it succeeds. If nil metadata is only held in memory.
+	KVStoreFn KVStoreFn
+	// KeyPrefix is the prefix of the KV keys metadata is persisted under.
+	KeyPrefix string
+	// NumShards is the number of KV keys metadata is spread across.
+	NumShards int
+	// MaxMetrics is the maximum number of metric families metadata is kept
+	// for, metadata of further metric families is dropped.
+	MaxMetrics int
+	// FlushInterval is how often metadata is synced with the KV store.
+	FlushInterval time.Duration
+	// InstrumentOptions are the instrument options.
+	InstrumentOptions instrument.Options
+}
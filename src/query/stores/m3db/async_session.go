@@ -212,6 +212,35 @@ func (s *AsyncSession) Aggregate(
 	return s.session.Aggregate(ctx, namespace, q, opts)
 }
 
+// IndexStats returns the cardinality of the series indexed for the namespace.
+func (s *AsyncSession) IndexStats(
+	namespace ident.ID,
+	opts client.IndexStatsOptions,
+) (index.CardinalityStatsSummary, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return index.CardinalityStatsSummary{}, s.err
+	}
+
+	return s.session.IndexStats(namespace, opts)
+}
+
+// DeleteSeries deletes the data of the series matching the query.
+func (s *AsyncSession) DeleteSeries(
+	namespace ident.ID,
+	q index.Query,
+	start, end xtime.UnixNano,
+) (int64, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	return s.session.DeleteSeries(namespace, q, start, end)
+}
+
 // ShardID returns the given shard for an ID for callers
 // to easily discern what shard is failing when operations
 // for given IDs begin failing.
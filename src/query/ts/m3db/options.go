@@ -63,6 +63,7 @@ type encodedBlockOptions struct {
 	blockSeriesProcessor          BlockSeriesProcessor
 	adminOptions                  []client.CustomAdminOption
 	instrumented                  bool
+	expandNativeHistograms        bool
 }
 
 // NewOptions creates a default encoded block options which dictates how
@@ -253,6 +254,16 @@ func (o *encodedBlockOptions) Instrumented() bool {
 	return o.instrumented
 }
 
+func (o *encodedBlockOptions) SetExpandNativeHistograms(v bool) Options {
+	opts := *o
+	opts.expandNativeHistograms = v
+	return &opts
+}
+
+func (o *encodedBlockOptions) ExpandNativeHistograms() bool {
+	return o.expandNativeHistograms
+}
+
 func (o *encodedBlockOptions) Validate() error {
 	if o.lookbackDuration < 0 {
 		return errors.New("unable to validate block options; negative lookback")
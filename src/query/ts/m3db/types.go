@@ -102,6 +102,12 @@ type Options interface {
 	SetInstrumented(bool) Options
 	// Instrumented returns if the encoding step should have instrumentation enabled.
 	Instrumented() bool
+	// SetExpandNativeHistograms sets whether fetched series holding native
+	// histogram samples are expanded into classic histogram series.
+	SetExpandNativeHistograms(bool) Options
+	// ExpandNativeHistograms returns whether fetched series holding native
+	// histogram samples are expanded into classic histogram series.
+	ExpandNativeHistograms() bool
 	// Validate ensures that the given block options are valid.
 	Validate() error
 }
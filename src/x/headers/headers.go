@@ -52,6 +52,23 @@ const (
 	// field `headerToMetricType`)
 	PromTypeHeader = "Prometheus-Metric-Type"
 
+	// PrometheusRemoteWriteVersionHeader is the header Prometheus remote
+	// write clients set with the version of the protocol of a request.
+	PrometheusRemoteWriteVersionHeader = "X-Prometheus-Remote-Write-Version"
+
+	// PrometheusRemoteWriteSamplesWrittenHeader is the header responding to
+	// remote write 2.0 requests with the number of samples written.
+	PrometheusRemoteWriteSamplesWrittenHeader = "X-Prometheus-Remote-Write-Samples-Written"
+
+	// PrometheusRemoteWriteHistogramsWrittenHeader is the header responding
+	// to remote write 2.0 requests with the number of native histogram
+	// samples written.
+	PrometheusRemoteWriteHistogramsWrittenHeader = "X-Prometheus-Remote-Write-Histograms-Written"
+
+	// PrometheusRemoteWriteExemplarsWrittenHeader is the header responding to
+	// remote write 2.0 requests with the number of exemplars written.
+	PrometheusRemoteWriteExemplarsWrittenHeader = "X-Prometheus-Remote-Write-Exemplars-Written"
+
 	// WriteTypeHeader is a header that controls if default
 	// writes should be written to both unaggregated and aggregated
 	// namespaces, or if unaggregated values are skipped and
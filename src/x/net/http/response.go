@@ -34,6 +34,9 @@ const (
 	// HeaderContentType is the HTTP Content Type header.
 	HeaderContentType = "Content-Type"
 
+	// HeaderContentEncoding is the HTTP Content Encoding header.
+	HeaderContentEncoding = "Content-Encoding"
+
 	// ContentTypeJSON is the Content-Type value for a JSON response.
 	ContentTypeJSON = "application/json"
 
@@ -48,6 +51,15 @@ const (
 
 	// ContentTypeOctetStream is the Content-Type value for binary data.
 	ContentTypeOctetStream = "application/octet-stream"
+
+	// ContentTypeCSV is the Content-Type value for a CSV response.
+	ContentTypeCSV = "text/csv"
+
+	// ContentTypePlainTextUTF8 is the Content-Type value for UTF8-encoded plain text.
+	ContentTypePlainTextUTF8 = "text/plain; charset=utf-8"
+
+	// ContentTypeMsgpack is the Content-Type value for a msgpack response.
+	ContentTypeMsgpack = "application/x-msgpack"
 )
 
 // WriteJSONResponse writes generic data to the ResponseWriter